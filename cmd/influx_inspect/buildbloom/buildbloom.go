@@ -0,0 +1,92 @@
+// Package buildbloom writes the bloom filters of TSM files which do not have one.
+package buildbloom
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/influxdata/influxdb/tsdb/engine/tsm1"
+)
+
+// Command represents the program execution for "influx_inspect buildbloom".
+type Command struct {
+	Stderr io.Writer
+	Stdout io.Writer
+}
+
+// NewCommand returns a new instance of Command.
+func NewCommand() *Command {
+	return &Command{
+		Stderr: os.Stderr,
+		Stdout: os.Stdout,
+	}
+}
+
+// Run executes the command.
+func (cmd *Command) Run(args ...string) error {
+	var dataDir, coldDir string
+	var force bool
+	fs := flag.NewFlagSet("buildbloom", flag.ExitOnError)
+	fs.StringVar(&dataDir, "datadir", os.Getenv("HOME")+"/.influxdb/data", "Data storage path")
+	fs.StringVar(&coldDir, "colddir", "", "Optional: cold data storage path")
+	fs.BoolVar(&force, "force", false, "Rebuild existing bloom filters")
+
+	fs.SetOutput(cmd.Stdout)
+	fs.Usage = cmd.printUsage
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	dirs := []string{dataDir}
+	if coldDir != "" {
+		dirs = append(dirs, coldDir)
+	}
+
+	var filesN, builtN int
+	for _, dir := range dirs {
+		if err := filepath.Walk(dir, func(path string, fi os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			} else if fi.IsDir() || filepath.Ext(path) != "."+tsm1.TSMFileExtension {
+				return nil
+			}
+
+			filesN++
+			if ok, err := tsm1.BuildBloomFilter(path, force); err != nil {
+				return fmt.Errorf("%s: %s", path, err)
+			} else if ok {
+				builtN++
+				fmt.Fprintf(cmd.Stdout, "%s: built\n", path)
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+
+	fmt.Fprintf(cmd.Stdout, "Built bloom filters for %d of %d files\n", builtN, filesN)
+	return nil
+}
+
+// printUsage prints the usage message to STDERR.
+func (cmd *Command) printUsage() {
+	usage := fmt.Sprintf(`Writes the bloom filters of TSM files which do not have one.  Encrypted
+files are skipped.  influxd must not be running while the command runs.
+
+Usage: influx_inspect buildbloom [flags]
+
+    -datadir <path>
+            Data storage path.
+            Defaults to "%[1]s/.influxdb/data".
+    -colddir <path>
+            Cold data storage path, if any.
+    -force
+            Rebuild existing bloom filters.
+`, os.Getenv("HOME"))
+
+	fmt.Fprint(cmd.Stdout, usage)
+}
@@ -14,6 +14,7 @@ import (
 
 	"github.com/influxdata/influxdb/logger"
 	"github.com/influxdata/influxdb/models"
+	"github.com/influxdata/influxdb/pkg/keyring"
 	"github.com/influxdata/influxdb/tsdb"
 	"github.com/influxdata/influxdb/tsdb/engine/tsm1"
 	"github.com/influxdata/influxdb/tsdb/index/tsi1"
@@ -31,6 +32,7 @@ type Command struct {
 	retentionFilter string
 	shardFilter     string
 	maxLogFileSize  int64
+	keys            keyring.Provider
 }
 
 // NewCommand returns a new instance of Command.
@@ -52,6 +54,7 @@ func (cmd *Command) Run(args ...string) error {
 	fs.StringVar(&cmd.shardFilter, "shard", "", "optional: shard id")
 	fs.Int64Var(&cmd.maxLogFileSize, "max-log-file-size", tsdb.DefaultMaxIndexLogFileSize, "optional: maximum log file size")
 	fs.BoolVar(&cmd.Verbose, "v", false, "verbose")
+	keyringPath := fs.String("keyring", "", "optional: keyring used to decrypt encrypted files")
 	fs.SetOutput(cmd.Stdout)
 	if err := fs.Parse(args); err != nil {
 		return err
@@ -61,6 +64,14 @@ func (cmd *Command) Run(args ...string) error {
 	}
 	cmd.Logger = logger.New(cmd.Stderr)
 
+	if *keyringPath != "" {
+		keys, err := keyring.Open(*keyringPath)
+		if err != nil {
+			return err
+		}
+		cmd.keys = keys
+	}
+
 	return cmd.run(*dataDir, *walDir)
 }
 
@@ -211,6 +222,7 @@ func (cmd *Command) processShard(sfile *tsdb.SeriesFile, dbName, rpName string,
 	cmd.Logger.Info("building cache from wal files")
 	cache := tsm1.NewCache(tsdb.DefaultCacheMaxMemorySize, "")
 	loader := tsm1.NewCacheLoader(walPaths)
+	loader.Keys = cmd.keys
 	loader.WithLogger(cmd.Logger)
 	if err := loader.Load(cache); err != nil {
 		return err
@@ -253,7 +265,7 @@ func (cmd *Command) processTSMFile(index *tsi1.Index, path string) error {
 	}
 	defer f.Close()
 
-	r, err := tsm1.NewTSMReader(f)
+	r, err := tsm1.NewTSMReaderWithKeys(f, cmd.keys)
 	if err != nil {
 		cmd.Logger.Warn("unable to read, skipping", zap.String("path", path), zap.Error(err))
 		return nil
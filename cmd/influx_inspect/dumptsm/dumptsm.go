@@ -12,6 +12,7 @@ import (
 	"text/tabwriter"
 	"time"
 
+	"github.com/influxdata/influxdb/pkg/keyring"
 	"github.com/influxdata/influxdb/tsdb/engine/tsm1"
 )
 
@@ -26,6 +27,7 @@ type Command struct {
 	dumpAll    bool
 	filterKey  string
 	path       string
+	keys       keyring.Provider
 }
 
 // NewCommand returns a new instance of Command.
@@ -38,11 +40,13 @@ func NewCommand() *Command {
 
 // Run executes the command.
 func (cmd *Command) Run(args ...string) error {
+	var keyringPath string
 	fs := flag.NewFlagSet("file", flag.ExitOnError)
 	fs.BoolVar(&cmd.dumpIndex, "index", false, "Dump raw index data")
 	fs.BoolVar(&cmd.dumpBlocks, "blocks", false, "Dump raw block data")
 	fs.BoolVar(&cmd.dumpAll, "all", false, "Dump all data. Caution: This may print a lot of information")
 	fs.StringVar(&cmd.filterKey, "filter-key", "", "Only display index and block data match this key substring")
+	fs.StringVar(&keyringPath, "keyring", "", "Optional: the keyring used to decrypt encrypted files")
 
 	fs.SetOutput(cmd.Stdout)
 	fs.Usage = cmd.printUsage
@@ -51,6 +55,14 @@ func (cmd *Command) Run(args ...string) error {
 		return err
 	}
 
+	if keyringPath != "" {
+		keys, err := keyring.Open(keyringPath)
+		if err != nil {
+			return err
+		}
+		cmd.keys = keys
+	}
+
 	if fs.Arg(0) == "" {
 		fmt.Printf("TSM file not specified\n\n")
 		fs.Usage()
@@ -75,9 +87,8 @@ func (cmd *Command) dump() error {
 	if err != nil {
 		return err
 	}
-	b := make([]byte, 8)
 
-	r, err := tsm1.NewTSMReader(f)
+	r, err := tsm1.NewTSMReaderWithKeys(f, cmd.keys)
 	if err != nil {
 		return fmt.Errorf("Error opening TSM files: %s", err.Error())
 	}
@@ -147,14 +158,11 @@ func (cmd *Command) dump() error {
 	for j := 0; j < keyCount; j++ {
 		key, _ := r.KeyAt(j)
 		for _, e := range r.Entries(key) {
-
-			f.Seek(int64(e.Offset), 0)
-			f.Read(b[:4])
-
-			chksum := binary.BigEndian.Uint32(b[:4])
-
-			buf := make([]byte, e.Size-4)
-			f.Read(buf)
+			// Blocks of encrypted files are decrypted by the reader.
+			chksum, buf, err := r.ReadBytes(&e, nil)
+			if err != nil {
+				return err
+			}
 
 			blockSize += int64(e.Size)
 
@@ -169,7 +177,7 @@ func (cmd *Command) dump() error {
 			encoded := buf[1:]
 
 			var v []tsm1.Value
-			v, err := tsm1.DecodeBlock(buf, v)
+			v, err = tsm1.DecodeBlock(buf, v)
 			if err != nil {
 				return err
 			}
@@ -275,6 +283,8 @@ Usage: influx_inspect dumptsm [flags] <path
             Dump all data. Caution: This may print a lot of information
     -filter-key <name>
             Only display index and block data match this key substring
+    -keyring <path>
+            Keyring used to decrypt encrypted TSM files
 `
 
 	fmt.Fprintf(cmd.Stdout, usage)
@@ -291,7 +301,7 @@ var (
 		"none", "s8b", "rle",
 	}
 	floatEnc = []string{
-		"none", "gor",
+		"none", "gor", "dfl",
 	}
 	intEnc = []string{
 		"none", "s8b", "rle",
@@ -300,7 +310,7 @@ var (
 		"none", "bp",
 	}
 	stringEnc = []string{
-		"none", "snpy",
+		"none", "snpy", "dfl", "dict",
 	}
 	unsignedEnc = []string{
 		"none", "s8b", "rle",
@@ -18,6 +18,7 @@ import (
 
 	"github.com/influxdata/influxdb/models"
 	"github.com/influxdata/influxdb/pkg/escape"
+	"github.com/influxdata/influxdb/pkg/keyring"
 	"github.com/influxdata/influxdb/tsdb/engine/tsm1"
 	"github.com/influxdata/influxql"
 )
@@ -36,6 +37,7 @@ type Command struct {
 	startTime       int64
 	endTime         int64
 	compress        bool
+	keys            keyring.Provider
 
 	manifest map[string]struct{}
 	tsmFiles map[string][]string
@@ -56,7 +58,7 @@ func NewCommand() *Command {
 
 // Run executes the command.
 func (cmd *Command) Run(args ...string) error {
-	var start, end string
+	var start, end, keyringPath string
 	fs := flag.NewFlagSet("export", flag.ExitOnError)
 	fs.StringVar(&cmd.dataDir, "datadir", os.Getenv("HOME")+"/.influxdb/data", "Data storage path")
 	fs.StringVar(&cmd.walDir, "waldir", os.Getenv("HOME")+"/.influxdb/wal", "WAL storage path")
@@ -66,6 +68,7 @@ func (cmd *Command) Run(args ...string) error {
 	fs.StringVar(&start, "start", "", "Optional: the start time to export (RFC3339 format)")
 	fs.StringVar(&end, "end", "", "Optional: the end time to export (RFC3339 format)")
 	fs.BoolVar(&cmd.compress, "compress", false, "Compress the output")
+	fs.StringVar(&keyringPath, "keyring", "", "Optional: the keyring used to decrypt encrypted files")
 
 	fs.SetOutput(cmd.Stdout)
 	fs.Usage = func() {
@@ -103,6 +106,14 @@ func (cmd *Command) Run(args ...string) error {
 		return err
 	}
 
+	if keyringPath != "" {
+		keys, err := keyring.Open(keyringPath)
+		if err != nil {
+			return err
+		}
+		cmd.keys = keys
+	}
+
 	return cmd.export()
 }
 
@@ -269,7 +280,7 @@ func (cmd *Command) exportTSMFile(tsmFilePath string, w io.Writer) error {
 	}
 	defer f.Close()
 
-	r, err := tsm1.NewTSMReader(f)
+	r, err := tsm1.NewTSMReaderWithKeys(f, cmd.keys)
 	if err != nil {
 		fmt.Fprintf(cmd.Stderr, "unable to read %s, skipping: %s\n", tsmFilePath, err.Error())
 		return nil
@@ -337,7 +348,7 @@ func (cmd *Command) exportWALFile(walFilePath string, w io.Writer, warnDelete fu
 	}
 	defer f.Close()
 
-	r := tsm1.NewWALSegmentReader(f)
+	r := tsm1.NewWALSegmentReaderWithKeys(f, cmd.keys)
 	defer r.Close()
 
 	for r.Next() {
@@ -31,12 +31,14 @@ Usage: influx_inspect [[command] [arguments]]
 
 The commands are:
 
+    buildbloom           builds missing bloom filters of tsm1 files
     dumptsi              dumps low-level details about tsi1 files.
     dumptsm              dumps low-level details about tsm1 files.
     export               exports raw data from a shard to line protocol
     buildtsi.            generates tsi1 indexes from tsm1 data
     help                 display this help message
     report               displays a shard level report
+    rotatekeys           re-encrypts data keys with the active keyring key
     verify               verifies integrity of TSM files
 
 "help" is the default command.
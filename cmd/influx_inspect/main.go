@@ -8,12 +8,14 @@ import (
 	"os"
 
 	"github.com/influxdata/influxdb/cmd"
+	"github.com/influxdata/influxdb/cmd/influx_inspect/buildbloom"
 	"github.com/influxdata/influxdb/cmd/influx_inspect/buildtsi"
 	"github.com/influxdata/influxdb/cmd/influx_inspect/dumptsi"
 	"github.com/influxdata/influxdb/cmd/influx_inspect/dumptsm"
 	"github.com/influxdata/influxdb/cmd/influx_inspect/export"
 	"github.com/influxdata/influxdb/cmd/influx_inspect/help"
 	"github.com/influxdata/influxdb/cmd/influx_inspect/report"
+	"github.com/influxdata/influxdb/cmd/influx_inspect/rotatekeys"
 	"github.com/influxdata/influxdb/cmd/influx_inspect/verify"
 	_ "github.com/influxdata/influxdb/tsdb/engine"
 )
@@ -73,6 +75,11 @@ func (m *Main) Run(args ...string) error {
 		if err := name.Run(args...); err != nil {
 			return fmt.Errorf("export: %s", err)
 		}
+	case "buildbloom":
+		name := buildbloom.NewCommand()
+		if err := name.Run(args...); err != nil {
+			return fmt.Errorf("buildbloom: %s", err)
+		}
 	case "buildtsi":
 		name := buildtsi.NewCommand()
 		if err := name.Run(args...); err != nil {
@@ -83,6 +90,11 @@ func (m *Main) Run(args ...string) error {
 		if err := name.Run(args...); err != nil {
 			return fmt.Errorf("report: %s", err)
 		}
+	case "rotatekeys":
+		name := rotatekeys.NewCommand()
+		if err := name.Run(args...); err != nil {
+			return fmt.Errorf("rotatekeys: %s", err)
+		}
 	case "verify":
 		name := verify.NewCommand()
 		if err := name.Run(args...); err != nil {
@@ -15,6 +15,7 @@ import (
 	"time"
 
 	"github.com/influxdata/influxdb/models"
+	"github.com/influxdata/influxdb/pkg/keyring"
 	"github.com/influxdata/influxdb/tsdb/engine/tsm1"
 	"github.com/retailnext/hllpp"
 )
@@ -27,6 +28,7 @@ type Command struct {
 	dir             string
 	pattern         string
 	detailed, exact bool
+	keys            keyring.Provider
 }
 
 // NewCommand returns a new instance of Command.
@@ -39,10 +41,12 @@ func NewCommand() *Command {
 
 // Run executes the command.
 func (cmd *Command) Run(args ...string) error {
+	var keyringPath string
 	fs := flag.NewFlagSet("report", flag.ExitOnError)
 	fs.StringVar(&cmd.pattern, "pattern", "", "Include only files matching a pattern")
 	fs.BoolVar(&cmd.detailed, "detailed", false, "Report detailed cardinality estimates")
 	fs.BoolVar(&cmd.exact, "exact", false, "Report exact counts")
+	fs.StringVar(&keyringPath, "keyring", "", "Optional: the keyring used to decrypt encrypted files")
 
 	fs.SetOutput(cmd.Stdout)
 	fs.Usage = cmd.printUsage
@@ -51,6 +55,14 @@ func (cmd *Command) Run(args ...string) error {
 		return err
 	}
 
+	if keyringPath != "" {
+		keys, err := keyring.Open(keyringPath)
+		if err != nil {
+			return err
+		}
+		cmd.keys = keys
+	}
+
 	newCounterFn := newHLLCounter
 	estTitle := " (est)"
 	if cmd.exact {
@@ -91,7 +103,7 @@ func (cmd *Command) Run(args ...string) error {
 		}
 
 		loadStart := time.Now()
-		reader, err := tsm1.NewTSMReader(file)
+		reader, err := tsm1.NewTSMReaderWithKeys(file, cmd.keys)
 		if err != nil {
 			fmt.Fprintf(cmd.Stderr, "error: %s: %v. Skipping.\n", file.Name(), err)
 			return nil
@@ -290,6 +302,8 @@ Usage: influx_inspect report [flags]
     -detailed
             Report detailed cardinality estimates.
             Defaults to "false".
+    -keyring <path>
+            Keyring used to decrypt encrypted TSM files.
 `
 
 	fmt.Fprintf(cmd.Stdout, usage)
@@ -0,0 +1,140 @@
+// Package rotatekeys re-encrypts the data keys of encrypted TSM files and WAL
+// segments with the active key of a keyring.
+package rotatekeys
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+
+	"github.com/influxdata/influxdb/pkg/keyring"
+	"github.com/influxdata/influxdb/tsdb/engine/tsm1"
+)
+
+// defaultBindAddress is the default RPC bind address of influxd.
+const defaultBindAddress = "127.0.0.1:8088"
+
+// Command represents the program execution for "influx_inspect rotatekeys".
+type Command struct {
+	Stderr io.Writer
+	Stdout io.Writer
+}
+
+// NewCommand returns a new instance of Command.
+func NewCommand() *Command {
+	return &Command{
+		Stderr: os.Stderr,
+		Stdout: os.Stdout,
+	}
+}
+
+// Run executes the command.
+func (cmd *Command) Run(args ...string) error {
+	var dataDir, coldDir, walDir, keyringPath, bindAddress string
+	fs := flag.NewFlagSet("rotatekeys", flag.ExitOnError)
+	fs.StringVar(&dataDir, "datadir", os.Getenv("HOME")+"/.influxdb/data", "Data storage path")
+	fs.StringVar(&coldDir, "colddir", "", "Optional: cold data storage path")
+	fs.StringVar(&walDir, "waldir", os.Getenv("HOME")+"/.influxdb/wal", "WAL storage path")
+	fs.StringVar(&keyringPath, "keyring", "", "Keyring holding the current and new master keys")
+	fs.StringVar(&bindAddress, "bind-address", defaultBindAddress, "RPC bind address of influxd, used to check that it is stopped")
+
+	fs.SetOutput(cmd.Stdout)
+	fs.Usage = cmd.printUsage
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if keyringPath == "" {
+		return errors.New("keyring is required")
+	}
+	keys, err := keyring.Open(keyringPath)
+	if err != nil {
+		return err
+	}
+
+	// Files are replaced by rotated copies, which a running influxd would
+	// neither read nor expect to disappear from under its compactions.
+	ln, err := net.Listen("tcp", bindAddress)
+	if err != nil {
+		return fmt.Errorf("influxd appears to be running since %s is in use; stop it before rotating keys", bindAddress)
+	}
+	ln.Close()
+
+	type dir struct {
+		path string
+		ext  string
+	}
+	dirs := []dir{
+		{dataDir, "." + tsm1.TSMFileExtension},
+		{walDir, "." + tsm1.WALFileExtension},
+	}
+	if coldDir != "" {
+		dirs = append(dirs, dir{coldDir, "." + tsm1.TSMFileExtension})
+	}
+
+	var filesN, rotatedN int
+	for _, d := range dirs {
+		if err := filepath.Walk(d.path, func(path string, fi os.FileInfo, err error) error {
+			if os.IsNotExist(err) && path != d.path {
+				return nil // The file was removed since its directory was read.
+			} else if err != nil {
+				return err
+			} else if fi.IsDir() || filepath.Ext(path) != d.ext {
+				return nil
+			}
+
+			ok, err := tsm1.RotateKey(path, keys)
+			if os.IsNotExist(err) {
+				fmt.Fprintf(cmd.Stderr, "%s: skipped, file was removed\n", path)
+				return nil
+			} else if err != nil {
+				return fmt.Errorf("%s: %s", path, err)
+			}
+
+			filesN++
+			if ok {
+				rotatedN++
+				fmt.Fprintf(cmd.Stdout, "%s: rotated\n", path)
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+
+	fmt.Fprintf(cmd.Stdout, "Rotated %d of %d files\n", rotatedN, filesN)
+	return nil
+}
+
+// printUsage prints the usage message to STDERR.
+func (cmd *Command) printUsage() {
+	usage := fmt.Sprintf(`Re-encrypts the data keys of encrypted TSM and WAL files with the last key
+in a keyring.  The keys previously used to encrypt the files must remain in the
+keyring until the command completes.  influxd must be stopped while the command
+runs, since files are replaced by rotated copies.
+
+Usage: influx_inspect rotatekeys [flags]
+
+    -keyring <path>
+            Keyring holding the current and new master keys.
+    -datadir <path>
+            Data storage path.
+            Defaults to "%[1]s/.influxdb/data".
+    -colddir <path>
+            Cold data storage path, if any.
+    -waldir <path>
+            WAL storage path.
+            Defaults to "%[1]s/.influxdb/wal".
+    -bind-address <addr>
+            RPC bind address of influxd.  The command refuses to run if the
+            address is in use, as influxd is then assumed to be running.
+            Defaults to "%[2]s".
+`, os.Getenv("HOME"), defaultBindAddress)
+
+	fmt.Fprint(cmd.Stdout, usage)
+}
@@ -11,6 +11,7 @@ import (
 	"text/tabwriter"
 	"time"
 
+	"github.com/influxdata/influxdb/pkg/keyring"
 	"github.com/influxdata/influxdb/tsdb/engine/tsm1"
 )
 
@@ -18,6 +19,8 @@ import (
 type Command struct {
 	Stderr io.Writer
 	Stdout io.Writer
+
+	keys keyring.Provider
 }
 
 // NewCommand returns a new instance of Command.
@@ -30,9 +33,10 @@ func NewCommand() *Command {
 
 // Run executes the command.
 func (cmd *Command) Run(args ...string) error {
-	var path string
+	var path, keyringPath string
 	fs := flag.NewFlagSet("verify", flag.ExitOnError)
 	fs.StringVar(&path, "dir", os.Getenv("HOME")+"/.influxdb", "Root storage path. [$HOME/.influxdb]")
+	fs.StringVar(&keyringPath, "keyring", "", "Optional: the keyring used to decrypt encrypted files")
 
 	fs.SetOutput(cmd.Stdout)
 	fs.Usage = cmd.printUsage
@@ -41,6 +45,14 @@ func (cmd *Command) Run(args ...string) error {
 		return err
 	}
 
+	if keyringPath != "" {
+		keys, err := keyring.Open(keyringPath)
+		if err != nil {
+			return err
+		}
+		cmd.keys = keys
+	}
+
 	start := time.Now()
 	dataPath := filepath.Join(path, "data")
 
@@ -74,7 +86,7 @@ func (cmd *Command) Run(args ...string) error {
 			return err
 		}
 
-		reader, err := tsm1.NewTSMReader(file)
+		reader, err := tsm1.NewTSMReaderWithKeys(file, cmd.keys)
 		if err != nil {
 			return err
 		}
@@ -114,6 +126,8 @@ Usage: influx_inspect verify [flags]
     -dir <path>
             Root storage path
             Defaults to "%[1]s/.influxdb".
+    -keyring <path>
+            Keyring used to decrypt encrypted TSM files.
  `, os.Getenv("HOME"))
 
 	fmt.Fprintf(cmd.Stdout, usage)
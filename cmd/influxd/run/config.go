@@ -23,6 +23,7 @@ import (
 	"github.com/influxdata/influxdb/services/opentsdb"
 	"github.com/influxdata/influxdb/services/precreator"
 	"github.com/influxdata/influxdb/services/retention"
+	"github.com/influxdata/influxdb/services/scraper"
 	"github.com/influxdata/influxdb/services/storage"
 	"github.com/influxdata/influxdb/services/subscriber"
 	"github.com/influxdata/influxdb/services/udp"
@@ -54,6 +55,7 @@ type Config struct {
 	CollectdInputs []collectd.Config `toml:"collectd"`
 	OpenTSDBInputs []opentsdb.Config `toml:"opentsdb"`
 	UDPInputs      []udp.Config      `toml:"udp"`
+	Scraper        scraper.Config    `toml:"scraper"`
 
 	ContinuousQuery continuous_querier.Config `toml:"continuous_queries"`
 
@@ -82,6 +84,7 @@ func NewConfig() *Config {
 	c.CollectdInputs = []collectd.Config{collectd.NewConfig()}
 	c.OpenTSDBInputs = []opentsdb.Config{opentsdb.NewConfig()}
 	c.UDPInputs = []udp.Config{udp.NewConfig()}
+	c.Scraper = scraper.NewConfig()
 
 	c.ContinuousQuery = continuous_querier.NewConfig()
 	c.Retention = retention.NewConfig()
@@ -160,6 +163,10 @@ func (c *Config) Validate() error {
 		return err
 	}
 
+	if err := c.Coordinator.Validate(); err != nil {
+		return err
+	}
+
 	if err := c.ContinuousQuery.Validate(); err != nil {
 		return err
 	}
@@ -176,6 +183,10 @@ func (c *Config) Validate() error {
 		return err
 	}
 
+	if err := c.HTTPD.Validate(); err != nil {
+		return err
+	}
+
 	for _, graphite := range c.GraphiteInputs {
 		if err := graphite.Validate(); err != nil {
 			return fmt.Errorf("invalid graphite config: %v", err)
@@ -188,6 +199,10 @@ func (c *Config) Validate() error {
 		}
 	}
 
+	if err := c.Scraper.Validate(); err != nil {
+		return fmt.Errorf("invalid scraper config: %v", err)
+	}
+
 	return nil
 }
 
@@ -218,6 +233,7 @@ func (c *Config) diagnosticsClients() map[string]diagnostics.Client {
 		"config-monitor":    c.Monitor,
 		"config-subscriber": c.Subscriber,
 		"config-httpd":      c.HTTPD,
+		"config-scraper":    c.Scraper,
 
 		"config-cqs": c.ContinuousQuery,
 	}
@@ -25,6 +25,7 @@ import (
 	"github.com/influxdata/influxdb/services/opentsdb"
 	"github.com/influxdata/influxdb/services/precreator"
 	"github.com/influxdata/influxdb/services/retention"
+	"github.com/influxdata/influxdb/services/scraper"
 	"github.com/influxdata/influxdb/services/snapshotter"
 	"github.com/influxdata/influxdb/services/subscriber"
 	"github.com/influxdata/influxdb/services/udp"
@@ -72,6 +73,8 @@ type Server struct {
 	TSDBStore     *tsdb.Store
 	QueryExecutor *query.Executor
 	PointsWriter  *coordinator.PointsWriter
+	WriteLimiter  *coordinator.WriteLimiter
+	ResultCache   *coordinator.ResultCache
 	Subscriber    *subscriber.Service
 
 	Services []Service
@@ -176,6 +179,18 @@ func NewServer(c *Config, buildInfo *BuildInfo) (*Server, error) {
 	s.PointsWriter = coordinator.NewPointsWriter()
 	s.PointsWriter.WriteTimeout = time.Duration(c.Coordinator.WriteTimeout)
 	s.PointsWriter.TSDBStore = s.TSDBStore
+	s.TSDBStore.EngineOptions.RollupWriter = s.PointsWriter
+
+	// Initialize write limits, if any are configured.
+	if len(c.Coordinator.WriteLimits) > 0 {
+		s.WriteLimiter = coordinator.NewWriteLimiter(c.Coordinator.WriteLimits)
+		s.PointsWriter.WriteLimiter = s.WriteLimiter
+	}
+
+	// Initialize the result cache, if enabled.
+	if c.Coordinator.ResultCacheSize > 0 {
+		s.ResultCache = coordinator.NewResultCache(int64(c.Coordinator.ResultCacheSize))
+	}
 
 	// Initialize query executor.
 	s.QueryExecutor = query.NewExecutor()
@@ -192,10 +207,21 @@ func NewServer(c *Config, buildInfo *BuildInfo) (*Server, error) {
 		MaxSelectPointN:   c.Coordinator.MaxSelectPointN,
 		MaxSelectSeriesN:  c.Coordinator.MaxSelectSeriesN,
 		MaxSelectBucketsN: c.Coordinator.MaxSelectBucketsN,
+		ResultCache:       s.ResultCache,
 	}
 	s.QueryExecutor.TaskManager.QueryTimeout = time.Duration(c.Coordinator.QueryTimeout)
 	s.QueryExecutor.TaskManager.LogQueriesAfter = time.Duration(c.Coordinator.LogQueriesAfter)
 	s.QueryExecutor.TaskManager.MaxConcurrentQueries = c.Coordinator.MaxConcurrentQueries
+	s.QueryExecutor.TaskManager.MaxQueryMemory = int64(c.Coordinator.MaxSelectMemory)
+	s.QueryExecutor.TaskManager.SpillDir = c.Coordinator.SpillDir
+	s.QueryExecutor.TaskManager.QueryLimiter = s.MetaClient
+	if c.Coordinator.SlowQueryThreshold > 0 {
+		s.QueryExecutor.TaskManager.SlowQueryThreshold = time.Duration(c.Coordinator.SlowQueryThreshold)
+		s.QueryExecutor.TaskManager.SlowQueryLog = query.NewSlowQueryLog(c.Coordinator.SlowQueryLogSize)
+		if c.Coordinator.SlowQueryStoreEnabled {
+			s.QueryExecutor.TaskManager.SlowQueryWriter = s.Monitor
+		}
+	}
 
 	// Initialize the monitor
 	s.Monitor.Version = s.buildInfo.Version
@@ -212,6 +238,12 @@ func (s *Server) Statistics(tags map[string]string) []models.Statistic {
 	statistics = append(statistics, s.QueryExecutor.Statistics(tags)...)
 	statistics = append(statistics, s.TSDBStore.Statistics(tags)...)
 	statistics = append(statistics, s.PointsWriter.Statistics(tags)...)
+	if s.WriteLimiter != nil {
+		statistics = append(statistics, s.WriteLimiter.Statistics(tags)...)
+	}
+	if s.ResultCache != nil {
+		statistics = append(statistics, s.ResultCache.Statistics(tags)...)
+	}
 	statistics = append(statistics, s.Subscriber.Statistics(tags)...)
 	for _, srv := range s.Services {
 		if m, ok := srv.(monitor.Reporter); ok {
@@ -260,6 +292,7 @@ func (s *Server) appendHTTPDService(c httpd.Config) {
 	srv.Handler.QueryExecutor = s.QueryExecutor
 	srv.Handler.Monitor = s.Monitor
 	srv.Handler.PointsWriter = s.PointsWriter
+	srv.Handler.TSDBStore = s.TSDBStore
 	srv.Handler.Version = s.buildInfo.Version
 	srv.Handler.BuildType = "OSS"
 
@@ -273,6 +306,7 @@ func (s *Server) appendStorageService(c storage.Config) {
 	srv := storage.NewService(c)
 	srv.MetaClient = s.MetaClient
 	srv.TSDBStore = s.TSDBStore
+	srv.PointsWriter = s.PointsWriter
 
 	s.Services = append(s.Services, srv)
 }
@@ -337,6 +371,20 @@ func (s *Server) appendUDPService(c udp.Config) {
 	s.Services = append(s.Services, srv)
 }
 
+func (s *Server) appendScraperService(c scraper.Config) error {
+	if !c.Enabled {
+		return nil
+	}
+	srv, err := scraper.NewService(c)
+	if err != nil {
+		return err
+	}
+	srv.PointsWriter = s.PointsWriter
+	srv.MetaClient = s.MetaClient
+	s.Services = append(s.Services, srv)
+	return nil
+}
+
 func (s *Server) appendContinuousQueryService(c continuous_querier.Config) {
 	if !c.Enabled {
 		return
@@ -391,6 +439,9 @@ func (s *Server) Open() error {
 	for _, i := range s.config.UDPInputs {
 		s.appendUDPService(i)
 	}
+	if err := s.appendScraperService(s.config.Scraper); err != nil {
+		return err
+	}
 
 	s.Subscriber.MetaClient = s.MetaClient
 	s.PointsWriter.MetaClient = s.MetaClient
@@ -41,12 +41,15 @@ type Command struct {
 	soffset         uint64
 	desc            bool
 	silent          bool
+	explain         bool
 	expr            string
 	agg             string
+	every           time.Duration
+	offset          time.Duration
 	grouping        string
 	keys            []string
 
-	aggType storage.Aggregate_AggregateType
+	aggTypes []storage.Aggregate_AggregateType
 
 	// response
 	integerSum  int64
@@ -92,8 +95,11 @@ func (cmd *Command) Run(args ...string) error {
 	fs.Uint64Var(&cmd.limit, "limit", 0, "Optional: limit number of values per series")
 	fs.BoolVar(&cmd.desc, "desc", false, "Optional: return results in descending order")
 	fs.BoolVar(&cmd.silent, "silent", false, "silence output")
+	fs.BoolVar(&cmd.explain, "explain", false, "Optional: describe the costs of the query instead of executing it")
 	fs.StringVar(&cmd.expr, "expr", "", "InfluxQL conditional expression")
-	fs.StringVar(&cmd.agg, "agg", "", "aggregate functions (sum, count)")
+	fs.StringVar(&cmd.agg, "agg", "", "comma-separated list of aggregate functions (sum, count, min, max, mean, first, last)")
+	fs.DurationVar(&cmd.every, "every", 0, "Optional: window duration for aggregate functions")
+	fs.DurationVar(&cmd.offset, "offset", 0, "Optional: window offset for aggregate functions")
 	fs.StringVar(&cmd.grouping, "grouping", "", "comma-separated list of tags to specify series order")
 
 	fs.SetOutput(cmd.Stdout)
@@ -130,10 +136,12 @@ func (cmd *Command) Run(args ...string) error {
 
 	if cmd.agg != "" {
 		tm := proto.EnumValueMap("storage.Aggregate_AggregateType")
-		if agg, ok := tm[strings.ToUpper(cmd.agg)]; !ok {
-			return errors.New("invalid aggregate function: " + cmd.agg)
-		} else {
-			cmd.aggType = storage.Aggregate_AggregateType(agg)
+		for _, name := range strings.Split(cmd.agg, ",") {
+			if agg, ok := tm[strings.ToUpper(name)]; !ok || agg == int32(storage.AggregateTypeNone) {
+				return errors.New("invalid aggregate function: " + name)
+			} else {
+				cmd.aggTypes = append(cmd.aggTypes, storage.Aggregate_AggregateType(agg))
+			}
 		}
 	}
 
@@ -164,6 +172,12 @@ func (cmd *Command) validate() error {
 	if cmd.startTime != 0 && cmd.endTime != 0 && cmd.endTime < cmd.startTime {
 		return fmt.Errorf("end time before start time")
 	}
+	if cmd.every < 0 {
+		return fmt.Errorf("window duration must be positive")
+	}
+	if (cmd.every != 0 || cmd.offset != 0) && len(cmd.aggTypes) == 0 {
+		return fmt.Errorf("window requires an aggregate function")
+	}
 	return nil
 }
 
@@ -185,9 +199,11 @@ func (cmd *Command) query(c storage.StorageClient) error {
 	req.Descending = cmd.desc
 	req.Grouping = cmd.keys
 
-	if cmd.aggType != storage.AggregateTypeNone {
-		req.Aggregate = &storage.Aggregate{Type: cmd.aggType}
+	for _, agg := range cmd.aggTypes {
+		req.Aggregates = append(req.Aggregates, storage.Aggregate{Type: agg})
 	}
+	req.Window.Every = int64(cmd.every)
+	req.Window.Offset = int64(cmd.offset)
 
 	if cmd.expr != "" {
 		expr, err := influxql.ParseExpr(cmd.expr)
@@ -204,6 +220,10 @@ func (cmd *Command) query(c storage.StorageClient) error {
 		req.Predicate = &storage.Predicate{Root: v.nodes[0]}
 	}
 
+	if cmd.explain {
+		return cmd.explainQuery(c, &req)
+	}
+
 	stream, err := c.Read(context.Background(), &req)
 	if err != nil {
 		fmt.Fprintln(cmd.Stdout, err)
@@ -242,6 +262,34 @@ func (cmd *Command) query(c storage.StorageClient) error {
 	return nil
 }
 
+func (cmd *Command) explainQuery(c storage.StorageClient, req *storage.ReadRequest) error {
+	res, err := c.Explain(context.Background(), &storage.ExplainRequest{ReadRequest: *req})
+	if err != nil {
+		fmt.Fprintln(cmd.Stdout, err)
+		return err
+	}
+
+	ids := make([]string, 0, len(res.ShardIDs))
+	for _, id := range res.ShardIDs {
+		ids = append(ids, strconv.FormatUint(id, 10))
+	}
+
+	fmt.Fprintln(cmd.Stdout, "shards:", strings.Join(ids, ","))
+	fmt.Fprintln(cmd.Stdout, "predicate:", res.Predicate)
+	fmt.Fprintln(cmd.Stdout, "series predicate:", res.SeriesPredicate)
+	fmt.Fprintln(cmd.Stdout, "series:", res.SeriesN)
+	fmt.Fprintln(cmd.Stdout, "cursors:", res.CursorN)
+	fmt.Fprintln(cmd.Stdout, "cost:")
+	fmt.Fprintln(cmd.Stdout, "  number of shards:", res.Cost.NumShards)
+	fmt.Fprintln(cmd.Stdout, "  number of series:", res.Cost.NumSeries)
+	fmt.Fprintln(cmd.Stdout, "  cached values:", res.Cost.CachedValues)
+	fmt.Fprintln(cmd.Stdout, "  number of files:", res.Cost.NumFiles)
+	fmt.Fprintln(cmd.Stdout, "  number of blocks:", res.Cost.BlocksRead)
+	fmt.Fprintln(cmd.Stdout, "  size of blocks:", res.Cost.BlockSize)
+
+	return nil
+}
+
 func (cmd *Command) processFramesSilent(frames []storage.ReadResponse_Frame) {
 	for _, frame := range frames {
 		switch f := frame.Data.(type) {
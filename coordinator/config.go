@@ -3,6 +3,8 @@
 package coordinator
 
 import (
+	"errors"
+	"fmt"
 	"time"
 
 	"github.com/influxdata/influxdb/monitor/diagnostics"
@@ -25,6 +27,13 @@ const (
 	// DefaultMaxSelectSeriesN is the maximum number of series a SELECT can run.
 	// A value of zero will make the maximum series count unlimited.
 	DefaultMaxSelectSeriesN = 0
+
+	// DefaultResultCacheSize is the maximum size of the result cache in bytes.
+	// A value of zero disables the result cache.
+	DefaultResultCacheSize = 0
+
+	// DefaultSlowQueryLogSize is the number of slow queries retained in memory.
+	DefaultSlowQueryLogSize = query.DefaultSlowQueryLogSize
 )
 
 // Config represents the configuration for the coordinator service.
@@ -36,6 +45,35 @@ type Config struct {
 	MaxSelectPointN      int           `toml:"max-select-point"`
 	MaxSelectSeriesN     int           `toml:"max-select-series"`
 	MaxSelectBucketsN    int           `toml:"max-select-buckets"`
+	MaxSelectMemory      toml.Size     `toml:"max-select-memory"`
+	SpillDir             string        `toml:"spill-dir"`
+	ResultCacheSize      toml.Size     `toml:"result-cache-size"`
+
+	SlowQueryThreshold    toml.Duration `toml:"slow-query-threshold"`
+	SlowQueryLogSize      int           `toml:"slow-query-log-size"`
+	SlowQueryStoreEnabled bool          `toml:"slow-query-store-enabled"`
+
+	WriteLimits []WriteLimitConfig `toml:"write-limit"`
+}
+
+// WriteLimitConfig represents the write rate limit of a single database or user.
+// A limit of zero means the limit is not set.
+type WriteLimitConfig struct {
+	Database        string `toml:"database"`
+	User            string `toml:"user"`
+	PointsPerSecond int    `toml:"points-per-second"`
+	BytesPerSecond  int    `toml:"bytes-per-second"`
+}
+
+// Validate returns an error if the config is invalid.
+func (c WriteLimitConfig) Validate() error {
+	if (c.Database == "") == (c.User == "") {
+		return errors.New("exactly one of database or user must be specified")
+	}
+	if c.PointsPerSecond < 0 || c.BytesPerSecond < 0 {
+		return errors.New("write limits must not be negative")
+	}
+	return nil
 }
 
 // NewConfig returns an instance of Config with defaults.
@@ -46,7 +84,19 @@ func NewConfig() Config {
 		MaxConcurrentQueries: DefaultMaxConcurrentQueries,
 		MaxSelectPointN:      DefaultMaxSelectPointN,
 		MaxSelectSeriesN:     DefaultMaxSelectSeriesN,
+		ResultCacheSize:      DefaultResultCacheSize,
+		SlowQueryLogSize:     DefaultSlowQueryLogSize,
+	}
+}
+
+// Validate returns an error if the config is invalid.
+func (c Config) Validate() error {
+	for _, wl := range c.WriteLimits {
+		if err := wl.Validate(); err != nil {
+			return fmt.Errorf("invalid write-limit: %v", err)
+		}
 	}
+	return nil
 }
 
 // Diagnostics returns a diagnostics representation of a subset of the Config.
@@ -59,5 +109,12 @@ func (c Config) Diagnostics() (*diagnostics.Diagnostics, error) {
 		"max-select-point":       c.MaxSelectPointN,
 		"max-select-series":      c.MaxSelectSeriesN,
 		"max-select-buckets":     c.MaxSelectBucketsN,
+		"max-select-memory":      c.MaxSelectMemory,
+		"spill-dir":              c.SpillDir,
+		"result-cache-size":      c.ResultCacheSize,
+		"slow-query-threshold":   c.SlowQueryThreshold,
+		"slow-query-log-size":    c.SlowQueryLogSize,
+		"slow-query-store":       c.SlowQueryStoreEnabled,
+		"write-limits":           len(c.WriteLimits),
 	}), nil
 }
@@ -22,3 +22,29 @@ write-timeout = "20s"
 		t.Fatalf("unexpected write timeout s: %s", c.WriteTimeout)
 	}
 }
+
+func TestConfig_Validate_WriteLimits(t *testing.T) {
+	var c coordinator.Config
+	if _, err := toml.Decode(`
+[[write-limit]]
+database = "db0"
+points-per-second = 1000
+
+[[write-limit]]
+user = "user0"
+bytes-per-second = 100000
+`, &c); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := c.Validate(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	} else if len(c.WriteLimits) != 2 {
+		t.Fatalf("unexpected write limits: %v", c.WriteLimits)
+	}
+
+	c.WriteLimits[0].User = "user1"
+	if err := c.Validate(); err == nil {
+		t.Fatal("expected error for write limit with both database and user")
+	}
+}
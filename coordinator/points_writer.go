@@ -59,6 +59,10 @@ type PointsWriter struct {
 		WriteToShard(shardID uint64, points []models.Point) error
 	}
 
+	// WriteLimiter, if set, limits the rate of writes to each database and
+	// by each user.
+	WriteLimiter *WriteLimiter
+
 	subPoints []chan<- *WritePointsRequest
 
 	stats *WriteStatistics
@@ -283,14 +287,34 @@ func (w *PointsWriter) WritePointsInto(p *IntoWriteRequest) error {
 
 // WritePoints writes the data to the underlying storage. consitencyLevel and user are only used for clustered scenarios
 func (w *PointsWriter) WritePoints(database, retentionPolicy string, consistencyLevel models.ConsistencyLevel, user meta.User, points []models.Point) error {
-	return w.WritePointsPrivileged(database, retentionPolicy, consistencyLevel, points)
+	var username string
+	if user != nil {
+		username = user.ID()
+	}
+	return w.writePoints(database, retentionPolicy, username, points)
 }
 
 // WritePointsPrivileged writes the data to the underlying storage, consitencyLevel is only used for clustered scenarios
 func (w *PointsWriter) WritePointsPrivileged(database, retentionPolicy string, consistencyLevel models.ConsistencyLevel, points []models.Point) error {
+	return w.writePoints(database, retentionPolicy, "", points)
+}
+
+// writePoints writes the points to the underlying storage, if the write is
+// within the rate limits of the database and of the user, if any.
+func (w *PointsWriter) writePoints(database, retentionPolicy, user string, points []models.Point) error {
 	atomic.AddInt64(&w.stats.WriteReq, 1)
 	atomic.AddInt64(&w.stats.PointWriteReq, int64(len(points)))
 
+	if w.WriteLimiter != nil {
+		var byteN int
+		for _, p := range points {
+			byteN += p.StringSize()
+		}
+		if err := w.WriteLimiter.AllowWrite(database, user, len(points), byteN); err != nil {
+			return err
+		}
+	}
+
 	if retentionPolicy == "" {
 		db := w.MetaClient.Database(database)
 		if db == nil {
@@ -428,6 +428,49 @@ func TestPointsWriter_WritePoints_Dropped(t *testing.T) {
 	}
 }
 
+// Ensure writes by services other than the HTTP API are rate limited.
+func TestPointsWriter_WritePointsPrivileged_Limited(t *testing.T) {
+	pr := &coordinator.WritePointsRequest{
+		Database:        "mydb",
+		RetentionPolicy: "myrp",
+	}
+
+	// The shard groups must be created before the points.
+	ms := NewPointsWriterMetaClient()
+	pr.AddPoint("cpu", 1.0, time.Now(), nil)
+	pr.AddPoint("cpu", 2.0, time.Now(), nil)
+
+	var writeN int
+	c := coordinator.NewPointsWriter()
+	c.MetaClient = ms
+	c.TSDBStore = &fakeStore{
+		WriteFn: func(shardID uint64, points []models.Point) error {
+			writeN++
+			return nil
+		},
+	}
+	c.WriteLimiter = coordinator.NewWriteLimiter([]coordinator.WriteLimitConfig{
+		{Database: "mydb", PointsPerSecond: 3},
+	})
+	c.Node = &influxdb.Node{ID: 1}
+
+	c.Open()
+	defer c.Close()
+
+	if err := c.WritePointsPrivileged(pr.Database, pr.RetentionPolicy, models.ConsistencyLevelOne, pr.Points); err != nil {
+		t.Fatal(err)
+	}
+
+	err := c.WritePointsPrivileged(pr.Database, pr.RetentionPolicy, models.ConsistencyLevelOne, pr.Points)
+	if lerr, ok := err.(*coordinator.WriteLimitError); !ok {
+		t.Fatalf("expected write limit error, got %v", err)
+	} else if lerr.Database != "mydb" || lerr.RetryAfter <= 0 {
+		t.Fatalf("unexpected error: %+v", lerr)
+	} else if writeN != 1 {
+		t.Fatalf("unexpected shard writes: %d", writeN)
+	}
+}
+
 type fakePointsWriter struct {
 	WritePointsIntoFn func(*coordinator.IntoWriteRequest) error
 }
@@ -0,0 +1,195 @@
+package coordinator
+
+import (
+	"container/list"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/influxdata/influxdb/models"
+)
+
+// Statistics for the ResultCache.
+const (
+	statResultCacheHits          = "hits"
+	statResultCacheMisses        = "misses"
+	statResultCacheBypassed      = "bypassed"
+	statResultCacheInvalidations = "invalidations"
+	statResultCacheEvictions     = "evictions"
+	statResultCacheEntries       = "entries"
+	statResultCacheSize          = "size"
+)
+
+// ResultCache holds the results of recent SELECT statements so that repeated
+// statements can be answered without reading any shards. Results are keyed on
+// the statement, its time range and the shards it reads. A result is
+// invalidated when any of those shards is modified.
+type ResultCache struct {
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	lru     *list.List
+	size    int64
+	maxSize int64
+
+	stats resultCacheStatistics
+}
+
+type resultCacheStatistics struct {
+	Hits          int64
+	Misses        int64
+	Bypassed      int64
+	Invalidations int64
+	Evictions     int64
+}
+
+// NewResultCache returns a ResultCache holding at most maxSize bytes of results.
+func NewResultCache(maxSize int64) *ResultCache {
+	return &ResultCache{
+		entries: make(map[string]*list.Element),
+		lru:     list.New(),
+		maxSize: maxSize,
+	}
+}
+
+// resultCacheEntry holds the results of a single statement.
+type resultCacheEntry struct {
+	key string
+
+	// The last modified time of each shard read by the statement.
+	modified []time.Time
+
+	rows    []*models.Row
+	partial []bool
+	size    int64
+}
+
+// append adds a copy of row to the entry.
+func (e *resultCacheEntry) append(row *models.Row, partial bool) {
+	e.rows = append(e.rows, copyRow(row))
+	e.partial = append(e.partial, partial)
+	e.size += rowSize(row)
+}
+
+// get returns the entry for key. An entry whose shards have been modified
+// since it was added is removed and nil is returned.
+func (c *ResultCache) get(key string, modified []time.Time) *resultCacheEntry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem := c.entries[key]
+	if elem == nil {
+		atomic.AddInt64(&c.stats.Misses, 1)
+		return nil
+	}
+
+	entry := elem.Value.(*resultCacheEntry)
+	if !timesEqual(entry.modified, modified) {
+		c.remove(elem)
+		atomic.AddInt64(&c.stats.Invalidations, 1)
+		atomic.AddInt64(&c.stats.Misses, 1)
+		return nil
+	}
+
+	c.lru.MoveToFront(elem)
+	atomic.AddInt64(&c.stats.Hits, 1)
+	return entry
+}
+
+// put adds entry to the cache, evicting the least recently used entries to
+// make room. Entries larger than the cache are ignored.
+func (c *ResultCache) put(entry *resultCacheEntry) {
+	if entry.size > c.maxSize {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem := c.entries[entry.key]; elem != nil {
+		c.remove(elem)
+	}
+
+	for c.size+entry.size > c.maxSize {
+		c.remove(c.lru.Back())
+		atomic.AddInt64(&c.stats.Evictions, 1)
+	}
+
+	c.entries[entry.key] = c.lru.PushFront(entry)
+	c.size += entry.size
+}
+
+// bypass records a statement which was not allowed to use the cache.
+func (c *ResultCache) bypass() {
+	atomic.AddInt64(&c.stats.Bypassed, 1)
+}
+
+// remove removes elem from the cache. The caller must hold the lock.
+func (c *ResultCache) remove(elem *list.Element) {
+	entry := c.lru.Remove(elem).(*resultCacheEntry)
+	delete(c.entries, entry.key)
+	c.size -= entry.size
+}
+
+// Statistics returns statistics for periodic monitoring.
+func (c *ResultCache) Statistics(tags map[string]string) []models.Statistic {
+	c.mu.Lock()
+	entriesN, size := len(c.entries), c.size
+	c.mu.Unlock()
+
+	return []models.Statistic{{
+		Name: "resultCache",
+		Tags: tags,
+		Values: map[string]interface{}{
+			statResultCacheHits:          atomic.LoadInt64(&c.stats.Hits),
+			statResultCacheMisses:        atomic.LoadInt64(&c.stats.Misses),
+			statResultCacheBypassed:      atomic.LoadInt64(&c.stats.Bypassed),
+			statResultCacheInvalidations: atomic.LoadInt64(&c.stats.Invalidations),
+			statResultCacheEvictions:     atomic.LoadInt64(&c.stats.Evictions),
+			statResultCacheEntries:       int64(entriesN),
+			statResultCacheSize:          size,
+		},
+	}}
+}
+
+// copyRow returns a copy of row which can be modified without changing row.
+func copyRow(row *models.Row) *models.Row {
+	other := *row
+	other.Values = make([][]interface{}, len(row.Values))
+	for i, values := range row.Values {
+		other.Values[i] = make([]interface{}, len(values))
+		copy(other.Values[i], values)
+	}
+	return &other
+}
+
+// rowSize returns the approximate number of bytes used by row.
+func rowSize(row *models.Row) int64 {
+	n := 64 + len(row.Name)
+	for k, v := range row.Tags {
+		n += len(k) + len(v)
+	}
+	for _, col := range row.Columns {
+		n += len(col)
+	}
+	for _, values := range row.Values {
+		n += 24 + 16*len(values)
+		for _, v := range values {
+			if s, ok := v.(string); ok {
+				n += len(s)
+			}
+		}
+	}
+	return int64(n)
+}
+
+func timesEqual(a, b []time.Time) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].Equal(b[i]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,64 @@
+package coordinator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/influxdata/influxdb/models"
+)
+
+func TestResultCache_Invalidate(t *testing.T) {
+	c := NewResultCache(1 << 20)
+
+	t0, t1 := time.Unix(0, 0), time.Unix(1, 0)
+	entry := &resultCacheEntry{key: "a", modified: []time.Time{t0}}
+	entry.append(&models.Row{Name: "cpu", Values: [][]interface{}{{t0, 1.0}}}, false)
+	c.put(entry)
+
+	if e := c.get("a", []time.Time{t0}); e != entry {
+		t.Fatal("expected cached entry")
+	}
+
+	// A modified shard invalidates the entry.
+	if e := c.get("a", []time.Time{t1}); e != nil {
+		t.Fatal("expected entry to be invalidated")
+	} else if e := c.get("a", []time.Time{t0}); e != nil {
+		t.Fatal("expected entry to be removed")
+	}
+
+	if stats := c.Statistics(nil)[0].Values; stats[statResultCacheHits] != int64(1) ||
+		stats[statResultCacheMisses] != int64(2) ||
+		stats[statResultCacheInvalidations] != int64(1) ||
+		stats[statResultCacheSize] != int64(0) {
+		t.Fatalf("unexpected statistics: %v", stats)
+	}
+}
+
+func TestResultCache_Evict(t *testing.T) {
+	row := &models.Row{Name: "cpu", Values: [][]interface{}{{time.Unix(0, 0), 1.0}}}
+	c := NewResultCache(2 * rowSize(row))
+
+	for _, key := range []string{"a", "b", "c"} {
+		entry := &resultCacheEntry{key: key}
+		entry.append(row, false)
+		c.put(entry)
+
+		// Keep "a" as the most recently used entry.
+		c.get("a", nil)
+	}
+
+	if c.get("a", nil) == nil {
+		t.Fatal("expected a to be cached")
+	} else if c.get("b", nil) != nil {
+		t.Fatal("expected b to be evicted")
+	} else if c.get("c", nil) == nil {
+		t.Fatal("expected c to be cached")
+	}
+
+	// Entries larger than the cache are not kept.
+	entry := &resultCacheEntry{key: "d", size: 3 * rowSize(row)}
+	c.put(entry)
+	if c.get("d", nil) != nil {
+		t.Fatal("expected d not to be cached")
+	}
+}
@@ -53,6 +53,9 @@ type StatementExecutor struct {
 	MaxSelectPointN   int
 	MaxSelectSeriesN  int
 	MaxSelectBucketsN int
+
+	// ResultCache, if set, holds the results of recent SELECT statements.
+	ResultCache *ResultCache
 }
 
 // ExecuteStatement executes the given statement with the given execution context.
@@ -446,7 +449,7 @@ func (e *StatementExecutor) executeExplainAnalyzeStatement(q *influxql.ExplainSt
 	ctx = query.NewContextWithIterators(ctx, &aux)
 	start := time.Now()
 
-	cur, err := e.createIterators(ctx, stmt, ectx.ExecutionOptions)
+	cur, err := e.createIterators(ctx, stmt, ectx.ExecutionOptions, ectx.Limits)
 	if err != nil {
 		return nil, err
 	}
@@ -497,8 +500,13 @@ CLEANUP:
 	row := &models.Row{
 		Columns: []string{"EXPLAIN ANALYZE"},
 	}
-	for _, s := range strings.Split(t.Tree().String(), "\n") {
-		row.Values = append(row.Values, []interface{}{s})
+	switch ectx.ExecutionOptions.ExplainFormat {
+	case query.ExplainFormatJSON:
+		row.Values = [][]interface{}{{t.Tree().Map()}}
+	default:
+		for _, s := range strings.Split(t.Tree().String(), "\n") {
+			row.Values = append(row.Values, []interface{}{s})
+		}
 	}
 
 	return models.Rows{row}, nil
@@ -537,7 +545,24 @@ func (e *StatementExecutor) executeSetPasswordUserStatement(q *influxql.SetPassw
 }
 
 func (e *StatementExecutor) executeSelectStatement(stmt *influxql.SelectStatement, ctx *query.ExecutionContext) error {
-	cur, err := e.createIterators(ctx, stmt, ctx.ExecutionOptions)
+	// Return the cached results of the statement if its shards are unchanged.
+	// The results of users limited by privileges are not cached, as they
+	// would still be returned after their privileges are revoked.
+	var entry *resultCacheEntry
+	if e.ResultCache != nil {
+		if ctx.NoCache || !query.AuthorizerIsOpen(ctx.Authorizer) {
+			e.ResultCache.bypass()
+		} else if key, modified, err := e.resultCacheKey(stmt, ctx); err != nil {
+			return err
+		} else if key != "" {
+			if cached := e.ResultCache.get(key, modified); cached != nil {
+				return e.sendCachedResults(cached, ctx)
+			}
+			entry = &resultCacheEntry{key: key, modified: modified}
+		}
+	}
+
+	cur, err := e.createIterators(ctx, stmt, ctx.ExecutionOptions, ctx.Limits)
 	if err != nil {
 		return err
 	}
@@ -578,6 +603,14 @@ func (e *StatementExecutor) executeSelectStatement(stmt *influxql.SelectStatemen
 			continue
 		}
 
+		// Keep a copy of the row for the result cache. Results larger than
+		// the cache are not kept.
+		if entry != nil {
+			if entry.append(row, partial); entry.size > e.ResultCache.maxSize {
+				entry = nil
+			}
+		}
+
 		result := &query.Result{
 			Series:  []*models.Row{row},
 			Partial: partial,
@@ -612,6 +645,10 @@ func (e *StatementExecutor) executeSelectStatement(stmt *influxql.SelectStatemen
 		})
 	}
 
+	if entry != nil {
+		e.ResultCache.put(entry)
+	}
+
 	// Always emit at least one result.
 	if !emitted {
 		return ctx.Send(&query.Result{
@@ -622,12 +659,133 @@ func (e *StatementExecutor) executeSelectStatement(stmt *influxql.SelectStatemen
 	return nil
 }
 
-func (e *StatementExecutor) createIterators(ctx context.Context, stmt *influxql.SelectStatement, opt query.ExecutionOptions) (query.Cursor, error) {
+// sendCachedResults sends the results of a SELECT statement from the result cache.
+func (e *StatementExecutor) sendCachedResults(entry *resultCacheEntry, ctx *query.ExecutionContext) error {
+	// Always emit at least one result.
+	if len(entry.rows) == 0 {
+		return ctx.Send(&query.Result{
+			Series: make([]*models.Row, 0),
+		})
+	}
+
+	// The rows are copied as the receiver may modify them.
+	for i, row := range entry.rows {
+		if err := ctx.Send(&query.Result{
+			Series:  []*models.Row{copyRow(row)},
+			Partial: entry.partial[i],
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resultCacheKey returns the result cache key of stmt and the last modified
+// time of each shard it reads. An empty key is returned if the results of the
+// statement depend on the current time or cannot be cached.
+func (e *StatementExecutor) resultCacheKey(stmt *influxql.SelectStatement, ctx *query.ExecutionContext) (string, []time.Time, error) {
+	if stmt.Target != nil {
+		return "", nil, nil
+	}
+
+	// Statements relative to now() return different results each time
+	// they are run. This includes aggregates without an upper time bound
+	// as they end at now().
+	var usesNow, hasInterval bool
+	influxql.WalkFunc(stmt, func(n influxql.Node) {
+		switch n := n.(type) {
+		case *influxql.Call:
+			if n.Name == "now" {
+				usesNow = true
+			}
+		case *influxql.SelectStatement:
+			if interval, err := n.GroupByInterval(); err == nil && interval > 0 {
+				hasInterval = true
+			}
+		}
+	})
+	if usesNow {
+		return "", nil, nil
+	}
+
+	// Invalid conditions are reported when the statement is executed.
+	_, t, err := influxql.ConditionExpr(stmt.Condition, &influxql.NowValuer{Location: stmt.Location})
+	if err != nil {
+		return "", nil, nil
+	} else if t.Max.IsZero() && hasInterval {
+		return "", nil, nil
+	}
+	tmin, tmax := time.Unix(0, t.MinTimeNano()), time.Unix(0, t.MaxTimeNano())
+
+	// Find the shards read by the statement in the same way as the shard mapper.
+	shardIDs, err := e.resultCacheShardIDs(stmt.Sources, tmin, tmax, make(map[Source]struct{}))
+	if err != nil {
+		return "", nil, err
+	}
+	sort.Slice(shardIDs, func(i, j int) bool { return shardIDs[i] < shardIDs[j] })
+
+	var buf bytes.Buffer
+	buf.WriteString(stmt.String())
+	fmt.Fprintf(&buf, "\x00%s\x00%d\x00%d\x00%d\x00%+v", ctx.Database, ctx.ChunkSize, tmin.UnixNano(), tmax.UnixNano(), ctx.Limits)
+
+	modified := make([]time.Time, len(shardIDs))
+	for i, id := range shardIDs {
+		fmt.Fprintf(&buf, "\x00%d", id)
+		if sh := e.TSDBStore.Shard(id); sh != nil {
+			modified[i] = sh.LastModified()
+		}
+	}
+	return buf.String(), modified, nil
+}
+
+// resultCacheShardIDs returns the IDs of the shards read by sources.
+func (e *StatementExecutor) resultCacheShardIDs(sources influxql.Sources, tmin, tmax time.Time, seen map[Source]struct{}) ([]uint64, error) {
+	var shardIDs []uint64
+	for _, s := range sources {
+		switch s := s.(type) {
+		case *influxql.Measurement:
+			source := Source{
+				Database:        s.Database,
+				RetentionPolicy: s.RetentionPolicy,
+			}
+			if _, ok := seen[source]; ok {
+				continue
+			}
+			seen[source] = struct{}{}
+
+			groups, err := e.MetaClient.ShardGroupsByTimeRange(s.Database, s.RetentionPolicy, tmin, tmax)
+			if err != nil {
+				return nil, err
+			}
+			for _, g := range groups {
+				for _, si := range g.Shards {
+					shardIDs = append(shardIDs, si.ID)
+				}
+			}
+		case *influxql.SubQuery:
+			ids, err := e.resultCacheShardIDs(s.Statement.Sources, tmin, tmax, seen)
+			if err != nil {
+				return nil, err
+			}
+			shardIDs = append(shardIDs, ids...)
+		}
+	}
+	return shardIDs, nil
+}
+
+func (e *StatementExecutor) createIterators(ctx context.Context, stmt *influxql.SelectStatement, opt query.ExecutionOptions, limits query.QueryLimits) (query.Cursor, error) {
+	// Apply the user and database limits on top of the global limits.
+	limits = limits.Merge(query.QueryLimits{
+		MaxSelectPointN:   e.MaxSelectPointN,
+		MaxSelectSeriesN:  e.MaxSelectSeriesN,
+		MaxSelectBucketsN: e.MaxSelectBucketsN,
+	})
+
 	sopt := query.SelectOptions{
 		NodeID:      opt.NodeID,
-		MaxSeriesN:  e.MaxSelectSeriesN,
-		MaxPointN:   e.MaxSelectPointN,
-		MaxBucketsN: e.MaxSelectBucketsN,
+		MaxSeriesN:  limits.MaxSelectSeriesN,
+		MaxPointN:   limits.MaxSelectPointN,
+		MaxBucketsN: limits.MaxSelectBucketsN,
 		Authorizer:  opt.Authorizer,
 	}
 
@@ -790,7 +948,7 @@ func (e *StatementExecutor) executeShowShardsStatement(stmt *influxql.ShowShards
 
 	rows := []*models.Row{}
 	for _, di := range dis {
-		row := &models.Row{Columns: []string{"id", "database", "retention_policy", "shard_group", "start_time", "end_time", "expiry_time", "owners"}, Name: di.Name}
+		row := &models.Row{Columns: []string{"id", "database", "retention_policy", "shard_group", "start_time", "end_time", "expiry_time", "owners", "disk_size", "cold_disk_size"}, Name: di.Name}
 		for _, rpi := range di.RetentionPolicies {
 			for _, sgi := range rpi.ShardGroups {
 				// Shards associated with deleted shard groups are effectively deleted.
@@ -805,6 +963,17 @@ func (e *StatementExecutor) executeShowShardsStatement(stmt *influxql.ShowShards
 						ownerIDs[i] = owner.NodeID
 					}
 
+					// The disk sizes are only known for shards stored on this node.
+					var diskSize, coldDiskSize interface{}
+					if sh := e.TSDBStore.Shard(si.ID); sh != nil {
+						if n, err := sh.DiskSize(); err == nil {
+							diskSize = n
+						}
+						if n, err := sh.ColdDiskSize(); err == nil {
+							coldDiskSize = n
+						}
+					}
+
 					row.Values = append(row.Values, []interface{}{
 						si.ID,
 						di.Name,
@@ -814,6 +983,8 @@ func (e *StatementExecutor) executeShowShardsStatement(stmt *influxql.ShowShards
 						sgi.EndTime.UTC().Format(time.RFC3339),
 						sgi.EndTime.Add(rpi.Duration).UTC().Format(time.RFC3339),
 						joinUint64(ownerIDs),
+						diskSize,
+						coldDiskSize,
 					})
 				}
 			}
@@ -1342,6 +1513,7 @@ type TSDBStore interface {
 	DeleteRetentionPolicy(database, name string) error
 	DeleteSeries(database string, sources []influxql.Source, condition influxql.Expr) error
 	DeleteShard(id uint64) error
+	Shard(id uint64) *tsdb.Shard
 
 	MeasurementNames(auth query.Authorizer, database string, cond influxql.Expr) ([][]byte, error)
 	TagKeys(auth query.Authorizer, shardIDs []uint64, cond influxql.Expr) ([]tsdb.TagKeys, error)
@@ -84,6 +84,85 @@ func TestQueryExecutor_ExecuteQuery_SelectStatement(t *testing.T) {
 	}
 }
 
+// Ensure query executor returns cached results until a shard is modified.
+func TestQueryExecutor_ExecuteQuery_ResultCache(t *testing.T) {
+	e := DefaultQueryExecutor()
+	e.StatementExecutor.ResultCache = coordinator.NewResultCache(1 << 20)
+
+	e.MetaClient.ShardGroupsByTimeRangeFn = func(database, policy string, min, max time.Time) (a []meta.ShardGroupInfo, err error) {
+		return []meta.ShardGroupInfo{
+			{ID: 1, Shards: []meta.ShardInfo{
+				{ID: 100, Owners: []meta.ShardOwner{{NodeID: 0}}},
+			}},
+		}, nil
+	}
+	e.TSDBStore.ShardFn = func(id uint64) *tsdb.Shard { return nil }
+
+	var iteratorN int
+	e.TSDBStore.ShardGroupFn = func(ids []uint64) tsdb.ShardGroup {
+		var sh MockShard
+		sh.CreateIteratorFn = func(_ context.Context, _ *influxql.Measurement, _ query.IteratorOptions) (query.Iterator, error) {
+			iteratorN++
+			return &FloatIterator{Points: []query.FloatPoint{
+				{Name: "cpu", Time: int64(0 * time.Second), Aux: []interface{}{float64(100)}},
+			}}, nil
+		}
+		sh.FieldDimensionsFn = func(measurements []string) (fields map[string]influxql.DataType, dimensions map[string]struct{}, err error) {
+			return map[string]influxql.DataType{"value": influxql.Float}, nil, nil
+		}
+		return &sh
+	}
+
+	exp := []*query.Result{
+		{
+			StatementID: 0,
+			Series: []*models.Row{{
+				Name:    "cpu",
+				Columns: []string{"time", "value"},
+				Values: [][]interface{}{
+					{time.Unix(0, 0).UTC(), float64(100)},
+				},
+			}},
+		},
+	}
+	for i := 0; i < 2; i++ {
+		if a := ReadAllResults(e.ExecuteQuery(`SELECT * FROM cpu WHERE time < 1h`, "db0", 0)); !reflect.DeepEqual(a, exp) {
+			t.Fatalf("unexpected results: %s", spew.Sdump(a))
+		} else if iteratorN != 1 {
+			t.Fatalf("unexpected iterator count: %d", iteratorN)
+		}
+	}
+
+	// Queries relative to now() are not cached.
+	ReadAllResults(e.ExecuteQuery(`SELECT * FROM cpu WHERE time < now()`, "db0", 0))
+	ReadAllResults(e.ExecuteQuery(`SELECT * FROM cpu WHERE time < now()`, "db0", 0))
+	if iteratorN != 3 {
+		t.Fatalf("unexpected iterator count: %d", iteratorN)
+	}
+
+	// The cache can be bypassed.
+	if a := ReadAllResults(e.Executor.ExecuteQuery(MustParseQuery(`SELECT * FROM cpu WHERE time < 1h`), query.ExecutionOptions{
+		Database: "db0",
+		NoCache:  true,
+	}, make(chan struct{}))); !reflect.DeepEqual(a, exp) {
+		t.Fatalf("unexpected results: %s", spew.Sdump(a))
+	} else if iteratorN != 4 {
+		t.Fatalf("unexpected iterator count: %d", iteratorN)
+	}
+
+	// The results of users limited by privileges are not cached.
+	for i := 0; i < 2; i++ {
+		if a := ReadAllResults(e.Executor.ExecuteQuery(MustParseQuery(`SELECT * FROM cpu WHERE time < 1h`), query.ExecutionOptions{
+			Database:   "db0",
+			Authorizer: &mockAuthorizer{AuthorizeDatabaseFn: func(influxql.Privilege, string) bool { return true }},
+		}, make(chan struct{}))); !reflect.DeepEqual(a, exp) {
+			t.Fatalf("unexpected results: %s", spew.Sdump(a))
+		} else if iteratorN != 5+i {
+			t.Fatalf("unexpected iterator count: %d", iteratorN)
+		}
+	}
+}
+
 // Ensure query executor can enforce a maximum bucket selection count.
 func TestQueryExecutor_ExecuteQuery_MaxSelectBucketsN(t *testing.T) {
 	e := DefaultQueryExecutor()
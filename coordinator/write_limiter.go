@@ -0,0 +1,191 @@
+package coordinator
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/influxdata/influxdb/models"
+	"github.com/influxdata/influxdb/pkg/limiter"
+)
+
+// Statistics for the WriteLimiter.
+const (
+	statWriteLimitReq          = "req"
+	statWriteLimitReqLimited   = "reqLimited"
+	statWriteLimitPointLimited = "pointsLimited"
+	statWriteLimitBytesLimited = "bytesLimited"
+)
+
+// WriteLimitError is returned when a write exceeds the rate limit of a
+// database or user.
+type WriteLimitError struct {
+	Database string
+	User     string
+
+	// RetryAfter is the time until the write may succeed.
+	RetryAfter time.Duration
+}
+
+// Error returns the string representation of the error.
+func (e *WriteLimitError) Error() string {
+	if e.User != "" {
+		return fmt.Sprintf("write limit exceeded for user %q, retry after %s", e.User, e.RetryAfter)
+	}
+	return fmt.Sprintf("write limit exceeded for database %q, retry after %s", e.Database, e.RetryAfter)
+}
+
+// WriteLimiter limits the rate at which points and bytes may be written to
+// individual databases and by individual users. Each limit is enforced with a
+// token bucket which holds one second's worth of writes.
+type WriteLimiter struct {
+	databases map[string]*writeLimit
+	users     map[string]*writeLimit
+}
+
+// NewWriteLimiter returns a WriteLimiter for the given configuration.
+func NewWriteLimiter(configs []WriteLimitConfig) *WriteLimiter {
+	l := &WriteLimiter{
+		databases: make(map[string]*writeLimit),
+		users:     make(map[string]*writeLimit),
+	}
+
+	for _, c := range configs {
+		wl := newWriteLimit(c)
+		if c.User != "" {
+			l.users[c.User] = wl
+		} else {
+			l.databases[c.Database] = wl
+		}
+	}
+	return l
+}
+
+// AllowWrite returns nil if pointN points totalling byteN bytes may be
+// written to database by user. Otherwise, a *WriteLimitError is returned and
+// the write is not counted against any limit.
+func (l *WriteLimiter) AllowWrite(database, user string, pointN, byteN int) error {
+	dl := l.databases[database]
+	var ul *writeLimit
+	if user != "" {
+		ul = l.users[user]
+	}
+
+	if dl == nil && ul == nil {
+		return nil
+	}
+
+	cancel, err := dl.reserve(pointN, byteN)
+	if err != nil {
+		err.Database = database
+		return err
+	}
+
+	if _, err := ul.reserve(pointN, byteN); err != nil {
+		// Return the tokens taken from the database limit.
+		cancel()
+		err.Database, err.User = database, user
+		return err
+	}
+	return nil
+}
+
+// Statistics returns statistics for periodic monitoring.
+func (l *WriteLimiter) Statistics(tags map[string]string) []models.Statistic {
+	var statistics []models.Statistic
+	for name, wl := range l.databases {
+		statistics = append(statistics, wl.statistics(models.StatisticTags{"database": name}.Merge(tags)))
+	}
+	for name, wl := range l.users {
+		statistics = append(statistics, wl.statistics(models.StatisticTags{"user": name}.Merge(tags)))
+	}
+	return statistics
+}
+
+// writeLimit holds the token buckets for a single database or user.
+type writeLimit struct {
+	points *limiter.Bucket
+	bytes  *limiter.Bucket
+
+	mu    sync.Mutex
+	stats writeLimitStatistics
+}
+
+type writeLimitStatistics struct {
+	Req           int64
+	ReqLimited    int64
+	PointsLimited int64
+	BytesLimited  int64
+}
+
+func newWriteLimit(c WriteLimitConfig) *writeLimit {
+	wl := &writeLimit{}
+	if c.PointsPerSecond > 0 {
+		wl.points = limiter.NewBucket(c.PointsPerSecond, c.PointsPerSecond)
+	}
+	if c.BytesPerSecond > 0 {
+		wl.bytes = limiter.NewBucket(c.BytesPerSecond, c.BytesPerSecond)
+	}
+	return wl
+}
+
+// reserve takes tokens for the write from each bucket. If any bucket does
+// not have enough tokens, none are taken and an error is returned.
+func (wl *writeLimit) reserve(pointN, byteN int) (func(), *WriteLimitError) {
+	if wl == nil {
+		return func() {}, nil
+	}
+
+	wl.mu.Lock()
+	defer wl.mu.Unlock()
+
+	atomic.AddInt64(&wl.stats.Req, 1)
+
+	var cancelPoints func()
+	if wl.points != nil {
+		var d time.Duration
+		if cancelPoints, d = wl.points.Reserve(pointN); d > 0 {
+			atomic.AddInt64(&wl.stats.ReqLimited, 1)
+			atomic.AddInt64(&wl.stats.PointsLimited, int64(pointN))
+			return nil, &WriteLimitError{RetryAfter: d}
+		}
+	}
+
+	var cancelBytes func()
+	if wl.bytes != nil {
+		var d time.Duration
+		if cancelBytes, d = wl.bytes.Reserve(byteN); d > 0 {
+			if cancelPoints != nil {
+				cancelPoints()
+			}
+			atomic.AddInt64(&wl.stats.ReqLimited, 1)
+			atomic.AddInt64(&wl.stats.BytesLimited, int64(byteN))
+			return nil, &WriteLimitError{RetryAfter: d}
+		}
+	}
+
+	return func() {
+		wl.mu.Lock()
+		defer wl.mu.Unlock()
+		if cancelPoints != nil {
+			cancelPoints()
+		}
+		if cancelBytes != nil {
+			cancelBytes()
+		}
+	}, nil
+}
+
+func (wl *writeLimit) statistics(tags map[string]string) models.Statistic {
+	return models.Statistic{
+		Name: "writeLimit",
+		Tags: tags,
+		Values: map[string]interface{}{
+			statWriteLimitReq:          atomic.LoadInt64(&wl.stats.Req),
+			statWriteLimitReqLimited:   atomic.LoadInt64(&wl.stats.ReqLimited),
+			statWriteLimitPointLimited: atomic.LoadInt64(&wl.stats.PointsLimited),
+			statWriteLimitBytesLimited: atomic.LoadInt64(&wl.stats.BytesLimited),
+		},
+	}
+}
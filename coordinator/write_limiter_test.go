@@ -0,0 +1,44 @@
+package coordinator_test
+
+import (
+	"testing"
+
+	"github.com/influxdata/influxdb/coordinator"
+)
+
+func TestWriteLimiter_AllowWrite(t *testing.T) {
+	l := coordinator.NewWriteLimiter([]coordinator.WriteLimitConfig{
+		{Database: "db0", PointsPerSecond: 100},
+		{User: "user0", BytesPerSecond: 1000},
+	})
+
+	// Databases and users without limits are never limited.
+	if err := l.AllowWrite("db1", "user1", 1000, 100000); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := l.AllowWrite("db0", "", 60, 100); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	err := l.AllowWrite("db0", "", 60, 100)
+	if lerr, ok := err.(*coordinator.WriteLimitError); !ok {
+		t.Fatalf("expected write limit error, got %v", err)
+	} else if lerr.Database != "db0" || lerr.RetryAfter <= 0 {
+		t.Fatalf("unexpected error: %+v", lerr)
+	}
+
+	// Rejected user writes must not consume the database limit.
+	if err := l.AllowWrite("db0", "user0", 10, 5000); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	err = l.AllowWrite("db0", "user0", 10, 5000)
+	if lerr, ok := err.(*coordinator.WriteLimitError); !ok {
+		t.Fatalf("expected write limit error, got %v", err)
+	} else if lerr.User != "user0" {
+		t.Fatalf("unexpected error: %+v", lerr)
+	}
+	if err := l.AllowWrite("db0", "", 30, 100); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
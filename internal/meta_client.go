@@ -3,6 +3,7 @@ package internal
 import (
 	"time"
 
+	"github.com/influxdata/influxdb/query"
 	"github.com/influxdata/influxdb/services/meta"
 	"github.com/influxdata/influxql"
 )
@@ -16,6 +17,7 @@ type MetaClientMock struct {
 	CreateRetentionPolicyFn             func(database string, spec *meta.RetentionPolicySpec, makeDefault bool) (*meta.RetentionPolicyInfo, error)
 	CreateShardGroupFn                  func(database, policy string, timestamp time.Time) (*meta.ShardGroupInfo, error)
 	CreateSubscriptionFn                func(database, rp, name, mode string, destinations []string) error
+	CreateTokenFn                       func(username, database string, p influxql.Privilege, expiration time.Time) (string, *meta.TokenInfo, error)
 	CreateUserFn                        func(name, password string, admin bool) (meta.User, error)
 
 	DatabaseFn  func(name string) *meta.DatabaseInfo
@@ -36,21 +38,27 @@ type MetaClientMock struct {
 	PruneShardGroupsFn     func() error
 
 	RetentionPolicyFn func(database, name string) (rpi *meta.RetentionPolicyInfo, err error)
-
-	AuthenticateFn           func(username, password string) (ui meta.User, err error)
-	AdminUserExistsFn        func() bool
-	SetAdminPrivilegeFn      func(username string, admin bool) error
-	SetDataFn                func(*meta.Data) error
-	SetPrivilegeFn           func(username, database string, p influxql.Privilege) error
-	ShardGroupsByTimeRangeFn func(database, policy string, min, max time.Time) (a []meta.ShardGroupInfo, err error)
-	ShardOwnerFn             func(shardID uint64) (database, policy string, sgi *meta.ShardGroupInfo)
-	TruncateShardGroupsFn    func(t time.Time) error
-	UpdateRetentionPolicyFn  func(database, name string, rpu *meta.RetentionPolicyUpdate, makeDefault bool) error
-	UpdateUserFn             func(name, password string) error
-	UserPrivilegeFn          func(username, database string) (*influxql.Privilege, error)
-	UserPrivilegesFn         func(username string) (map[string]influxql.Privilege, error)
-	UserFn                   func(username string) (meta.User, error)
-	UsersFn                  func() []meta.UserInfo
+	RevokeTokenFn     func(id string) error
+
+	AuthenticateFn            func(username, password string) (ui meta.User, err error)
+	AuthenticateTokenFn       func(token string) (meta.User, error)
+	AdminUserExistsFn         func() bool
+	SetAdminPrivilegeFn       func(username string, admin bool) error
+	SetUserQueryLimitsFn      func(username string, limits query.QueryLimits) error
+	SetDatabaseQueryLimitsFn  func(database string, limits query.QueryLimits) error
+	SetDataFn                 func(*meta.Data) error
+	SetMeasurementPrivilegeFn func(username, database, measurement string, regex bool, p influxql.Privilege) error
+	SetPrivilegeFn            func(username, database string, p influxql.Privilege) error
+	ShardGroupsByTimeRangeFn  func(database, policy string, min, max time.Time) (a []meta.ShardGroupInfo, err error)
+	ShardOwnerFn              func(shardID uint64) (database, policy string, sgi *meta.ShardGroupInfo)
+	TokensFn                  func() []meta.TokenInfo
+	TruncateShardGroupsFn     func(t time.Time) error
+	UpdateRetentionPolicyFn   func(database, name string, rpu *meta.RetentionPolicyUpdate, makeDefault bool) error
+	UpdateUserFn              func(name, password string) error
+	UserPrivilegeFn           func(username, database string) (*influxql.Privilege, error)
+	UserPrivilegesFn          func(username string) (map[string]influxql.Privilege, error)
+	UserFn                    func(username string) (meta.User, error)
+	UsersFn                   func() []meta.UserInfo
 }
 
 func (c *MetaClientMock) Close() error {
@@ -81,6 +89,10 @@ func (c *MetaClientMock) CreateSubscription(database, rp, name, mode string, des
 	return c.CreateSubscriptionFn(database, rp, name, mode, destinations)
 }
 
+func (c *MetaClientMock) CreateToken(username, database string, p influxql.Privilege, expiration time.Time) (string, *meta.TokenInfo, error) {
+	return c.CreateTokenFn(username, database, p, expiration)
+}
+
 func (c *MetaClientMock) CreateUser(name, password string, admin bool) (meta.User, error) {
 	return c.CreateUserFn(name, password, admin)
 }
@@ -125,10 +137,26 @@ func (c *MetaClientMock) RetentionPolicy(database, name string) (rpi *meta.Reten
 	return c.RetentionPolicyFn(database, name)
 }
 
+func (c *MetaClientMock) RevokeToken(id string) error {
+	return c.RevokeTokenFn(id)
+}
+
 func (c *MetaClientMock) SetAdminPrivilege(username string, admin bool) error {
 	return c.SetAdminPrivilegeFn(username, admin)
 }
 
+func (c *MetaClientMock) SetUserQueryLimits(username string, limits query.QueryLimits) error {
+	return c.SetUserQueryLimitsFn(username, limits)
+}
+
+func (c *MetaClientMock) SetDatabaseQueryLimits(database string, limits query.QueryLimits) error {
+	return c.SetDatabaseQueryLimitsFn(database, limits)
+}
+
+func (c *MetaClientMock) SetMeasurementPrivilege(username, database, measurement string, regex bool, p influxql.Privilege) error {
+	return c.SetMeasurementPrivilegeFn(username, database, measurement, regex, p)
+}
+
 func (c *MetaClientMock) SetPrivilege(username, database string, p influxql.Privilege) error {
 	return c.SetPrivilegeFn(username, database, p)
 }
@@ -141,6 +169,10 @@ func (c *MetaClientMock) ShardOwner(shardID uint64) (database, policy string, sg
 	return c.ShardOwnerFn(shardID)
 }
 
+func (c *MetaClientMock) Tokens() []meta.TokenInfo {
+	return c.TokensFn()
+}
+
 func (c *MetaClientMock) TruncateShardGroups(t time.Time) error {
 	return c.TruncateShardGroupsFn(t)
 }
@@ -164,6 +196,9 @@ func (c *MetaClientMock) UserPrivileges(username string) (map[string]influxql.Pr
 func (c *MetaClientMock) Authenticate(username, password string) (meta.User, error) {
 	return c.AuthenticateFn(username, password)
 }
+func (c *MetaClientMock) AuthenticateToken(token string) (meta.User, error) {
+	return c.AuthenticateTokenFn(token)
+}
 func (c *MetaClientMock) AdminUserExists() bool { return c.AdminUserExistsFn() }
 
 func (c *MetaClientMock) User(username string) (meta.User, error) { return c.UserFn(username) }
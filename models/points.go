@@ -58,6 +58,11 @@ func EnableUintSupport() {
 	enableUint64Support = true
 }
 
+// UintSupportEnabled returns true if uint support is enabled for the point parser.
+func UintSupportEnabled() bool {
+	return enableUint64Support
+}
+
 // Point defines the values that will be written to the database.
 type Point interface {
 	// Name return the measurement name for the point.
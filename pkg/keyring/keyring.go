@@ -0,0 +1,105 @@
+// Package keyring provides the master keys used to encrypt data at rest.
+package keyring
+
+import (
+	"bufio"
+	"crypto/aes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// MaxKeyIDSize is the maximum length of a key ID in bytes.
+const MaxKeyIDSize = 64
+
+// ErrKeyNotFound is returned when a key does not exist in a keyring.
+var ErrKeyNotFound = errors.New("key not found")
+
+// Provider provides master keys by ID. Data encrypted with a key can only be
+// decrypted while the provider still holds that key.
+type Provider interface {
+	// ActiveKey returns the ID and value of the key used to encrypt new data.
+	ActiveKey() (id string, key []byte, err error)
+
+	// Key returns the value of the key with the given ID.
+	Key(id string) ([]byte, error)
+}
+
+// File is a Provider which reads its keys from a file.
+//
+// Each line of the file holds a key ID and a base64 encoded 128, 192 or 256-bit
+// AES key separated by whitespace. Blank lines and lines starting with # are
+// ignored. The last key in the file is the active key, so keys are rotated by
+// appending a new key to the file.
+type File struct {
+	path   string
+	active string
+	keys   map[string][]byte
+}
+
+// Open returns a keyring with the keys read from the file at path.
+func Open(path string) (*File, error) {
+	fd, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer fd.Close()
+
+	f := &File{path: path, keys: make(map[string][]byte)}
+	scanner := bufio.NewScanner(fd)
+	for lineN := 1; scanner.Scan(); lineN++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("keyring %s:%d: expected key ID and key", path, lineN)
+		}
+
+		id := fields[0]
+		if len(id) > MaxKeyIDSize {
+			return nil, fmt.Errorf("keyring %s:%d: key ID longer than %d bytes", path, lineN, MaxKeyIDSize)
+		} else if _, ok := f.keys[id]; ok {
+			return nil, fmt.Errorf("keyring %s:%d: duplicate key ID %s", path, lineN, id)
+		}
+
+		key, err := base64.StdEncoding.DecodeString(fields[1])
+		if err != nil {
+			return nil, fmt.Errorf("keyring %s:%d: %s", path, lineN, err)
+		} else if _, err := aes.NewCipher(key); err != nil {
+			return nil, fmt.Errorf("keyring %s:%d: %s", path, lineN, err)
+		}
+
+		f.keys[id] = key
+		f.active = id
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(f.keys) == 0 {
+		return nil, fmt.Errorf("keyring %s: no keys found", path)
+	}
+	return f, nil
+}
+
+// Path returns the path of the keyring file.
+func (f *File) Path() string { return f.path }
+
+// ActiveKey returns the ID and value of the last key in the file.
+func (f *File) ActiveKey() (string, []byte, error) {
+	return f.active, f.keys[f.active], nil
+}
+
+// Key returns the value of the key with the given ID.
+func (f *File) Key(id string) ([]byte, error) {
+	key, ok := f.keys[id]
+	if !ok {
+		return nil, ErrKeyNotFound
+	}
+	return key, nil
+}
@@ -0,0 +1,72 @@
+package keyring_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/influxdata/influxdb/pkg/keyring"
+)
+
+func TestOpen(t *testing.T) {
+	path := writeKeyring(t, `
+# retired
+k1 AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA=
+
+k2 AQEBAQEBAQEBAQEBAQEBAQ==
+`)
+	defer os.RemoveAll(filepath.Dir(path))
+
+	keys, err := keyring.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The last key in the file is active.
+	id, key, err := keys.ActiveKey()
+	if err != nil {
+		t.Fatal(err)
+	} else if id != "k2" || !bytes.Equal(key, bytes.Repeat([]byte{1}, 16)) {
+		t.Fatalf("unexpected active key: %s %x", id, key)
+	}
+
+	if key, err := keys.Key("k1"); err != nil {
+		t.Fatal(err)
+	} else if !bytes.Equal(key, make([]byte, 32)) {
+		t.Fatalf("unexpected key: %x", key)
+	}
+
+	if _, err := keys.Key("k3"); err != keyring.ErrKeyNotFound {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestOpen_Invalid(t *testing.T) {
+	for _, s := range []string{
+		"",
+		"k1",
+		"k1 not-base64",
+		"k1 AQEB",
+		"k1 AQEBAQEBAQEBAQEBAQEBAQ==\nk1 AQEBAQEBAQEBAQEBAQEBAQ==",
+	} {
+		path := writeKeyring(t, s)
+		if _, err := keyring.Open(path); err == nil {
+			t.Errorf("expected error for keyring %q", s)
+		}
+		os.RemoveAll(filepath.Dir(path))
+	}
+}
+
+func writeKeyring(t *testing.T, s string) string {
+	dir, err := ioutil.TempDir("", "keyring")
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(dir, "keyring")
+	if err := ioutil.WriteFile(path, []byte(s), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
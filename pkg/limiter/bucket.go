@@ -0,0 +1,47 @@
+package limiter
+
+import (
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// Bucket is a non-blocking token bucket which refills at a fixed rate.
+type Bucket struct {
+	limiter *rate.Limiter
+	burst   int
+}
+
+// NewBucket returns a bucket which refills at perSec tokens per second and
+// holds at most burst tokens. The bucket is initially full.
+func NewBucket(perSec, burst int) *Bucket {
+	return &Bucket{
+		limiter: rate.NewLimiter(rate.Limit(perSec), burst),
+		burst:   burst,
+	}
+}
+
+// Reserve takes n tokens from the bucket if they are available now and
+// returns a function which returns them to the bucket. Otherwise, no tokens
+// are taken and the time until n tokens will be available is returned.
+//
+// Requests for more tokens than the burst size are treated as a request for
+// the entire bucket, so large requests can succeed once the bucket is full.
+func (b *Bucket) Reserve(n int) (cancel func(), delay time.Duration) {
+	if n > b.burst {
+		n = b.burst
+	}
+
+	now := time.Now()
+	r := b.limiter.ReserveN(now, n)
+	if !r.OK() {
+		// Only possible when the burst size is zero.
+		return nil, time.Second
+	}
+
+	if d := r.DelayFrom(now); d > 0 {
+		r.CancelAt(now)
+		return nil, d
+	}
+	return func() { r.CancelAt(now) }, 0
+}
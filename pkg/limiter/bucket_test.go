@@ -0,0 +1,39 @@
+package limiter_test
+
+import (
+	"testing"
+
+	"github.com/influxdata/influxdb/pkg/limiter"
+)
+
+func TestBucket_Reserve(t *testing.T) {
+	b := limiter.NewBucket(10, 10)
+
+	cancel, d := b.Reserve(8)
+	if d != 0 || cancel == nil {
+		t.Fatalf("expected reservation to succeed, delay %s", d)
+	}
+
+	// Not enough tokens remain, so nothing is taken.
+	if _, d := b.Reserve(5); d <= 0 {
+		t.Fatalf("expected reservation to be delayed")
+	}
+
+	// Returning the tokens allows the next reservation to succeed.
+	cancel()
+	if _, d := b.Reserve(5); d != 0 {
+		t.Fatalf("expected reservation to succeed after cancel, delay %s", d)
+	}
+}
+
+func TestBucket_Reserve_ExceedsBurst(t *testing.T) {
+	b := limiter.NewBucket(10, 10)
+
+	// A request larger than the burst takes the whole bucket.
+	if _, d := b.Reserve(100); d != 0 {
+		t.Fatalf("expected reservation to succeed, delay %s", d)
+	}
+	if _, d := b.Reserve(1); d <= 0 {
+		t.Fatalf("expected reservation to be delayed")
+	}
+}
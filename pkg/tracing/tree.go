@@ -1,6 +1,8 @@
 package tracing
 
 import (
+	"time"
+
 	"github.com/xlab/treeprint"
 )
 
@@ -72,3 +74,47 @@ func (v *treeVisitor) Visit(n *TreeNode) Visitor {
 
 	return nil
 }
+
+// Map returns the tree as nested maps and slices, which is suitable for
+// encoding as JSON or MessagePack. Duration fields are represented in
+// nanoseconds.
+func (t *TreeNode) Map() map[string]interface{} {
+	if t == nil {
+		return nil
+	}
+
+	m := map[string]interface{}{
+		"name":  t.Raw.Name,
+		"start": t.Raw.Start,
+	}
+
+	if len(t.Raw.Labels) > 0 {
+		labels := make(map[string]interface{}, len(t.Raw.Labels))
+		for _, l := range t.Raw.Labels {
+			labels[l.Key] = l.Value
+		}
+		m["labels"] = labels
+	}
+
+	if len(t.Raw.Fields) > 0 {
+		fields := make(map[string]interface{}, len(t.Raw.Fields))
+		for _, f := range t.Raw.Fields {
+			v := f.Value()
+			if d, ok := v.(time.Duration); ok {
+				v = int64(d)
+			}
+			fields[f.Key()] = v
+		}
+		m["fields"] = fields
+	}
+
+	if len(t.Children) > 0 {
+		children := make([]interface{}, 0, len(t.Children))
+		for _, c := range t.Children {
+			children = append(children, c.Map())
+		}
+		m["children"] = children
+	}
+
+	return m
+}
@@ -0,0 +1,40 @@
+package tracing_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/influxdata/influxdb/pkg/tracing"
+	"github.com/influxdata/influxdb/pkg/tracing/fields"
+	"github.com/influxdata/influxdb/pkg/tracing/labels"
+)
+
+func TestTreeNode_Map(t *testing.T) {
+	start := time.Unix(0, 0).UTC()
+	tree := &tracing.TreeNode{
+		Raw: tracing.RawSpan{
+			Name:   "select",
+			Start:  start,
+			Labels: labels.New("db", "foo"),
+			Fields: fields.New(fields.Duration("total_time", time.Second), fields.Int64("series_n", 2)),
+		},
+		Children: []*tracing.TreeNode{
+			{Raw: tracing.RawSpan{Name: "create_iterator", Start: start}},
+		},
+	}
+
+	exp := map[string]interface{}{
+		"name":   "select",
+		"start":  start,
+		"labels": map[string]interface{}{"db": "foo"},
+		"fields": map[string]interface{}{"total_time": int64(time.Second), "series_n": int64(2)},
+		"children": []interface{}{
+			map[string]interface{}{"name": "create_iterator", "start": start},
+		},
+	}
+
+	if got := tree.Map(); !reflect.DeepEqual(got, exp) {
+		t.Fatalf("unexpected map:\ngot: %#v\nexp: %#v", got, exp)
+	}
+}
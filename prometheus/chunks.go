@@ -0,0 +1,187 @@
+package prometheus
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"io"
+	"math"
+	"math/bits"
+
+	"github.com/dgryski/go-bitstream"
+	"github.com/influxdata/influxdb/prometheus/remote"
+)
+
+// StreamedContentType is the content type of a streamed chunked remote read response.
+const StreamedContentType = "application/x-streamed-protobuf; proto=prometheus.ChunkedReadResponse"
+
+// maxSamplesPerChunk is the number of samples Prometheus stores in a chunk.
+const maxSamplesPerChunk = 120
+
+// castagnoliTable is the CRC32 table used for the checksums of response frames.
+var castagnoliTable = crc32.MakeTable(crc32.Castagnoli)
+
+// AcceptsStreamedChunks returns true if the client of a remote read request prefers a streamed
+// chunked response over a single response holding the raw samples.
+func AcceptsStreamedChunks(req *remote.ReadRequest) bool {
+	for _, t := range req.AcceptedResponseTypes {
+		switch t {
+		case remote.ReadRequest_STREAMED_XOR_CHUNKS:
+			return true
+		case remote.ReadRequest_SAMPLES:
+			return false
+		}
+	}
+	return false
+}
+
+// SamplesToChunks encodes samples sorted by time as XOR chunks.
+func SamplesToChunks(samples []*remote.Sample) []*remote.Chunk {
+	chunks := make([]*remote.Chunk, 0, (len(samples)+maxSamplesPerChunk-1)/maxSamplesPerChunk)
+	for len(samples) > 0 {
+		n := len(samples)
+		if n > maxSamplesPerChunk {
+			n = maxSamplesPerChunk
+		}
+
+		chunks = append(chunks, &remote.Chunk{
+			MinTimeMs: samples[0].TimestampMs,
+			MaxTimeMs: samples[n-1].TimestampMs,
+			Type:      remote.Chunk_XOR,
+			Data:      encodeXORChunk(samples[:n]),
+		})
+		samples = samples[n:]
+	}
+	return chunks
+}
+
+// encodeXORChunk encodes samples in the XOR chunk format of the Prometheus TSDB. The chunk starts
+// with the number of samples, followed by the timestamp and value of the first sample. Later
+// timestamps are stored as the delta, and then the delta of deltas, of the previous ones and
+// values as the XOR with the previous value.
+func encodeXORChunk(samples []*remote.Sample) []byte {
+	var buf bytes.Buffer
+	var b [binary.MaxVarintLen64]byte
+	binary.BigEndian.PutUint16(b[:2], uint16(len(samples)))
+	buf.Write(b[:2])
+
+	bw := bitstream.NewWriter(&buf)
+	var (
+		t, tDelta         int64
+		v                 float64
+		leading, trailing = uint64(math.MaxUint64), uint64(0)
+	)
+	for i, s := range samples {
+		switch i {
+		case 0:
+			for _, c := range b[:binary.PutVarint(b[:], s.TimestampMs)] {
+				bw.WriteByte(c)
+			}
+			bw.WriteBits(math.Float64bits(s.Value), 64)
+		case 1:
+			tDelta = s.TimestampMs - t
+			for _, c := range b[:binary.PutUvarint(b[:], uint64(tDelta))] {
+				bw.WriteByte(c)
+			}
+			leading, trailing = writeXORValue(bw, s.Value, v, leading, trailing)
+		default:
+			delta := s.TimestampMs - t
+			dod := delta - tDelta
+			tDelta = delta
+
+			// Prometheus uses millisecond timestamps, so the buckets for the
+			// delta of deltas are wider than the ones of Gorilla.
+			switch {
+			case dod == 0:
+				bw.WriteBit(bitstream.Zero)
+			case bitRange(dod, 14):
+				bw.WriteBits(0x02, 2)
+				bw.WriteBits(uint64(dod), 14)
+			case bitRange(dod, 17):
+				bw.WriteBits(0x06, 3)
+				bw.WriteBits(uint64(dod), 17)
+			case bitRange(dod, 20):
+				bw.WriteBits(0x0e, 4)
+				bw.WriteBits(uint64(dod), 20)
+			default:
+				bw.WriteBits(0x0f, 4)
+				bw.WriteBits(uint64(dod), 64)
+			}
+			leading, trailing = writeXORValue(bw, s.Value, v, leading, trailing)
+		}
+		t, v = s.TimestampMs, s.Value
+	}
+	bw.Flush(bitstream.Zero)
+	return buf.Bytes()
+}
+
+// writeXORValue writes the XOR of a value with the previous one and returns the leading and
+// trailing zeros of the window its meaningful bits are stored in.
+func writeXORValue(bw *bitstream.BitWriter, v, prev float64, leading, trailing uint64) (uint64, uint64) {
+	vDelta := math.Float64bits(v) ^ math.Float64bits(prev)
+	if vDelta == 0 {
+		bw.WriteBit(bitstream.Zero)
+		return leading, trailing
+	}
+	bw.WriteBit(bitstream.One)
+
+	l := uint64(bits.LeadingZeros64(vDelta))
+	tr := uint64(bits.TrailingZeros64(vDelta))
+
+	// Clamp number of leading zeros to avoid overflow when encoding
+	if l >= 32 {
+		l = 31
+	}
+
+	if leading != math.MaxUint64 && l >= leading && tr >= trailing {
+		bw.WriteBit(bitstream.Zero)
+		bw.WriteBits(vDelta>>trailing, 64-int(leading)-int(trailing))
+		return leading, trailing
+	}
+
+	bw.WriteBit(bitstream.One)
+	bw.WriteBits(l, 5)
+
+	// 64 significant bits are written as 0, since no value has 0 of them.
+	sigbits := 64 - l - tr
+	bw.WriteBits(sigbits, 6)
+	bw.WriteBits(vDelta>>tr, int(sigbits))
+	return l, tr
+}
+
+// bitRange returns true if x fits in the nbits wide bucket of a delta of deltas.
+func bitRange(x int64, nbits uint) bool {
+	return -((1<<(nbits-1))-1) <= x && x <= 1<<(nbits-1)
+}
+
+// ChunkedWriter writes the frames of a streamed chunked remote read response.
+type ChunkedWriter struct {
+	w   io.Writer
+	hdr [binary.MaxVarintLen64 + 4]byte
+}
+
+// NewChunkedWriter returns a new instance of ChunkedWriter writing to w.
+func NewChunkedWriter(w io.Writer) *ChunkedWriter {
+	return &ChunkedWriter{w: w}
+}
+
+// Write writes a frame holding resp. The frame is the size of the encoded message as a varint,
+// its CRC32 Castagnoli checksum and the message itself.
+func (w *ChunkedWriter) Write(resp *remote.ChunkedReadResponse) (int, error) {
+	data, err := resp.Marshal()
+	if err != nil {
+		return 0, err
+	}
+
+	n := binary.PutUvarint(w.hdr[:], uint64(len(data)))
+	binary.BigEndian.PutUint32(w.hdr[n:], crc32.Checksum(data, castagnoliTable))
+	n += 4
+
+	if _, err := w.w.Write(w.hdr[:n]); err != nil {
+		return 0, err
+	}
+	if _, err := w.w.Write(data); err != nil {
+		return 0, err
+	}
+	return n + len(data), nil
+}
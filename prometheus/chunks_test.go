@@ -0,0 +1,192 @@
+package prometheus_test
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"io"
+	"io/ioutil"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/dgryski/go-bitstream"
+	"github.com/influxdata/influxdb/prometheus"
+	"github.com/influxdata/influxdb/prometheus/remote"
+)
+
+// Ensure samples are encoded as XOR chunks of at most 120 samples.
+func TestSamplesToChunks(t *testing.T) {
+	var samples []*remote.Sample
+	ts := int64(1000)
+	for i := 0; i < 250; i++ {
+		// Vary the intervals and values to use every encoding of both.
+		ts += int64(10000 + (i%7)*1000*(i%5) + (i%3)*100000)
+		if i%11 == 0 {
+			ts += 1 << 30
+		}
+		v := float64(i % 4)
+		if i%3 == 0 {
+			v = math.Sqrt(float64(i))
+		}
+		samples = append(samples, &remote.Sample{TimestampMs: ts, Value: v})
+	}
+
+	chunks := prometheus.SamplesToChunks(samples)
+	if len(chunks) != 3 {
+		t.Fatalf("unexpected chunks: %d", len(chunks))
+	}
+
+	var got []*remote.Sample
+	for _, c := range chunks {
+		if c.Type != remote.Chunk_XOR {
+			t.Fatalf("unexpected encoding: %v", c.Type)
+		}
+		decoded := decodeXORChunk(t, c.Data)
+		if c.MinTimeMs != decoded[0].TimestampMs || c.MaxTimeMs != decoded[len(decoded)-1].TimestampMs {
+			t.Fatalf("unexpected chunk time range: %d-%d", c.MinTimeMs, c.MaxTimeMs)
+		}
+		got = append(got, decoded...)
+	}
+
+	if !reflect.DeepEqual(got, samples) {
+		t.Fatalf("unexpected samples\n\texp: %v\n\tgot: %v", samples, got)
+	}
+}
+
+// Ensure frames are written with their size and checksum.
+func TestChunkedWriter_Write(t *testing.T) {
+	var buf bytes.Buffer
+	w := prometheus.NewChunkedWriter(&buf)
+
+	exp := &remote.ChunkedReadResponse{
+		ChunkedSeries: []*remote.ChunkedSeries{{
+			Labels: []*remote.LabelPair{{Name: "foo", Value: "bar"}},
+			Chunks: prometheus.SamplesToChunks([]*remote.Sample{{TimestampMs: 1, Value: 1}}),
+		}},
+	}
+	for i := 0; i < 2; i++ {
+		if n, err := w.Write(exp); err != nil {
+			t.Fatal(err)
+		} else if n != 1+4+exp.Size() {
+			t.Fatalf("unexpected frame size: %d", n)
+		}
+	}
+
+	r := bufio.NewReader(&buf)
+	for i := 0; i < 2; i++ {
+		size, err := binary.ReadUvarint(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var checksum uint32
+		if err := binary.Read(r, binary.BigEndian, &checksum); err != nil {
+			t.Fatal(err)
+		}
+		data := make([]byte, size)
+		if _, err := io.ReadFull(r, data); err != nil {
+			t.Fatal(err)
+		} else if crc32.Checksum(data, crc32.MakeTable(crc32.Castagnoli)) != checksum {
+			t.Fatal("unexpected checksum")
+		}
+
+		var got remote.ChunkedReadResponse
+		if err := got.Unmarshal(data); err != nil {
+			t.Fatal(err)
+		} else if !reflect.DeepEqual(&got, exp) {
+			t.Fatalf("unexpected frame\n\texp: %v\n\tgot: %v", exp, &got)
+		}
+	}
+	if b, _ := ioutil.ReadAll(r); len(b) != 0 {
+		t.Fatalf("unexpected trailing bytes: %d", len(b))
+	}
+}
+
+// decodeXORChunk decodes the samples of a chunk in the XOR chunk format of the Prometheus TSDB.
+func decodeXORChunk(t *testing.T, data []byte) []*remote.Sample {
+	n := int(binary.BigEndian.Uint16(data))
+	br := bitstream.NewReader(bytes.NewReader(data[2:]))
+
+	readBits := func(nbits int) uint64 {
+		v, err := br.ReadBits(nbits)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return v
+	}
+	readBit := func() bool {
+		b, err := br.ReadBit()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return bool(b)
+	}
+	readVarint := func(signed bool) int64 {
+		var buf []byte
+		for {
+			b := byte(readBits(8))
+			buf = append(buf, b)
+			if b < 0x80 {
+				break
+			}
+		}
+		if signed {
+			v, _ := binary.Varint(buf)
+			return v
+		}
+		v, _ := binary.Uvarint(buf)
+		return int64(v)
+	}
+
+	var (
+		samples           []*remote.Sample
+		ts, tDelta        int64
+		vbits             uint64
+		leading, trailing uint64
+	)
+	readValue := func() {
+		if !readBit() {
+			return
+		}
+		if readBit() {
+			leading = readBits(5)
+			sigbits := readBits(6)
+			if sigbits == 0 {
+				sigbits = 64
+			}
+			trailing = 64 - leading - sigbits
+		}
+		vbits ^= readBits(int(64-leading-trailing)) << trailing
+	}
+
+	for i := 0; i < n; i++ {
+		switch i {
+		case 0:
+			ts = readVarint(true)
+			vbits = readBits(64)
+		case 1:
+			tDelta = readVarint(false)
+			ts += tDelta
+			readValue()
+		default:
+			var sz int
+			for sz = 0; sz < 4 && readBit(); sz++ {
+			}
+			var dod int64
+			if nbits := []int{0, 14, 17, 20, 64}[sz]; nbits > 0 {
+				bits := readBits(nbits)
+				if nbits < 64 && bits > 1<<uint(nbits-1) {
+					dod = int64(bits) - 1<<uint(nbits)
+				} else {
+					dod = int64(bits)
+				}
+			}
+			tDelta += dod
+			ts += tDelta
+			readValue()
+		}
+		samples = append(samples, &remote.Sample{TimestampMs: ts, Value: math.Float64frombits(vbits)})
+	}
+	return samples
+}
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"math"
 	"regexp"
+	"regexp/syntax"
+	"sort"
 	"time"
 
 	"github.com/influxdata/influxdb/models"
@@ -61,7 +63,8 @@ func WriteRequestToPoints(req *remote.WriteRequest) ([]models.Point, error) {
 }
 
 // ReadRequestToInfluxQLQuery converts a Prometheus remote read request to an equivalent InfluxQL
-// query that will return the requested data when executed
+// query that will return the requested data when executed. When the read hints of the query allow
+// it, the samples are aggregated per step by the query rather than returned raw.
 func ReadRequestToInfluxQLQuery(req *remote.ReadRequest, db, rp string) (*influxql.Query, error) {
 	if len(req.Queries) != 1 {
 		return nil, errors.New("Prometheus read endpoint currently only supports one query at a time")
@@ -81,6 +84,23 @@ func ReadRequestToInfluxQLQuery(req *remote.ReadRequest, db, rp string) (*influx
 		Dimensions: []*influxql.Dimension{{Expr: &influxql.Wildcard{}}},
 	}
 
+	if call, step, offset, ok := pushdownAggregate(promQuery); ok {
+		stmt.IsRawQuery = false
+		stmt.Fields = []*influxql.Field{{
+			Expr:  &influxql.Call{Name: call, Args: []influxql.Expr{&influxql.VarRef{Val: fieldName}}},
+			Alias: fieldName,
+		}}
+
+		args := []influxql.Expr{&influxql.DurationLiteral{Val: step}}
+		if offset != 0 {
+			args = append(args, &influxql.DurationLiteral{Val: offset})
+		}
+		stmt.Dimensions = append(stmt.Dimensions, &influxql.Dimension{
+			Expr: &influxql.Call{Name: "time", Args: args},
+		})
+		stmt.Fill = influxql.NoFill
+	}
+
 	cond, err := condFromMatchers(promQuery, promQuery.Matchers)
 	if err != nil {
 		return nil, err
@@ -91,8 +111,81 @@ func ReadRequestToInfluxQLQuery(req *remote.ReadRequest, db, rp string) (*influx
 	return &influxql.Query{Statements: []influxql.Statement{stmt}}, nil
 }
 
-// condFromMatcher converts a Prometheus LabelMatcher into an equivalent InfluxQL BinaryExpr
-func condFromMatcher(m *remote.LabelMatcher) (*influxql.BinaryExpr, error) {
+// rangePushdownFuncs maps the functions of range vector selectors which can be evaluated over
+// samples aggregated per step to the InfluxQL function doing the aggregation. The sample of each
+// step aggregates the samples since the previous step and is stamped with the start of its
+// interval, so a range of whole steps selects exactly the samples of its steps and the function
+// gives the same result as over the raw samples, except for raw samples falling exactly on the
+// start of the range.
+var rangePushdownFuncs = map[string]string{
+	"max_over_time": "max",
+	"min_over_time": "min",
+	"sum_over_time": "sum",
+}
+
+// lookbackDeltaMs is the default time Prometheus looks back for the last sample of a series
+// when it evaluates an instant vector selector.
+const lookbackDeltaMs = int64(5 * time.Minute / time.Millisecond)
+
+// pushdownAggregate returns the InfluxQL function, interval and offset which aggregate the samples
+// of a query per step, if its read hints allow it. Each interval ends on a time the expression is
+// evaluated at, so it holds the samples the evaluation sees since the previous step.
+func pushdownAggregate(q *remote.Query) (call string, step, offset time.Duration, ok bool) {
+	h := q.Hints
+	if h == nil || h.StepMs <= 0 {
+		// Instant queries are evaluated once, so there is nothing to save.
+		return "", 0, 0, false
+	}
+
+	var evalMs int64
+	switch {
+	case h.RangeMs == 0:
+		// An instant vector selector only sees the last sample before each
+		// evaluation, unless the function needs its timestamp. Its hints start
+		// a lookback delta before the first evaluation. The last sample of a
+		// step is stamped with the evaluation ending it, which makes it look
+		// up to a step fresher than it is, so steps longer than the lookback
+		// delta would revive series which are already stale.
+		if h.Func == "timestamp" || h.StepMs > lookbackDeltaMs {
+			return "", 0, 0, false
+		}
+		call, evalMs = "last", h.StartMs+lookbackDeltaMs
+		if (h.EndMs-evalMs)%h.StepMs != 0 {
+			// The query was not run with the default lookback delta.
+			return "", 0, 0, false
+		}
+	case h.Func == "avg_over_time" && h.RangeMs == h.StepMs:
+		call, evalMs = "mean", h.StartMs+h.RangeMs
+	case h.RangeMs%h.StepMs == 0 && rangePushdownFuncs[h.Func] != "":
+		call, evalMs = rangePushdownFuncs[h.Func], h.StartMs+h.RangeMs
+	default:
+		return "", 0, 0, false
+	}
+
+	// Intervals start a millisecond after an evaluation, so that each holds
+	// the samples of (eval-step, eval].
+	offsetMs := (evalMs + 1) % h.StepMs
+	if offsetMs < 0 {
+		offsetMs += h.StepMs
+	}
+	return call, time.Duration(h.StepMs) * time.Millisecond, time.Duration(offsetMs) * time.Millisecond, true
+}
+
+// sampleTimestampMs returns the timestamp of the Prometheus sample for a point returned by the
+// InfluxQL query of a remote read query. The last sample of a step is stamped with the end of its
+// interval, which is the time the expression is evaluated at. Samples aggregated for a range
+// vector selector keep the start of their interval, as the range is closed at both ends and
+// would otherwise select the step before it too.
+func sampleTimestampMs(q *remote.Query, t time.Time) int64 {
+	timestamp := t.UnixNano() / int64(time.Millisecond)
+	if call, step, _, ok := pushdownAggregate(q); ok && call == "last" {
+		timestamp += int64(step/time.Millisecond) - 1
+	}
+	return timestamp
+}
+
+// condFromMatcher converts a Prometheus LabelMatcher into an equivalent InfluxQL expression
+func condFromMatcher(m *remote.LabelMatcher) (influxql.Expr, error) {
 	var op influxql.Token
 	var rhs influxql.Expr
 
@@ -110,11 +203,20 @@ func condFromMatcher(m *remote.LabelMatcher) (*influxql.BinaryExpr, error) {
 	}
 
 	if op == influxql.EQREGEX || op == influxql.NEQREGEX {
-		re, err := regexp.Compile(m.Value)
+		// Prometheus regexes are anchored at both ends, while InfluxQL ones
+		// match anywhere in the value.
+		re, err := regexp.Compile("^(?:" + m.Value + ")$")
 		if err != nil {
 			return nil, err
 		}
 
+		// Regexes matching a small set of literal values, such as a|b|c
+		// for several metric names, are rewritten as comparisons which can be
+		// answered by the index without matching every tag value.
+		if values, ok := regexLiterals(m.Value); ok {
+			return condFromLiterals(op, m.Name, values), nil
+		}
+
 		// Convert regex values to InfluxDB format.
 		rhs = &influxql.RegexLiteral{Val: re}
 	} else {
@@ -128,11 +230,109 @@ func condFromMatcher(m *remote.LabelMatcher) (*influxql.BinaryExpr, error) {
 	}, nil
 }
 
+// maxRegexLiterals is the most values a regex is expanded to before it is matched as a regex.
+const maxRegexLiterals = 64
+
+// regexLiterals returns the values matched by a Prometheus regex if it only matches a small set
+// of literal values.
+func regexLiterals(expr string) ([]string, bool) {
+	re, err := syntax.Parse(expr, syntax.Perl)
+	if err != nil {
+		return nil, false
+	}
+	return expandRegex(re.Simplify())
+}
+
+// expandRegex returns the values matched by a parsed regex if it only matches a small set of
+// literal values. The parser factors common prefixes out of alternations, so concatenations and
+// character classes are expanded as well.
+func expandRegex(re *syntax.Regexp) ([]string, bool) {
+	switch re.Op {
+	case syntax.OpEmptyMatch:
+		return []string{""}, true
+	case syntax.OpLiteral:
+		if re.Flags&syntax.FoldCase != 0 {
+			return nil, false
+		}
+		return []string{string(re.Rune)}, true
+	case syntax.OpCapture:
+		return expandRegex(re.Sub[0])
+	case syntax.OpCharClass:
+		var values []string
+		for i := 0; i < len(re.Rune); i += 2 {
+			for r := re.Rune[i]; r <= re.Rune[i+1]; r++ {
+				if len(values) == maxRegexLiterals {
+					return nil, false
+				}
+				values = append(values, string(r))
+			}
+		}
+		return values, true
+	case syntax.OpAlternate:
+		var values []string
+		for _, sub := range re.Sub {
+			v, ok := expandRegex(sub)
+			if !ok || len(values)+len(v) > maxRegexLiterals {
+				return nil, false
+			}
+			values = append(values, v...)
+		}
+		return values, true
+	case syntax.OpConcat:
+		values := []string{""}
+		for _, sub := range re.Sub {
+			v, ok := expandRegex(sub)
+			if !ok || len(values)*len(v) > maxRegexLiterals {
+				return nil, false
+			}
+			next := make([]string, 0, len(values)*len(v))
+			for _, prefix := range values {
+				for _, suffix := range v {
+					next = append(next, prefix+suffix)
+				}
+			}
+			values = next
+		}
+		return values, true
+	default:
+		return nil, false
+	}
+}
+
+// condFromLiterals returns a condition matching a tag against any (for =~) or none (for !~) of
+// a set of values.
+func condFromLiterals(op influxql.Token, name string, values []string) influxql.Expr {
+	cmp, join := influxql.EQ, influxql.OR
+	if op == influxql.NEQREGEX {
+		cmp, join = influxql.NEQ, influxql.AND
+	}
+
+	var cond influxql.Expr
+	for _, v := range values {
+		expr := &influxql.BinaryExpr{
+			Op:  cmp,
+			LHS: &influxql.VarRef{Val: name},
+			RHS: &influxql.StringLiteral{Val: v},
+		}
+		if cond == nil {
+			cond = expr
+			continue
+		}
+		cond = &influxql.BinaryExpr{Op: join, LHS: cond, RHS: expr}
+	}
+
+	if len(values) > 1 && join == influxql.OR {
+		// Keep the alternatives together when ANDed with the other matchers.
+		return &influxql.ParenExpr{Expr: cond}
+	}
+	return cond
+}
+
 // condFromMatchers converts a Prometheus remote query and a collection of Prometheus label matchers
 // into an equivalent influxql.BinaryExpr. This assume a schema that is written via the Prometheus
 // remote write endpoint, which uses a measurement name of _ and a field name of f64. Tags and labels
 // are kept equivalent.
-func condFromMatchers(q *remote.Query, matchers []*remote.LabelMatcher) (*influxql.BinaryExpr, error) {
+func condFromMatchers(q *remote.Query, matchers []*remote.LabelMatcher) (influxql.Expr, error) {
 	if len(matchers) > 0 {
 		lhs, err := condFromMatcher(matchers[0])
 		if err != nil {
@@ -165,7 +365,8 @@ func condFromMatchers(q *remote.Query, matchers []*remote.LabelMatcher) (*influx
 	}, nil
 }
 
-// TagsToLabelPairs converts a map of Influx tags into a slice of Prometheus label pairs
+// TagsToLabelPairs converts a map of Influx tags into a slice of Prometheus label pairs sorted by
+// name
 func TagsToLabelPairs(tags map[string]string) []*remote.LabelPair {
 	pairs := make([]*remote.LabelPair, 0, len(tags))
 	for k, v := range tags {
@@ -183,5 +384,6 @@ func TagsToLabelPairs(tags map[string]string) []*remote.LabelPair {
 			Value: v,
 		})
 	}
+	sort.Slice(pairs, func(i, j int) bool { return pairs[i].Name < pairs[j].Name })
 	return pairs
 }
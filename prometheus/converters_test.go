@@ -2,9 +2,13 @@ package prometheus_test
 
 import (
 	"errors"
+	"math"
 	"reflect"
+	"regexp/syntax"
 	"testing"
+	"time"
 
+	"github.com/influxdata/influxdb/models"
 	"github.com/influxdata/influxdb/prometheus"
 	"github.com/influxdata/influxdb/prometheus/remote"
 	"github.com/influxdata/influxql"
@@ -52,10 +56,10 @@ func TestReadRequestToInfluxQLQuery(t *testing.T) {
 				EndTimestampMs:   100,
 				Matchers: []*remote.LabelMatcher{
 					{Name: "region", Value: "c.*", Type: remote.MatchType_REGEX_MATCH},
-					{Name: "host", Value: `\d`, Type: remote.MatchType_REGEX_NO_MATCH},
+					{Name: "host", Value: `\d+`, Type: remote.MatchType_REGEX_NO_MATCH},
 				},
 			}},
-			expQuery: `SELECT f64 FROM db0.rp0._ WHERE region =~ /c.*/ AND host !~ /\d/ AND time >= '1970-01-01T00:00:00.001Z' AND time <= '1970-01-01T00:00:00.1Z' GROUP BY *`,
+			expQuery: `SELECT f64 FROM db0.rp0._ WHERE region =~ /^(?:c.*)$/ AND host !~ /^(?:\d+)$/ AND time >= '1970-01-01T00:00:00.001Z' AND time <= '1970-01-01T00:00:00.1Z' GROUP BY *`,
 		},
 		{
 			name: "escape regex",
@@ -63,10 +67,105 @@ func TestReadRequestToInfluxQLQuery(t *testing.T) {
 				StartTimestampMs: 1,
 				EndTimestampMs:   100,
 				Matchers: []*remote.LabelMatcher{
-					{Name: "test_type", Value: "a/b", Type: remote.MatchType_REGEX_MATCH},
+					{Name: "test_type", Value: "a/b.*", Type: remote.MatchType_REGEX_MATCH},
 				},
 			}},
-			expQuery: `SELECT f64 FROM db0.rp0._ WHERE test_type =~ /a\/b/ AND time >= '1970-01-01T00:00:00.001Z' AND time <= '1970-01-01T00:00:00.1Z' GROUP BY *`,
+			expQuery: `SELECT f64 FROM db0.rp0._ WHERE test_type =~ /^(?:a\/b.*)$/ AND time >= '1970-01-01T00:00:00.001Z' AND time <= '1970-01-01T00:00:00.1Z' GROUP BY *`,
+		},
+		{
+			name: "literal regex",
+			queries: []*remote.Query{{
+				StartTimestampMs: 1,
+				EndTimestampMs:   100,
+				Matchers: []*remote.LabelMatcher{
+					{Name: "__name__", Value: "cpu_seconds|mem_bytes", Type: remote.MatchType_REGEX_MATCH},
+					{Name: "region", Value: "west", Type: remote.MatchType_REGEX_MATCH},
+					{Name: "host", Value: "serverA|serverB", Type: remote.MatchType_REGEX_NO_MATCH},
+					{Name: "dc", Value: "", Type: remote.MatchType_REGEX_MATCH},
+				},
+			}},
+			expQuery: `SELECT f64 FROM db0.rp0._ WHERE (__name__ = 'cpu_seconds' OR __name__ = 'mem_bytes') AND region = 'west' AND host != 'serverA' AND host != 'serverB' AND dc = '' AND time >= '1970-01-01T00:00:00.001Z' AND time <= '1970-01-01T00:00:00.1Z' GROUP BY *`,
+		},
+		{
+			name: "invalid regex",
+			queries: []*remote.Query{{
+				Matchers: []*remote.LabelMatcher{
+					{Name: "region", Value: "(", Type: remote.MatchType_REGEX_MATCH},
+				},
+			}},
+			expError: &syntax.Error{Code: syntax.ErrMissingParen, Expr: "^(?:()$"},
+		},
+		{
+			name: "instant selector hints",
+			queries: []*remote.Query{{
+				StartTimestampMs: 0,
+				EndTimestampMs:   600000,
+				Matchers: []*remote.LabelMatcher{
+					{Name: "__name__", Value: "cpu_seconds", Type: remote.MatchType_EQUAL},
+				},
+				Hints: &remote.ReadHints{StepMs: 60000, Func: "sum", StartMs: 0, EndMs: 600000},
+			}},
+			expQuery: `SELECT last(f64) AS f64 FROM db0.rp0._ WHERE __name__ = 'cpu_seconds' AND time >= '1970-01-01T00:00:00Z' AND time <= '1970-01-01T00:10:00Z' GROUP BY *, time(1m, 1ms) fill(none)`,
+		},
+		{
+			name: "instant selector hints longer than lookback",
+			queries: []*remote.Query{{
+				StartTimestampMs: 0,
+				EndTimestampMs:   3600000,
+				Matchers: []*remote.LabelMatcher{
+					{Name: "__name__", Value: "cpu_seconds", Type: remote.MatchType_EQUAL},
+				},
+				Hints: &remote.ReadHints{StepMs: 600000, Func: "sum", StartMs: 0, EndMs: 3600000},
+			}},
+			expQuery: `SELECT f64 FROM db0.rp0._ WHERE __name__ = 'cpu_seconds' AND time >= '1970-01-01T00:00:00Z' AND time <= '1970-01-01T01:00:00Z' GROUP BY *`,
+		},
+		{
+			name: "instant selector hints not start aligned",
+			queries: []*remote.Query{{
+				StartTimestampMs: 0,
+				EndTimestampMs:   630000,
+				Matchers: []*remote.LabelMatcher{
+					{Name: "__name__", Value: "cpu_seconds", Type: remote.MatchType_EQUAL},
+				},
+				Hints: &remote.ReadHints{StepMs: 60000, Func: "sum", StartMs: 0, EndMs: 630000},
+			}},
+			expQuery: `SELECT f64 FROM db0.rp0._ WHERE __name__ = 'cpu_seconds' AND time >= '1970-01-01T00:00:00Z' AND time <= '1970-01-01T00:10:30Z' GROUP BY *`,
+		},
+		{
+			name: "range selector hints",
+			queries: []*remote.Query{{
+				StartTimestampMs: 0,
+				EndTimestampMs:   600000,
+				Matchers: []*remote.LabelMatcher{
+					{Name: "__name__", Value: "cpu_seconds", Type: remote.MatchType_EQUAL},
+				},
+				Hints: &remote.ReadHints{StepMs: 60000, Func: "max_over_time", StartMs: 0, EndMs: 600000, RangeMs: 300000},
+			}},
+			expQuery: `SELECT max(f64) AS f64 FROM db0.rp0._ WHERE __name__ = 'cpu_seconds' AND time >= '1970-01-01T00:00:00Z' AND time <= '1970-01-01T00:10:00Z' GROUP BY *, time(1m, 1ms) fill(none)`,
+		},
+		{
+			name: "unsupported function hints",
+			queries: []*remote.Query{{
+				StartTimestampMs: 0,
+				EndTimestampMs:   600000,
+				Matchers: []*remote.LabelMatcher{
+					{Name: "__name__", Value: "cpu_seconds", Type: remote.MatchType_EQUAL},
+				},
+				Hints: &remote.ReadHints{StepMs: 60000, Func: "rate", StartMs: 0, EndMs: 600000, RangeMs: 300000},
+			}},
+			expQuery: `SELECT f64 FROM db0.rp0._ WHERE __name__ = 'cpu_seconds' AND time >= '1970-01-01T00:00:00Z' AND time <= '1970-01-01T00:10:00Z' GROUP BY *`,
+		},
+		{
+			name: "partial step range hints",
+			queries: []*remote.Query{{
+				StartTimestampMs: 0,
+				EndTimestampMs:   600000,
+				Matchers: []*remote.LabelMatcher{
+					{Name: "__name__", Value: "cpu_seconds", Type: remote.MatchType_EQUAL},
+				},
+				Hints: &remote.ReadHints{StepMs: 60000, Func: "max_over_time", StartMs: 0, EndMs: 600000, RangeMs: 90000},
+			}},
+			expQuery: `SELECT f64 FROM db0.rp0._ WHERE __name__ = 'cpu_seconds' AND time >= '1970-01-01T00:00:00Z' AND time <= '1970-01-01T00:10:00Z' GROUP BY *`,
 		},
 	}
 
@@ -95,3 +194,316 @@ func TestReadRequestToInfluxQLQuery(t *testing.T) {
 		})
 	}
 }
+
+// Ensure range functions evaluated over samples aggregated per step give the same results
+// as over the raw samples.
+func TestReadRequestToInfluxQLQuery_RangePushdown(t *testing.T) {
+	const (
+		startMs = int64(0)
+		endMs   = int64(1800000)
+		stepMs  = int64(60000)
+	)
+
+	// Raw samples every 7s, never on a step boundary.
+	var raw []*remote.Sample
+	for ts := int64(1234); ts <= endMs; ts += 7000 {
+		raw = append(raw, &remote.Sample{TimestampMs: ts, Value: float64((ts * 7919) % 101)})
+	}
+
+	for _, tt := range []struct {
+		fn      string
+		rangeMs int64
+	}{
+		{fn: "avg_over_time", rangeMs: stepMs},
+		{fn: "sum_over_time", rangeMs: stepMs},
+		{fn: "sum_over_time", rangeMs: 5 * stepMs},
+		{fn: "max_over_time", rangeMs: 5 * stepMs},
+		{fn: "min_over_time", rangeMs: 3 * stepMs},
+	} {
+		t.Run(tt.fn, func(t *testing.T) {
+			q := &remote.Query{
+				StartTimestampMs: startMs,
+				EndTimestampMs:   endMs,
+				Hints:            &remote.ReadHints{StepMs: stepMs, Func: tt.fn, StartMs: startMs, EndMs: endMs, RangeMs: tt.rangeMs},
+			}
+			query, err := prometheus.ReadRequestToInfluxQLQuery(&remote.ReadRequest{Queries: []*remote.Query{q}}, "db0", "rp0")
+			if err != nil {
+				t.Fatal(err)
+			}
+			stmt := query.Statements[0].(*influxql.SelectStatement)
+			if stmt.IsRawQuery {
+				t.Fatal("expected aggregate query")
+			}
+
+			series, err := prometheus.RowToTimeSeries(q, aggregateRow(t, stmt, raw), prometheus.MetricMappingFlat)
+			if err != nil {
+				t.Fatal(err)
+			}
+			pushed := series[0].Samples
+
+			// Evaluate the function the way Prometheus does, over [eval-range, eval].
+			for evalMs := startMs + tt.rangeMs; evalMs <= endMs; evalMs += stepMs {
+				exp, got := evalOverTime(tt.fn, raw, evalMs, tt.rangeMs), evalOverTime(tt.fn, pushed, evalMs, tt.rangeMs)
+				if exp != got {
+					t.Fatalf("unexpected %s at %d: exp %v, got %v", tt.fn, evalMs, exp, got)
+				}
+			}
+		})
+	}
+}
+
+// aggregateRow aggregates samples the way the InfluxQL statement does and returns the result row.
+func aggregateRow(t *testing.T, stmt *influxql.SelectStatement, samples []*remote.Sample) *models.Row {
+	interval, err := stmt.GroupByInterval()
+	if err != nil {
+		t.Fatal(err)
+	}
+	offset, err := stmt.GroupByOffset()
+	if err != nil {
+		t.Fatal(err)
+	}
+	intervalMs, offsetMs := int64(interval/time.Millisecond), int64(offset/time.Millisecond)
+
+	var (
+		starts  []int64
+		buckets = make(map[int64][]*remote.Sample)
+	)
+	for _, s := range samples {
+		start := s.TimestampMs - ((s.TimestampMs-offsetMs)%intervalMs+intervalMs)%intervalMs
+		if _, ok := buckets[start]; !ok {
+			starts = append(starts, start)
+		}
+		buckets[start] = append(buckets[start], s)
+	}
+
+	call := stmt.Fields[0].Expr.(*influxql.Call).Name
+	row := &models.Row{Name: "_", Columns: []string{"time", "f64"}}
+	for _, start := range starts {
+		fn, ok := map[string]string{"mean": "avg_over_time", "sum": "sum_over_time", "max": "max_over_time", "min": "min_over_time"}[call]
+		if !ok {
+			t.Fatalf("unexpected call: %s", call)
+		}
+		b := buckets[start]
+		v := evalOverTime(fn, b, b[len(b)-1].TimestampMs, b[len(b)-1].TimestampMs-b[0].TimestampMs)
+		row.Values = append(row.Values, []interface{}{time.Unix(0, start*int64(time.Millisecond)), v})
+	}
+	return row
+}
+
+// evalOverTime evaluates a range function over the samples of [evalMs-rangeMs, evalMs].
+func evalOverTime(fn string, samples []*remote.Sample, evalMs, rangeMs int64) float64 {
+	var values []float64
+	for _, s := range samples {
+		if s.TimestampMs >= evalMs-rangeMs && s.TimestampMs <= evalMs {
+			values = append(values, s.Value)
+		}
+	}
+	if len(values) == 0 {
+		return 0
+	}
+
+	result := values[0]
+	for _, v := range values[1:] {
+		switch fn {
+		case "avg_over_time", "sum_over_time":
+			result += v
+		case "max_over_time":
+			result = math.Max(result, v)
+		case "min_over_time":
+			result = math.Min(result, v)
+		}
+	}
+	if fn == "avg_over_time" {
+		result /= float64(len(values))
+	}
+	return result
+}
+
+func TestRowToTimeSeries(t *testing.T) {
+	row := &models.Row{
+		Name:    "_",
+		Tags:    map[string]string{"__name__": "cpu_seconds", "host": "a", "region": ""},
+		Columns: []string{"time", "f64"},
+		Values:  [][]interface{}{{time.Unix(60, int64(time.Millisecond)), 1.5}},
+	}
+
+	q := &remote.Query{}
+	exp := []*remote.TimeSeries{{
+		Labels:  []*remote.LabelPair{{Name: "__name__", Value: "cpu_seconds"}, {Name: "host", Value: "a"}},
+		Samples: []*remote.Sample{{TimestampMs: 60001, Value: 1.5}},
+	}}
+	if series, err := prometheus.RowToTimeSeries(q, row, prometheus.MetricMappingFlat); err != nil {
+		t.Fatal(err)
+	} else if !reflect.DeepEqual(series, exp) {
+		t.Fatalf("unexpected series\n\texp: %v\n\tgot: %v", exp, series)
+	}
+
+	// Samples aggregated per step are stamped with the end of their interval.
+	q.Hints = &remote.ReadHints{StepMs: 60000, EndMs: 600000}
+	exp[0].Samples[0].TimestampMs = 120000
+	if series, err := prometheus.RowToTimeSeries(q, row, prometheus.MetricMappingFlat); err != nil {
+		t.Fatal(err)
+	} else if !reflect.DeepEqual(series, exp) {
+		t.Fatalf("unexpected series\n\texp: %v\n\tgot: %v", exp, series)
+	}
+}
+
+func TestWriteRequestToPointsWithMapping_Family(t *testing.T) {
+	labels := func(name string, pairs ...string) []*remote.LabelPair {
+		l := []*remote.LabelPair{{Name: "__name__", Value: name}, {Name: "host", Value: "a"}}
+		for i := 0; i < len(pairs); i += 2 {
+			l = append(l, &remote.LabelPair{Name: pairs[i], Value: pairs[i+1]})
+		}
+		return l
+	}
+	samples := func(v float64) []*remote.Sample {
+		return []*remote.Sample{{TimestampMs: 1000, Value: v}}
+	}
+
+	req := &remote.WriteRequest{
+		Timeseries: []*remote.TimeSeries{
+			{Labels: labels("http_duration_seconds_bucket", "le", "0.5"), Samples: samples(3)},
+			{Labels: labels("http_duration_seconds_bucket", "le", "+Inf"), Samples: samples(4)},
+			{Labels: labels("http_duration_seconds_sum"), Samples: samples(1.5)},
+			{Labels: labels("http_duration_seconds_count"), Samples: samples(4)},
+			{Labels: labels("rpc_duration_seconds", "quantile", "0.99"), Samples: samples(0.2)},
+			{Labels: labels("rpc_duration_seconds_count"), Samples: samples(7)},
+			{Labels: labels("cpu_seconds", "le", "0.5"), Samples: samples(2)},
+		},
+	}
+
+	points, err := prometheus.WriteRequestToPointsWithMapping(req, prometheus.MetricMappingFamily)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	for _, p := range points {
+		got = append(got, p.String())
+	}
+	exp := []string{
+		`http_duration_seconds,host=a count=4,le_+Inf=4,le_0.5=3,sum=1.5 1000000000`,
+		`rpc_duration_seconds,host=a count=7,quantile_0.99=0.2 1000000000`,
+		`cpu_seconds,host=a,le=0.5 value=2 1000000000`,
+	}
+	if !reflect.DeepEqual(got, exp) {
+		t.Fatalf("unexpected points\n\texp: %v\n\tgot: %v", exp, got)
+	}
+
+	// Time series without a metric name are dropped.
+	req = &remote.WriteRequest{Timeseries: []*remote.TimeSeries{
+		{Samples: samples(1)},
+		{Labels: labels("cpu_seconds"), Samples: samples(3)},
+		{Labels: []*remote.LabelPair{{Name: "host", Value: "b"}}, Samples: samples(1)},
+	}}
+	points, err = prometheus.WriteRequestToPointsWithMapping(req, prometheus.MetricMappingFamily)
+	if err != (prometheus.MissingMetricNameError{N: 2}) {
+		t.Fatalf("unexpected error: %v", err)
+	} else if len(points) != 1 || points[0].String() != `cpu_seconds,host=a value=3 1000000000` {
+		t.Fatalf("unexpected points: %v", points)
+	}
+}
+
+func TestReadRequestToInfluxQLQueryWithMapping_Family(t *testing.T) {
+	examples := []struct {
+		name     string
+		matchers []*remote.LabelMatcher
+		expQuery string
+		expError error
+	}{
+		{
+			name: "histogram bucket",
+			matchers: []*remote.LabelMatcher{
+				{Name: "__name__", Value: "http_duration_seconds_bucket", Type: remote.MatchType_EQUAL},
+				{Name: "le", Value: "0.5", Type: remote.MatchType_EQUAL},
+				{Name: "host", Value: "a", Type: remote.MatchType_EQUAL},
+			},
+			expQuery: `SELECT * FROM db0.rp0.http_duration_seconds, db0.rp0.http_duration_seconds_bucket WHERE host = 'a' AND time >= '1970-01-01T00:00:00.001Z' AND time <= '1970-01-01T00:00:00.1Z' GROUP BY *`,
+		},
+		{
+			name: "metric names",
+			matchers: []*remote.LabelMatcher{
+				{Name: "__name__", Value: "cpu_seconds|rpc_duration_seconds_count", Type: remote.MatchType_REGEX_MATCH},
+			},
+			expQuery: `SELECT * FROM db0.rp0.cpu_seconds, db0.rp0.rpc_duration_seconds, db0.rp0.rpc_duration_seconds_count WHERE time >= '1970-01-01T00:00:00.001Z' AND time <= '1970-01-01T00:00:00.1Z' GROUP BY *`,
+		},
+		{
+			name: "metric name regex",
+			matchers: []*remote.LabelMatcher{
+				{Name: "__name__", Value: "cpu_.*", Type: remote.MatchType_REGEX_MATCH},
+			},
+			expError: prometheus.ErrUnboundedMetricName,
+		},
+		{
+			name: "no metric name",
+			matchers: []*remote.LabelMatcher{
+				{Name: "host", Value: "a", Type: remote.MatchType_EQUAL},
+			},
+			expError: prometheus.ErrUnboundedMetricName,
+		},
+	}
+
+	for _, example := range examples {
+		t.Run(example.name, func(t *testing.T) {
+			req := &remote.ReadRequest{Queries: []*remote.Query{{
+				StartTimestampMs: 1,
+				EndTimestampMs:   100,
+				Matchers:         example.matchers,
+			}}}
+			query, err := prometheus.ReadRequestToInfluxQLQueryWithMapping(req, "db0", "rp0", prometheus.MetricMappingFamily)
+			if err != example.expError {
+				t.Fatalf("got error %v, expected %v", err, example.expError)
+			} else if err != nil {
+				return
+			} else if query.String() != example.expQuery {
+				t.Errorf("got query %v, expected %v", query.String(), example.expQuery)
+			} else if _, err := influxql.ParseStatement(query.String()); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestRowToTimeSeries_Family(t *testing.T) {
+	row := &models.Row{
+		Name:    "http_duration_seconds",
+		Tags:    map[string]string{"host": "a"},
+		Columns: []string{"time", "count", "le_+Inf", "le_0.5", "sum", "other"},
+		Values: [][]interface{}{
+			{time.Unix(1, 0), 4.0, 4.0, 3.0, 1.5, nil},
+			{time.Unix(2, 0), 5.0, 5.0, nil, 2.5, nil},
+			{time.Unix(3, 0), nil, nil, nil, int64(3), nil},
+		},
+	}
+	q := &remote.Query{
+		Matchers: []*remote.LabelMatcher{
+			{Name: "__name__", Value: "http_duration_seconds_(bucket|sum)", Type: remote.MatchType_REGEX_MATCH},
+			{Name: "le", Value: "+Inf", Type: remote.MatchType_NOT_EQUAL},
+		},
+	}
+
+	series, err := prometheus.RowToTimeSeries(q, row, prometheus.MetricMappingFamily)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := []*remote.TimeSeries{
+		{
+			Labels: []*remote.LabelPair{
+				{Name: "__name__", Value: "http_duration_seconds_bucket"},
+				{Name: "host", Value: "a"},
+				{Name: "le", Value: "0.5"},
+			},
+			Samples: []*remote.Sample{{TimestampMs: 1000, Value: 3}},
+		},
+		{
+			Labels: []*remote.LabelPair{
+				{Name: "__name__", Value: "http_duration_seconds_sum"},
+				{Name: "host", Value: "a"},
+			},
+			Samples: []*remote.Sample{{TimestampMs: 1000, Value: 1.5}, {TimestampMs: 2000, Value: 2.5}},
+		},
+	}
+	if !reflect.DeepEqual(series, exp) {
+		t.Fatalf("unexpected series\n\texp: %v\n\tgot: %v", exp, series)
+	}
+}
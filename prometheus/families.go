@@ -0,0 +1,412 @@
+package prometheus
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/influxdata/influxdb/models"
+	"github.com/influxdata/influxdb/prometheus/remote"
+	"github.com/influxdata/influxql"
+)
+
+// Mappings between Prometheus time series and InfluxDB points.
+const (
+	// MetricMappingFlat writes every sample to the f64 field of the _ measurement, with the
+	// metric name in the __name__ tag.
+	MetricMappingFlat = "flat"
+
+	// MetricMappingFamily writes the samples of each metric family to a measurement named after
+	// the family. Histogram buckets and summary quantiles are written to le_<bound> and
+	// quantile_<quantile> fields, histogram and summary sums and counts to sum and count fields
+	// and the samples of other metrics to a value field.
+	MetricMappingFamily = "family"
+)
+
+// MetricNameLabel is the label holding the name of a Prometheus metric.
+const MetricNameLabel = "__name__"
+
+// Labels of histogram buckets and summary quantiles.
+const (
+	BucketLabel   = "le"
+	QuantileLabel = "quantile"
+)
+
+// Fields of the measurements of metric families.
+const (
+	familyValueField     = "value"
+	familySumField       = "sum"
+	familyCountField     = "count"
+	familyBucketPrefix   = "le_"
+	familyQuantilePrefix = "quantile_"
+)
+
+// Suffixes of the metric names of histogram and summary series.
+const (
+	BucketSuffix = "_bucket"
+	SumSuffix    = "_sum"
+	CountSuffix  = "_count"
+)
+
+// ErrUnboundedMetricName is returned when a read with the family mapping does not match the metric
+// name against a set of names, as it would have to read every measurement of the database.
+var ErrUnboundedMetricName = errors.New("the family metric mapping requires the metric name to be matched against a set of names")
+
+// MissingMetricNameError is returned along with the points of the other time series when time
+// series without a metric name are dropped by the family mapping.
+type MissingMetricNameError struct {
+	// N is the number of dropped time series.
+	N int
+}
+
+// Error returns a string representation of the error.
+func (e MissingMetricNameError) Error() string {
+	return fmt.Sprintf("dropped %d time series without a metric name", e.N)
+}
+
+// ValidateMetricMapping returns an error if mapping is not a known metric mapping. An empty
+// mapping is the flat mapping.
+func ValidateMetricMapping(mapping string) error {
+	switch mapping {
+	case "", MetricMappingFlat, MetricMappingFamily:
+		return nil
+	default:
+		return fmt.Errorf("unknown metric mapping %q", mapping)
+	}
+}
+
+// WriteRequestToPointsWithMapping converts a Prometheus remote write request of time series and
+// their samples into Points using the given metric mapping.
+func WriteRequestToPointsWithMapping(req *remote.WriteRequest, mapping string) ([]models.Point, error) {
+	if err := ValidateMetricMapping(mapping); err != nil {
+		return nil, err
+	} else if mapping != MetricMappingFamily {
+		return WriteRequestToPoints(req)
+	}
+
+	type pointKey struct {
+		series string
+		time   int64
+	}
+	type point struct {
+		name   string
+		tags   models.Tags
+		fields models.Fields
+		time   time.Time
+	}
+
+	// The samples of a family at the same time are folded into one point.
+	var (
+		points      []*point
+		index       = make(map[pointKey]*point)
+		droppedNaN  error
+		missingName int
+	)
+	for _, ts := range req.Timeseries {
+		tags := make(map[string]string, len(ts.Labels))
+		for _, l := range ts.Labels {
+			tags[l.Name] = l.Value
+		}
+
+		measurement, field, label := familyField(tags)
+		if measurement == "" {
+			missingName++
+			continue
+		}
+		delete(tags, MetricNameLabel)
+		if label != "" {
+			delete(tags, label)
+		}
+		t := models.NewTags(tags)
+		series := string(models.MakeKey([]byte(measurement), t))
+
+		for _, s := range ts.Samples {
+			// skip NaN values, which are valid in Prometheus
+			if math.IsNaN(s.Value) {
+				droppedNaN = ErrNaNDropped
+				continue
+			}
+
+			key := pointKey{series: series, time: s.TimestampMs}
+			p := index[key]
+			if p == nil {
+				p = &point{
+					name:   measurement,
+					tags:   t,
+					fields: make(models.Fields),
+					time:   time.Unix(0, s.TimestampMs*int64(time.Millisecond)),
+				}
+				index[key] = p
+				points = append(points, p)
+			}
+			p.fields[field] = s.Value
+		}
+	}
+
+	out := make([]models.Point, 0, len(points))
+	for _, p := range points {
+		pt, err := models.NewPoint(p.name, p.tags, p.fields, p.time)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, pt)
+	}
+	if missingName > 0 {
+		return out, MissingMetricNameError{N: missingName}
+	}
+	return out, droppedNaN
+}
+
+// familyField returns the measurement and field the samples of a metric are written to by the
+// family mapping, and the label the field name replaces, if any.
+func familyField(labels map[string]string) (measurement, field, label string) {
+	name := labels[MetricNameLabel]
+	if le, ok := labels[BucketLabel]; ok && hasFamilySuffix(name, BucketSuffix) {
+		return strings.TrimSuffix(name, BucketSuffix), familyBucketPrefix + le, BucketLabel
+	} else if q, ok := labels[QuantileLabel]; ok {
+		return name, familyQuantilePrefix + q, QuantileLabel
+	} else if hasFamilySuffix(name, SumSuffix) {
+		return strings.TrimSuffix(name, SumSuffix), familySumField, ""
+	} else if hasFamilySuffix(name, CountSuffix) {
+		return strings.TrimSuffix(name, CountSuffix), familyCountField, ""
+	}
+	return name, familyValueField, ""
+}
+
+// familyMetric returns the metric name of a field of a family measurement written by the family
+// mapping, and the label and value its field name holds, if any.
+func familyMetric(measurement, field string) (name, label, value string, ok bool) {
+	switch {
+	case field == familyValueField:
+		return measurement, "", "", true
+	case field == familySumField:
+		return measurement + SumSuffix, "", "", true
+	case field == familyCountField:
+		return measurement + CountSuffix, "", "", true
+	case strings.HasPrefix(field, familyBucketPrefix):
+		return measurement + BucketSuffix, BucketLabel, strings.TrimPrefix(field, familyBucketPrefix), true
+	case strings.HasPrefix(field, familyQuantilePrefix):
+		return measurement, QuantileLabel, strings.TrimPrefix(field, familyQuantilePrefix), true
+	default:
+		return "", "", "", false
+	}
+}
+
+// hasFamilySuffix returns true if name is the name of a family member with the given suffix.
+func hasFamilySuffix(name, suffix string) bool {
+	return len(name) > len(suffix) && strings.HasSuffix(name, suffix)
+}
+
+// ReadRequestToInfluxQLQueryWithMapping converts a Prometheus remote read request to an InfluxQL
+// query that will return the requested data, as written with the given metric mapping, when
+// executed.
+//
+// With the family mapping, the metric name selects the measurements read and matchers on the
+// metric name, le and quantile labels may also refer to fields. These matchers are applied by
+// RowToTimeSeries instead, and samples are always returned raw. The metric name must be matched
+// against a set of names, so that only the measurements of their families are read.
+func ReadRequestToInfluxQLQueryWithMapping(req *remote.ReadRequest, db, rp, mapping string) (*influxql.Query, error) {
+	if err := ValidateMetricMapping(mapping); err != nil {
+		return nil, err
+	} else if mapping != MetricMappingFamily {
+		return ReadRequestToInfluxQLQuery(req, db, rp)
+	}
+
+	if len(req.Queries) != 1 {
+		return nil, errors.New("Prometheus read endpoint currently only supports one query at a time")
+	}
+	promQuery := req.Queries[0]
+
+	var matchers []*remote.LabelMatcher
+	for _, m := range promQuery.Matchers {
+		switch m.Name {
+		case MetricNameLabel, BucketLabel, QuantileLabel:
+			// Ensure the matcher is valid, as it is applied to the results.
+			if _, err := condFromMatcher(m); err != nil {
+				return nil, err
+			}
+		default:
+			matchers = append(matchers, m)
+		}
+	}
+
+	cond, err := condFromMatchers(promQuery, matchers)
+	if err != nil {
+		return nil, err
+	}
+
+	sources := familySources(promQuery.Matchers, db, rp)
+	if len(sources) == 0 {
+		return nil, ErrUnboundedMetricName
+	}
+
+	stmt := &influxql.SelectStatement{
+		IsRawQuery: true,
+		Fields: []*influxql.Field{
+			{Expr: &influxql.Wildcard{}},
+		},
+		Sources:    sources,
+		Condition:  cond,
+		Dimensions: []*influxql.Dimension{{Expr: &influxql.Wildcard{}}},
+	}
+	return &influxql.Query{Statements: []influxql.Statement{stmt}}, nil
+}
+
+// familySources returns the measurements which may hold the metrics selected by matchers with
+// the family mapping. No measurements are returned unless the metric name is matched against a
+// small set of values.
+func familySources(matchers []*remote.LabelMatcher, db, rp string) influxql.Sources {
+	var names []string
+	for _, m := range matchers {
+		if m.Name != MetricNameLabel {
+			continue
+		} else if m.Type == remote.MatchType_EQUAL {
+			names = []string{m.Value}
+			break
+		} else if m.Type == remote.MatchType_REGEX_MATCH {
+			if values, ok := regexLiterals(m.Value); ok {
+				names = values
+				break
+			}
+		}
+	}
+
+	// A metric is written to the measurement of its family, which may be
+	// named after it with a histogram or summary suffix removed.
+	measurements := make(map[string]struct{})
+	for _, name := range names {
+		if name == "" {
+			continue
+		}
+		measurements[name] = struct{}{}
+		for _, suffix := range []string{BucketSuffix, SumSuffix, CountSuffix} {
+			if hasFamilySuffix(name, suffix) {
+				measurements[strings.TrimSuffix(name, suffix)] = struct{}{}
+			}
+		}
+	}
+
+	sources := make(influxql.Sources, 0, len(measurements))
+	for name := range measurements {
+		sources = append(sources, &influxql.Measurement{
+			Name:            name,
+			Database:        db,
+			RetentionPolicy: rp,
+		})
+	}
+	sort.Slice(sources, func(i, j int) bool {
+		return sources[i].(*influxql.Measurement).Name < sources[j].(*influxql.Measurement).Name
+	})
+	return sources
+}
+
+// RowToTimeSeries converts a series returned by the InfluxQL query of a Prometheus remote read
+// query, as written with the given metric mapping, into Prometheus time series.
+func RowToTimeSeries(q *remote.Query, row *models.Row, mapping string) ([]*remote.TimeSeries, error) {
+	if mapping != MetricMappingFamily {
+		samples, err := rowSamples(row, 1, false, func(t time.Time) int64 { return sampleTimestampMs(q, t) })
+		if err != nil {
+			return nil, err
+		}
+		return []*remote.TimeSeries{{
+			Labels:  TagsToLabelPairs(row.Tags),
+			Samples: samples,
+		}}, nil
+	}
+
+	var series []*remote.TimeSeries
+	for i := 1; i < len(row.Columns); i++ {
+		name, label, value, ok := familyMetric(row.Name, row.Columns[i])
+		if !ok {
+			continue
+		}
+
+		labels := make(map[string]string, len(row.Tags)+2)
+		for k, v := range row.Tags {
+			labels[k] = v
+		}
+		labels[MetricNameLabel] = name
+		if label != "" {
+			labels[label] = value
+		}
+
+		if ok, err := matchLabels(q.Matchers, labels); err != nil {
+			return nil, err
+		} else if !ok {
+			continue
+		}
+
+		samples, err := rowSamples(row, i, true, func(t time.Time) int64 { return t.UnixNano() / int64(time.Millisecond) })
+		if err != nil {
+			return nil, err
+		} else if len(samples) == 0 {
+			continue
+		}
+		series = append(series, &remote.TimeSeries{
+			Labels:  TagsToLabelPairs(labels),
+			Samples: samples,
+		})
+	}
+	return series, nil
+}
+
+// rowSamples returns the samples of a column of a series. Empty values, such as those of fields
+// missing from a point of a family measurement, are skipped. Values which are not floats are
+// skipped if skipOther is set, as fields of family measurements may also be written by clients
+// other than Prometheus.
+func rowSamples(row *models.Row, column int, skipOther bool, timestampMs func(time.Time) int64) ([]*remote.Sample, error) {
+	samples := make([]*remote.Sample, 0, len(row.Values))
+	for _, v := range row.Values {
+		if v[column] == nil {
+			continue
+		}
+		t, ok := v[0].(time.Time)
+		if !ok {
+			return nil, fmt.Errorf("value %v wasn't a time", v[0])
+		}
+		val, ok := v[column].(float64)
+		if !ok && skipOther {
+			continue
+		} else if !ok {
+			return nil, fmt.Errorf("value %v wasn't a float64", v[column])
+		}
+		samples = append(samples, &remote.Sample{
+			TimestampMs: timestampMs(t),
+			Value:       val,
+		})
+	}
+	return samples, nil
+}
+
+// matchLabels returns true if the labels of a series are selected by all matchers. As in
+// Prometheus, a missing label has an empty value and regexes are anchored at both ends.
+func matchLabels(matchers []*remote.LabelMatcher, labels map[string]string) (bool, error) {
+	for _, m := range matchers {
+		v := labels[m.Name]
+		switch m.Type {
+		case remote.MatchType_EQUAL:
+			if v != m.Value {
+				return false, nil
+			}
+		case remote.MatchType_NOT_EQUAL:
+			if v == m.Value {
+				return false, nil
+			}
+		case remote.MatchType_REGEX_MATCH, remote.MatchType_REGEX_NO_MATCH:
+			re, err := regexp.Compile("^(?:" + m.Value + ")$")
+			if err != nil {
+				return false, err
+			}
+			if re.MatchString(v) != (m.Type == remote.MatchType_REGEX_MATCH) {
+				return false, nil
+			}
+		default:
+			return false, fmt.Errorf("unknown match type %v", m.Type)
+		}
+	}
+	return true, nil
+}
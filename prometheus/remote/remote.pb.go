@@ -18,6 +18,10 @@
 		Query
 		LabelMatcher
 		QueryResult
+		ReadHints
+		ChunkedReadResponse
+		ChunkedSeries
+		Chunk
 */
 package remote
 
@@ -65,6 +69,56 @@ func (x MatchType) String() string {
 }
 func (MatchType) EnumDescriptor() ([]byte, []int) { return fileDescriptorRemote, []int{0} }
 
+type ReadRequest_ResponseType int32
+
+const (
+	// Server will return a single snappy compressed ReadResponse message holding
+	// the raw samples of the matched series.
+	ReadRequest_SAMPLES ReadRequest_ResponseType = 0
+	// Server will stream ChunkedReadResponse messages holding the XOR encoded
+	// chunks of the matched series.  Each message is preceded by its varint size
+	// and its big endian uint32 CRC32 Castagnoli checksum.
+	ReadRequest_STREAMED_XOR_CHUNKS ReadRequest_ResponseType = 1
+)
+
+var ReadRequest_ResponseType_name = map[int32]string{
+	0: "SAMPLES",
+	1: "STREAMED_XOR_CHUNKS",
+}
+var ReadRequest_ResponseType_value = map[string]int32{
+	"SAMPLES":             0,
+	"STREAMED_XOR_CHUNKS": 1,
+}
+
+func (x ReadRequest_ResponseType) String() string {
+	return proto.EnumName(ReadRequest_ResponseType_name, int32(x))
+}
+func (ReadRequest_ResponseType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptorRemote, []int{4, 0}
+}
+
+// Encoding matches the chunk encodings of the Prometheus TSDB.
+type Chunk_Encoding int32
+
+const (
+	Chunk_UNKNOWN Chunk_Encoding = 0
+	Chunk_XOR     Chunk_Encoding = 1
+)
+
+var Chunk_Encoding_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "XOR",
+}
+var Chunk_Encoding_value = map[string]int32{
+	"UNKNOWN": 0,
+	"XOR":     1,
+}
+
+func (x Chunk_Encoding) String() string {
+	return proto.EnumName(Chunk_Encoding_name, int32(x))
+}
+func (Chunk_Encoding) EnumDescriptor() ([]byte, []int) { return fileDescriptorRemote, []int{12, 0} }
+
 type Sample struct {
 	Value       float64 `protobuf:"fixed64,1,opt,name=value,proto3" json:"value,omitempty"`
 	TimestampMs int64   `protobuf:"varint,2,opt,name=timestamp_ms,json=timestampMs,proto3" json:"timestamp_ms,omitempty"`
@@ -156,6 +210,9 @@ func (m *WriteRequest) GetTimeseries() []*TimeSeries {
 
 type ReadRequest struct {
 	Queries []*Query `protobuf:"bytes,1,rep,name=queries" json:"queries,omitempty"`
+	// accepted_response_types lists the response types the client accepts in order
+	// of preference.  Requests which do not list any accept SAMPLES.
+	AcceptedResponseTypes []ReadRequest_ResponseType `protobuf:"varint,2,rep,packed,name=accepted_response_types,json=acceptedResponseTypes,enum=remote.ReadRequest_ResponseType" json:"accepted_response_types,omitempty"`
 }
 
 func (m *ReadRequest) Reset()                    { *m = ReadRequest{} }
@@ -170,6 +227,13 @@ func (m *ReadRequest) GetQueries() []*Query {
 	return nil
 }
 
+func (m *ReadRequest) GetAcceptedResponseTypes() []ReadRequest_ResponseType {
+	if m != nil {
+		return m.AcceptedResponseTypes
+	}
+	return nil
+}
+
 type ReadResponse struct {
 	// In same order as the request's queries.
 	Results []*QueryResult `protobuf:"bytes,1,rep,name=results" json:"results,omitempty"`
@@ -191,6 +255,7 @@ type Query struct {
 	StartTimestampMs int64           `protobuf:"varint,1,opt,name=start_timestamp_ms,json=startTimestampMs,proto3" json:"start_timestamp_ms,omitempty"`
 	EndTimestampMs   int64           `protobuf:"varint,2,opt,name=end_timestamp_ms,json=endTimestampMs,proto3" json:"end_timestamp_ms,omitempty"`
 	Matchers         []*LabelMatcher `protobuf:"bytes,3,rep,name=matchers" json:"matchers,omitempty"`
+	Hints            *ReadHints      `protobuf:"bytes,4,opt,name=hints" json:"hints,omitempty"`
 }
 
 func (m *Query) Reset()                    { *m = Query{} }
@@ -219,6 +284,13 @@ func (m *Query) GetMatchers() []*LabelMatcher {
 	return nil
 }
 
+func (m *Query) GetHints() *ReadHints {
+	if m != nil {
+		return m.Hints
+	}
+	return nil
+}
+
 type LabelMatcher struct {
 	Type  MatchType `protobuf:"varint,1,opt,name=type,proto3,enum=remote.MatchType" json:"type,omitempty"`
 	Name  string    `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
@@ -267,6 +339,172 @@ func (m *QueryResult) GetTimeseries() []*TimeSeries {
 	return nil
 }
 
+// ReadHints describe the PromQL expression a query is evaluated for.
+type ReadHints struct {
+	// Query step size in milliseconds.
+	StepMs int64 `protobuf:"varint,1,opt,name=step_ms,json=stepMs,proto3" json:"step_ms,omitempty"`
+	// Name of the function or aggregation surrounding the selector.
+	Func string `protobuf:"bytes,2,opt,name=func,proto3" json:"func,omitempty"`
+	// Start time in milliseconds.
+	StartMs int64 `protobuf:"varint,3,opt,name=start_ms,json=startMs,proto3" json:"start_ms,omitempty"`
+	// End time in milliseconds.
+	EndMs int64 `protobuf:"varint,4,opt,name=end_ms,json=endMs,proto3" json:"end_ms,omitempty"`
+	// Label names used by the aggregation.
+	Grouping []string `protobuf:"bytes,5,rep,name=grouping" json:"grouping,omitempty"`
+	// Whether the aggregation groups by or without the labels.
+	By bool `protobuf:"varint,6,opt,name=by,proto3" json:"by,omitempty"`
+	// Range of the range vector selector in milliseconds.
+	RangeMs int64 `protobuf:"varint,7,opt,name=range_ms,json=rangeMs,proto3" json:"range_ms,omitempty"`
+}
+
+func (m *ReadHints) Reset()                    { *m = ReadHints{} }
+func (m *ReadHints) String() string            { return proto.CompactTextString(m) }
+func (*ReadHints) ProtoMessage()               {}
+func (*ReadHints) Descriptor() ([]byte, []int) { return fileDescriptorRemote, []int{9} }
+
+func (m *ReadHints) GetStepMs() int64 {
+	if m != nil {
+		return m.StepMs
+	}
+	return 0
+}
+
+func (m *ReadHints) GetFunc() string {
+	if m != nil {
+		return m.Func
+	}
+	return ""
+}
+
+func (m *ReadHints) GetStartMs() int64 {
+	if m != nil {
+		return m.StartMs
+	}
+	return 0
+}
+
+func (m *ReadHints) GetEndMs() int64 {
+	if m != nil {
+		return m.EndMs
+	}
+	return 0
+}
+
+func (m *ReadHints) GetGrouping() []string {
+	if m != nil {
+		return m.Grouping
+	}
+	return nil
+}
+
+func (m *ReadHints) GetBy() bool {
+	if m != nil {
+		return m.By
+	}
+	return false
+}
+
+func (m *ReadHints) GetRangeMs() int64 {
+	if m != nil {
+		return m.RangeMs
+	}
+	return 0
+}
+
+// ChunkedReadResponse is a frame of a STREAMED_XOR_CHUNKS response.  A series
+// may be split over several frames, but once a new series is started no more
+// chunks are sent for the previous one.
+type ChunkedReadResponse struct {
+	ChunkedSeries []*ChunkedSeries `protobuf:"bytes,1,rep,name=chunked_series,json=chunkedSeries" json:"chunked_series,omitempty"`
+	// Index of the request's query the chunks belong to.
+	QueryIndex int64 `protobuf:"varint,2,opt,name=query_index,json=queryIndex,proto3" json:"query_index,omitempty"`
+}
+
+func (m *ChunkedReadResponse) Reset()                    { *m = ChunkedReadResponse{} }
+func (m *ChunkedReadResponse) String() string            { return proto.CompactTextString(m) }
+func (*ChunkedReadResponse) ProtoMessage()               {}
+func (*ChunkedReadResponse) Descriptor() ([]byte, []int) { return fileDescriptorRemote, []int{10} }
+
+func (m *ChunkedReadResponse) GetChunkedSeries() []*ChunkedSeries {
+	if m != nil {
+		return m.ChunkedSeries
+	}
+	return nil
+}
+
+func (m *ChunkedReadResponse) GetQueryIndex() int64 {
+	if m != nil {
+		return m.QueryIndex
+	}
+	return 0
+}
+
+type ChunkedSeries struct {
+	// Labels are sorted by name.
+	Labels []*LabelPair `protobuf:"bytes,1,rep,name=labels" json:"labels,omitempty"`
+	// Sorted by time, oldest chunk first.
+	Chunks []*Chunk `protobuf:"bytes,2,rep,name=chunks" json:"chunks,omitempty"`
+}
+
+func (m *ChunkedSeries) Reset()                    { *m = ChunkedSeries{} }
+func (m *ChunkedSeries) String() string            { return proto.CompactTextString(m) }
+func (*ChunkedSeries) ProtoMessage()               {}
+func (*ChunkedSeries) Descriptor() ([]byte, []int) { return fileDescriptorRemote, []int{11} }
+
+func (m *ChunkedSeries) GetLabels() []*LabelPair {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *ChunkedSeries) GetChunks() []*Chunk {
+	if m != nil {
+		return m.Chunks
+	}
+	return nil
+}
+
+type Chunk struct {
+	MinTimeMs int64          `protobuf:"varint,1,opt,name=min_time_ms,json=minTimeMs,proto3" json:"min_time_ms,omitempty"`
+	MaxTimeMs int64          `protobuf:"varint,2,opt,name=max_time_ms,json=maxTimeMs,proto3" json:"max_time_ms,omitempty"`
+	Type      Chunk_Encoding `protobuf:"varint,3,opt,name=type,proto3,enum=remote.Chunk_Encoding" json:"type,omitempty"`
+	Data      []byte         `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *Chunk) Reset()                    { *m = Chunk{} }
+func (m *Chunk) String() string            { return proto.CompactTextString(m) }
+func (*Chunk) ProtoMessage()               {}
+func (*Chunk) Descriptor() ([]byte, []int) { return fileDescriptorRemote, []int{12} }
+
+func (m *Chunk) GetMinTimeMs() int64 {
+	if m != nil {
+		return m.MinTimeMs
+	}
+	return 0
+}
+
+func (m *Chunk) GetMaxTimeMs() int64 {
+	if m != nil {
+		return m.MaxTimeMs
+	}
+	return 0
+}
+
+func (m *Chunk) GetType() Chunk_Encoding {
+	if m != nil {
+		return m.Type
+	}
+	return Chunk_UNKNOWN
+}
+
+func (m *Chunk) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Sample)(nil), "remote.Sample")
 	proto.RegisterType((*LabelPair)(nil), "remote.LabelPair")
@@ -277,7 +515,13 @@ func init() {
 	proto.RegisterType((*Query)(nil), "remote.Query")
 	proto.RegisterType((*LabelMatcher)(nil), "remote.LabelMatcher")
 	proto.RegisterType((*QueryResult)(nil), "remote.QueryResult")
+	proto.RegisterType((*ReadHints)(nil), "remote.ReadHints")
+	proto.RegisterType((*ChunkedReadResponse)(nil), "remote.ChunkedReadResponse")
+	proto.RegisterType((*ChunkedSeries)(nil), "remote.ChunkedSeries")
+	proto.RegisterType((*Chunk)(nil), "remote.Chunk")
 	proto.RegisterEnum("remote.MatchType", MatchType_name, MatchType_value)
+	proto.RegisterEnum("remote.ReadRequest_ResponseType", ReadRequest_ResponseType_name, ReadRequest_ResponseType_value)
+	proto.RegisterEnum("remote.Chunk_Encoding", Chunk_Encoding_name, Chunk_Encoding_value)
 }
 func (m *Sample) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
@@ -436,6 +680,23 @@ func (m *ReadRequest) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if len(m.AcceptedResponseTypes) > 0 {
+		dAtA2 := make([]byte, len(m.AcceptedResponseTypes)*10)
+		var j1 int
+		for _, num := range m.AcceptedResponseTypes {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRemote(dAtA, i, uint64(j1))
+		i += copy(dAtA[i:], dAtA2[:j1])
+	}
 	return i, nil
 }
 
@@ -506,6 +767,16 @@ func (m *Query) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if m.Hints != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintRemote(dAtA, i, uint64(m.Hints.Size()))
+		n3, err := m.Hints.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
 	return i, nil
 }
 
@@ -574,6 +845,191 @@ func (m *QueryResult) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *ReadHints) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReadHints) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.StepMs != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintRemote(dAtA, i, uint64(m.StepMs))
+	}
+	if len(m.Func) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRemote(dAtA, i, uint64(len(m.Func)))
+		i += copy(dAtA[i:], m.Func)
+	}
+	if m.StartMs != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintRemote(dAtA, i, uint64(m.StartMs))
+	}
+	if m.EndMs != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintRemote(dAtA, i, uint64(m.EndMs))
+	}
+	if len(m.Grouping) > 0 {
+		for _, s := range m.Grouping {
+			dAtA[i] = 0x2a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.By {
+		dAtA[i] = 0x30
+		i++
+		if m.By {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.RangeMs != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintRemote(dAtA, i, uint64(m.RangeMs))
+	}
+	return i, nil
+}
+
+func (m *ChunkedReadResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChunkedReadResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChunkedSeries) > 0 {
+		for _, msg := range m.ChunkedSeries {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintRemote(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.QueryIndex != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintRemote(dAtA, i, uint64(m.QueryIndex))
+	}
+	return i, nil
+}
+
+func (m *ChunkedSeries) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChunkedSeries) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Labels) > 0 {
+		for _, msg := range m.Labels {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintRemote(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Chunks) > 0 {
+		for _, msg := range m.Chunks {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintRemote(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *Chunk) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Chunk) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.MinTimeMs != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintRemote(dAtA, i, uint64(m.MinTimeMs))
+	}
+	if m.MaxTimeMs != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintRemote(dAtA, i, uint64(m.MaxTimeMs))
+	}
+	if m.Type != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintRemote(dAtA, i, uint64(m.Type))
+	}
+	if len(m.Data) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintRemote(dAtA, i, uint64(len(m.Data)))
+		i += copy(dAtA[i:], m.Data)
+	}
+	return i, nil
+}
+
 func encodeFixed64Remote(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	dAtA[offset+1] = uint8(v >> 8)
@@ -666,6 +1122,13 @@ func (m *ReadRequest) Size() (n int) {
 			n += 1 + l + sovRemote(uint64(l))
 		}
 	}
+	if len(m.AcceptedResponseTypes) > 0 {
+		l = 0
+		for _, e := range m.AcceptedResponseTypes {
+			l += sovRemote(uint64(e))
+		}
+		n += 1 + sovRemote(uint64(l)) + l
+	}
 	return n
 }
 
@@ -696,7 +1159,11 @@ func (m *Query) Size() (n int) {
 			n += 1 + l + sovRemote(uint64(l))
 		}
 	}
-	return n
+	if m.Hints != nil {
+		l = m.Hints.Size()
+		n += 1 + l + sovRemote(uint64(l))
+	}
+	return n
 }
 
 func (m *LabelMatcher) Size() (n int) {
@@ -728,6 +1195,89 @@ func (m *QueryResult) Size() (n int) {
 	return n
 }
 
+func (m *ReadHints) Size() (n int) {
+	var l int
+	_ = l
+	if m.StepMs != 0 {
+		n += 1 + sovRemote(uint64(m.StepMs))
+	}
+	l = len(m.Func)
+	if l > 0 {
+		n += 1 + l + sovRemote(uint64(l))
+	}
+	if m.StartMs != 0 {
+		n += 1 + sovRemote(uint64(m.StartMs))
+	}
+	if m.EndMs != 0 {
+		n += 1 + sovRemote(uint64(m.EndMs))
+	}
+	if len(m.Grouping) > 0 {
+		for _, s := range m.Grouping {
+			l = len(s)
+			n += 1 + l + sovRemote(uint64(l))
+		}
+	}
+	if m.By {
+		n += 2
+	}
+	if m.RangeMs != 0 {
+		n += 1 + sovRemote(uint64(m.RangeMs))
+	}
+	return n
+}
+
+func (m *ChunkedReadResponse) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.ChunkedSeries) > 0 {
+		for _, e := range m.ChunkedSeries {
+			l = e.Size()
+			n += 1 + l + sovRemote(uint64(l))
+		}
+	}
+	if m.QueryIndex != 0 {
+		n += 1 + sovRemote(uint64(m.QueryIndex))
+	}
+	return n
+}
+
+func (m *ChunkedSeries) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Labels) > 0 {
+		for _, e := range m.Labels {
+			l = e.Size()
+			n += 1 + l + sovRemote(uint64(l))
+		}
+	}
+	if len(m.Chunks) > 0 {
+		for _, e := range m.Chunks {
+			l = e.Size()
+			n += 1 + l + sovRemote(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Chunk) Size() (n int) {
+	var l int
+	_ = l
+	if m.MinTimeMs != 0 {
+		n += 1 + sovRemote(uint64(m.MinTimeMs))
+	}
+	if m.MaxTimeMs != 0 {
+		n += 1 + sovRemote(uint64(m.MaxTimeMs))
+	}
+	if m.Type != 0 {
+		n += 1 + sovRemote(uint64(m.Type))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovRemote(uint64(l))
+	}
+	return n
+}
+
 func sovRemote(x uint64) (n int) {
 	for {
 		n++
@@ -1189,6 +1739,68 @@ func (m *ReadRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v ReadRequest_ResponseType
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRemote
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (ReadRequest_ResponseType(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.AcceptedResponseTypes = append(m.AcceptedResponseTypes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRemote
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthRemote
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v ReadRequest_ResponseType
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRemote
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (ReadRequest_ResponseType(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.AcceptedResponseTypes = append(m.AcceptedResponseTypes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptedResponseTypes", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRemote(dAtA[iNdEx:])
@@ -1389,6 +2001,39 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemote
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRemote
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Hints == nil {
+				m.Hints = &ReadHints{}
+			}
+			if err := m.Hints.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRemote(dAtA[iNdEx:])
@@ -1618,6 +2263,560 @@ func (m *QueryResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ReadHints) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRemote
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReadHints: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReadHints: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StepMs", wireType)
+			}
+			m.StepMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemote
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StepMs |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Func", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemote
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRemote
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Func = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartMs", wireType)
+			}
+			m.StartMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemote
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartMs |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndMs", wireType)
+			}
+			m.EndMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemote
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndMs |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grouping", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemote
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRemote
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grouping = append(m.Grouping, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field By", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemote
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.By = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RangeMs", wireType)
+			}
+			m.RangeMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemote
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RangeMs |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRemote(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRemote
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChunkedReadResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRemote
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChunkedReadResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChunkedReadResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkedSeries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemote
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRemote
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChunkedSeries = append(m.ChunkedSeries, &ChunkedSeries{})
+			if err := m.ChunkedSeries[len(m.ChunkedSeries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryIndex", wireType)
+			}
+			m.QueryIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemote
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueryIndex |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRemote(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRemote
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChunkedSeries) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRemote
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChunkedSeries: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChunkedSeries: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemote
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRemote
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Labels = append(m.Labels, &LabelPair{})
+			if err := m.Labels[len(m.Labels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemote
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRemote
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chunks = append(m.Chunks, &Chunk{})
+			if err := m.Chunks[len(m.Chunks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRemote(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRemote
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Chunk) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRemote
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Chunk: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Chunk: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinTimeMs", wireType)
+			}
+			m.MinTimeMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemote
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinTimeMs |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTimeMs", wireType)
+			}
+			m.MaxTimeMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemote
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTimeMs |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemote
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= (Chunk_Encoding(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemote
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRemote
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRemote(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRemote
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipRemote(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1726,34 +2925,55 @@ var (
 func init() { proto.RegisterFile("remote.proto", fileDescriptorRemote) }
 
 var fileDescriptorRemote = []byte{
-	// 449 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0xed, 0xc6, 0x4d, 0x82, 0xc7, 0x6e, 0x30, 0x43, 0x0f, 0x39, 0x45, 0xc1, 0x12, 0xc2, 0x20,
-	0xa8, 0x50, 0x11, 0xdc, 0x38, 0xa4, 0x28, 0x02, 0xa1, 0xa6, 0xa5, 0x5b, 0x23, 0xb8, 0x59, 0x5b,
-	0x32, 0x12, 0x96, 0xec, 0xc4, 0xdd, 0x5d, 0x23, 0xe5, 0x5f, 0xc0, 0xbf, 0xe2, 0xc8, 0x4f, 0x40,
-	0xe1, 0x8f, 0xa0, 0xec, 0xc6, 0x1f, 0x91, 0x72, 0xea, 0x2d, 0x33, 0xef, 0xbd, 0x99, 0x97, 0x7d,
-	0x63, 0xf0, 0x25, 0xe5, 0x4b, 0x4d, 0x27, 0x85, 0x5c, 0xea, 0x25, 0xf6, 0x6c, 0x15, 0x4e, 0xa0,
-	0x77, 0x2d, 0xf2, 0x22, 0x23, 0x3c, 0x86, 0xee, 0x0f, 0x91, 0x95, 0x34, 0x64, 0x63, 0x16, 0x31,
-	0x6e, 0x0b, 0x7c, 0x04, 0xbe, 0x4e, 0x73, 0x52, 0x5a, 0xe4, 0x45, 0x92, 0xab, 0x61, 0x67, 0xcc,
-	0x22, 0x87, 0x7b, 0x75, 0x6f, 0xa6, 0xc2, 0xd7, 0xe0, 0x9e, 0x8b, 0x1b, 0xca, 0x3e, 0x89, 0x54,
-	0x22, 0xc2, 0xe1, 0x42, 0xe4, 0x76, 0x88, 0xcb, 0xcd, 0xef, 0x66, 0x72, 0xc7, 0x34, 0x6d, 0x11,
-	0x0a, 0x80, 0x38, 0xcd, 0xe9, 0x9a, 0x64, 0x4a, 0x0a, 0x9f, 0x42, 0x2f, 0xdb, 0x0c, 0x51, 0x43,
-	0x36, 0x76, 0x22, 0xef, 0xf4, 0xc1, 0xc9, 0xd6, 0x6e, 0x3d, 0x9a, 0x6f, 0x09, 0x18, 0x41, 0x5f,
-	0x19, 0xcb, 0x1b, 0x37, 0x1b, 0xee, 0xa0, 0xe2, 0xda, 0x7f, 0xc2, 0x2b, 0x38, 0x3c, 0x03, 0xff,
-	0x8b, 0x4c, 0x35, 0x71, 0xba, 0x2d, 0x49, 0x69, 0x3c, 0x05, 0x30, 0xc6, 0xcd, 0xca, 0xed, 0x22,
-	0xac, 0xc4, 0x8d, 0x19, 0xde, 0x62, 0x85, 0x6f, 0xc0, 0xe3, 0x24, 0xe6, 0xd5, 0x88, 0x27, 0xd0,
-	0xbf, 0x2d, 0xdb, 0xfa, 0xa3, 0x4a, 0x7f, 0x55, 0x92, 0x5c, 0xf1, 0x0a, 0x0d, 0xdf, 0x82, 0x6f,
-	0x75, 0xaa, 0x58, 0x2e, 0x14, 0xe1, 0x0b, 0xe8, 0x4b, 0x52, 0x65, 0xa6, 0x2b, 0xe1, 0xc3, 0x5d,
-	0xa1, 0xc1, 0x78, 0xc5, 0x09, 0x7f, 0x31, 0xe8, 0x1a, 0x00, 0x9f, 0x03, 0x2a, 0x2d, 0xa4, 0x4e,
-	0x76, 0x72, 0x60, 0x26, 0x87, 0xc0, 0x20, 0x71, 0x13, 0x06, 0x46, 0x10, 0xd0, 0x62, 0x9e, 0xec,
-	0xc9, 0x6c, 0x40, 0x8b, 0x79, 0x9b, 0xf9, 0x12, 0xee, 0xe5, 0x42, 0x7f, 0xfb, 0x4e, 0x52, 0x0d,
-	0x1d, 0xe3, 0xe8, 0x78, 0xe7, 0xcd, 0x67, 0x16, 0xe4, 0x35, 0x2b, 0x4c, 0xc0, 0x6f, 0x23, 0xf8,
-	0x18, 0x0e, 0xf5, 0xaa, 0xb0, 0x59, 0x0f, 0x9a, 0xc4, 0x0c, 0x1c, 0xaf, 0x0a, 0xe2, 0x06, 0xae,
-	0x4f, 0xa2, 0xb3, 0xef, 0x24, 0x9c, 0xf6, 0x49, 0x4c, 0xc0, 0x6b, 0x3d, 0xc6, 0x5d, 0xe2, 0x7a,
-	0xf6, 0x11, 0xdc, 0x7a, 0x3f, 0xba, 0xd0, 0x9d, 0x5e, 0x7d, 0x9e, 0x9c, 0x07, 0x07, 0x78, 0x04,
-	0xee, 0xc5, 0x65, 0x9c, 0xd8, 0x92, 0xe1, 0x7d, 0xf0, 0xf8, 0xf4, 0xfd, 0xf4, 0x6b, 0x32, 0x9b,
-	0xc4, 0xef, 0x3e, 0x04, 0x1d, 0x44, 0x18, 0xd8, 0xc6, 0xc5, 0xe5, 0xb6, 0xe7, 0x9c, 0x05, 0xbf,
-	0xd7, 0x23, 0xf6, 0x67, 0x3d, 0x62, 0x7f, 0xd7, 0x23, 0xf6, 0xf3, 0xdf, 0xe8, 0xe0, 0xa6, 0x67,
-	0x3e, 0x9e, 0x57, 0xff, 0x03, 0x00, 0x00, 0xff, 0xff, 0x28, 0xd6, 0xf1, 0x18, 0x4c, 0x03, 0x00,
-	0x00,
+	// 796 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xdd, 0x6e, 0xe3, 0x44,
+	0x18, 0xed, 0xc4, 0x8d, 0x1d, 0x7f, 0x4e, 0x82, 0x99, 0x6e, 0x69, 0xe0, 0x22, 0x18, 0x4b, 0xab,
+	0x0d, 0x2b, 0x88, 0x50, 0x81, 0x3b, 0xb8, 0xc8, 0x96, 0x88, 0xc2, 0xd6, 0xc9, 0xee, 0x24, 0xd5,
+	0xe6, 0xce, 0x9a, 0xc4, 0x43, 0x6a, 0x11, 0x3b, 0x5e, 0xcf, 0x18, 0x35, 0x6f, 0xc1, 0x6b, 0x70,
+	0xc9, 0x2d, 0x0f, 0x80, 0xb8, 0xe4, 0x11, 0x50, 0x79, 0x11, 0x34, 0xe3, 0x9f, 0xd8, 0x52, 0x6f,
+	0xd8, 0xbb, 0xf9, 0xce, 0xf9, 0xe6, 0xcc, 0x99, 0x6f, 0x4e, 0x1c, 0xe8, 0xa6, 0x2c, 0xda, 0x0b,
+	0x36, 0x4e, 0xd2, 0xbd, 0xd8, 0x63, 0x3d, 0xaf, 0xdc, 0x09, 0xe8, 0x0b, 0x1a, 0x25, 0x3b, 0x86,
+	0x9f, 0x40, 0xfb, 0x17, 0xba, 0xcb, 0xd8, 0x00, 0x39, 0x68, 0x84, 0x48, 0x5e, 0xe0, 0x4f, 0xa0,
+	0x2b, 0xc2, 0x88, 0x71, 0x41, 0xa3, 0xc4, 0x8f, 0xf8, 0xa0, 0xe5, 0xa0, 0x91, 0x46, 0xac, 0x0a,
+	0xf3, 0xb8, 0xfb, 0x35, 0x98, 0x37, 0x74, 0xcd, 0x76, 0xaf, 0x68, 0x98, 0x62, 0x0c, 0xa7, 0x31,
+	0x8d, 0x72, 0x11, 0x93, 0xa8, 0xf5, 0x51, 0xb9, 0xa5, 0xc0, 0xbc, 0x70, 0x29, 0xc0, 0x32, 0x8c,
+	0xd8, 0x82, 0xa5, 0x21, 0xe3, 0xf8, 0x53, 0xd0, 0x77, 0x52, 0x84, 0x0f, 0x90, 0xa3, 0x8d, 0xac,
+	0xcb, 0xf7, 0xc7, 0x85, 0xdd, 0x4a, 0x9a, 0x14, 0x0d, 0x78, 0x04, 0x06, 0x57, 0x96, 0xa5, 0x1b,
+	0xd9, 0xdb, 0x2f, 0x7b, 0xf3, 0x9b, 0x90, 0x92, 0x76, 0x5f, 0x40, 0xf7, 0x4d, 0x1a, 0x0a, 0x46,
+	0xd8, 0xdb, 0x8c, 0x71, 0x81, 0x2f, 0x01, 0x94, 0x71, 0x75, 0x64, 0x71, 0x10, 0x2e, 0x37, 0x1f,
+	0xcd, 0x90, 0x5a, 0x97, 0xfb, 0x27, 0x02, 0x8b, 0x30, 0x1a, 0x94, 0x1a, 0xcf, 0xc0, 0x78, 0x9b,
+	0xd5, 0x05, 0x7a, 0xa5, 0xc0, 0xeb, 0x8c, 0xa5, 0x07, 0x52, 0xb2, 0x78, 0x05, 0x17, 0x74, 0xb3,
+	0x61, 0x89, 0x60, 0x81, 0x9f, 0x32, 0x9e, 0xec, 0x63, 0xce, 0x7c, 0x71, 0x48, 0x0a, 0xdb, 0xfd,
+	0x4b, 0xa7, 0xdc, 0x58, 0x93, 0x1f, 0x93, 0xa2, 0x73, 0x79, 0x48, 0x18, 0x39, 0x2f, 0x05, 0xea,
+	0x28, 0x77, 0xbf, 0x82, 0x6e, 0x1d, 0xc0, 0x16, 0x18, 0x8b, 0x89, 0xf7, 0xea, 0x66, 0xba, 0xb0,
+	0x4f, 0xf0, 0x05, 0x9c, 0x2d, 0x96, 0x64, 0x3a, 0xf1, 0xa6, 0xdf, 0xf9, 0xab, 0x39, 0xf1, 0xaf,
+	0xae, 0x6f, 0x67, 0x2f, 0x17, 0x36, 0x72, 0xbf, 0x85, 0x6e, 0x7e, 0x50, 0xbe, 0x13, 0x7f, 0x0e,
+	0x46, 0xca, 0x78, 0xb6, 0x13, 0xe5, 0x45, 0xce, 0x9a, 0x17, 0x51, 0x1c, 0x29, 0x7b, 0xdc, 0x3f,
+	0x10, 0xb4, 0x15, 0x81, 0x3f, 0x03, 0xcc, 0x05, 0x4d, 0x85, 0xdf, 0x08, 0x06, 0x52, 0xc1, 0xb0,
+	0x15, 0xb3, 0x3c, 0xa6, 0x03, 0x8f, 0xc0, 0x66, 0x71, 0xe0, 0x3f, 0x12, 0xa2, 0x3e, 0x8b, 0x83,
+	0x7a, 0xe7, 0x17, 0xd0, 0x89, 0xa8, 0xd8, 0xdc, 0xb1, 0x94, 0x0f, 0x34, 0xe5, 0xe8, 0x49, 0x23,
+	0x04, 0x5e, 0x4e, 0x92, 0xaa, 0x0b, 0x3f, 0x83, 0xf6, 0x5d, 0x18, 0x0b, 0x3e, 0x38, 0x75, 0x50,
+	0x3d, 0x33, 0xf2, 0x9e, 0xd7, 0x92, 0x20, 0x39, 0xef, 0xfa, 0xd0, 0xad, 0x4b, 0xe0, 0xa7, 0x70,
+	0x2a, 0x5f, 0x42, 0x99, 0xee, 0x1f, 0xf7, 0x29, 0x5a, 0x4d, 0x5e, 0xd1, 0x55, 0x98, 0x5b, 0x8f,
+	0x85, 0x59, 0xab, 0x87, 0x79, 0x02, 0x56, 0x6d, 0x6a, 0xef, 0x14, 0xb4, 0xdf, 0x11, 0x98, 0x95,
+	0x71, 0x7c, 0x01, 0x06, 0x17, 0xac, 0x36, 0x59, 0x5d, 0x96, 0x1e, 0x97, 0x9e, 0x7e, 0xca, 0xe2,
+	0x4d, 0xe9, 0x49, 0xae, 0xf1, 0x87, 0xd0, 0xc9, 0x5f, 0x24, 0xe2, 0xca, 0x96, 0x46, 0x0c, 0x55,
+	0x7b, 0x1c, 0x9f, 0x83, 0x2e, 0xc7, 0x1f, 0xe5, 0x33, 0xd2, 0x48, 0x9b, 0xc5, 0x81, 0xc7, 0xf1,
+	0x47, 0xd0, 0xd9, 0xa6, 0xfb, 0x2c, 0x09, 0xe3, 0xed, 0xa0, 0xed, 0x68, 0x23, 0x93, 0x54, 0x35,
+	0xee, 0x43, 0x6b, 0x7d, 0x18, 0xe8, 0x0e, 0x1a, 0x75, 0x48, 0x6b, 0x7d, 0x90, 0xea, 0x29, 0x8d,
+	0xb7, 0x4c, 0x8a, 0x18, 0xb9, 0xba, 0xaa, 0x3d, 0xee, 0x0a, 0x38, 0xbb, 0xba, 0xcb, 0xe2, 0x9f,
+	0x59, 0xd0, 0x88, 0xd6, 0x37, 0xd0, 0xdf, 0xe4, 0xb0, 0xdf, 0x18, 0xc1, 0x79, 0x39, 0x82, 0x62,
+	0x53, 0x31, 0x85, 0xde, 0xa6, 0x5e, 0xe2, 0x8f, 0xc1, 0x92, 0xbf, 0xa1, 0x83, 0x1f, 0xc6, 0x01,
+	0xbb, 0x2f, 0xc2, 0x02, 0x0a, 0xfa, 0x41, 0x22, 0x2e, 0x85, 0x5e, 0x43, 0xe0, 0xff, 0x7c, 0x3c,
+	0x9e, 0x82, 0xae, 0x4e, 0x2b, 0xbf, 0x1d, 0xbd, 0x86, 0x25, 0x52, 0x90, 0xee, 0x6f, 0x08, 0xda,
+	0x0a, 0xc1, 0x43, 0xb0, 0xa2, 0x30, 0x56, 0xf9, 0x3d, 0x3e, 0x86, 0x19, 0x85, 0xb1, 0x7c, 0x46,
+	0x8f, 0x2b, 0x9e, 0xde, 0x57, 0x7c, 0xab, 0xe0, 0xe9, 0x7d, 0xc1, 0x3f, 0x2f, 0xa2, 0xa6, 0xa9,
+	0xa8, 0x7d, 0xd0, 0x38, 0x6e, 0x3c, 0x8d, 0x37, 0xfb, 0x20, 0x8c, 0xb7, 0xc7, 0xbc, 0x05, 0x54,
+	0x50, 0xf5, 0x54, 0x5d, 0xa2, 0xd6, 0xae, 0x03, 0x9d, 0xb2, 0x4b, 0xfe, 0xd0, 0x6f, 0x67, 0x2f,
+	0x67, 0xf3, 0x37, 0x33, 0xfb, 0x04, 0x1b, 0xa0, 0xad, 0xe6, 0xc4, 0x46, 0xcf, 0x7f, 0x04, 0xb3,
+	0x0a, 0x2e, 0x36, 0xa1, 0x3d, 0x7d, 0x7d, 0x3b, 0xb9, 0xb1, 0x4f, 0x70, 0x0f, 0xcc, 0xd9, 0x7c,
+	0xe9, 0xe7, 0x25, 0xc2, 0xef, 0x81, 0x45, 0xa6, 0xdf, 0x4f, 0x57, 0xbe, 0x37, 0x59, 0x5e, 0x5d,
+	0xdb, 0x2d, 0x8c, 0xa1, 0x9f, 0x03, 0xb3, 0x79, 0x81, 0x69, 0x2f, 0xec, 0xbf, 0x1e, 0x86, 0xe8,
+	0xef, 0x87, 0x21, 0xfa, 0xe7, 0x61, 0x88, 0x7e, 0xfd, 0x77, 0x78, 0xb2, 0xd6, 0xd5, 0xff, 0xc5,
+	0x97, 0xff, 0x0d, 0x00, 0xd3, 0xde, 0x13, 0x9a, 0x3f, 0x06, 0x00, 0x00,
 }
@@ -24,6 +24,14 @@ type ExecutionContext struct {
 	// Options used to start this query.
 	ExecutionOptions
 
+	// Limits for the user and database of this query. These are applied in
+	// addition to any limits configured by the StatementExecutor.
+	Limits QueryLimits
+
+	// Memory limits the points buffered by the iterators of this query.
+	// If nil, memory is not limited.
+	Memory *MemoryBudget
+
 	mu   sync.RWMutex
 	done chan struct{}
 	err  error
@@ -84,6 +92,16 @@ func (ctx *ExecutionContext) Value(key interface{}) interface{} {
 	switch key {
 	case monitorContextKey:
 		return ctx.task
+	case statsRecorderContextKey:
+		if ctx.task != nil && ctx.task.recordStats {
+			return ctx.task
+		}
+		return nil
+	case memoryBudgetContextKey:
+		if ctx.Memory != nil {
+			return ctx.Memory
+		}
+		return nil
 	}
 	return ctx.Context.Value(key)
 }
@@ -68,6 +68,18 @@ func ErrMaxConcurrentQueriesLimitExceeded(n, limit int) error {
 	return fmt.Errorf("max-concurrent-queries limit exceeded(%d, %d)", n, limit)
 }
 
+// ErrUserMaxConcurrentQueriesLimitExceeded is an error when a query cannot be run
+// because the maximum number of queries for the user has been reached.
+func ErrUserMaxConcurrentQueriesLimitExceeded(user string, n, limit int) error {
+	return fmt.Errorf("max-concurrent-queries limit exceeded for user %s (%d, %d)", user, n, limit)
+}
+
+// ErrDatabaseMaxConcurrentQueriesLimitExceeded is an error when a query cannot be run
+// because the maximum number of queries for the database has been reached.
+func ErrDatabaseMaxConcurrentQueriesLimitExceeded(database string, n, limit int) error {
+	return fmt.Errorf("max-concurrent-queries limit exceeded for database %s (%d, %d)", database, n, limit)
+}
+
 // Authorizer determines if certain operations are authorized.
 type Authorizer interface {
 	// AuthorizeDatabase indicates whether the given Privilege is authorized on the database with the given name.
@@ -132,18 +144,54 @@ type ExecutionOptions struct {
 	// Node to execute on.
 	NodeID uint64
 
+	// The user executing the query, if known.
+	UserID string
+
 	// Quiet suppresses non-essential output from the query executor.
 	Quiet bool
 
+	// NoCache prevents SELECT statements from using the result cache.
+	NoCache bool
+
+	// ExplainFormat determines how the output of EXPLAIN ANALYZE is rendered.
+	ExplainFormat ExplainFormat
+
 	// AbortCh is a channel that signals when results are no longer desired by the caller.
 	AbortCh <-chan struct{}
 }
 
+// ExplainFormat specifies the output format of an EXPLAIN ANALYZE statement.
+type ExplainFormat int
+
+const (
+	// ExplainFormatText renders the execution trace as a text tree, one line per row.
+	ExplainFormatText ExplainFormat = iota
+
+	// ExplainFormatJSON returns the execution trace as a single structured
+	// value which is encoded by the response writer.
+	ExplainFormatJSON
+)
+
+// ParseExplainFormat returns the ExplainFormat for the given name.
+// An empty name selects ExplainFormatText.
+func ParseExplainFormat(s string) (ExplainFormat, error) {
+	switch s {
+	case "", "text":
+		return ExplainFormatText, nil
+	case "json":
+		return ExplainFormatJSON, nil
+	default:
+		return ExplainFormatText, fmt.Errorf("unknown explain format: %q", s)
+	}
+}
+
 type contextKey int
 
 const (
 	iteratorsContextKey contextKey = iota
 	monitorContextKey
+	statsRecorderContextKey
+	memoryBudgetContextKey
 )
 
 // NewContextWithIterators returns a new context.Context with the *Iterators slice added.
@@ -408,12 +456,19 @@ func (e *Executor) recover(query *influxql.Query, results chan *Result) {
 type Task struct {
 	query     string
 	database  string
+	user      string
 	status    TaskStatus
 	startTime time.Time
 	closing   chan struct{}
 	monitorCh chan error
 	err       error
 	mu        sync.Mutex
+
+	// Cost and statistics of the iterators created by the query.
+	// These are only collected when recordStats is set.
+	recordStats bool
+	cost        IteratorCost
+	stats       IteratorStats
 }
 
 // Monitor starts a new goroutine that will monitor a query. The function
@@ -438,6 +493,18 @@ func (q *Task) setError(err error) {
 	q.mu.Unlock()
 }
 
+func (q *Task) addCost(cost IteratorCost) {
+	q.mu.Lock()
+	q.cost = q.cost.Combine(cost)
+	q.mu.Unlock()
+}
+
+func (q *Task) addStats(stats IteratorStats) {
+	q.mu.Lock()
+	q.stats.Add(stats)
+	q.mu.Unlock()
+}
+
 func (q *Task) monitor(fn MonitorFunc) {
 	if err := fn(q.closing); err != nil {
 		select {
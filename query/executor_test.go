@@ -366,6 +366,41 @@ func TestQueryExecutor_Limit_Timeout(t *testing.T) {
 	}
 }
 
+func TestQueryExecutor_SlowQueryLog(t *testing.T) {
+	q, err := influxql.ParseQuery(`SELECT count(value) FROM cpu`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	e := NewQueryExecutor()
+	e.StatementExecutor = &StatementExecutor{
+		ExecuteStatementFn: func(stmt influxql.Statement, ctx *query.ExecutionContext) error {
+			time.Sleep(10 * time.Millisecond)
+			return nil
+		},
+	}
+	e.TaskManager.SlowQueryThreshold = time.Millisecond
+	e.TaskManager.SlowQueryLog = query.NewSlowQueryLog(10)
+
+	discardOutput(e.ExecuteQuery(q, query.ExecutionOptions{Database: "mydb", UserID: "admin"}, nil))
+
+	queries := e.TaskManager.SlowQueries()
+	if len(queries) != 1 {
+		t.Fatalf("unexpected number of slow queries: %d", len(queries))
+	}
+
+	sq := queries[0]
+	if sq.Query != `SELECT count(value) FROM cpu` {
+		t.Errorf("unexpected query: %s", sq.Query)
+	} else if sq.Database != "mydb" {
+		t.Errorf("unexpected database: %s", sq.Database)
+	} else if sq.User != "admin" {
+		t.Errorf("unexpected user: %s", sq.User)
+	} else if sq.Duration < e.TaskManager.SlowQueryThreshold {
+		t.Errorf("unexpected duration: %s", sq.Duration)
+	}
+}
+
 func TestQueryExecutor_Limit_ConcurrentQueries(t *testing.T) {
 	q, err := influxql.ParseQuery(`SELECT count(value) FROM cpu`)
 	if err != nil {
@@ -405,6 +440,81 @@ func TestQueryExecutor_Limit_ConcurrentQueries(t *testing.T) {
 	}
 }
 
+type QueryLimiter struct {
+	UserQueryLimitsFn     func(name string) query.QueryLimits
+	DatabaseQueryLimitsFn func(name string) query.QueryLimits
+}
+
+func (l *QueryLimiter) UserQueryLimits(name string) query.QueryLimits {
+	return l.UserQueryLimitsFn(name)
+}
+
+func (l *QueryLimiter) DatabaseQueryLimits(name string) query.QueryLimits {
+	return l.DatabaseQueryLimitsFn(name)
+}
+
+func TestQueryExecutor_Limit_UserConcurrentQueries(t *testing.T) {
+	q, err := influxql.ParseQuery(`SELECT count(value) FROM cpu`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	qid := make(chan uint64)
+
+	e := NewQueryExecutor()
+	e.StatementExecutor = &StatementExecutor{
+		ExecuteStatementFn: func(stmt influxql.Statement, ctx *query.ExecutionContext) error {
+			if ctx.Limits.MaxSelectPointN != 10 {
+				t.Errorf("unexpected point limit: %d", ctx.Limits.MaxSelectPointN)
+			}
+			qid <- ctx.QueryID
+			<-ctx.Done()
+			return ctx.Err()
+		},
+	}
+	e.TaskManager.QueryLimiter = &QueryLimiter{
+		UserQueryLimitsFn: func(name string) query.QueryLimits {
+			if name == "limited" {
+				return query.QueryLimits{MaxConcurrentQueries: 1, MaxSelectPointN: 100}
+			}
+			return query.QueryLimits{}
+		},
+		DatabaseQueryLimitsFn: func(name string) query.QueryLimits {
+			return query.QueryLimits{MaxSelectPointN: 10}
+		},
+	}
+	defer e.Close()
+
+	// Start first query and wait for it to be executing.
+	go discardOutput(e.ExecuteQuery(q, query.ExecutionOptions{Database: "db0", UserID: "limited"}, nil))
+	<-qid
+
+	// A query from another user is not limited.
+	go discardOutput(e.ExecuteQuery(q, query.ExecutionOptions{Database: "db0", UserID: "other"}, nil))
+	<-qid
+
+	// A second query from the same user is expected to fail.
+	results := e.ExecuteQuery(q, query.ExecutionOptions{Database: "db0", UserID: "limited"}, nil)
+
+	select {
+	case result := <-results:
+		if result.Err == nil || !strings.Contains(result.Err.Error(), "max-concurrent-queries limit exceeded for user limited") {
+			t.Errorf("unexpected error: %s", result.Err)
+		}
+	case <-qid:
+		t.Errorf("unexpected statement execution for the third query")
+	}
+}
+
+func TestQueryLimits_Merge(t *testing.T) {
+	a := query.QueryLimits{MaxConcurrentQueries: 2, MaxSelectPointN: 100}
+	b := query.QueryLimits{MaxConcurrentQueries: 4, MaxSelectSeriesN: 10}
+	exp := query.QueryLimits{MaxConcurrentQueries: 2, MaxSelectPointN: 100, MaxSelectSeriesN: 10}
+	if got := a.Merge(b); got != exp {
+		t.Fatalf("unexpected limits: got=%+v exp=%+v", got, exp)
+	}
+}
+
 func TestQueryExecutor_Close(t *testing.T) {
 	q, err := influxql.ParseQuery(`SELECT count(value) FROM cpu`)
 	if err != nil {
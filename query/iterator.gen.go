@@ -317,6 +317,9 @@ type floatSortedMergeIterator struct {
 	inputs []FloatIterator
 	heap   *floatSortedMergeHeap
 	init   bool
+
+	budget   *MemoryBudget
+	reserved int64
 }
 
 // newFloatSortedMergeIterator returns an instance of floatSortedMergeIterator.
@@ -327,6 +330,7 @@ func newFloatSortedMergeIterator(inputs []FloatIterator, opt IteratorOptions) It
 			items: make([]*floatSortedMergeHeapItem, 0, len(inputs)),
 			opt:   opt,
 		},
+		budget: opt.Memory,
 	}
 
 	// Initialize heap items.
@@ -352,6 +356,8 @@ func (itr *floatSortedMergeIterator) Close() error {
 	for _, input := range itr.inputs {
 		input.Close()
 	}
+	itr.budget.shrink(itr.reserved)
+	itr.reserved = 0
 	return nil
 }
 
@@ -365,7 +371,20 @@ func (itr *floatSortedMergeIterator) pop() (*FloatPoint, error) {
 	if !itr.init {
 		items := itr.heap.items
 		itr.heap.items = make([]*floatSortedMergeHeapItem, 0, len(items))
-		for _, item := range items {
+		for i, item := range items {
+			// Reserve memory for the input. If the budget is exhausted then
+			// spill the input to disk so it can be closed.
+			if itr.budget.grow(mergeInputSize) {
+				itr.reserved += mergeInputSize
+			} else {
+				itr.budget.shrink(mergeInputSize)
+				run, err := spillFloatIterator(item.itr, itr.budget)
+				if err != nil {
+					return nil, err
+				}
+				item.itr, itr.inputs[i] = run, run
+			}
+
 			var err error
 			if item.point, err = item.itr.Next(); err != nil {
 				return nil, err
@@ -491,6 +510,206 @@ type floatSortedMergeHeapItem struct {
 	itr   FloatIterator
 }
 
+// floatSpillRun is a run of points written to a temporary file.
+type floatSpillRun struct {
+	file  *spillFile
+	enc   *FloatPointEncoder
+	dec   *FloatPointDecoder
+	buf   *FloatPoint
+	eof   bool
+	stats IteratorStats
+}
+
+// newFloatSpillRun returns a new run written to a temporary file.
+func newFloatSpillRun(budget *MemoryBudget) (*floatSpillRun, error) {
+	f, err := budget.createSpillFile()
+	if err != nil {
+		return nil, err
+	}
+	return &floatSpillRun{file: f, enc: NewFloatPointEncoder(f)}, nil
+}
+
+// spillFloatIterator writes all of the points of input to a new run and
+// closes input.
+func spillFloatIterator(input FloatIterator, budget *MemoryBudget) (*floatSpillRun, error) {
+	defer input.Close()
+
+	run, err := newFloatSpillRun(budget)
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		p, err := input.Next()
+		if err != nil {
+			run.Close()
+			return nil, err
+		} else if p == nil {
+			break
+		}
+
+		if err := run.write(p); err != nil {
+			run.Close()
+			return nil, err
+		}
+	}
+	run.stats = input.Stats()
+	return run, nil
+}
+
+// write appends p to the run. A run cannot be written to once it has been read.
+func (r *floatSpillRun) write(p *FloatPoint) error {
+	return r.enc.EncodeFloatPoint(p)
+}
+
+// peek returns the next point of the run without consuming it.
+func (r *floatSpillRun) peek() (*FloatPoint, error) {
+	if r.buf != nil || r.eof {
+		return r.buf, nil
+	}
+
+	if r.dec == nil {
+		rd, err := r.file.reader()
+		if err != nil {
+			return nil, err
+		}
+		r.dec = NewFloatPointDecoder(context.Background(), rd)
+	}
+
+	p := &FloatPoint{}
+	if err := r.dec.DecodeFloatPoint(p); err == io.EOF {
+		r.eof = true
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	r.buf = p
+	return p, nil
+}
+
+// Next returns the next point of the run.
+func (r *floatSpillRun) Next() (*FloatPoint, error) {
+	p, err := r.peek()
+	r.buf = nil
+	return p, err
+}
+
+// Stats returns the stats of the iterator the run was written from.
+func (r *floatSpillRun) Stats() IteratorStats { return r.stats }
+
+// Close closes and removes the temporary file.
+func (r *floatSpillRun) Close() error { return r.file.Close() }
+
+// floatSpillBuffer buffers points in memory until the memory budget is
+// exhausted. The buffered points are then written to a new run on disk.
+// If sorted is set, the points are returned in time order. Otherwise they are
+// returned in the order they were appended.
+type floatSpillBuffer struct {
+	budget *MemoryBudget
+	sorted bool
+	points []FloatPoint
+	size   int64
+	runs   []*floatSpillRun
+}
+
+// append adds p to the buffer.
+func (b *floatSpillBuffer) append(p FloatPoint) error {
+	b.points = append(b.points, p)
+
+	n := pointSize(p.Name, p.Tags, p.Aux)
+	b.size += n
+	if !b.budget.grow(n) {
+		return b.spill()
+	}
+	return nil
+}
+
+// spill writes the points held in memory to a new run.
+func (b *floatSpillBuffer) spill() error {
+	if b.sorted {
+		b.sort()
+	}
+
+	run, err := newFloatSpillRun(b.budget)
+	if err != nil {
+		return err
+	}
+	b.runs = append(b.runs, run)
+
+	for i := range b.points {
+		if err := run.write(&b.points[i]); err != nil {
+			return err
+		}
+	}
+	b.points = b.points[:0]
+	b.budget.shrink(b.size)
+	b.size = 0
+	return nil
+}
+
+// sort performs a stable sort of the points held in memory by time.
+func (b *floatSpillBuffer) sort() {
+	sort.Stable(floatPointsByTime(b.points))
+}
+
+// Next returns the next point from the buffer.
+func (b *floatSpillBuffer) Next() (*FloatPoint, error) {
+	if !b.sorted {
+		for len(b.runs) > 0 {
+			if p, err := b.runs[0].Next(); err != nil || p != nil {
+				return p, err
+			}
+			b.runs[0].Close()
+			b.runs = b.runs[1:]
+		}
+		return b.pop(), nil
+	}
+
+	// Find the run with the earliest point. Points with equal times are
+	// returned in the order they were appended.
+	var min *floatSpillRun
+	var t int64
+	for _, r := range b.runs {
+		p, err := r.peek()
+		if err != nil {
+			return nil, err
+		} else if p != nil && (min == nil || p.Time < t) {
+			min, t = r, p.Time
+		}
+	}
+
+	if len(b.points) > 0 && (min == nil || b.points[0].Time < t) {
+		return b.pop(), nil
+	} else if min == nil {
+		return nil, nil
+	}
+	return min.Next()
+}
+
+// pop removes and returns the first point held in memory.
+func (b *floatSpillBuffer) pop() *FloatPoint {
+	if len(b.points) == 0 {
+		return nil
+	}
+	p := &b.points[0]
+	b.points = b.points[1:]
+	return p
+}
+
+// reset removes all points from the buffer and releases its memory.
+func (b *floatSpillBuffer) reset() {
+	for _, r := range b.runs {
+		r.Close()
+	}
+	b.runs = nil
+
+	// Points previously returned may still be referenced so the
+	// underlying array cannot be reused.
+	b.points = nil
+	b.budget.shrink(b.size)
+	b.size = 0
+}
+
 // floatIteratorScanner scans the results of a FloatIterator into a map.
 type floatIteratorScanner struct {
 	input        *bufFloatIterator
@@ -999,7 +1218,7 @@ type floatReduceFloatIterator struct {
 	create   func() (FloatPointAggregator, FloatPointEmitter)
 	dims     []string
 	opt      IteratorOptions
-	points   []FloatPoint
+	points   floatSpillBuffer
 	keepTags bool
 }
 
@@ -1009,6 +1228,7 @@ func newFloatReduceFloatIterator(input FloatIterator, opt IteratorOptions, creat
 		create: createFn,
 		dims:   opt.GetDimensions(),
 		opt:    opt,
+		points: floatSpillBuffer{budget: opt.Memory, sorted: opt.Ordered},
 	}
 }
 
@@ -1016,23 +1236,22 @@ func newFloatReduceFloatIterator(input FloatIterator, opt IteratorOptions, creat
 func (itr *floatReduceFloatIterator) Stats() IteratorStats { return itr.input.Stats() }
 
 // Close closes the iterator and all child iterators.
-func (itr *floatReduceFloatIterator) Close() error { return itr.input.Close() }
+func (itr *floatReduceFloatIterator) Close() error {
+	itr.points.reset()
+	return itr.input.Close()
+}
 
 // Next returns the minimum value for the next available interval.
 func (itr *floatReduceFloatIterator) Next() (*FloatPoint, error) {
-	// Calculate next window if we have no more points.
-	if len(itr.points) == 0 {
-		var err error
-		itr.points, err = itr.reduce()
-		if len(itr.points) == 0 {
-			return nil, err
-		}
+	if p, err := itr.points.Next(); err != nil || p != nil {
+		return p, err
 	}
 
-	// Pop next point off the stack.
-	p := &itr.points[len(itr.points)-1]
-	itr.points = itr.points[:len(itr.points)-1]
-	return p, nil
+	// Calculate next window if we have no more points.
+	if err := itr.reduce(); err != nil {
+		return nil, err
+	}
+	return itr.points.Next()
 }
 
 // floatReduceFloatPoint stores the reduced data for a name/tag combination.
@@ -1045,7 +1264,9 @@ type floatReduceFloatPoint struct {
 
 // reduce executes fn once for every point in the next window.
 // The previous value for the dimension is passed to fn.
-func (itr *floatReduceFloatIterator) reduce() ([]FloatPoint, error) {
+func (itr *floatReduceFloatIterator) reduce() error {
+	itr.points.reset()
+
 	// Calculate next window.
 	var (
 		startTime, endTime int64
@@ -1057,7 +1278,7 @@ func (itr *floatReduceFloatIterator) reduce() ([]FloatPoint, error) {
 	for {
 		p, err := itr.input.Next()
 		if err != nil || p == nil {
-			return nil, err
+			return err
 		} else if p.Nil {
 			continue
 		}
@@ -1075,7 +1296,7 @@ func (itr *floatReduceFloatIterator) reduce() ([]FloatPoint, error) {
 		// Read next point.
 		curr, err := itr.input.NextInWindow(startTime, endTime)
 		if err != nil {
-			return nil, err
+			return err
 		} else if curr == nil {
 			break
 		} else if curr.Nil {
@@ -1114,23 +1335,22 @@ func (itr *floatReduceFloatIterator) reduce() ([]FloatPoint, error) {
 		rp.Aggregator.AggregateFloat(curr)
 	}
 
-	// Reverse sort points by name & tag if our output is supposed to be ordered.
+	// Sort points by name & tag if our output is supposed to be ordered.
 	keys := make([]string, 0, len(m))
 	for k := range m {
 		keys = append(keys, k)
 	}
 	if len(keys) > 1 && itr.opt.Ordered {
-		sort.Sort(reverseStringSlice(keys))
+		sort.Strings(keys)
 	}
 
 	// Assume the points are already sorted until proven otherwise.
 	sortedByTime := true
 	// Emit the points for each name & tag combination.
-	a := make([]FloatPoint, 0, len(m))
 	for _, k := range keys {
 		rp := m[k]
 		points := rp.Emitter.Emit()
-		for i := len(points) - 1; i >= 0; i-- {
+		for i := range points {
 			points[i].Name = rp.Name
 			if !itr.keepTags {
 				points[i].Tags = rp.Tags
@@ -1141,16 +1361,18 @@ func (itr *floatReduceFloatIterator) reduce() ([]FloatPoint, error) {
 			} else {
 				sortedByTime = false
 			}
-			a = append(a, points[i])
+			if err := itr.points.append(points[i]); err != nil {
+				return err
+			}
 		}
 	}
 
 	// Points may be out of order. Perform a stable sort by time if requested.
+	// Points spilled to disk have already been sorted.
 	if !sortedByTime && itr.opt.Ordered {
-		sort.Stable(sort.Reverse(floatPointsByTime(a)))
+		itr.points.sort()
 	}
-
-	return a, nil
+	return nil
 }
 
 // floatStreamFloatIterator streams inputs into the iterator and emits points gradually.
@@ -1274,7 +1496,7 @@ type floatReduceIntegerIterator struct {
 	create   func() (FloatPointAggregator, IntegerPointEmitter)
 	dims     []string
 	opt      IteratorOptions
-	points   []IntegerPoint
+	points   integerSpillBuffer
 	keepTags bool
 }
 
@@ -1284,6 +1506,7 @@ func newFloatReduceIntegerIterator(input FloatIterator, opt IteratorOptions, cre
 		create: createFn,
 		dims:   opt.GetDimensions(),
 		opt:    opt,
+		points: integerSpillBuffer{budget: opt.Memory, sorted: opt.Ordered},
 	}
 }
 
@@ -1291,23 +1514,22 @@ func newFloatReduceIntegerIterator(input FloatIterator, opt IteratorOptions, cre
 func (itr *floatReduceIntegerIterator) Stats() IteratorStats { return itr.input.Stats() }
 
 // Close closes the iterator and all child iterators.
-func (itr *floatReduceIntegerIterator) Close() error { return itr.input.Close() }
+func (itr *floatReduceIntegerIterator) Close() error {
+	itr.points.reset()
+	return itr.input.Close()
+}
 
 // Next returns the minimum value for the next available interval.
 func (itr *floatReduceIntegerIterator) Next() (*IntegerPoint, error) {
-	// Calculate next window if we have no more points.
-	if len(itr.points) == 0 {
-		var err error
-		itr.points, err = itr.reduce()
-		if len(itr.points) == 0 {
-			return nil, err
-		}
+	if p, err := itr.points.Next(); err != nil || p != nil {
+		return p, err
 	}
 
-	// Pop next point off the stack.
-	p := &itr.points[len(itr.points)-1]
-	itr.points = itr.points[:len(itr.points)-1]
-	return p, nil
+	// Calculate next window if we have no more points.
+	if err := itr.reduce(); err != nil {
+		return nil, err
+	}
+	return itr.points.Next()
 }
 
 // floatReduceIntegerPoint stores the reduced data for a name/tag combination.
@@ -1320,7 +1542,9 @@ type floatReduceIntegerPoint struct {
 
 // reduce executes fn once for every point in the next window.
 // The previous value for the dimension is passed to fn.
-func (itr *floatReduceIntegerIterator) reduce() ([]IntegerPoint, error) {
+func (itr *floatReduceIntegerIterator) reduce() error {
+	itr.points.reset()
+
 	// Calculate next window.
 	var (
 		startTime, endTime int64
@@ -1332,7 +1556,7 @@ func (itr *floatReduceIntegerIterator) reduce() ([]IntegerPoint, error) {
 	for {
 		p, err := itr.input.Next()
 		if err != nil || p == nil {
-			return nil, err
+			return err
 		} else if p.Nil {
 			continue
 		}
@@ -1350,7 +1574,7 @@ func (itr *floatReduceIntegerIterator) reduce() ([]IntegerPoint, error) {
 		// Read next point.
 		curr, err := itr.input.NextInWindow(startTime, endTime)
 		if err != nil {
-			return nil, err
+			return err
 		} else if curr == nil {
 			break
 		} else if curr.Nil {
@@ -1389,23 +1613,22 @@ func (itr *floatReduceIntegerIterator) reduce() ([]IntegerPoint, error) {
 		rp.Aggregator.AggregateFloat(curr)
 	}
 
-	// Reverse sort points by name & tag if our output is supposed to be ordered.
+	// Sort points by name & tag if our output is supposed to be ordered.
 	keys := make([]string, 0, len(m))
 	for k := range m {
 		keys = append(keys, k)
 	}
 	if len(keys) > 1 && itr.opt.Ordered {
-		sort.Sort(reverseStringSlice(keys))
+		sort.Strings(keys)
 	}
 
 	// Assume the points are already sorted until proven otherwise.
 	sortedByTime := true
 	// Emit the points for each name & tag combination.
-	a := make([]IntegerPoint, 0, len(m))
 	for _, k := range keys {
 		rp := m[k]
 		points := rp.Emitter.Emit()
-		for i := len(points) - 1; i >= 0; i-- {
+		for i := range points {
 			points[i].Name = rp.Name
 			if !itr.keepTags {
 				points[i].Tags = rp.Tags
@@ -1416,16 +1639,18 @@ func (itr *floatReduceIntegerIterator) reduce() ([]IntegerPoint, error) {
 			} else {
 				sortedByTime = false
 			}
-			a = append(a, points[i])
+			if err := itr.points.append(points[i]); err != nil {
+				return err
+			}
 		}
 	}
 
 	// Points may be out of order. Perform a stable sort by time if requested.
+	// Points spilled to disk have already been sorted.
 	if !sortedByTime && itr.opt.Ordered {
-		sort.Stable(sort.Reverse(integerPointsByTime(a)))
+		itr.points.sort()
 	}
-
-	return a, nil
+	return nil
 }
 
 // floatStreamIntegerIterator streams inputs into the iterator and emits points gradually.
@@ -1549,7 +1774,7 @@ type floatReduceUnsignedIterator struct {
 	create   func() (FloatPointAggregator, UnsignedPointEmitter)
 	dims     []string
 	opt      IteratorOptions
-	points   []UnsignedPoint
+	points   unsignedSpillBuffer
 	keepTags bool
 }
 
@@ -1559,6 +1784,7 @@ func newFloatReduceUnsignedIterator(input FloatIterator, opt IteratorOptions, cr
 		create: createFn,
 		dims:   opt.GetDimensions(),
 		opt:    opt,
+		points: unsignedSpillBuffer{budget: opt.Memory, sorted: opt.Ordered},
 	}
 }
 
@@ -1566,23 +1792,22 @@ func newFloatReduceUnsignedIterator(input FloatIterator, opt IteratorOptions, cr
 func (itr *floatReduceUnsignedIterator) Stats() IteratorStats { return itr.input.Stats() }
 
 // Close closes the iterator and all child iterators.
-func (itr *floatReduceUnsignedIterator) Close() error { return itr.input.Close() }
+func (itr *floatReduceUnsignedIterator) Close() error {
+	itr.points.reset()
+	return itr.input.Close()
+}
 
 // Next returns the minimum value for the next available interval.
 func (itr *floatReduceUnsignedIterator) Next() (*UnsignedPoint, error) {
-	// Calculate next window if we have no more points.
-	if len(itr.points) == 0 {
-		var err error
-		itr.points, err = itr.reduce()
-		if len(itr.points) == 0 {
-			return nil, err
-		}
+	if p, err := itr.points.Next(); err != nil || p != nil {
+		return p, err
 	}
 
-	// Pop next point off the stack.
-	p := &itr.points[len(itr.points)-1]
-	itr.points = itr.points[:len(itr.points)-1]
-	return p, nil
+	// Calculate next window if we have no more points.
+	if err := itr.reduce(); err != nil {
+		return nil, err
+	}
+	return itr.points.Next()
 }
 
 // floatReduceUnsignedPoint stores the reduced data for a name/tag combination.
@@ -1595,7 +1820,9 @@ type floatReduceUnsignedPoint struct {
 
 // reduce executes fn once for every point in the next window.
 // The previous value for the dimension is passed to fn.
-func (itr *floatReduceUnsignedIterator) reduce() ([]UnsignedPoint, error) {
+func (itr *floatReduceUnsignedIterator) reduce() error {
+	itr.points.reset()
+
 	// Calculate next window.
 	var (
 		startTime, endTime int64
@@ -1607,7 +1834,7 @@ func (itr *floatReduceUnsignedIterator) reduce() ([]UnsignedPoint, error) {
 	for {
 		p, err := itr.input.Next()
 		if err != nil || p == nil {
-			return nil, err
+			return err
 		} else if p.Nil {
 			continue
 		}
@@ -1625,7 +1852,7 @@ func (itr *floatReduceUnsignedIterator) reduce() ([]UnsignedPoint, error) {
 		// Read next point.
 		curr, err := itr.input.NextInWindow(startTime, endTime)
 		if err != nil {
-			return nil, err
+			return err
 		} else if curr == nil {
 			break
 		} else if curr.Nil {
@@ -1664,23 +1891,22 @@ func (itr *floatReduceUnsignedIterator) reduce() ([]UnsignedPoint, error) {
 		rp.Aggregator.AggregateFloat(curr)
 	}
 
-	// Reverse sort points by name & tag if our output is supposed to be ordered.
+	// Sort points by name & tag if our output is supposed to be ordered.
 	keys := make([]string, 0, len(m))
 	for k := range m {
 		keys = append(keys, k)
 	}
 	if len(keys) > 1 && itr.opt.Ordered {
-		sort.Sort(reverseStringSlice(keys))
+		sort.Strings(keys)
 	}
 
 	// Assume the points are already sorted until proven otherwise.
 	sortedByTime := true
 	// Emit the points for each name & tag combination.
-	a := make([]UnsignedPoint, 0, len(m))
 	for _, k := range keys {
 		rp := m[k]
 		points := rp.Emitter.Emit()
-		for i := len(points) - 1; i >= 0; i-- {
+		for i := range points {
 			points[i].Name = rp.Name
 			if !itr.keepTags {
 				points[i].Tags = rp.Tags
@@ -1691,16 +1917,18 @@ func (itr *floatReduceUnsignedIterator) reduce() ([]UnsignedPoint, error) {
 			} else {
 				sortedByTime = false
 			}
-			a = append(a, points[i])
+			if err := itr.points.append(points[i]); err != nil {
+				return err
+			}
 		}
 	}
 
 	// Points may be out of order. Perform a stable sort by time if requested.
+	// Points spilled to disk have already been sorted.
 	if !sortedByTime && itr.opt.Ordered {
-		sort.Stable(sort.Reverse(unsignedPointsByTime(a)))
+		itr.points.sort()
 	}
-
-	return a, nil
+	return nil
 }
 
 // floatStreamUnsignedIterator streams inputs into the iterator and emits points gradually.
@@ -1824,7 +2052,7 @@ type floatReduceStringIterator struct {
 	create   func() (FloatPointAggregator, StringPointEmitter)
 	dims     []string
 	opt      IteratorOptions
-	points   []StringPoint
+	points   stringSpillBuffer
 	keepTags bool
 }
 
@@ -1834,6 +2062,7 @@ func newFloatReduceStringIterator(input FloatIterator, opt IteratorOptions, crea
 		create: createFn,
 		dims:   opt.GetDimensions(),
 		opt:    opt,
+		points: stringSpillBuffer{budget: opt.Memory, sorted: opt.Ordered},
 	}
 }
 
@@ -1841,23 +2070,22 @@ func newFloatReduceStringIterator(input FloatIterator, opt IteratorOptions, crea
 func (itr *floatReduceStringIterator) Stats() IteratorStats { return itr.input.Stats() }
 
 // Close closes the iterator and all child iterators.
-func (itr *floatReduceStringIterator) Close() error { return itr.input.Close() }
+func (itr *floatReduceStringIterator) Close() error {
+	itr.points.reset()
+	return itr.input.Close()
+}
 
 // Next returns the minimum value for the next available interval.
 func (itr *floatReduceStringIterator) Next() (*StringPoint, error) {
-	// Calculate next window if we have no more points.
-	if len(itr.points) == 0 {
-		var err error
-		itr.points, err = itr.reduce()
-		if len(itr.points) == 0 {
-			return nil, err
-		}
+	if p, err := itr.points.Next(); err != nil || p != nil {
+		return p, err
 	}
 
-	// Pop next point off the stack.
-	p := &itr.points[len(itr.points)-1]
-	itr.points = itr.points[:len(itr.points)-1]
-	return p, nil
+	// Calculate next window if we have no more points.
+	if err := itr.reduce(); err != nil {
+		return nil, err
+	}
+	return itr.points.Next()
 }
 
 // floatReduceStringPoint stores the reduced data for a name/tag combination.
@@ -1870,7 +2098,9 @@ type floatReduceStringPoint struct {
 
 // reduce executes fn once for every point in the next window.
 // The previous value for the dimension is passed to fn.
-func (itr *floatReduceStringIterator) reduce() ([]StringPoint, error) {
+func (itr *floatReduceStringIterator) reduce() error {
+	itr.points.reset()
+
 	// Calculate next window.
 	var (
 		startTime, endTime int64
@@ -1882,7 +2112,7 @@ func (itr *floatReduceStringIterator) reduce() ([]StringPoint, error) {
 	for {
 		p, err := itr.input.Next()
 		if err != nil || p == nil {
-			return nil, err
+			return err
 		} else if p.Nil {
 			continue
 		}
@@ -1900,7 +2130,7 @@ func (itr *floatReduceStringIterator) reduce() ([]StringPoint, error) {
 		// Read next point.
 		curr, err := itr.input.NextInWindow(startTime, endTime)
 		if err != nil {
-			return nil, err
+			return err
 		} else if curr == nil {
 			break
 		} else if curr.Nil {
@@ -1939,23 +2169,22 @@ func (itr *floatReduceStringIterator) reduce() ([]StringPoint, error) {
 		rp.Aggregator.AggregateFloat(curr)
 	}
 
-	// Reverse sort points by name & tag if our output is supposed to be ordered.
+	// Sort points by name & tag if our output is supposed to be ordered.
 	keys := make([]string, 0, len(m))
 	for k := range m {
 		keys = append(keys, k)
 	}
 	if len(keys) > 1 && itr.opt.Ordered {
-		sort.Sort(reverseStringSlice(keys))
+		sort.Strings(keys)
 	}
 
 	// Assume the points are already sorted until proven otherwise.
 	sortedByTime := true
 	// Emit the points for each name & tag combination.
-	a := make([]StringPoint, 0, len(m))
 	for _, k := range keys {
 		rp := m[k]
 		points := rp.Emitter.Emit()
-		for i := len(points) - 1; i >= 0; i-- {
+		for i := range points {
 			points[i].Name = rp.Name
 			if !itr.keepTags {
 				points[i].Tags = rp.Tags
@@ -1966,16 +2195,18 @@ func (itr *floatReduceStringIterator) reduce() ([]StringPoint, error) {
 			} else {
 				sortedByTime = false
 			}
-			a = append(a, points[i])
+			if err := itr.points.append(points[i]); err != nil {
+				return err
+			}
 		}
 	}
 
 	// Points may be out of order. Perform a stable sort by time if requested.
+	// Points spilled to disk have already been sorted.
 	if !sortedByTime && itr.opt.Ordered {
-		sort.Stable(sort.Reverse(stringPointsByTime(a)))
+		itr.points.sort()
 	}
-
-	return a, nil
+	return nil
 }
 
 // floatStreamStringIterator streams inputs into the iterator and emits points gradually.
@@ -2099,7 +2330,7 @@ type floatReduceBooleanIterator struct {
 	create   func() (FloatPointAggregator, BooleanPointEmitter)
 	dims     []string
 	opt      IteratorOptions
-	points   []BooleanPoint
+	points   booleanSpillBuffer
 	keepTags bool
 }
 
@@ -2109,6 +2340,7 @@ func newFloatReduceBooleanIterator(input FloatIterator, opt IteratorOptions, cre
 		create: createFn,
 		dims:   opt.GetDimensions(),
 		opt:    opt,
+		points: booleanSpillBuffer{budget: opt.Memory, sorted: opt.Ordered},
 	}
 }
 
@@ -2116,23 +2348,22 @@ func newFloatReduceBooleanIterator(input FloatIterator, opt IteratorOptions, cre
 func (itr *floatReduceBooleanIterator) Stats() IteratorStats { return itr.input.Stats() }
 
 // Close closes the iterator and all child iterators.
-func (itr *floatReduceBooleanIterator) Close() error { return itr.input.Close() }
+func (itr *floatReduceBooleanIterator) Close() error {
+	itr.points.reset()
+	return itr.input.Close()
+}
 
 // Next returns the minimum value for the next available interval.
 func (itr *floatReduceBooleanIterator) Next() (*BooleanPoint, error) {
-	// Calculate next window if we have no more points.
-	if len(itr.points) == 0 {
-		var err error
-		itr.points, err = itr.reduce()
-		if len(itr.points) == 0 {
-			return nil, err
-		}
+	if p, err := itr.points.Next(); err != nil || p != nil {
+		return p, err
 	}
 
-	// Pop next point off the stack.
-	p := &itr.points[len(itr.points)-1]
-	itr.points = itr.points[:len(itr.points)-1]
-	return p, nil
+	// Calculate next window if we have no more points.
+	if err := itr.reduce(); err != nil {
+		return nil, err
+	}
+	return itr.points.Next()
 }
 
 // floatReduceBooleanPoint stores the reduced data for a name/tag combination.
@@ -2145,7 +2376,9 @@ type floatReduceBooleanPoint struct {
 
 // reduce executes fn once for every point in the next window.
 // The previous value for the dimension is passed to fn.
-func (itr *floatReduceBooleanIterator) reduce() ([]BooleanPoint, error) {
+func (itr *floatReduceBooleanIterator) reduce() error {
+	itr.points.reset()
+
 	// Calculate next window.
 	var (
 		startTime, endTime int64
@@ -2157,7 +2390,7 @@ func (itr *floatReduceBooleanIterator) reduce() ([]BooleanPoint, error) {
 	for {
 		p, err := itr.input.Next()
 		if err != nil || p == nil {
-			return nil, err
+			return err
 		} else if p.Nil {
 			continue
 		}
@@ -2175,7 +2408,7 @@ func (itr *floatReduceBooleanIterator) reduce() ([]BooleanPoint, error) {
 		// Read next point.
 		curr, err := itr.input.NextInWindow(startTime, endTime)
 		if err != nil {
-			return nil, err
+			return err
 		} else if curr == nil {
 			break
 		} else if curr.Nil {
@@ -2214,23 +2447,22 @@ func (itr *floatReduceBooleanIterator) reduce() ([]BooleanPoint, error) {
 		rp.Aggregator.AggregateFloat(curr)
 	}
 
-	// Reverse sort points by name & tag if our output is supposed to be ordered.
+	// Sort points by name & tag if our output is supposed to be ordered.
 	keys := make([]string, 0, len(m))
 	for k := range m {
 		keys = append(keys, k)
 	}
 	if len(keys) > 1 && itr.opt.Ordered {
-		sort.Sort(reverseStringSlice(keys))
+		sort.Strings(keys)
 	}
 
 	// Assume the points are already sorted until proven otherwise.
 	sortedByTime := true
 	// Emit the points for each name & tag combination.
-	a := make([]BooleanPoint, 0, len(m))
 	for _, k := range keys {
 		rp := m[k]
 		points := rp.Emitter.Emit()
-		for i := len(points) - 1; i >= 0; i-- {
+		for i := range points {
 			points[i].Name = rp.Name
 			if !itr.keepTags {
 				points[i].Tags = rp.Tags
@@ -2241,16 +2473,18 @@ func (itr *floatReduceBooleanIterator) reduce() ([]BooleanPoint, error) {
 			} else {
 				sortedByTime = false
 			}
-			a = append(a, points[i])
+			if err := itr.points.append(points[i]); err != nil {
+				return err
+			}
 		}
 	}
 
 	// Points may be out of order. Perform a stable sort by time if requested.
+	// Points spilled to disk have already been sorted.
 	if !sortedByTime && itr.opt.Ordered {
-		sort.Stable(sort.Reverse(booleanPointsByTime(a)))
+		itr.points.sort()
 	}
-
-	return a, nil
+	return nil
 }
 
 // floatStreamBooleanIterator streams inputs into the iterator and emits points gradually.
@@ -2873,6 +3107,9 @@ type integerSortedMergeIterator struct {
 	inputs []IntegerIterator
 	heap   *integerSortedMergeHeap
 	init   bool
+
+	budget   *MemoryBudget
+	reserved int64
 }
 
 // newIntegerSortedMergeIterator returns an instance of integerSortedMergeIterator.
@@ -2883,6 +3120,7 @@ func newIntegerSortedMergeIterator(inputs []IntegerIterator, opt IteratorOptions
 			items: make([]*integerSortedMergeHeapItem, 0, len(inputs)),
 			opt:   opt,
 		},
+		budget: opt.Memory,
 	}
 
 	// Initialize heap items.
@@ -2908,6 +3146,8 @@ func (itr *integerSortedMergeIterator) Close() error {
 	for _, input := range itr.inputs {
 		input.Close()
 	}
+	itr.budget.shrink(itr.reserved)
+	itr.reserved = 0
 	return nil
 }
 
@@ -2921,7 +3161,20 @@ func (itr *integerSortedMergeIterator) pop() (*IntegerPoint, error) {
 	if !itr.init {
 		items := itr.heap.items
 		itr.heap.items = make([]*integerSortedMergeHeapItem, 0, len(items))
-		for _, item := range items {
+		for i, item := range items {
+			// Reserve memory for the input. If the budget is exhausted then
+			// spill the input to disk so it can be closed.
+			if itr.budget.grow(mergeInputSize) {
+				itr.reserved += mergeInputSize
+			} else {
+				itr.budget.shrink(mergeInputSize)
+				run, err := spillIntegerIterator(item.itr, itr.budget)
+				if err != nil {
+					return nil, err
+				}
+				item.itr, itr.inputs[i] = run, run
+			}
+
 			var err error
 			if item.point, err = item.itr.Next(); err != nil {
 				return nil, err
@@ -3047,6 +3300,206 @@ type integerSortedMergeHeapItem struct {
 	itr   IntegerIterator
 }
 
+// integerSpillRun is a run of points written to a temporary file.
+type integerSpillRun struct {
+	file  *spillFile
+	enc   *IntegerPointEncoder
+	dec   *IntegerPointDecoder
+	buf   *IntegerPoint
+	eof   bool
+	stats IteratorStats
+}
+
+// newIntegerSpillRun returns a new run written to a temporary file.
+func newIntegerSpillRun(budget *MemoryBudget) (*integerSpillRun, error) {
+	f, err := budget.createSpillFile()
+	if err != nil {
+		return nil, err
+	}
+	return &integerSpillRun{file: f, enc: NewIntegerPointEncoder(f)}, nil
+}
+
+// spillIntegerIterator writes all of the points of input to a new run and
+// closes input.
+func spillIntegerIterator(input IntegerIterator, budget *MemoryBudget) (*integerSpillRun, error) {
+	defer input.Close()
+
+	run, err := newIntegerSpillRun(budget)
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		p, err := input.Next()
+		if err != nil {
+			run.Close()
+			return nil, err
+		} else if p == nil {
+			break
+		}
+
+		if err := run.write(p); err != nil {
+			run.Close()
+			return nil, err
+		}
+	}
+	run.stats = input.Stats()
+	return run, nil
+}
+
+// write appends p to the run. A run cannot be written to once it has been read.
+func (r *integerSpillRun) write(p *IntegerPoint) error {
+	return r.enc.EncodeIntegerPoint(p)
+}
+
+// peek returns the next point of the run without consuming it.
+func (r *integerSpillRun) peek() (*IntegerPoint, error) {
+	if r.buf != nil || r.eof {
+		return r.buf, nil
+	}
+
+	if r.dec == nil {
+		rd, err := r.file.reader()
+		if err != nil {
+			return nil, err
+		}
+		r.dec = NewIntegerPointDecoder(context.Background(), rd)
+	}
+
+	p := &IntegerPoint{}
+	if err := r.dec.DecodeIntegerPoint(p); err == io.EOF {
+		r.eof = true
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	r.buf = p
+	return p, nil
+}
+
+// Next returns the next point of the run.
+func (r *integerSpillRun) Next() (*IntegerPoint, error) {
+	p, err := r.peek()
+	r.buf = nil
+	return p, err
+}
+
+// Stats returns the stats of the iterator the run was written from.
+func (r *integerSpillRun) Stats() IteratorStats { return r.stats }
+
+// Close closes and removes the temporary file.
+func (r *integerSpillRun) Close() error { return r.file.Close() }
+
+// integerSpillBuffer buffers points in memory until the memory budget is
+// exhausted. The buffered points are then written to a new run on disk.
+// If sorted is set, the points are returned in time order. Otherwise they are
+// returned in the order they were appended.
+type integerSpillBuffer struct {
+	budget *MemoryBudget
+	sorted bool
+	points []IntegerPoint
+	size   int64
+	runs   []*integerSpillRun
+}
+
+// append adds p to the buffer.
+func (b *integerSpillBuffer) append(p IntegerPoint) error {
+	b.points = append(b.points, p)
+
+	n := pointSize(p.Name, p.Tags, p.Aux)
+	b.size += n
+	if !b.budget.grow(n) {
+		return b.spill()
+	}
+	return nil
+}
+
+// spill writes the points held in memory to a new run.
+func (b *integerSpillBuffer) spill() error {
+	if b.sorted {
+		b.sort()
+	}
+
+	run, err := newIntegerSpillRun(b.budget)
+	if err != nil {
+		return err
+	}
+	b.runs = append(b.runs, run)
+
+	for i := range b.points {
+		if err := run.write(&b.points[i]); err != nil {
+			return err
+		}
+	}
+	b.points = b.points[:0]
+	b.budget.shrink(b.size)
+	b.size = 0
+	return nil
+}
+
+// sort performs a stable sort of the points held in memory by time.
+func (b *integerSpillBuffer) sort() {
+	sort.Stable(integerPointsByTime(b.points))
+}
+
+// Next returns the next point from the buffer.
+func (b *integerSpillBuffer) Next() (*IntegerPoint, error) {
+	if !b.sorted {
+		for len(b.runs) > 0 {
+			if p, err := b.runs[0].Next(); err != nil || p != nil {
+				return p, err
+			}
+			b.runs[0].Close()
+			b.runs = b.runs[1:]
+		}
+		return b.pop(), nil
+	}
+
+	// Find the run with the earliest point. Points with equal times are
+	// returned in the order they were appended.
+	var min *integerSpillRun
+	var t int64
+	for _, r := range b.runs {
+		p, err := r.peek()
+		if err != nil {
+			return nil, err
+		} else if p != nil && (min == nil || p.Time < t) {
+			min, t = r, p.Time
+		}
+	}
+
+	if len(b.points) > 0 && (min == nil || b.points[0].Time < t) {
+		return b.pop(), nil
+	} else if min == nil {
+		return nil, nil
+	}
+	return min.Next()
+}
+
+// pop removes and returns the first point held in memory.
+func (b *integerSpillBuffer) pop() *IntegerPoint {
+	if len(b.points) == 0 {
+		return nil
+	}
+	p := &b.points[0]
+	b.points = b.points[1:]
+	return p
+}
+
+// reset removes all points from the buffer and releases its memory.
+func (b *integerSpillBuffer) reset() {
+	for _, r := range b.runs {
+		r.Close()
+	}
+	b.runs = nil
+
+	// Points previously returned may still be referenced so the
+	// underlying array cannot be reused.
+	b.points = nil
+	b.budget.shrink(b.size)
+	b.size = 0
+}
+
 // integerIteratorScanner scans the results of a IntegerIterator into a map.
 type integerIteratorScanner struct {
 	input        *bufIntegerIterator
@@ -3555,7 +4008,7 @@ type integerReduceFloatIterator struct {
 	create   func() (IntegerPointAggregator, FloatPointEmitter)
 	dims     []string
 	opt      IteratorOptions
-	points   []FloatPoint
+	points   floatSpillBuffer
 	keepTags bool
 }
 
@@ -3565,6 +4018,7 @@ func newIntegerReduceFloatIterator(input IntegerIterator, opt IteratorOptions, c
 		create: createFn,
 		dims:   opt.GetDimensions(),
 		opt:    opt,
+		points: floatSpillBuffer{budget: opt.Memory, sorted: opt.Ordered},
 	}
 }
 
@@ -3572,23 +4026,22 @@ func newIntegerReduceFloatIterator(input IntegerIterator, opt IteratorOptions, c
 func (itr *integerReduceFloatIterator) Stats() IteratorStats { return itr.input.Stats() }
 
 // Close closes the iterator and all child iterators.
-func (itr *integerReduceFloatIterator) Close() error { return itr.input.Close() }
+func (itr *integerReduceFloatIterator) Close() error {
+	itr.points.reset()
+	return itr.input.Close()
+}
 
 // Next returns the minimum value for the next available interval.
 func (itr *integerReduceFloatIterator) Next() (*FloatPoint, error) {
-	// Calculate next window if we have no more points.
-	if len(itr.points) == 0 {
-		var err error
-		itr.points, err = itr.reduce()
-		if len(itr.points) == 0 {
-			return nil, err
-		}
+	if p, err := itr.points.Next(); err != nil || p != nil {
+		return p, err
 	}
 
-	// Pop next point off the stack.
-	p := &itr.points[len(itr.points)-1]
-	itr.points = itr.points[:len(itr.points)-1]
-	return p, nil
+	// Calculate next window if we have no more points.
+	if err := itr.reduce(); err != nil {
+		return nil, err
+	}
+	return itr.points.Next()
 }
 
 // integerReduceFloatPoint stores the reduced data for a name/tag combination.
@@ -3601,7 +4054,9 @@ type integerReduceFloatPoint struct {
 
 // reduce executes fn once for every point in the next window.
 // The previous value for the dimension is passed to fn.
-func (itr *integerReduceFloatIterator) reduce() ([]FloatPoint, error) {
+func (itr *integerReduceFloatIterator) reduce() error {
+	itr.points.reset()
+
 	// Calculate next window.
 	var (
 		startTime, endTime int64
@@ -3613,7 +4068,7 @@ func (itr *integerReduceFloatIterator) reduce() ([]FloatPoint, error) {
 	for {
 		p, err := itr.input.Next()
 		if err != nil || p == nil {
-			return nil, err
+			return err
 		} else if p.Nil {
 			continue
 		}
@@ -3631,7 +4086,7 @@ func (itr *integerReduceFloatIterator) reduce() ([]FloatPoint, error) {
 		// Read next point.
 		curr, err := itr.input.NextInWindow(startTime, endTime)
 		if err != nil {
-			return nil, err
+			return err
 		} else if curr == nil {
 			break
 		} else if curr.Nil {
@@ -3670,23 +4125,22 @@ func (itr *integerReduceFloatIterator) reduce() ([]FloatPoint, error) {
 		rp.Aggregator.AggregateInteger(curr)
 	}
 
-	// Reverse sort points by name & tag if our output is supposed to be ordered.
+	// Sort points by name & tag if our output is supposed to be ordered.
 	keys := make([]string, 0, len(m))
 	for k := range m {
 		keys = append(keys, k)
 	}
 	if len(keys) > 1 && itr.opt.Ordered {
-		sort.Sort(reverseStringSlice(keys))
+		sort.Strings(keys)
 	}
 
 	// Assume the points are already sorted until proven otherwise.
 	sortedByTime := true
 	// Emit the points for each name & tag combination.
-	a := make([]FloatPoint, 0, len(m))
 	for _, k := range keys {
 		rp := m[k]
 		points := rp.Emitter.Emit()
-		for i := len(points) - 1; i >= 0; i-- {
+		for i := range points {
 			points[i].Name = rp.Name
 			if !itr.keepTags {
 				points[i].Tags = rp.Tags
@@ -3697,16 +4151,18 @@ func (itr *integerReduceFloatIterator) reduce() ([]FloatPoint, error) {
 			} else {
 				sortedByTime = false
 			}
-			a = append(a, points[i])
+			if err := itr.points.append(points[i]); err != nil {
+				return err
+			}
 		}
 	}
 
 	// Points may be out of order. Perform a stable sort by time if requested.
+	// Points spilled to disk have already been sorted.
 	if !sortedByTime && itr.opt.Ordered {
-		sort.Stable(sort.Reverse(floatPointsByTime(a)))
+		itr.points.sort()
 	}
-
-	return a, nil
+	return nil
 }
 
 // integerStreamFloatIterator streams inputs into the iterator and emits points gradually.
@@ -3830,7 +4286,7 @@ type integerReduceIntegerIterator struct {
 	create   func() (IntegerPointAggregator, IntegerPointEmitter)
 	dims     []string
 	opt      IteratorOptions
-	points   []IntegerPoint
+	points   integerSpillBuffer
 	keepTags bool
 }
 
@@ -3840,6 +4296,7 @@ func newIntegerReduceIntegerIterator(input IntegerIterator, opt IteratorOptions,
 		create: createFn,
 		dims:   opt.GetDimensions(),
 		opt:    opt,
+		points: integerSpillBuffer{budget: opt.Memory, sorted: opt.Ordered},
 	}
 }
 
@@ -3847,23 +4304,22 @@ func newIntegerReduceIntegerIterator(input IntegerIterator, opt IteratorOptions,
 func (itr *integerReduceIntegerIterator) Stats() IteratorStats { return itr.input.Stats() }
 
 // Close closes the iterator and all child iterators.
-func (itr *integerReduceIntegerIterator) Close() error { return itr.input.Close() }
+func (itr *integerReduceIntegerIterator) Close() error {
+	itr.points.reset()
+	return itr.input.Close()
+}
 
 // Next returns the minimum value for the next available interval.
 func (itr *integerReduceIntegerIterator) Next() (*IntegerPoint, error) {
+	if p, err := itr.points.Next(); err != nil || p != nil {
+		return p, err
+	}
+
 	// Calculate next window if we have no more points.
-	if len(itr.points) == 0 {
-		var err error
-		itr.points, err = itr.reduce()
-		if len(itr.points) == 0 {
-			return nil, err
-		}
+	if err := itr.reduce(); err != nil {
+		return nil, err
 	}
-
-	// Pop next point off the stack.
-	p := &itr.points[len(itr.points)-1]
-	itr.points = itr.points[:len(itr.points)-1]
-	return p, nil
+	return itr.points.Next()
 }
 
 // integerReduceIntegerPoint stores the reduced data for a name/tag combination.
@@ -3876,7 +4332,9 @@ type integerReduceIntegerPoint struct {
 
 // reduce executes fn once for every point in the next window.
 // The previous value for the dimension is passed to fn.
-func (itr *integerReduceIntegerIterator) reduce() ([]IntegerPoint, error) {
+func (itr *integerReduceIntegerIterator) reduce() error {
+	itr.points.reset()
+
 	// Calculate next window.
 	var (
 		startTime, endTime int64
@@ -3888,7 +4346,7 @@ func (itr *integerReduceIntegerIterator) reduce() ([]IntegerPoint, error) {
 	for {
 		p, err := itr.input.Next()
 		if err != nil || p == nil {
-			return nil, err
+			return err
 		} else if p.Nil {
 			continue
 		}
@@ -3906,7 +4364,7 @@ func (itr *integerReduceIntegerIterator) reduce() ([]IntegerPoint, error) {
 		// Read next point.
 		curr, err := itr.input.NextInWindow(startTime, endTime)
 		if err != nil {
-			return nil, err
+			return err
 		} else if curr == nil {
 			break
 		} else if curr.Nil {
@@ -3945,23 +4403,22 @@ func (itr *integerReduceIntegerIterator) reduce() ([]IntegerPoint, error) {
 		rp.Aggregator.AggregateInteger(curr)
 	}
 
-	// Reverse sort points by name & tag if our output is supposed to be ordered.
+	// Sort points by name & tag if our output is supposed to be ordered.
 	keys := make([]string, 0, len(m))
 	for k := range m {
 		keys = append(keys, k)
 	}
 	if len(keys) > 1 && itr.opt.Ordered {
-		sort.Sort(reverseStringSlice(keys))
+		sort.Strings(keys)
 	}
 
 	// Assume the points are already sorted until proven otherwise.
 	sortedByTime := true
 	// Emit the points for each name & tag combination.
-	a := make([]IntegerPoint, 0, len(m))
 	for _, k := range keys {
 		rp := m[k]
 		points := rp.Emitter.Emit()
-		for i := len(points) - 1; i >= 0; i-- {
+		for i := range points {
 			points[i].Name = rp.Name
 			if !itr.keepTags {
 				points[i].Tags = rp.Tags
@@ -3972,16 +4429,18 @@ func (itr *integerReduceIntegerIterator) reduce() ([]IntegerPoint, error) {
 			} else {
 				sortedByTime = false
 			}
-			a = append(a, points[i])
+			if err := itr.points.append(points[i]); err != nil {
+				return err
+			}
 		}
 	}
 
 	// Points may be out of order. Perform a stable sort by time if requested.
+	// Points spilled to disk have already been sorted.
 	if !sortedByTime && itr.opt.Ordered {
-		sort.Stable(sort.Reverse(integerPointsByTime(a)))
+		itr.points.sort()
 	}
-
-	return a, nil
+	return nil
 }
 
 // integerStreamIntegerIterator streams inputs into the iterator and emits points gradually.
@@ -4105,7 +4564,7 @@ type integerReduceUnsignedIterator struct {
 	create   func() (IntegerPointAggregator, UnsignedPointEmitter)
 	dims     []string
 	opt      IteratorOptions
-	points   []UnsignedPoint
+	points   unsignedSpillBuffer
 	keepTags bool
 }
 
@@ -4115,6 +4574,7 @@ func newIntegerReduceUnsignedIterator(input IntegerIterator, opt IteratorOptions
 		create: createFn,
 		dims:   opt.GetDimensions(),
 		opt:    opt,
+		points: unsignedSpillBuffer{budget: opt.Memory, sorted: opt.Ordered},
 	}
 }
 
@@ -4122,23 +4582,22 @@ func newIntegerReduceUnsignedIterator(input IntegerIterator, opt IteratorOptions
 func (itr *integerReduceUnsignedIterator) Stats() IteratorStats { return itr.input.Stats() }
 
 // Close closes the iterator and all child iterators.
-func (itr *integerReduceUnsignedIterator) Close() error { return itr.input.Close() }
+func (itr *integerReduceUnsignedIterator) Close() error {
+	itr.points.reset()
+	return itr.input.Close()
+}
 
 // Next returns the minimum value for the next available interval.
 func (itr *integerReduceUnsignedIterator) Next() (*UnsignedPoint, error) {
-	// Calculate next window if we have no more points.
-	if len(itr.points) == 0 {
-		var err error
-		itr.points, err = itr.reduce()
-		if len(itr.points) == 0 {
-			return nil, err
-		}
+	if p, err := itr.points.Next(); err != nil || p != nil {
+		return p, err
 	}
 
-	// Pop next point off the stack.
-	p := &itr.points[len(itr.points)-1]
-	itr.points = itr.points[:len(itr.points)-1]
-	return p, nil
+	// Calculate next window if we have no more points.
+	if err := itr.reduce(); err != nil {
+		return nil, err
+	}
+	return itr.points.Next()
 }
 
 // integerReduceUnsignedPoint stores the reduced data for a name/tag combination.
@@ -4151,7 +4610,9 @@ type integerReduceUnsignedPoint struct {
 
 // reduce executes fn once for every point in the next window.
 // The previous value for the dimension is passed to fn.
-func (itr *integerReduceUnsignedIterator) reduce() ([]UnsignedPoint, error) {
+func (itr *integerReduceUnsignedIterator) reduce() error {
+	itr.points.reset()
+
 	// Calculate next window.
 	var (
 		startTime, endTime int64
@@ -4163,7 +4624,7 @@ func (itr *integerReduceUnsignedIterator) reduce() ([]UnsignedPoint, error) {
 	for {
 		p, err := itr.input.Next()
 		if err != nil || p == nil {
-			return nil, err
+			return err
 		} else if p.Nil {
 			continue
 		}
@@ -4181,7 +4642,7 @@ func (itr *integerReduceUnsignedIterator) reduce() ([]UnsignedPoint, error) {
 		// Read next point.
 		curr, err := itr.input.NextInWindow(startTime, endTime)
 		if err != nil {
-			return nil, err
+			return err
 		} else if curr == nil {
 			break
 		} else if curr.Nil {
@@ -4220,23 +4681,22 @@ func (itr *integerReduceUnsignedIterator) reduce() ([]UnsignedPoint, error) {
 		rp.Aggregator.AggregateInteger(curr)
 	}
 
-	// Reverse sort points by name & tag if our output is supposed to be ordered.
+	// Sort points by name & tag if our output is supposed to be ordered.
 	keys := make([]string, 0, len(m))
 	for k := range m {
 		keys = append(keys, k)
 	}
 	if len(keys) > 1 && itr.opt.Ordered {
-		sort.Sort(reverseStringSlice(keys))
+		sort.Strings(keys)
 	}
 
 	// Assume the points are already sorted until proven otherwise.
 	sortedByTime := true
 	// Emit the points for each name & tag combination.
-	a := make([]UnsignedPoint, 0, len(m))
 	for _, k := range keys {
 		rp := m[k]
 		points := rp.Emitter.Emit()
-		for i := len(points) - 1; i >= 0; i-- {
+		for i := range points {
 			points[i].Name = rp.Name
 			if !itr.keepTags {
 				points[i].Tags = rp.Tags
@@ -4247,16 +4707,18 @@ func (itr *integerReduceUnsignedIterator) reduce() ([]UnsignedPoint, error) {
 			} else {
 				sortedByTime = false
 			}
-			a = append(a, points[i])
+			if err := itr.points.append(points[i]); err != nil {
+				return err
+			}
 		}
 	}
 
 	// Points may be out of order. Perform a stable sort by time if requested.
+	// Points spilled to disk have already been sorted.
 	if !sortedByTime && itr.opt.Ordered {
-		sort.Stable(sort.Reverse(unsignedPointsByTime(a)))
+		itr.points.sort()
 	}
-
-	return a, nil
+	return nil
 }
 
 // integerStreamUnsignedIterator streams inputs into the iterator and emits points gradually.
@@ -4380,7 +4842,7 @@ type integerReduceStringIterator struct {
 	create   func() (IntegerPointAggregator, StringPointEmitter)
 	dims     []string
 	opt      IteratorOptions
-	points   []StringPoint
+	points   stringSpillBuffer
 	keepTags bool
 }
 
@@ -4390,6 +4852,7 @@ func newIntegerReduceStringIterator(input IntegerIterator, opt IteratorOptions,
 		create: createFn,
 		dims:   opt.GetDimensions(),
 		opt:    opt,
+		points: stringSpillBuffer{budget: opt.Memory, sorted: opt.Ordered},
 	}
 }
 
@@ -4397,23 +4860,22 @@ func newIntegerReduceStringIterator(input IntegerIterator, opt IteratorOptions,
 func (itr *integerReduceStringIterator) Stats() IteratorStats { return itr.input.Stats() }
 
 // Close closes the iterator and all child iterators.
-func (itr *integerReduceStringIterator) Close() error { return itr.input.Close() }
+func (itr *integerReduceStringIterator) Close() error {
+	itr.points.reset()
+	return itr.input.Close()
+}
 
 // Next returns the minimum value for the next available interval.
 func (itr *integerReduceStringIterator) Next() (*StringPoint, error) {
-	// Calculate next window if we have no more points.
-	if len(itr.points) == 0 {
-		var err error
-		itr.points, err = itr.reduce()
-		if len(itr.points) == 0 {
-			return nil, err
-		}
+	if p, err := itr.points.Next(); err != nil || p != nil {
+		return p, err
 	}
 
-	// Pop next point off the stack.
-	p := &itr.points[len(itr.points)-1]
-	itr.points = itr.points[:len(itr.points)-1]
-	return p, nil
+	// Calculate next window if we have no more points.
+	if err := itr.reduce(); err != nil {
+		return nil, err
+	}
+	return itr.points.Next()
 }
 
 // integerReduceStringPoint stores the reduced data for a name/tag combination.
@@ -4426,7 +4888,9 @@ type integerReduceStringPoint struct {
 
 // reduce executes fn once for every point in the next window.
 // The previous value for the dimension is passed to fn.
-func (itr *integerReduceStringIterator) reduce() ([]StringPoint, error) {
+func (itr *integerReduceStringIterator) reduce() error {
+	itr.points.reset()
+
 	// Calculate next window.
 	var (
 		startTime, endTime int64
@@ -4438,7 +4902,7 @@ func (itr *integerReduceStringIterator) reduce() ([]StringPoint, error) {
 	for {
 		p, err := itr.input.Next()
 		if err != nil || p == nil {
-			return nil, err
+			return err
 		} else if p.Nil {
 			continue
 		}
@@ -4456,7 +4920,7 @@ func (itr *integerReduceStringIterator) reduce() ([]StringPoint, error) {
 		// Read next point.
 		curr, err := itr.input.NextInWindow(startTime, endTime)
 		if err != nil {
-			return nil, err
+			return err
 		} else if curr == nil {
 			break
 		} else if curr.Nil {
@@ -4495,23 +4959,22 @@ func (itr *integerReduceStringIterator) reduce() ([]StringPoint, error) {
 		rp.Aggregator.AggregateInteger(curr)
 	}
 
-	// Reverse sort points by name & tag if our output is supposed to be ordered.
+	// Sort points by name & tag if our output is supposed to be ordered.
 	keys := make([]string, 0, len(m))
 	for k := range m {
 		keys = append(keys, k)
 	}
 	if len(keys) > 1 && itr.opt.Ordered {
-		sort.Sort(reverseStringSlice(keys))
+		sort.Strings(keys)
 	}
 
 	// Assume the points are already sorted until proven otherwise.
 	sortedByTime := true
 	// Emit the points for each name & tag combination.
-	a := make([]StringPoint, 0, len(m))
 	for _, k := range keys {
 		rp := m[k]
 		points := rp.Emitter.Emit()
-		for i := len(points) - 1; i >= 0; i-- {
+		for i := range points {
 			points[i].Name = rp.Name
 			if !itr.keepTags {
 				points[i].Tags = rp.Tags
@@ -4522,16 +4985,18 @@ func (itr *integerReduceStringIterator) reduce() ([]StringPoint, error) {
 			} else {
 				sortedByTime = false
 			}
-			a = append(a, points[i])
+			if err := itr.points.append(points[i]); err != nil {
+				return err
+			}
 		}
 	}
 
 	// Points may be out of order. Perform a stable sort by time if requested.
+	// Points spilled to disk have already been sorted.
 	if !sortedByTime && itr.opt.Ordered {
-		sort.Stable(sort.Reverse(stringPointsByTime(a)))
+		itr.points.sort()
 	}
-
-	return a, nil
+	return nil
 }
 
 // integerStreamStringIterator streams inputs into the iterator and emits points gradually.
@@ -4655,7 +5120,7 @@ type integerReduceBooleanIterator struct {
 	create   func() (IntegerPointAggregator, BooleanPointEmitter)
 	dims     []string
 	opt      IteratorOptions
-	points   []BooleanPoint
+	points   booleanSpillBuffer
 	keepTags bool
 }
 
@@ -4665,6 +5130,7 @@ func newIntegerReduceBooleanIterator(input IntegerIterator, opt IteratorOptions,
 		create: createFn,
 		dims:   opt.GetDimensions(),
 		opt:    opt,
+		points: booleanSpillBuffer{budget: opt.Memory, sorted: opt.Ordered},
 	}
 }
 
@@ -4672,23 +5138,22 @@ func newIntegerReduceBooleanIterator(input IntegerIterator, opt IteratorOptions,
 func (itr *integerReduceBooleanIterator) Stats() IteratorStats { return itr.input.Stats() }
 
 // Close closes the iterator and all child iterators.
-func (itr *integerReduceBooleanIterator) Close() error { return itr.input.Close() }
+func (itr *integerReduceBooleanIterator) Close() error {
+	itr.points.reset()
+	return itr.input.Close()
+}
 
 // Next returns the minimum value for the next available interval.
 func (itr *integerReduceBooleanIterator) Next() (*BooleanPoint, error) {
-	// Calculate next window if we have no more points.
-	if len(itr.points) == 0 {
-		var err error
-		itr.points, err = itr.reduce()
-		if len(itr.points) == 0 {
-			return nil, err
-		}
+	if p, err := itr.points.Next(); err != nil || p != nil {
+		return p, err
 	}
 
-	// Pop next point off the stack.
-	p := &itr.points[len(itr.points)-1]
-	itr.points = itr.points[:len(itr.points)-1]
-	return p, nil
+	// Calculate next window if we have no more points.
+	if err := itr.reduce(); err != nil {
+		return nil, err
+	}
+	return itr.points.Next()
 }
 
 // integerReduceBooleanPoint stores the reduced data for a name/tag combination.
@@ -4701,7 +5166,9 @@ type integerReduceBooleanPoint struct {
 
 // reduce executes fn once for every point in the next window.
 // The previous value for the dimension is passed to fn.
-func (itr *integerReduceBooleanIterator) reduce() ([]BooleanPoint, error) {
+func (itr *integerReduceBooleanIterator) reduce() error {
+	itr.points.reset()
+
 	// Calculate next window.
 	var (
 		startTime, endTime int64
@@ -4713,7 +5180,7 @@ func (itr *integerReduceBooleanIterator) reduce() ([]BooleanPoint, error) {
 	for {
 		p, err := itr.input.Next()
 		if err != nil || p == nil {
-			return nil, err
+			return err
 		} else if p.Nil {
 			continue
 		}
@@ -4731,7 +5198,7 @@ func (itr *integerReduceBooleanIterator) reduce() ([]BooleanPoint, error) {
 		// Read next point.
 		curr, err := itr.input.NextInWindow(startTime, endTime)
 		if err != nil {
-			return nil, err
+			return err
 		} else if curr == nil {
 			break
 		} else if curr.Nil {
@@ -4770,23 +5237,22 @@ func (itr *integerReduceBooleanIterator) reduce() ([]BooleanPoint, error) {
 		rp.Aggregator.AggregateInteger(curr)
 	}
 
-	// Reverse sort points by name & tag if our output is supposed to be ordered.
+	// Sort points by name & tag if our output is supposed to be ordered.
 	keys := make([]string, 0, len(m))
 	for k := range m {
 		keys = append(keys, k)
 	}
 	if len(keys) > 1 && itr.opt.Ordered {
-		sort.Sort(reverseStringSlice(keys))
+		sort.Strings(keys)
 	}
 
 	// Assume the points are already sorted until proven otherwise.
 	sortedByTime := true
 	// Emit the points for each name & tag combination.
-	a := make([]BooleanPoint, 0, len(m))
 	for _, k := range keys {
 		rp := m[k]
 		points := rp.Emitter.Emit()
-		for i := len(points) - 1; i >= 0; i-- {
+		for i := range points {
 			points[i].Name = rp.Name
 			if !itr.keepTags {
 				points[i].Tags = rp.Tags
@@ -4797,16 +5263,18 @@ func (itr *integerReduceBooleanIterator) reduce() ([]BooleanPoint, error) {
 			} else {
 				sortedByTime = false
 			}
-			a = append(a, points[i])
+			if err := itr.points.append(points[i]); err != nil {
+				return err
+			}
 		}
 	}
 
 	// Points may be out of order. Perform a stable sort by time if requested.
+	// Points spilled to disk have already been sorted.
 	if !sortedByTime && itr.opt.Ordered {
-		sort.Stable(sort.Reverse(booleanPointsByTime(a)))
+		itr.points.sort()
 	}
-
-	return a, nil
+	return nil
 }
 
 // integerStreamBooleanIterator streams inputs into the iterator and emits points gradually.
@@ -5429,6 +5897,9 @@ type unsignedSortedMergeIterator struct {
 	inputs []UnsignedIterator
 	heap   *unsignedSortedMergeHeap
 	init   bool
+
+	budget   *MemoryBudget
+	reserved int64
 }
 
 // newUnsignedSortedMergeIterator returns an instance of unsignedSortedMergeIterator.
@@ -5439,6 +5910,7 @@ func newUnsignedSortedMergeIterator(inputs []UnsignedIterator, opt IteratorOptio
 			items: make([]*unsignedSortedMergeHeapItem, 0, len(inputs)),
 			opt:   opt,
 		},
+		budget: opt.Memory,
 	}
 
 	// Initialize heap items.
@@ -5464,6 +5936,8 @@ func (itr *unsignedSortedMergeIterator) Close() error {
 	for _, input := range itr.inputs {
 		input.Close()
 	}
+	itr.budget.shrink(itr.reserved)
+	itr.reserved = 0
 	return nil
 }
 
@@ -5477,7 +5951,20 @@ func (itr *unsignedSortedMergeIterator) pop() (*UnsignedPoint, error) {
 	if !itr.init {
 		items := itr.heap.items
 		itr.heap.items = make([]*unsignedSortedMergeHeapItem, 0, len(items))
-		for _, item := range items {
+		for i, item := range items {
+			// Reserve memory for the input. If the budget is exhausted then
+			// spill the input to disk so it can be closed.
+			if itr.budget.grow(mergeInputSize) {
+				itr.reserved += mergeInputSize
+			} else {
+				itr.budget.shrink(mergeInputSize)
+				run, err := spillUnsignedIterator(item.itr, itr.budget)
+				if err != nil {
+					return nil, err
+				}
+				item.itr, itr.inputs[i] = run, run
+			}
+
 			var err error
 			if item.point, err = item.itr.Next(); err != nil {
 				return nil, err
@@ -5603,6 +6090,206 @@ type unsignedSortedMergeHeapItem struct {
 	itr   UnsignedIterator
 }
 
+// unsignedSpillRun is a run of points written to a temporary file.
+type unsignedSpillRun struct {
+	file  *spillFile
+	enc   *UnsignedPointEncoder
+	dec   *UnsignedPointDecoder
+	buf   *UnsignedPoint
+	eof   bool
+	stats IteratorStats
+}
+
+// newUnsignedSpillRun returns a new run written to a temporary file.
+func newUnsignedSpillRun(budget *MemoryBudget) (*unsignedSpillRun, error) {
+	f, err := budget.createSpillFile()
+	if err != nil {
+		return nil, err
+	}
+	return &unsignedSpillRun{file: f, enc: NewUnsignedPointEncoder(f)}, nil
+}
+
+// spillUnsignedIterator writes all of the points of input to a new run and
+// closes input.
+func spillUnsignedIterator(input UnsignedIterator, budget *MemoryBudget) (*unsignedSpillRun, error) {
+	defer input.Close()
+
+	run, err := newUnsignedSpillRun(budget)
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		p, err := input.Next()
+		if err != nil {
+			run.Close()
+			return nil, err
+		} else if p == nil {
+			break
+		}
+
+		if err := run.write(p); err != nil {
+			run.Close()
+			return nil, err
+		}
+	}
+	run.stats = input.Stats()
+	return run, nil
+}
+
+// write appends p to the run. A run cannot be written to once it has been read.
+func (r *unsignedSpillRun) write(p *UnsignedPoint) error {
+	return r.enc.EncodeUnsignedPoint(p)
+}
+
+// peek returns the next point of the run without consuming it.
+func (r *unsignedSpillRun) peek() (*UnsignedPoint, error) {
+	if r.buf != nil || r.eof {
+		return r.buf, nil
+	}
+
+	if r.dec == nil {
+		rd, err := r.file.reader()
+		if err != nil {
+			return nil, err
+		}
+		r.dec = NewUnsignedPointDecoder(context.Background(), rd)
+	}
+
+	p := &UnsignedPoint{}
+	if err := r.dec.DecodeUnsignedPoint(p); err == io.EOF {
+		r.eof = true
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	r.buf = p
+	return p, nil
+}
+
+// Next returns the next point of the run.
+func (r *unsignedSpillRun) Next() (*UnsignedPoint, error) {
+	p, err := r.peek()
+	r.buf = nil
+	return p, err
+}
+
+// Stats returns the stats of the iterator the run was written from.
+func (r *unsignedSpillRun) Stats() IteratorStats { return r.stats }
+
+// Close closes and removes the temporary file.
+func (r *unsignedSpillRun) Close() error { return r.file.Close() }
+
+// unsignedSpillBuffer buffers points in memory until the memory budget is
+// exhausted. The buffered points are then written to a new run on disk.
+// If sorted is set, the points are returned in time order. Otherwise they are
+// returned in the order they were appended.
+type unsignedSpillBuffer struct {
+	budget *MemoryBudget
+	sorted bool
+	points []UnsignedPoint
+	size   int64
+	runs   []*unsignedSpillRun
+}
+
+// append adds p to the buffer.
+func (b *unsignedSpillBuffer) append(p UnsignedPoint) error {
+	b.points = append(b.points, p)
+
+	n := pointSize(p.Name, p.Tags, p.Aux)
+	b.size += n
+	if !b.budget.grow(n) {
+		return b.spill()
+	}
+	return nil
+}
+
+// spill writes the points held in memory to a new run.
+func (b *unsignedSpillBuffer) spill() error {
+	if b.sorted {
+		b.sort()
+	}
+
+	run, err := newUnsignedSpillRun(b.budget)
+	if err != nil {
+		return err
+	}
+	b.runs = append(b.runs, run)
+
+	for i := range b.points {
+		if err := run.write(&b.points[i]); err != nil {
+			return err
+		}
+	}
+	b.points = b.points[:0]
+	b.budget.shrink(b.size)
+	b.size = 0
+	return nil
+}
+
+// sort performs a stable sort of the points held in memory by time.
+func (b *unsignedSpillBuffer) sort() {
+	sort.Stable(unsignedPointsByTime(b.points))
+}
+
+// Next returns the next point from the buffer.
+func (b *unsignedSpillBuffer) Next() (*UnsignedPoint, error) {
+	if !b.sorted {
+		for len(b.runs) > 0 {
+			if p, err := b.runs[0].Next(); err != nil || p != nil {
+				return p, err
+			}
+			b.runs[0].Close()
+			b.runs = b.runs[1:]
+		}
+		return b.pop(), nil
+	}
+
+	// Find the run with the earliest point. Points with equal times are
+	// returned in the order they were appended.
+	var min *unsignedSpillRun
+	var t int64
+	for _, r := range b.runs {
+		p, err := r.peek()
+		if err != nil {
+			return nil, err
+		} else if p != nil && (min == nil || p.Time < t) {
+			min, t = r, p.Time
+		}
+	}
+
+	if len(b.points) > 0 && (min == nil || b.points[0].Time < t) {
+		return b.pop(), nil
+	} else if min == nil {
+		return nil, nil
+	}
+	return min.Next()
+}
+
+// pop removes and returns the first point held in memory.
+func (b *unsignedSpillBuffer) pop() *UnsignedPoint {
+	if len(b.points) == 0 {
+		return nil
+	}
+	p := &b.points[0]
+	b.points = b.points[1:]
+	return p
+}
+
+// reset removes all points from the buffer and releases its memory.
+func (b *unsignedSpillBuffer) reset() {
+	for _, r := range b.runs {
+		r.Close()
+	}
+	b.runs = nil
+
+	// Points previously returned may still be referenced so the
+	// underlying array cannot be reused.
+	b.points = nil
+	b.budget.shrink(b.size)
+	b.size = 0
+}
+
 // unsignedIteratorScanner scans the results of a UnsignedIterator into a map.
 type unsignedIteratorScanner struct {
 	input        *bufUnsignedIterator
@@ -6111,7 +6798,7 @@ type unsignedReduceFloatIterator struct {
 	create   func() (UnsignedPointAggregator, FloatPointEmitter)
 	dims     []string
 	opt      IteratorOptions
-	points   []FloatPoint
+	points   floatSpillBuffer
 	keepTags bool
 }
 
@@ -6121,6 +6808,7 @@ func newUnsignedReduceFloatIterator(input UnsignedIterator, opt IteratorOptions,
 		create: createFn,
 		dims:   opt.GetDimensions(),
 		opt:    opt,
+		points: floatSpillBuffer{budget: opt.Memory, sorted: opt.Ordered},
 	}
 }
 
@@ -6128,23 +6816,22 @@ func newUnsignedReduceFloatIterator(input UnsignedIterator, opt IteratorOptions,
 func (itr *unsignedReduceFloatIterator) Stats() IteratorStats { return itr.input.Stats() }
 
 // Close closes the iterator and all child iterators.
-func (itr *unsignedReduceFloatIterator) Close() error { return itr.input.Close() }
+func (itr *unsignedReduceFloatIterator) Close() error {
+	itr.points.reset()
+	return itr.input.Close()
+}
 
 // Next returns the minimum value for the next available interval.
 func (itr *unsignedReduceFloatIterator) Next() (*FloatPoint, error) {
-	// Calculate next window if we have no more points.
-	if len(itr.points) == 0 {
-		var err error
-		itr.points, err = itr.reduce()
-		if len(itr.points) == 0 {
-			return nil, err
-		}
+	if p, err := itr.points.Next(); err != nil || p != nil {
+		return p, err
 	}
 
-	// Pop next point off the stack.
-	p := &itr.points[len(itr.points)-1]
-	itr.points = itr.points[:len(itr.points)-1]
-	return p, nil
+	// Calculate next window if we have no more points.
+	if err := itr.reduce(); err != nil {
+		return nil, err
+	}
+	return itr.points.Next()
 }
 
 // unsignedReduceFloatPoint stores the reduced data for a name/tag combination.
@@ -6157,7 +6844,9 @@ type unsignedReduceFloatPoint struct {
 
 // reduce executes fn once for every point in the next window.
 // The previous value for the dimension is passed to fn.
-func (itr *unsignedReduceFloatIterator) reduce() ([]FloatPoint, error) {
+func (itr *unsignedReduceFloatIterator) reduce() error {
+	itr.points.reset()
+
 	// Calculate next window.
 	var (
 		startTime, endTime int64
@@ -6169,7 +6858,7 @@ func (itr *unsignedReduceFloatIterator) reduce() ([]FloatPoint, error) {
 	for {
 		p, err := itr.input.Next()
 		if err != nil || p == nil {
-			return nil, err
+			return err
 		} else if p.Nil {
 			continue
 		}
@@ -6187,7 +6876,7 @@ func (itr *unsignedReduceFloatIterator) reduce() ([]FloatPoint, error) {
 		// Read next point.
 		curr, err := itr.input.NextInWindow(startTime, endTime)
 		if err != nil {
-			return nil, err
+			return err
 		} else if curr == nil {
 			break
 		} else if curr.Nil {
@@ -6226,23 +6915,22 @@ func (itr *unsignedReduceFloatIterator) reduce() ([]FloatPoint, error) {
 		rp.Aggregator.AggregateUnsigned(curr)
 	}
 
-	// Reverse sort points by name & tag if our output is supposed to be ordered.
+	// Sort points by name & tag if our output is supposed to be ordered.
 	keys := make([]string, 0, len(m))
 	for k := range m {
 		keys = append(keys, k)
 	}
 	if len(keys) > 1 && itr.opt.Ordered {
-		sort.Sort(reverseStringSlice(keys))
+		sort.Strings(keys)
 	}
 
 	// Assume the points are already sorted until proven otherwise.
 	sortedByTime := true
 	// Emit the points for each name & tag combination.
-	a := make([]FloatPoint, 0, len(m))
 	for _, k := range keys {
 		rp := m[k]
 		points := rp.Emitter.Emit()
-		for i := len(points) - 1; i >= 0; i-- {
+		for i := range points {
 			points[i].Name = rp.Name
 			if !itr.keepTags {
 				points[i].Tags = rp.Tags
@@ -6253,16 +6941,18 @@ func (itr *unsignedReduceFloatIterator) reduce() ([]FloatPoint, error) {
 			} else {
 				sortedByTime = false
 			}
-			a = append(a, points[i])
+			if err := itr.points.append(points[i]); err != nil {
+				return err
+			}
 		}
 	}
 
 	// Points may be out of order. Perform a stable sort by time if requested.
+	// Points spilled to disk have already been sorted.
 	if !sortedByTime && itr.opt.Ordered {
-		sort.Stable(sort.Reverse(floatPointsByTime(a)))
+		itr.points.sort()
 	}
-
-	return a, nil
+	return nil
 }
 
 // unsignedStreamFloatIterator streams inputs into the iterator and emits points gradually.
@@ -6386,7 +7076,7 @@ type unsignedReduceIntegerIterator struct {
 	create   func() (UnsignedPointAggregator, IntegerPointEmitter)
 	dims     []string
 	opt      IteratorOptions
-	points   []IntegerPoint
+	points   integerSpillBuffer
 	keepTags bool
 }
 
@@ -6396,6 +7086,7 @@ func newUnsignedReduceIntegerIterator(input UnsignedIterator, opt IteratorOption
 		create: createFn,
 		dims:   opt.GetDimensions(),
 		opt:    opt,
+		points: integerSpillBuffer{budget: opt.Memory, sorted: opt.Ordered},
 	}
 }
 
@@ -6403,23 +7094,22 @@ func newUnsignedReduceIntegerIterator(input UnsignedIterator, opt IteratorOption
 func (itr *unsignedReduceIntegerIterator) Stats() IteratorStats { return itr.input.Stats() }
 
 // Close closes the iterator and all child iterators.
-func (itr *unsignedReduceIntegerIterator) Close() error { return itr.input.Close() }
+func (itr *unsignedReduceIntegerIterator) Close() error {
+	itr.points.reset()
+	return itr.input.Close()
+}
 
 // Next returns the minimum value for the next available interval.
 func (itr *unsignedReduceIntegerIterator) Next() (*IntegerPoint, error) {
-	// Calculate next window if we have no more points.
-	if len(itr.points) == 0 {
-		var err error
-		itr.points, err = itr.reduce()
-		if len(itr.points) == 0 {
-			return nil, err
-		}
+	if p, err := itr.points.Next(); err != nil || p != nil {
+		return p, err
 	}
 
-	// Pop next point off the stack.
-	p := &itr.points[len(itr.points)-1]
-	itr.points = itr.points[:len(itr.points)-1]
-	return p, nil
+	// Calculate next window if we have no more points.
+	if err := itr.reduce(); err != nil {
+		return nil, err
+	}
+	return itr.points.Next()
 }
 
 // unsignedReduceIntegerPoint stores the reduced data for a name/tag combination.
@@ -6432,7 +7122,9 @@ type unsignedReduceIntegerPoint struct {
 
 // reduce executes fn once for every point in the next window.
 // The previous value for the dimension is passed to fn.
-func (itr *unsignedReduceIntegerIterator) reduce() ([]IntegerPoint, error) {
+func (itr *unsignedReduceIntegerIterator) reduce() error {
+	itr.points.reset()
+
 	// Calculate next window.
 	var (
 		startTime, endTime int64
@@ -6444,7 +7136,7 @@ func (itr *unsignedReduceIntegerIterator) reduce() ([]IntegerPoint, error) {
 	for {
 		p, err := itr.input.Next()
 		if err != nil || p == nil {
-			return nil, err
+			return err
 		} else if p.Nil {
 			continue
 		}
@@ -6462,7 +7154,7 @@ func (itr *unsignedReduceIntegerIterator) reduce() ([]IntegerPoint, error) {
 		// Read next point.
 		curr, err := itr.input.NextInWindow(startTime, endTime)
 		if err != nil {
-			return nil, err
+			return err
 		} else if curr == nil {
 			break
 		} else if curr.Nil {
@@ -6501,23 +7193,22 @@ func (itr *unsignedReduceIntegerIterator) reduce() ([]IntegerPoint, error) {
 		rp.Aggregator.AggregateUnsigned(curr)
 	}
 
-	// Reverse sort points by name & tag if our output is supposed to be ordered.
+	// Sort points by name & tag if our output is supposed to be ordered.
 	keys := make([]string, 0, len(m))
 	for k := range m {
 		keys = append(keys, k)
 	}
 	if len(keys) > 1 && itr.opt.Ordered {
-		sort.Sort(reverseStringSlice(keys))
+		sort.Strings(keys)
 	}
 
 	// Assume the points are already sorted until proven otherwise.
 	sortedByTime := true
 	// Emit the points for each name & tag combination.
-	a := make([]IntegerPoint, 0, len(m))
 	for _, k := range keys {
 		rp := m[k]
 		points := rp.Emitter.Emit()
-		for i := len(points) - 1; i >= 0; i-- {
+		for i := range points {
 			points[i].Name = rp.Name
 			if !itr.keepTags {
 				points[i].Tags = rp.Tags
@@ -6528,16 +7219,18 @@ func (itr *unsignedReduceIntegerIterator) reduce() ([]IntegerPoint, error) {
 			} else {
 				sortedByTime = false
 			}
-			a = append(a, points[i])
+			if err := itr.points.append(points[i]); err != nil {
+				return err
+			}
 		}
 	}
 
 	// Points may be out of order. Perform a stable sort by time if requested.
+	// Points spilled to disk have already been sorted.
 	if !sortedByTime && itr.opt.Ordered {
-		sort.Stable(sort.Reverse(integerPointsByTime(a)))
+		itr.points.sort()
 	}
-
-	return a, nil
+	return nil
 }
 
 // unsignedStreamIntegerIterator streams inputs into the iterator and emits points gradually.
@@ -6661,7 +7354,7 @@ type unsignedReduceUnsignedIterator struct {
 	create   func() (UnsignedPointAggregator, UnsignedPointEmitter)
 	dims     []string
 	opt      IteratorOptions
-	points   []UnsignedPoint
+	points   unsignedSpillBuffer
 	keepTags bool
 }
 
@@ -6671,6 +7364,7 @@ func newUnsignedReduceUnsignedIterator(input UnsignedIterator, opt IteratorOptio
 		create: createFn,
 		dims:   opt.GetDimensions(),
 		opt:    opt,
+		points: unsignedSpillBuffer{budget: opt.Memory, sorted: opt.Ordered},
 	}
 }
 
@@ -6678,23 +7372,22 @@ func newUnsignedReduceUnsignedIterator(input UnsignedIterator, opt IteratorOptio
 func (itr *unsignedReduceUnsignedIterator) Stats() IteratorStats { return itr.input.Stats() }
 
 // Close closes the iterator and all child iterators.
-func (itr *unsignedReduceUnsignedIterator) Close() error { return itr.input.Close() }
+func (itr *unsignedReduceUnsignedIterator) Close() error {
+	itr.points.reset()
+	return itr.input.Close()
+}
 
 // Next returns the minimum value for the next available interval.
 func (itr *unsignedReduceUnsignedIterator) Next() (*UnsignedPoint, error) {
-	// Calculate next window if we have no more points.
-	if len(itr.points) == 0 {
-		var err error
-		itr.points, err = itr.reduce()
-		if len(itr.points) == 0 {
-			return nil, err
-		}
+	if p, err := itr.points.Next(); err != nil || p != nil {
+		return p, err
 	}
 
-	// Pop next point off the stack.
-	p := &itr.points[len(itr.points)-1]
-	itr.points = itr.points[:len(itr.points)-1]
-	return p, nil
+	// Calculate next window if we have no more points.
+	if err := itr.reduce(); err != nil {
+		return nil, err
+	}
+	return itr.points.Next()
 }
 
 // unsignedReduceUnsignedPoint stores the reduced data for a name/tag combination.
@@ -6707,7 +7400,9 @@ type unsignedReduceUnsignedPoint struct {
 
 // reduce executes fn once for every point in the next window.
 // The previous value for the dimension is passed to fn.
-func (itr *unsignedReduceUnsignedIterator) reduce() ([]UnsignedPoint, error) {
+func (itr *unsignedReduceUnsignedIterator) reduce() error {
+	itr.points.reset()
+
 	// Calculate next window.
 	var (
 		startTime, endTime int64
@@ -6719,7 +7414,7 @@ func (itr *unsignedReduceUnsignedIterator) reduce() ([]UnsignedPoint, error) {
 	for {
 		p, err := itr.input.Next()
 		if err != nil || p == nil {
-			return nil, err
+			return err
 		} else if p.Nil {
 			continue
 		}
@@ -6737,7 +7432,7 @@ func (itr *unsignedReduceUnsignedIterator) reduce() ([]UnsignedPoint, error) {
 		// Read next point.
 		curr, err := itr.input.NextInWindow(startTime, endTime)
 		if err != nil {
-			return nil, err
+			return err
 		} else if curr == nil {
 			break
 		} else if curr.Nil {
@@ -6776,23 +7471,22 @@ func (itr *unsignedReduceUnsignedIterator) reduce() ([]UnsignedPoint, error) {
 		rp.Aggregator.AggregateUnsigned(curr)
 	}
 
-	// Reverse sort points by name & tag if our output is supposed to be ordered.
+	// Sort points by name & tag if our output is supposed to be ordered.
 	keys := make([]string, 0, len(m))
 	for k := range m {
 		keys = append(keys, k)
 	}
 	if len(keys) > 1 && itr.opt.Ordered {
-		sort.Sort(reverseStringSlice(keys))
+		sort.Strings(keys)
 	}
 
 	// Assume the points are already sorted until proven otherwise.
 	sortedByTime := true
 	// Emit the points for each name & tag combination.
-	a := make([]UnsignedPoint, 0, len(m))
 	for _, k := range keys {
 		rp := m[k]
 		points := rp.Emitter.Emit()
-		for i := len(points) - 1; i >= 0; i-- {
+		for i := range points {
 			points[i].Name = rp.Name
 			if !itr.keepTags {
 				points[i].Tags = rp.Tags
@@ -6803,16 +7497,18 @@ func (itr *unsignedReduceUnsignedIterator) reduce() ([]UnsignedPoint, error) {
 			} else {
 				sortedByTime = false
 			}
-			a = append(a, points[i])
+			if err := itr.points.append(points[i]); err != nil {
+				return err
+			}
 		}
 	}
 
 	// Points may be out of order. Perform a stable sort by time if requested.
+	// Points spilled to disk have already been sorted.
 	if !sortedByTime && itr.opt.Ordered {
-		sort.Stable(sort.Reverse(unsignedPointsByTime(a)))
+		itr.points.sort()
 	}
-
-	return a, nil
+	return nil
 }
 
 // unsignedStreamUnsignedIterator streams inputs into the iterator and emits points gradually.
@@ -6936,7 +7632,7 @@ type unsignedReduceStringIterator struct {
 	create   func() (UnsignedPointAggregator, StringPointEmitter)
 	dims     []string
 	opt      IteratorOptions
-	points   []StringPoint
+	points   stringSpillBuffer
 	keepTags bool
 }
 
@@ -6946,6 +7642,7 @@ func newUnsignedReduceStringIterator(input UnsignedIterator, opt IteratorOptions
 		create: createFn,
 		dims:   opt.GetDimensions(),
 		opt:    opt,
+		points: stringSpillBuffer{budget: opt.Memory, sorted: opt.Ordered},
 	}
 }
 
@@ -6953,23 +7650,22 @@ func newUnsignedReduceStringIterator(input UnsignedIterator, opt IteratorOptions
 func (itr *unsignedReduceStringIterator) Stats() IteratorStats { return itr.input.Stats() }
 
 // Close closes the iterator and all child iterators.
-func (itr *unsignedReduceStringIterator) Close() error { return itr.input.Close() }
+func (itr *unsignedReduceStringIterator) Close() error {
+	itr.points.reset()
+	return itr.input.Close()
+}
 
 // Next returns the minimum value for the next available interval.
 func (itr *unsignedReduceStringIterator) Next() (*StringPoint, error) {
-	// Calculate next window if we have no more points.
-	if len(itr.points) == 0 {
-		var err error
-		itr.points, err = itr.reduce()
-		if len(itr.points) == 0 {
-			return nil, err
-		}
+	if p, err := itr.points.Next(); err != nil || p != nil {
+		return p, err
 	}
 
-	// Pop next point off the stack.
-	p := &itr.points[len(itr.points)-1]
-	itr.points = itr.points[:len(itr.points)-1]
-	return p, nil
+	// Calculate next window if we have no more points.
+	if err := itr.reduce(); err != nil {
+		return nil, err
+	}
+	return itr.points.Next()
 }
 
 // unsignedReduceStringPoint stores the reduced data for a name/tag combination.
@@ -6982,7 +7678,9 @@ type unsignedReduceStringPoint struct {
 
 // reduce executes fn once for every point in the next window.
 // The previous value for the dimension is passed to fn.
-func (itr *unsignedReduceStringIterator) reduce() ([]StringPoint, error) {
+func (itr *unsignedReduceStringIterator) reduce() error {
+	itr.points.reset()
+
 	// Calculate next window.
 	var (
 		startTime, endTime int64
@@ -6994,7 +7692,7 @@ func (itr *unsignedReduceStringIterator) reduce() ([]StringPoint, error) {
 	for {
 		p, err := itr.input.Next()
 		if err != nil || p == nil {
-			return nil, err
+			return err
 		} else if p.Nil {
 			continue
 		}
@@ -7012,7 +7710,7 @@ func (itr *unsignedReduceStringIterator) reduce() ([]StringPoint, error) {
 		// Read next point.
 		curr, err := itr.input.NextInWindow(startTime, endTime)
 		if err != nil {
-			return nil, err
+			return err
 		} else if curr == nil {
 			break
 		} else if curr.Nil {
@@ -7051,23 +7749,22 @@ func (itr *unsignedReduceStringIterator) reduce() ([]StringPoint, error) {
 		rp.Aggregator.AggregateUnsigned(curr)
 	}
 
-	// Reverse sort points by name & tag if our output is supposed to be ordered.
+	// Sort points by name & tag if our output is supposed to be ordered.
 	keys := make([]string, 0, len(m))
 	for k := range m {
 		keys = append(keys, k)
 	}
 	if len(keys) > 1 && itr.opt.Ordered {
-		sort.Sort(reverseStringSlice(keys))
+		sort.Strings(keys)
 	}
 
 	// Assume the points are already sorted until proven otherwise.
 	sortedByTime := true
 	// Emit the points for each name & tag combination.
-	a := make([]StringPoint, 0, len(m))
 	for _, k := range keys {
 		rp := m[k]
 		points := rp.Emitter.Emit()
-		for i := len(points) - 1; i >= 0; i-- {
+		for i := range points {
 			points[i].Name = rp.Name
 			if !itr.keepTags {
 				points[i].Tags = rp.Tags
@@ -7078,16 +7775,18 @@ func (itr *unsignedReduceStringIterator) reduce() ([]StringPoint, error) {
 			} else {
 				sortedByTime = false
 			}
-			a = append(a, points[i])
+			if err := itr.points.append(points[i]); err != nil {
+				return err
+			}
 		}
 	}
 
 	// Points may be out of order. Perform a stable sort by time if requested.
+	// Points spilled to disk have already been sorted.
 	if !sortedByTime && itr.opt.Ordered {
-		sort.Stable(sort.Reverse(stringPointsByTime(a)))
+		itr.points.sort()
 	}
-
-	return a, nil
+	return nil
 }
 
 // unsignedStreamStringIterator streams inputs into the iterator and emits points gradually.
@@ -7211,7 +7910,7 @@ type unsignedReduceBooleanIterator struct {
 	create   func() (UnsignedPointAggregator, BooleanPointEmitter)
 	dims     []string
 	opt      IteratorOptions
-	points   []BooleanPoint
+	points   booleanSpillBuffer
 	keepTags bool
 }
 
@@ -7221,6 +7920,7 @@ func newUnsignedReduceBooleanIterator(input UnsignedIterator, opt IteratorOption
 		create: createFn,
 		dims:   opt.GetDimensions(),
 		opt:    opt,
+		points: booleanSpillBuffer{budget: opt.Memory, sorted: opt.Ordered},
 	}
 }
 
@@ -7228,23 +7928,22 @@ func newUnsignedReduceBooleanIterator(input UnsignedIterator, opt IteratorOption
 func (itr *unsignedReduceBooleanIterator) Stats() IteratorStats { return itr.input.Stats() }
 
 // Close closes the iterator and all child iterators.
-func (itr *unsignedReduceBooleanIterator) Close() error { return itr.input.Close() }
+func (itr *unsignedReduceBooleanIterator) Close() error {
+	itr.points.reset()
+	return itr.input.Close()
+}
 
 // Next returns the minimum value for the next available interval.
 func (itr *unsignedReduceBooleanIterator) Next() (*BooleanPoint, error) {
-	// Calculate next window if we have no more points.
-	if len(itr.points) == 0 {
-		var err error
-		itr.points, err = itr.reduce()
-		if len(itr.points) == 0 {
-			return nil, err
-		}
+	if p, err := itr.points.Next(); err != nil || p != nil {
+		return p, err
 	}
 
-	// Pop next point off the stack.
-	p := &itr.points[len(itr.points)-1]
-	itr.points = itr.points[:len(itr.points)-1]
-	return p, nil
+	// Calculate next window if we have no more points.
+	if err := itr.reduce(); err != nil {
+		return nil, err
+	}
+	return itr.points.Next()
 }
 
 // unsignedReduceBooleanPoint stores the reduced data for a name/tag combination.
@@ -7257,7 +7956,9 @@ type unsignedReduceBooleanPoint struct {
 
 // reduce executes fn once for every point in the next window.
 // The previous value for the dimension is passed to fn.
-func (itr *unsignedReduceBooleanIterator) reduce() ([]BooleanPoint, error) {
+func (itr *unsignedReduceBooleanIterator) reduce() error {
+	itr.points.reset()
+
 	// Calculate next window.
 	var (
 		startTime, endTime int64
@@ -7269,7 +7970,7 @@ func (itr *unsignedReduceBooleanIterator) reduce() ([]BooleanPoint, error) {
 	for {
 		p, err := itr.input.Next()
 		if err != nil || p == nil {
-			return nil, err
+			return err
 		} else if p.Nil {
 			continue
 		}
@@ -7287,7 +7988,7 @@ func (itr *unsignedReduceBooleanIterator) reduce() ([]BooleanPoint, error) {
 		// Read next point.
 		curr, err := itr.input.NextInWindow(startTime, endTime)
 		if err != nil {
-			return nil, err
+			return err
 		} else if curr == nil {
 			break
 		} else if curr.Nil {
@@ -7326,23 +8027,22 @@ func (itr *unsignedReduceBooleanIterator) reduce() ([]BooleanPoint, error) {
 		rp.Aggregator.AggregateUnsigned(curr)
 	}
 
-	// Reverse sort points by name & tag if our output is supposed to be ordered.
+	// Sort points by name & tag if our output is supposed to be ordered.
 	keys := make([]string, 0, len(m))
 	for k := range m {
 		keys = append(keys, k)
 	}
 	if len(keys) > 1 && itr.opt.Ordered {
-		sort.Sort(reverseStringSlice(keys))
+		sort.Strings(keys)
 	}
 
 	// Assume the points are already sorted until proven otherwise.
 	sortedByTime := true
 	// Emit the points for each name & tag combination.
-	a := make([]BooleanPoint, 0, len(m))
 	for _, k := range keys {
 		rp := m[k]
 		points := rp.Emitter.Emit()
-		for i := len(points) - 1; i >= 0; i-- {
+		for i := range points {
 			points[i].Name = rp.Name
 			if !itr.keepTags {
 				points[i].Tags = rp.Tags
@@ -7353,16 +8053,18 @@ func (itr *unsignedReduceBooleanIterator) reduce() ([]BooleanPoint, error) {
 			} else {
 				sortedByTime = false
 			}
-			a = append(a, points[i])
+			if err := itr.points.append(points[i]); err != nil {
+				return err
+			}
 		}
 	}
 
 	// Points may be out of order. Perform a stable sort by time if requested.
+	// Points spilled to disk have already been sorted.
 	if !sortedByTime && itr.opt.Ordered {
-		sort.Stable(sort.Reverse(booleanPointsByTime(a)))
+		itr.points.sort()
 	}
-
-	return a, nil
+	return nil
 }
 
 // unsignedStreamBooleanIterator streams inputs into the iterator and emits points gradually.
@@ -7985,6 +8687,9 @@ type stringSortedMergeIterator struct {
 	inputs []StringIterator
 	heap   *stringSortedMergeHeap
 	init   bool
+
+	budget   *MemoryBudget
+	reserved int64
 }
 
 // newStringSortedMergeIterator returns an instance of stringSortedMergeIterator.
@@ -7995,6 +8700,7 @@ func newStringSortedMergeIterator(inputs []StringIterator, opt IteratorOptions)
 			items: make([]*stringSortedMergeHeapItem, 0, len(inputs)),
 			opt:   opt,
 		},
+		budget: opt.Memory,
 	}
 
 	// Initialize heap items.
@@ -8020,6 +8726,8 @@ func (itr *stringSortedMergeIterator) Close() error {
 	for _, input := range itr.inputs {
 		input.Close()
 	}
+	itr.budget.shrink(itr.reserved)
+	itr.reserved = 0
 	return nil
 }
 
@@ -8033,7 +8741,20 @@ func (itr *stringSortedMergeIterator) pop() (*StringPoint, error) {
 	if !itr.init {
 		items := itr.heap.items
 		itr.heap.items = make([]*stringSortedMergeHeapItem, 0, len(items))
-		for _, item := range items {
+		for i, item := range items {
+			// Reserve memory for the input. If the budget is exhausted then
+			// spill the input to disk so it can be closed.
+			if itr.budget.grow(mergeInputSize) {
+				itr.reserved += mergeInputSize
+			} else {
+				itr.budget.shrink(mergeInputSize)
+				run, err := spillStringIterator(item.itr, itr.budget)
+				if err != nil {
+					return nil, err
+				}
+				item.itr, itr.inputs[i] = run, run
+			}
+
 			var err error
 			if item.point, err = item.itr.Next(); err != nil {
 				return nil, err
@@ -8159,6 +8880,206 @@ type stringSortedMergeHeapItem struct {
 	itr   StringIterator
 }
 
+// stringSpillRun is a run of points written to a temporary file.
+type stringSpillRun struct {
+	file  *spillFile
+	enc   *StringPointEncoder
+	dec   *StringPointDecoder
+	buf   *StringPoint
+	eof   bool
+	stats IteratorStats
+}
+
+// newStringSpillRun returns a new run written to a temporary file.
+func newStringSpillRun(budget *MemoryBudget) (*stringSpillRun, error) {
+	f, err := budget.createSpillFile()
+	if err != nil {
+		return nil, err
+	}
+	return &stringSpillRun{file: f, enc: NewStringPointEncoder(f)}, nil
+}
+
+// spillStringIterator writes all of the points of input to a new run and
+// closes input.
+func spillStringIterator(input StringIterator, budget *MemoryBudget) (*stringSpillRun, error) {
+	defer input.Close()
+
+	run, err := newStringSpillRun(budget)
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		p, err := input.Next()
+		if err != nil {
+			run.Close()
+			return nil, err
+		} else if p == nil {
+			break
+		}
+
+		if err := run.write(p); err != nil {
+			run.Close()
+			return nil, err
+		}
+	}
+	run.stats = input.Stats()
+	return run, nil
+}
+
+// write appends p to the run. A run cannot be written to once it has been read.
+func (r *stringSpillRun) write(p *StringPoint) error {
+	return r.enc.EncodeStringPoint(p)
+}
+
+// peek returns the next point of the run without consuming it.
+func (r *stringSpillRun) peek() (*StringPoint, error) {
+	if r.buf != nil || r.eof {
+		return r.buf, nil
+	}
+
+	if r.dec == nil {
+		rd, err := r.file.reader()
+		if err != nil {
+			return nil, err
+		}
+		r.dec = NewStringPointDecoder(context.Background(), rd)
+	}
+
+	p := &StringPoint{}
+	if err := r.dec.DecodeStringPoint(p); err == io.EOF {
+		r.eof = true
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	r.buf = p
+	return p, nil
+}
+
+// Next returns the next point of the run.
+func (r *stringSpillRun) Next() (*StringPoint, error) {
+	p, err := r.peek()
+	r.buf = nil
+	return p, err
+}
+
+// Stats returns the stats of the iterator the run was written from.
+func (r *stringSpillRun) Stats() IteratorStats { return r.stats }
+
+// Close closes and removes the temporary file.
+func (r *stringSpillRun) Close() error { return r.file.Close() }
+
+// stringSpillBuffer buffers points in memory until the memory budget is
+// exhausted. The buffered points are then written to a new run on disk.
+// If sorted is set, the points are returned in time order. Otherwise they are
+// returned in the order they were appended.
+type stringSpillBuffer struct {
+	budget *MemoryBudget
+	sorted bool
+	points []StringPoint
+	size   int64
+	runs   []*stringSpillRun
+}
+
+// append adds p to the buffer.
+func (b *stringSpillBuffer) append(p StringPoint) error {
+	b.points = append(b.points, p)
+
+	n := pointSize(p.Name, p.Tags, p.Aux) + int64(len(p.Value))
+	b.size += n
+	if !b.budget.grow(n) {
+		return b.spill()
+	}
+	return nil
+}
+
+// spill writes the points held in memory to a new run.
+func (b *stringSpillBuffer) spill() error {
+	if b.sorted {
+		b.sort()
+	}
+
+	run, err := newStringSpillRun(b.budget)
+	if err != nil {
+		return err
+	}
+	b.runs = append(b.runs, run)
+
+	for i := range b.points {
+		if err := run.write(&b.points[i]); err != nil {
+			return err
+		}
+	}
+	b.points = b.points[:0]
+	b.budget.shrink(b.size)
+	b.size = 0
+	return nil
+}
+
+// sort performs a stable sort of the points held in memory by time.
+func (b *stringSpillBuffer) sort() {
+	sort.Stable(stringPointsByTime(b.points))
+}
+
+// Next returns the next point from the buffer.
+func (b *stringSpillBuffer) Next() (*StringPoint, error) {
+	if !b.sorted {
+		for len(b.runs) > 0 {
+			if p, err := b.runs[0].Next(); err != nil || p != nil {
+				return p, err
+			}
+			b.runs[0].Close()
+			b.runs = b.runs[1:]
+		}
+		return b.pop(), nil
+	}
+
+	// Find the run with the earliest point. Points with equal times are
+	// returned in the order they were appended.
+	var min *stringSpillRun
+	var t int64
+	for _, r := range b.runs {
+		p, err := r.peek()
+		if err != nil {
+			return nil, err
+		} else if p != nil && (min == nil || p.Time < t) {
+			min, t = r, p.Time
+		}
+	}
+
+	if len(b.points) > 0 && (min == nil || b.points[0].Time < t) {
+		return b.pop(), nil
+	} else if min == nil {
+		return nil, nil
+	}
+	return min.Next()
+}
+
+// pop removes and returns the first point held in memory.
+func (b *stringSpillBuffer) pop() *StringPoint {
+	if len(b.points) == 0 {
+		return nil
+	}
+	p := &b.points[0]
+	b.points = b.points[1:]
+	return p
+}
+
+// reset removes all points from the buffer and releases its memory.
+func (b *stringSpillBuffer) reset() {
+	for _, r := range b.runs {
+		r.Close()
+	}
+	b.runs = nil
+
+	// Points previously returned may still be referenced so the
+	// underlying array cannot be reused.
+	b.points = nil
+	b.budget.shrink(b.size)
+	b.size = 0
+}
+
 // stringIteratorScanner scans the results of a StringIterator into a map.
 type stringIteratorScanner struct {
 	input        *bufStringIterator
@@ -8653,7 +9574,7 @@ type stringReduceFloatIterator struct {
 	create   func() (StringPointAggregator, FloatPointEmitter)
 	dims     []string
 	opt      IteratorOptions
-	points   []FloatPoint
+	points   floatSpillBuffer
 	keepTags bool
 }
 
@@ -8663,6 +9584,7 @@ func newStringReduceFloatIterator(input StringIterator, opt IteratorOptions, cre
 		create: createFn,
 		dims:   opt.GetDimensions(),
 		opt:    opt,
+		points: floatSpillBuffer{budget: opt.Memory, sorted: opt.Ordered},
 	}
 }
 
@@ -8670,23 +9592,22 @@ func newStringReduceFloatIterator(input StringIterator, opt IteratorOptions, cre
 func (itr *stringReduceFloatIterator) Stats() IteratorStats { return itr.input.Stats() }
 
 // Close closes the iterator and all child iterators.
-func (itr *stringReduceFloatIterator) Close() error { return itr.input.Close() }
+func (itr *stringReduceFloatIterator) Close() error {
+	itr.points.reset()
+	return itr.input.Close()
+}
 
 // Next returns the minimum value for the next available interval.
 func (itr *stringReduceFloatIterator) Next() (*FloatPoint, error) {
-	// Calculate next window if we have no more points.
-	if len(itr.points) == 0 {
-		var err error
-		itr.points, err = itr.reduce()
-		if len(itr.points) == 0 {
-			return nil, err
-		}
+	if p, err := itr.points.Next(); err != nil || p != nil {
+		return p, err
 	}
 
-	// Pop next point off the stack.
-	p := &itr.points[len(itr.points)-1]
-	itr.points = itr.points[:len(itr.points)-1]
-	return p, nil
+	// Calculate next window if we have no more points.
+	if err := itr.reduce(); err != nil {
+		return nil, err
+	}
+	return itr.points.Next()
 }
 
 // stringReduceFloatPoint stores the reduced data for a name/tag combination.
@@ -8699,7 +9620,9 @@ type stringReduceFloatPoint struct {
 
 // reduce executes fn once for every point in the next window.
 // The previous value for the dimension is passed to fn.
-func (itr *stringReduceFloatIterator) reduce() ([]FloatPoint, error) {
+func (itr *stringReduceFloatIterator) reduce() error {
+	itr.points.reset()
+
 	// Calculate next window.
 	var (
 		startTime, endTime int64
@@ -8711,7 +9634,7 @@ func (itr *stringReduceFloatIterator) reduce() ([]FloatPoint, error) {
 	for {
 		p, err := itr.input.Next()
 		if err != nil || p == nil {
-			return nil, err
+			return err
 		} else if p.Nil {
 			continue
 		}
@@ -8729,7 +9652,7 @@ func (itr *stringReduceFloatIterator) reduce() ([]FloatPoint, error) {
 		// Read next point.
 		curr, err := itr.input.NextInWindow(startTime, endTime)
 		if err != nil {
-			return nil, err
+			return err
 		} else if curr == nil {
 			break
 		} else if curr.Nil {
@@ -8768,23 +9691,22 @@ func (itr *stringReduceFloatIterator) reduce() ([]FloatPoint, error) {
 		rp.Aggregator.AggregateString(curr)
 	}
 
-	// Reverse sort points by name & tag if our output is supposed to be ordered.
+	// Sort points by name & tag if our output is supposed to be ordered.
 	keys := make([]string, 0, len(m))
 	for k := range m {
 		keys = append(keys, k)
 	}
 	if len(keys) > 1 && itr.opt.Ordered {
-		sort.Sort(reverseStringSlice(keys))
+		sort.Strings(keys)
 	}
 
 	// Assume the points are already sorted until proven otherwise.
 	sortedByTime := true
 	// Emit the points for each name & tag combination.
-	a := make([]FloatPoint, 0, len(m))
 	for _, k := range keys {
 		rp := m[k]
 		points := rp.Emitter.Emit()
-		for i := len(points) - 1; i >= 0; i-- {
+		for i := range points {
 			points[i].Name = rp.Name
 			if !itr.keepTags {
 				points[i].Tags = rp.Tags
@@ -8795,16 +9717,18 @@ func (itr *stringReduceFloatIterator) reduce() ([]FloatPoint, error) {
 			} else {
 				sortedByTime = false
 			}
-			a = append(a, points[i])
+			if err := itr.points.append(points[i]); err != nil {
+				return err
+			}
 		}
 	}
 
 	// Points may be out of order. Perform a stable sort by time if requested.
+	// Points spilled to disk have already been sorted.
 	if !sortedByTime && itr.opt.Ordered {
-		sort.Stable(sort.Reverse(floatPointsByTime(a)))
+		itr.points.sort()
 	}
-
-	return a, nil
+	return nil
 }
 
 // stringStreamFloatIterator streams inputs into the iterator and emits points gradually.
@@ -8928,7 +9852,7 @@ type stringReduceIntegerIterator struct {
 	create   func() (StringPointAggregator, IntegerPointEmitter)
 	dims     []string
 	opt      IteratorOptions
-	points   []IntegerPoint
+	points   integerSpillBuffer
 	keepTags bool
 }
 
@@ -8938,6 +9862,7 @@ func newStringReduceIntegerIterator(input StringIterator, opt IteratorOptions, c
 		create: createFn,
 		dims:   opt.GetDimensions(),
 		opt:    opt,
+		points: integerSpillBuffer{budget: opt.Memory, sorted: opt.Ordered},
 	}
 }
 
@@ -8945,23 +9870,22 @@ func newStringReduceIntegerIterator(input StringIterator, opt IteratorOptions, c
 func (itr *stringReduceIntegerIterator) Stats() IteratorStats { return itr.input.Stats() }
 
 // Close closes the iterator and all child iterators.
-func (itr *stringReduceIntegerIterator) Close() error { return itr.input.Close() }
+func (itr *stringReduceIntegerIterator) Close() error {
+	itr.points.reset()
+	return itr.input.Close()
+}
 
 // Next returns the minimum value for the next available interval.
 func (itr *stringReduceIntegerIterator) Next() (*IntegerPoint, error) {
-	// Calculate next window if we have no more points.
-	if len(itr.points) == 0 {
-		var err error
-		itr.points, err = itr.reduce()
-		if len(itr.points) == 0 {
-			return nil, err
-		}
+	if p, err := itr.points.Next(); err != nil || p != nil {
+		return p, err
 	}
 
-	// Pop next point off the stack.
-	p := &itr.points[len(itr.points)-1]
-	itr.points = itr.points[:len(itr.points)-1]
-	return p, nil
+	// Calculate next window if we have no more points.
+	if err := itr.reduce(); err != nil {
+		return nil, err
+	}
+	return itr.points.Next()
 }
 
 // stringReduceIntegerPoint stores the reduced data for a name/tag combination.
@@ -8974,7 +9898,9 @@ type stringReduceIntegerPoint struct {
 
 // reduce executes fn once for every point in the next window.
 // The previous value for the dimension is passed to fn.
-func (itr *stringReduceIntegerIterator) reduce() ([]IntegerPoint, error) {
+func (itr *stringReduceIntegerIterator) reduce() error {
+	itr.points.reset()
+
 	// Calculate next window.
 	var (
 		startTime, endTime int64
@@ -8986,7 +9912,7 @@ func (itr *stringReduceIntegerIterator) reduce() ([]IntegerPoint, error) {
 	for {
 		p, err := itr.input.Next()
 		if err != nil || p == nil {
-			return nil, err
+			return err
 		} else if p.Nil {
 			continue
 		}
@@ -9004,7 +9930,7 @@ func (itr *stringReduceIntegerIterator) reduce() ([]IntegerPoint, error) {
 		// Read next point.
 		curr, err := itr.input.NextInWindow(startTime, endTime)
 		if err != nil {
-			return nil, err
+			return err
 		} else if curr == nil {
 			break
 		} else if curr.Nil {
@@ -9043,23 +9969,22 @@ func (itr *stringReduceIntegerIterator) reduce() ([]IntegerPoint, error) {
 		rp.Aggregator.AggregateString(curr)
 	}
 
-	// Reverse sort points by name & tag if our output is supposed to be ordered.
+	// Sort points by name & tag if our output is supposed to be ordered.
 	keys := make([]string, 0, len(m))
 	for k := range m {
 		keys = append(keys, k)
 	}
 	if len(keys) > 1 && itr.opt.Ordered {
-		sort.Sort(reverseStringSlice(keys))
+		sort.Strings(keys)
 	}
 
 	// Assume the points are already sorted until proven otherwise.
 	sortedByTime := true
 	// Emit the points for each name & tag combination.
-	a := make([]IntegerPoint, 0, len(m))
 	for _, k := range keys {
 		rp := m[k]
 		points := rp.Emitter.Emit()
-		for i := len(points) - 1; i >= 0; i-- {
+		for i := range points {
 			points[i].Name = rp.Name
 			if !itr.keepTags {
 				points[i].Tags = rp.Tags
@@ -9070,16 +9995,18 @@ func (itr *stringReduceIntegerIterator) reduce() ([]IntegerPoint, error) {
 			} else {
 				sortedByTime = false
 			}
-			a = append(a, points[i])
+			if err := itr.points.append(points[i]); err != nil {
+				return err
+			}
 		}
 	}
 
 	// Points may be out of order. Perform a stable sort by time if requested.
+	// Points spilled to disk have already been sorted.
 	if !sortedByTime && itr.opt.Ordered {
-		sort.Stable(sort.Reverse(integerPointsByTime(a)))
+		itr.points.sort()
 	}
-
-	return a, nil
+	return nil
 }
 
 // stringStreamIntegerIterator streams inputs into the iterator and emits points gradually.
@@ -9203,7 +10130,7 @@ type stringReduceUnsignedIterator struct {
 	create   func() (StringPointAggregator, UnsignedPointEmitter)
 	dims     []string
 	opt      IteratorOptions
-	points   []UnsignedPoint
+	points   unsignedSpillBuffer
 	keepTags bool
 }
 
@@ -9213,6 +10140,7 @@ func newStringReduceUnsignedIterator(input StringIterator, opt IteratorOptions,
 		create: createFn,
 		dims:   opt.GetDimensions(),
 		opt:    opt,
+		points: unsignedSpillBuffer{budget: opt.Memory, sorted: opt.Ordered},
 	}
 }
 
@@ -9220,23 +10148,22 @@ func newStringReduceUnsignedIterator(input StringIterator, opt IteratorOptions,
 func (itr *stringReduceUnsignedIterator) Stats() IteratorStats { return itr.input.Stats() }
 
 // Close closes the iterator and all child iterators.
-func (itr *stringReduceUnsignedIterator) Close() error { return itr.input.Close() }
+func (itr *stringReduceUnsignedIterator) Close() error {
+	itr.points.reset()
+	return itr.input.Close()
+}
 
 // Next returns the minimum value for the next available interval.
 func (itr *stringReduceUnsignedIterator) Next() (*UnsignedPoint, error) {
-	// Calculate next window if we have no more points.
-	if len(itr.points) == 0 {
-		var err error
-		itr.points, err = itr.reduce()
-		if len(itr.points) == 0 {
-			return nil, err
-		}
+	if p, err := itr.points.Next(); err != nil || p != nil {
+		return p, err
 	}
 
-	// Pop next point off the stack.
-	p := &itr.points[len(itr.points)-1]
-	itr.points = itr.points[:len(itr.points)-1]
-	return p, nil
+	// Calculate next window if we have no more points.
+	if err := itr.reduce(); err != nil {
+		return nil, err
+	}
+	return itr.points.Next()
 }
 
 // stringReduceUnsignedPoint stores the reduced data for a name/tag combination.
@@ -9249,7 +10176,9 @@ type stringReduceUnsignedPoint struct {
 
 // reduce executes fn once for every point in the next window.
 // The previous value for the dimension is passed to fn.
-func (itr *stringReduceUnsignedIterator) reduce() ([]UnsignedPoint, error) {
+func (itr *stringReduceUnsignedIterator) reduce() error {
+	itr.points.reset()
+
 	// Calculate next window.
 	var (
 		startTime, endTime int64
@@ -9261,7 +10190,7 @@ func (itr *stringReduceUnsignedIterator) reduce() ([]UnsignedPoint, error) {
 	for {
 		p, err := itr.input.Next()
 		if err != nil || p == nil {
-			return nil, err
+			return err
 		} else if p.Nil {
 			continue
 		}
@@ -9279,7 +10208,7 @@ func (itr *stringReduceUnsignedIterator) reduce() ([]UnsignedPoint, error) {
 		// Read next point.
 		curr, err := itr.input.NextInWindow(startTime, endTime)
 		if err != nil {
-			return nil, err
+			return err
 		} else if curr == nil {
 			break
 		} else if curr.Nil {
@@ -9318,23 +10247,22 @@ func (itr *stringReduceUnsignedIterator) reduce() ([]UnsignedPoint, error) {
 		rp.Aggregator.AggregateString(curr)
 	}
 
-	// Reverse sort points by name & tag if our output is supposed to be ordered.
+	// Sort points by name & tag if our output is supposed to be ordered.
 	keys := make([]string, 0, len(m))
 	for k := range m {
 		keys = append(keys, k)
 	}
 	if len(keys) > 1 && itr.opt.Ordered {
-		sort.Sort(reverseStringSlice(keys))
+		sort.Strings(keys)
 	}
 
 	// Assume the points are already sorted until proven otherwise.
 	sortedByTime := true
 	// Emit the points for each name & tag combination.
-	a := make([]UnsignedPoint, 0, len(m))
 	for _, k := range keys {
 		rp := m[k]
 		points := rp.Emitter.Emit()
-		for i := len(points) - 1; i >= 0; i-- {
+		for i := range points {
 			points[i].Name = rp.Name
 			if !itr.keepTags {
 				points[i].Tags = rp.Tags
@@ -9345,16 +10273,18 @@ func (itr *stringReduceUnsignedIterator) reduce() ([]UnsignedPoint, error) {
 			} else {
 				sortedByTime = false
 			}
-			a = append(a, points[i])
+			if err := itr.points.append(points[i]); err != nil {
+				return err
+			}
 		}
 	}
 
 	// Points may be out of order. Perform a stable sort by time if requested.
+	// Points spilled to disk have already been sorted.
 	if !sortedByTime && itr.opt.Ordered {
-		sort.Stable(sort.Reverse(unsignedPointsByTime(a)))
+		itr.points.sort()
 	}
-
-	return a, nil
+	return nil
 }
 
 // stringStreamUnsignedIterator streams inputs into the iterator and emits points gradually.
@@ -9478,7 +10408,7 @@ type stringReduceStringIterator struct {
 	create   func() (StringPointAggregator, StringPointEmitter)
 	dims     []string
 	opt      IteratorOptions
-	points   []StringPoint
+	points   stringSpillBuffer
 	keepTags bool
 }
 
@@ -9488,6 +10418,7 @@ func newStringReduceStringIterator(input StringIterator, opt IteratorOptions, cr
 		create: createFn,
 		dims:   opt.GetDimensions(),
 		opt:    opt,
+		points: stringSpillBuffer{budget: opt.Memory, sorted: opt.Ordered},
 	}
 }
 
@@ -9495,23 +10426,22 @@ func newStringReduceStringIterator(input StringIterator, opt IteratorOptions, cr
 func (itr *stringReduceStringIterator) Stats() IteratorStats { return itr.input.Stats() }
 
 // Close closes the iterator and all child iterators.
-func (itr *stringReduceStringIterator) Close() error { return itr.input.Close() }
+func (itr *stringReduceStringIterator) Close() error {
+	itr.points.reset()
+	return itr.input.Close()
+}
 
 // Next returns the minimum value for the next available interval.
 func (itr *stringReduceStringIterator) Next() (*StringPoint, error) {
-	// Calculate next window if we have no more points.
-	if len(itr.points) == 0 {
-		var err error
-		itr.points, err = itr.reduce()
-		if len(itr.points) == 0 {
-			return nil, err
-		}
+	if p, err := itr.points.Next(); err != nil || p != nil {
+		return p, err
 	}
 
-	// Pop next point off the stack.
-	p := &itr.points[len(itr.points)-1]
-	itr.points = itr.points[:len(itr.points)-1]
-	return p, nil
+	// Calculate next window if we have no more points.
+	if err := itr.reduce(); err != nil {
+		return nil, err
+	}
+	return itr.points.Next()
 }
 
 // stringReduceStringPoint stores the reduced data for a name/tag combination.
@@ -9524,7 +10454,9 @@ type stringReduceStringPoint struct {
 
 // reduce executes fn once for every point in the next window.
 // The previous value for the dimension is passed to fn.
-func (itr *stringReduceStringIterator) reduce() ([]StringPoint, error) {
+func (itr *stringReduceStringIterator) reduce() error {
+	itr.points.reset()
+
 	// Calculate next window.
 	var (
 		startTime, endTime int64
@@ -9536,7 +10468,7 @@ func (itr *stringReduceStringIterator) reduce() ([]StringPoint, error) {
 	for {
 		p, err := itr.input.Next()
 		if err != nil || p == nil {
-			return nil, err
+			return err
 		} else if p.Nil {
 			continue
 		}
@@ -9554,7 +10486,7 @@ func (itr *stringReduceStringIterator) reduce() ([]StringPoint, error) {
 		// Read next point.
 		curr, err := itr.input.NextInWindow(startTime, endTime)
 		if err != nil {
-			return nil, err
+			return err
 		} else if curr == nil {
 			break
 		} else if curr.Nil {
@@ -9593,23 +10525,22 @@ func (itr *stringReduceStringIterator) reduce() ([]StringPoint, error) {
 		rp.Aggregator.AggregateString(curr)
 	}
 
-	// Reverse sort points by name & tag if our output is supposed to be ordered.
+	// Sort points by name & tag if our output is supposed to be ordered.
 	keys := make([]string, 0, len(m))
 	for k := range m {
 		keys = append(keys, k)
 	}
 	if len(keys) > 1 && itr.opt.Ordered {
-		sort.Sort(reverseStringSlice(keys))
+		sort.Strings(keys)
 	}
 
 	// Assume the points are already sorted until proven otherwise.
 	sortedByTime := true
 	// Emit the points for each name & tag combination.
-	a := make([]StringPoint, 0, len(m))
 	for _, k := range keys {
 		rp := m[k]
 		points := rp.Emitter.Emit()
-		for i := len(points) - 1; i >= 0; i-- {
+		for i := range points {
 			points[i].Name = rp.Name
 			if !itr.keepTags {
 				points[i].Tags = rp.Tags
@@ -9620,16 +10551,18 @@ func (itr *stringReduceStringIterator) reduce() ([]StringPoint, error) {
 			} else {
 				sortedByTime = false
 			}
-			a = append(a, points[i])
+			if err := itr.points.append(points[i]); err != nil {
+				return err
+			}
 		}
 	}
 
 	// Points may be out of order. Perform a stable sort by time if requested.
+	// Points spilled to disk have already been sorted.
 	if !sortedByTime && itr.opt.Ordered {
-		sort.Stable(sort.Reverse(stringPointsByTime(a)))
+		itr.points.sort()
 	}
-
-	return a, nil
+	return nil
 }
 
 // stringStreamStringIterator streams inputs into the iterator and emits points gradually.
@@ -9753,7 +10686,7 @@ type stringReduceBooleanIterator struct {
 	create   func() (StringPointAggregator, BooleanPointEmitter)
 	dims     []string
 	opt      IteratorOptions
-	points   []BooleanPoint
+	points   booleanSpillBuffer
 	keepTags bool
 }
 
@@ -9763,6 +10696,7 @@ func newStringReduceBooleanIterator(input StringIterator, opt IteratorOptions, c
 		create: createFn,
 		dims:   opt.GetDimensions(),
 		opt:    opt,
+		points: booleanSpillBuffer{budget: opt.Memory, sorted: opt.Ordered},
 	}
 }
 
@@ -9770,23 +10704,22 @@ func newStringReduceBooleanIterator(input StringIterator, opt IteratorOptions, c
 func (itr *stringReduceBooleanIterator) Stats() IteratorStats { return itr.input.Stats() }
 
 // Close closes the iterator and all child iterators.
-func (itr *stringReduceBooleanIterator) Close() error { return itr.input.Close() }
+func (itr *stringReduceBooleanIterator) Close() error {
+	itr.points.reset()
+	return itr.input.Close()
+}
 
 // Next returns the minimum value for the next available interval.
 func (itr *stringReduceBooleanIterator) Next() (*BooleanPoint, error) {
-	// Calculate next window if we have no more points.
-	if len(itr.points) == 0 {
-		var err error
-		itr.points, err = itr.reduce()
-		if len(itr.points) == 0 {
-			return nil, err
-		}
+	if p, err := itr.points.Next(); err != nil || p != nil {
+		return p, err
 	}
 
-	// Pop next point off the stack.
-	p := &itr.points[len(itr.points)-1]
-	itr.points = itr.points[:len(itr.points)-1]
-	return p, nil
+	// Calculate next window if we have no more points.
+	if err := itr.reduce(); err != nil {
+		return nil, err
+	}
+	return itr.points.Next()
 }
 
 // stringReduceBooleanPoint stores the reduced data for a name/tag combination.
@@ -9799,7 +10732,9 @@ type stringReduceBooleanPoint struct {
 
 // reduce executes fn once for every point in the next window.
 // The previous value for the dimension is passed to fn.
-func (itr *stringReduceBooleanIterator) reduce() ([]BooleanPoint, error) {
+func (itr *stringReduceBooleanIterator) reduce() error {
+	itr.points.reset()
+
 	// Calculate next window.
 	var (
 		startTime, endTime int64
@@ -9811,7 +10746,7 @@ func (itr *stringReduceBooleanIterator) reduce() ([]BooleanPoint, error) {
 	for {
 		p, err := itr.input.Next()
 		if err != nil || p == nil {
-			return nil, err
+			return err
 		} else if p.Nil {
 			continue
 		}
@@ -9829,7 +10764,7 @@ func (itr *stringReduceBooleanIterator) reduce() ([]BooleanPoint, error) {
 		// Read next point.
 		curr, err := itr.input.NextInWindow(startTime, endTime)
 		if err != nil {
-			return nil, err
+			return err
 		} else if curr == nil {
 			break
 		} else if curr.Nil {
@@ -9868,23 +10803,22 @@ func (itr *stringReduceBooleanIterator) reduce() ([]BooleanPoint, error) {
 		rp.Aggregator.AggregateString(curr)
 	}
 
-	// Reverse sort points by name & tag if our output is supposed to be ordered.
+	// Sort points by name & tag if our output is supposed to be ordered.
 	keys := make([]string, 0, len(m))
 	for k := range m {
 		keys = append(keys, k)
 	}
 	if len(keys) > 1 && itr.opt.Ordered {
-		sort.Sort(reverseStringSlice(keys))
+		sort.Strings(keys)
 	}
 
 	// Assume the points are already sorted until proven otherwise.
 	sortedByTime := true
 	// Emit the points for each name & tag combination.
-	a := make([]BooleanPoint, 0, len(m))
 	for _, k := range keys {
 		rp := m[k]
 		points := rp.Emitter.Emit()
-		for i := len(points) - 1; i >= 0; i-- {
+		for i := range points {
 			points[i].Name = rp.Name
 			if !itr.keepTags {
 				points[i].Tags = rp.Tags
@@ -9895,16 +10829,18 @@ func (itr *stringReduceBooleanIterator) reduce() ([]BooleanPoint, error) {
 			} else {
 				sortedByTime = false
 			}
-			a = append(a, points[i])
+			if err := itr.points.append(points[i]); err != nil {
+				return err
+			}
 		}
 	}
 
 	// Points may be out of order. Perform a stable sort by time if requested.
+	// Points spilled to disk have already been sorted.
 	if !sortedByTime && itr.opt.Ordered {
-		sort.Stable(sort.Reverse(booleanPointsByTime(a)))
+		itr.points.sort()
 	}
-
-	return a, nil
+	return nil
 }
 
 // stringStreamBooleanIterator streams inputs into the iterator and emits points gradually.
@@ -10527,6 +11463,9 @@ type booleanSortedMergeIterator struct {
 	inputs []BooleanIterator
 	heap   *booleanSortedMergeHeap
 	init   bool
+
+	budget   *MemoryBudget
+	reserved int64
 }
 
 // newBooleanSortedMergeIterator returns an instance of booleanSortedMergeIterator.
@@ -10537,6 +11476,7 @@ func newBooleanSortedMergeIterator(inputs []BooleanIterator, opt IteratorOptions
 			items: make([]*booleanSortedMergeHeapItem, 0, len(inputs)),
 			opt:   opt,
 		},
+		budget: opt.Memory,
 	}
 
 	// Initialize heap items.
@@ -10562,6 +11502,8 @@ func (itr *booleanSortedMergeIterator) Close() error {
 	for _, input := range itr.inputs {
 		input.Close()
 	}
+	itr.budget.shrink(itr.reserved)
+	itr.reserved = 0
 	return nil
 }
 
@@ -10575,7 +11517,20 @@ func (itr *booleanSortedMergeIterator) pop() (*BooleanPoint, error) {
 	if !itr.init {
 		items := itr.heap.items
 		itr.heap.items = make([]*booleanSortedMergeHeapItem, 0, len(items))
-		for _, item := range items {
+		for i, item := range items {
+			// Reserve memory for the input. If the budget is exhausted then
+			// spill the input to disk so it can be closed.
+			if itr.budget.grow(mergeInputSize) {
+				itr.reserved += mergeInputSize
+			} else {
+				itr.budget.shrink(mergeInputSize)
+				run, err := spillBooleanIterator(item.itr, itr.budget)
+				if err != nil {
+					return nil, err
+				}
+				item.itr, itr.inputs[i] = run, run
+			}
+
 			var err error
 			if item.point, err = item.itr.Next(); err != nil {
 				return nil, err
@@ -10701,6 +11656,206 @@ type booleanSortedMergeHeapItem struct {
 	itr   BooleanIterator
 }
 
+// booleanSpillRun is a run of points written to a temporary file.
+type booleanSpillRun struct {
+	file  *spillFile
+	enc   *BooleanPointEncoder
+	dec   *BooleanPointDecoder
+	buf   *BooleanPoint
+	eof   bool
+	stats IteratorStats
+}
+
+// newBooleanSpillRun returns a new run written to a temporary file.
+func newBooleanSpillRun(budget *MemoryBudget) (*booleanSpillRun, error) {
+	f, err := budget.createSpillFile()
+	if err != nil {
+		return nil, err
+	}
+	return &booleanSpillRun{file: f, enc: NewBooleanPointEncoder(f)}, nil
+}
+
+// spillBooleanIterator writes all of the points of input to a new run and
+// closes input.
+func spillBooleanIterator(input BooleanIterator, budget *MemoryBudget) (*booleanSpillRun, error) {
+	defer input.Close()
+
+	run, err := newBooleanSpillRun(budget)
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		p, err := input.Next()
+		if err != nil {
+			run.Close()
+			return nil, err
+		} else if p == nil {
+			break
+		}
+
+		if err := run.write(p); err != nil {
+			run.Close()
+			return nil, err
+		}
+	}
+	run.stats = input.Stats()
+	return run, nil
+}
+
+// write appends p to the run. A run cannot be written to once it has been read.
+func (r *booleanSpillRun) write(p *BooleanPoint) error {
+	return r.enc.EncodeBooleanPoint(p)
+}
+
+// peek returns the next point of the run without consuming it.
+func (r *booleanSpillRun) peek() (*BooleanPoint, error) {
+	if r.buf != nil || r.eof {
+		return r.buf, nil
+	}
+
+	if r.dec == nil {
+		rd, err := r.file.reader()
+		if err != nil {
+			return nil, err
+		}
+		r.dec = NewBooleanPointDecoder(context.Background(), rd)
+	}
+
+	p := &BooleanPoint{}
+	if err := r.dec.DecodeBooleanPoint(p); err == io.EOF {
+		r.eof = true
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	r.buf = p
+	return p, nil
+}
+
+// Next returns the next point of the run.
+func (r *booleanSpillRun) Next() (*BooleanPoint, error) {
+	p, err := r.peek()
+	r.buf = nil
+	return p, err
+}
+
+// Stats returns the stats of the iterator the run was written from.
+func (r *booleanSpillRun) Stats() IteratorStats { return r.stats }
+
+// Close closes and removes the temporary file.
+func (r *booleanSpillRun) Close() error { return r.file.Close() }
+
+// booleanSpillBuffer buffers points in memory until the memory budget is
+// exhausted. The buffered points are then written to a new run on disk.
+// If sorted is set, the points are returned in time order. Otherwise they are
+// returned in the order they were appended.
+type booleanSpillBuffer struct {
+	budget *MemoryBudget
+	sorted bool
+	points []BooleanPoint
+	size   int64
+	runs   []*booleanSpillRun
+}
+
+// append adds p to the buffer.
+func (b *booleanSpillBuffer) append(p BooleanPoint) error {
+	b.points = append(b.points, p)
+
+	n := pointSize(p.Name, p.Tags, p.Aux)
+	b.size += n
+	if !b.budget.grow(n) {
+		return b.spill()
+	}
+	return nil
+}
+
+// spill writes the points held in memory to a new run.
+func (b *booleanSpillBuffer) spill() error {
+	if b.sorted {
+		b.sort()
+	}
+
+	run, err := newBooleanSpillRun(b.budget)
+	if err != nil {
+		return err
+	}
+	b.runs = append(b.runs, run)
+
+	for i := range b.points {
+		if err := run.write(&b.points[i]); err != nil {
+			return err
+		}
+	}
+	b.points = b.points[:0]
+	b.budget.shrink(b.size)
+	b.size = 0
+	return nil
+}
+
+// sort performs a stable sort of the points held in memory by time.
+func (b *booleanSpillBuffer) sort() {
+	sort.Stable(booleanPointsByTime(b.points))
+}
+
+// Next returns the next point from the buffer.
+func (b *booleanSpillBuffer) Next() (*BooleanPoint, error) {
+	if !b.sorted {
+		for len(b.runs) > 0 {
+			if p, err := b.runs[0].Next(); err != nil || p != nil {
+				return p, err
+			}
+			b.runs[0].Close()
+			b.runs = b.runs[1:]
+		}
+		return b.pop(), nil
+	}
+
+	// Find the run with the earliest point. Points with equal times are
+	// returned in the order they were appended.
+	var min *booleanSpillRun
+	var t int64
+	for _, r := range b.runs {
+		p, err := r.peek()
+		if err != nil {
+			return nil, err
+		} else if p != nil && (min == nil || p.Time < t) {
+			min, t = r, p.Time
+		}
+	}
+
+	if len(b.points) > 0 && (min == nil || b.points[0].Time < t) {
+		return b.pop(), nil
+	} else if min == nil {
+		return nil, nil
+	}
+	return min.Next()
+}
+
+// pop removes and returns the first point held in memory.
+func (b *booleanSpillBuffer) pop() *BooleanPoint {
+	if len(b.points) == 0 {
+		return nil
+	}
+	p := &b.points[0]
+	b.points = b.points[1:]
+	return p
+}
+
+// reset removes all points from the buffer and releases its memory.
+func (b *booleanSpillBuffer) reset() {
+	for _, r := range b.runs {
+		r.Close()
+	}
+	b.runs = nil
+
+	// Points previously returned may still be referenced so the
+	// underlying array cannot be reused.
+	b.points = nil
+	b.budget.shrink(b.size)
+	b.size = 0
+}
+
 // booleanIteratorScanner scans the results of a BooleanIterator into a map.
 type booleanIteratorScanner struct {
 	input        *bufBooleanIterator
@@ -11195,7 +12350,7 @@ type booleanReduceFloatIterator struct {
 	create   func() (BooleanPointAggregator, FloatPointEmitter)
 	dims     []string
 	opt      IteratorOptions
-	points   []FloatPoint
+	points   floatSpillBuffer
 	keepTags bool
 }
 
@@ -11205,6 +12360,7 @@ func newBooleanReduceFloatIterator(input BooleanIterator, opt IteratorOptions, c
 		create: createFn,
 		dims:   opt.GetDimensions(),
 		opt:    opt,
+		points: floatSpillBuffer{budget: opt.Memory, sorted: opt.Ordered},
 	}
 }
 
@@ -11212,23 +12368,22 @@ func newBooleanReduceFloatIterator(input BooleanIterator, opt IteratorOptions, c
 func (itr *booleanReduceFloatIterator) Stats() IteratorStats { return itr.input.Stats() }
 
 // Close closes the iterator and all child iterators.
-func (itr *booleanReduceFloatIterator) Close() error { return itr.input.Close() }
+func (itr *booleanReduceFloatIterator) Close() error {
+	itr.points.reset()
+	return itr.input.Close()
+}
 
 // Next returns the minimum value for the next available interval.
 func (itr *booleanReduceFloatIterator) Next() (*FloatPoint, error) {
-	// Calculate next window if we have no more points.
-	if len(itr.points) == 0 {
-		var err error
-		itr.points, err = itr.reduce()
-		if len(itr.points) == 0 {
-			return nil, err
-		}
+	if p, err := itr.points.Next(); err != nil || p != nil {
+		return p, err
 	}
 
-	// Pop next point off the stack.
-	p := &itr.points[len(itr.points)-1]
-	itr.points = itr.points[:len(itr.points)-1]
-	return p, nil
+	// Calculate next window if we have no more points.
+	if err := itr.reduce(); err != nil {
+		return nil, err
+	}
+	return itr.points.Next()
 }
 
 // booleanReduceFloatPoint stores the reduced data for a name/tag combination.
@@ -11241,7 +12396,9 @@ type booleanReduceFloatPoint struct {
 
 // reduce executes fn once for every point in the next window.
 // The previous value for the dimension is passed to fn.
-func (itr *booleanReduceFloatIterator) reduce() ([]FloatPoint, error) {
+func (itr *booleanReduceFloatIterator) reduce() error {
+	itr.points.reset()
+
 	// Calculate next window.
 	var (
 		startTime, endTime int64
@@ -11253,7 +12410,7 @@ func (itr *booleanReduceFloatIterator) reduce() ([]FloatPoint, error) {
 	for {
 		p, err := itr.input.Next()
 		if err != nil || p == nil {
-			return nil, err
+			return err
 		} else if p.Nil {
 			continue
 		}
@@ -11271,7 +12428,7 @@ func (itr *booleanReduceFloatIterator) reduce() ([]FloatPoint, error) {
 		// Read next point.
 		curr, err := itr.input.NextInWindow(startTime, endTime)
 		if err != nil {
-			return nil, err
+			return err
 		} else if curr == nil {
 			break
 		} else if curr.Nil {
@@ -11310,23 +12467,22 @@ func (itr *booleanReduceFloatIterator) reduce() ([]FloatPoint, error) {
 		rp.Aggregator.AggregateBoolean(curr)
 	}
 
-	// Reverse sort points by name & tag if our output is supposed to be ordered.
+	// Sort points by name & tag if our output is supposed to be ordered.
 	keys := make([]string, 0, len(m))
 	for k := range m {
 		keys = append(keys, k)
 	}
 	if len(keys) > 1 && itr.opt.Ordered {
-		sort.Sort(reverseStringSlice(keys))
+		sort.Strings(keys)
 	}
 
 	// Assume the points are already sorted until proven otherwise.
 	sortedByTime := true
 	// Emit the points for each name & tag combination.
-	a := make([]FloatPoint, 0, len(m))
 	for _, k := range keys {
 		rp := m[k]
 		points := rp.Emitter.Emit()
-		for i := len(points) - 1; i >= 0; i-- {
+		for i := range points {
 			points[i].Name = rp.Name
 			if !itr.keepTags {
 				points[i].Tags = rp.Tags
@@ -11337,16 +12493,18 @@ func (itr *booleanReduceFloatIterator) reduce() ([]FloatPoint, error) {
 			} else {
 				sortedByTime = false
 			}
-			a = append(a, points[i])
+			if err := itr.points.append(points[i]); err != nil {
+				return err
+			}
 		}
 	}
 
 	// Points may be out of order. Perform a stable sort by time if requested.
+	// Points spilled to disk have already been sorted.
 	if !sortedByTime && itr.opt.Ordered {
-		sort.Stable(sort.Reverse(floatPointsByTime(a)))
+		itr.points.sort()
 	}
-
-	return a, nil
+	return nil
 }
 
 // booleanStreamFloatIterator streams inputs into the iterator and emits points gradually.
@@ -11470,7 +12628,7 @@ type booleanReduceIntegerIterator struct {
 	create   func() (BooleanPointAggregator, IntegerPointEmitter)
 	dims     []string
 	opt      IteratorOptions
-	points   []IntegerPoint
+	points   integerSpillBuffer
 	keepTags bool
 }
 
@@ -11480,6 +12638,7 @@ func newBooleanReduceIntegerIterator(input BooleanIterator, opt IteratorOptions,
 		create: createFn,
 		dims:   opt.GetDimensions(),
 		opt:    opt,
+		points: integerSpillBuffer{budget: opt.Memory, sorted: opt.Ordered},
 	}
 }
 
@@ -11487,23 +12646,22 @@ func newBooleanReduceIntegerIterator(input BooleanIterator, opt IteratorOptions,
 func (itr *booleanReduceIntegerIterator) Stats() IteratorStats { return itr.input.Stats() }
 
 // Close closes the iterator and all child iterators.
-func (itr *booleanReduceIntegerIterator) Close() error { return itr.input.Close() }
+func (itr *booleanReduceIntegerIterator) Close() error {
+	itr.points.reset()
+	return itr.input.Close()
+}
 
 // Next returns the minimum value for the next available interval.
 func (itr *booleanReduceIntegerIterator) Next() (*IntegerPoint, error) {
-	// Calculate next window if we have no more points.
-	if len(itr.points) == 0 {
-		var err error
-		itr.points, err = itr.reduce()
-		if len(itr.points) == 0 {
-			return nil, err
-		}
+	if p, err := itr.points.Next(); err != nil || p != nil {
+		return p, err
 	}
 
-	// Pop next point off the stack.
-	p := &itr.points[len(itr.points)-1]
-	itr.points = itr.points[:len(itr.points)-1]
-	return p, nil
+	// Calculate next window if we have no more points.
+	if err := itr.reduce(); err != nil {
+		return nil, err
+	}
+	return itr.points.Next()
 }
 
 // booleanReduceIntegerPoint stores the reduced data for a name/tag combination.
@@ -11516,7 +12674,9 @@ type booleanReduceIntegerPoint struct {
 
 // reduce executes fn once for every point in the next window.
 // The previous value for the dimension is passed to fn.
-func (itr *booleanReduceIntegerIterator) reduce() ([]IntegerPoint, error) {
+func (itr *booleanReduceIntegerIterator) reduce() error {
+	itr.points.reset()
+
 	// Calculate next window.
 	var (
 		startTime, endTime int64
@@ -11528,7 +12688,7 @@ func (itr *booleanReduceIntegerIterator) reduce() ([]IntegerPoint, error) {
 	for {
 		p, err := itr.input.Next()
 		if err != nil || p == nil {
-			return nil, err
+			return err
 		} else if p.Nil {
 			continue
 		}
@@ -11546,7 +12706,7 @@ func (itr *booleanReduceIntegerIterator) reduce() ([]IntegerPoint, error) {
 		// Read next point.
 		curr, err := itr.input.NextInWindow(startTime, endTime)
 		if err != nil {
-			return nil, err
+			return err
 		} else if curr == nil {
 			break
 		} else if curr.Nil {
@@ -11585,23 +12745,22 @@ func (itr *booleanReduceIntegerIterator) reduce() ([]IntegerPoint, error) {
 		rp.Aggregator.AggregateBoolean(curr)
 	}
 
-	// Reverse sort points by name & tag if our output is supposed to be ordered.
+	// Sort points by name & tag if our output is supposed to be ordered.
 	keys := make([]string, 0, len(m))
 	for k := range m {
 		keys = append(keys, k)
 	}
 	if len(keys) > 1 && itr.opt.Ordered {
-		sort.Sort(reverseStringSlice(keys))
+		sort.Strings(keys)
 	}
 
 	// Assume the points are already sorted until proven otherwise.
 	sortedByTime := true
 	// Emit the points for each name & tag combination.
-	a := make([]IntegerPoint, 0, len(m))
 	for _, k := range keys {
 		rp := m[k]
 		points := rp.Emitter.Emit()
-		for i := len(points) - 1; i >= 0; i-- {
+		for i := range points {
 			points[i].Name = rp.Name
 			if !itr.keepTags {
 				points[i].Tags = rp.Tags
@@ -11612,16 +12771,18 @@ func (itr *booleanReduceIntegerIterator) reduce() ([]IntegerPoint, error) {
 			} else {
 				sortedByTime = false
 			}
-			a = append(a, points[i])
+			if err := itr.points.append(points[i]); err != nil {
+				return err
+			}
 		}
 	}
 
 	// Points may be out of order. Perform a stable sort by time if requested.
+	// Points spilled to disk have already been sorted.
 	if !sortedByTime && itr.opt.Ordered {
-		sort.Stable(sort.Reverse(integerPointsByTime(a)))
+		itr.points.sort()
 	}
-
-	return a, nil
+	return nil
 }
 
 // booleanStreamIntegerIterator streams inputs into the iterator and emits points gradually.
@@ -11745,7 +12906,7 @@ type booleanReduceUnsignedIterator struct {
 	create   func() (BooleanPointAggregator, UnsignedPointEmitter)
 	dims     []string
 	opt      IteratorOptions
-	points   []UnsignedPoint
+	points   unsignedSpillBuffer
 	keepTags bool
 }
 
@@ -11755,6 +12916,7 @@ func newBooleanReduceUnsignedIterator(input BooleanIterator, opt IteratorOptions
 		create: createFn,
 		dims:   opt.GetDimensions(),
 		opt:    opt,
+		points: unsignedSpillBuffer{budget: opt.Memory, sorted: opt.Ordered},
 	}
 }
 
@@ -11762,23 +12924,22 @@ func newBooleanReduceUnsignedIterator(input BooleanIterator, opt IteratorOptions
 func (itr *booleanReduceUnsignedIterator) Stats() IteratorStats { return itr.input.Stats() }
 
 // Close closes the iterator and all child iterators.
-func (itr *booleanReduceUnsignedIterator) Close() error { return itr.input.Close() }
+func (itr *booleanReduceUnsignedIterator) Close() error {
+	itr.points.reset()
+	return itr.input.Close()
+}
 
 // Next returns the minimum value for the next available interval.
 func (itr *booleanReduceUnsignedIterator) Next() (*UnsignedPoint, error) {
-	// Calculate next window if we have no more points.
-	if len(itr.points) == 0 {
-		var err error
-		itr.points, err = itr.reduce()
-		if len(itr.points) == 0 {
-			return nil, err
-		}
+	if p, err := itr.points.Next(); err != nil || p != nil {
+		return p, err
 	}
 
-	// Pop next point off the stack.
-	p := &itr.points[len(itr.points)-1]
-	itr.points = itr.points[:len(itr.points)-1]
-	return p, nil
+	// Calculate next window if we have no more points.
+	if err := itr.reduce(); err != nil {
+		return nil, err
+	}
+	return itr.points.Next()
 }
 
 // booleanReduceUnsignedPoint stores the reduced data for a name/tag combination.
@@ -11791,7 +12952,9 @@ type booleanReduceUnsignedPoint struct {
 
 // reduce executes fn once for every point in the next window.
 // The previous value for the dimension is passed to fn.
-func (itr *booleanReduceUnsignedIterator) reduce() ([]UnsignedPoint, error) {
+func (itr *booleanReduceUnsignedIterator) reduce() error {
+	itr.points.reset()
+
 	// Calculate next window.
 	var (
 		startTime, endTime int64
@@ -11803,7 +12966,7 @@ func (itr *booleanReduceUnsignedIterator) reduce() ([]UnsignedPoint, error) {
 	for {
 		p, err := itr.input.Next()
 		if err != nil || p == nil {
-			return nil, err
+			return err
 		} else if p.Nil {
 			continue
 		}
@@ -11821,7 +12984,7 @@ func (itr *booleanReduceUnsignedIterator) reduce() ([]UnsignedPoint, error) {
 		// Read next point.
 		curr, err := itr.input.NextInWindow(startTime, endTime)
 		if err != nil {
-			return nil, err
+			return err
 		} else if curr == nil {
 			break
 		} else if curr.Nil {
@@ -11860,23 +13023,22 @@ func (itr *booleanReduceUnsignedIterator) reduce() ([]UnsignedPoint, error) {
 		rp.Aggregator.AggregateBoolean(curr)
 	}
 
-	// Reverse sort points by name & tag if our output is supposed to be ordered.
+	// Sort points by name & tag if our output is supposed to be ordered.
 	keys := make([]string, 0, len(m))
 	for k := range m {
 		keys = append(keys, k)
 	}
 	if len(keys) > 1 && itr.opt.Ordered {
-		sort.Sort(reverseStringSlice(keys))
+		sort.Strings(keys)
 	}
 
 	// Assume the points are already sorted until proven otherwise.
 	sortedByTime := true
 	// Emit the points for each name & tag combination.
-	a := make([]UnsignedPoint, 0, len(m))
 	for _, k := range keys {
 		rp := m[k]
 		points := rp.Emitter.Emit()
-		for i := len(points) - 1; i >= 0; i-- {
+		for i := range points {
 			points[i].Name = rp.Name
 			if !itr.keepTags {
 				points[i].Tags = rp.Tags
@@ -11887,16 +13049,18 @@ func (itr *booleanReduceUnsignedIterator) reduce() ([]UnsignedPoint, error) {
 			} else {
 				sortedByTime = false
 			}
-			a = append(a, points[i])
+			if err := itr.points.append(points[i]); err != nil {
+				return err
+			}
 		}
 	}
 
 	// Points may be out of order. Perform a stable sort by time if requested.
+	// Points spilled to disk have already been sorted.
 	if !sortedByTime && itr.opt.Ordered {
-		sort.Stable(sort.Reverse(unsignedPointsByTime(a)))
+		itr.points.sort()
 	}
-
-	return a, nil
+	return nil
 }
 
 // booleanStreamUnsignedIterator streams inputs into the iterator and emits points gradually.
@@ -12020,7 +13184,7 @@ type booleanReduceStringIterator struct {
 	create   func() (BooleanPointAggregator, StringPointEmitter)
 	dims     []string
 	opt      IteratorOptions
-	points   []StringPoint
+	points   stringSpillBuffer
 	keepTags bool
 }
 
@@ -12030,6 +13194,7 @@ func newBooleanReduceStringIterator(input BooleanIterator, opt IteratorOptions,
 		create: createFn,
 		dims:   opt.GetDimensions(),
 		opt:    opt,
+		points: stringSpillBuffer{budget: opt.Memory, sorted: opt.Ordered},
 	}
 }
 
@@ -12037,23 +13202,22 @@ func newBooleanReduceStringIterator(input BooleanIterator, opt IteratorOptions,
 func (itr *booleanReduceStringIterator) Stats() IteratorStats { return itr.input.Stats() }
 
 // Close closes the iterator and all child iterators.
-func (itr *booleanReduceStringIterator) Close() error { return itr.input.Close() }
+func (itr *booleanReduceStringIterator) Close() error {
+	itr.points.reset()
+	return itr.input.Close()
+}
 
 // Next returns the minimum value for the next available interval.
 func (itr *booleanReduceStringIterator) Next() (*StringPoint, error) {
-	// Calculate next window if we have no more points.
-	if len(itr.points) == 0 {
-		var err error
-		itr.points, err = itr.reduce()
-		if len(itr.points) == 0 {
-			return nil, err
-		}
+	if p, err := itr.points.Next(); err != nil || p != nil {
+		return p, err
 	}
 
-	// Pop next point off the stack.
-	p := &itr.points[len(itr.points)-1]
-	itr.points = itr.points[:len(itr.points)-1]
-	return p, nil
+	// Calculate next window if we have no more points.
+	if err := itr.reduce(); err != nil {
+		return nil, err
+	}
+	return itr.points.Next()
 }
 
 // booleanReduceStringPoint stores the reduced data for a name/tag combination.
@@ -12066,7 +13230,9 @@ type booleanReduceStringPoint struct {
 
 // reduce executes fn once for every point in the next window.
 // The previous value for the dimension is passed to fn.
-func (itr *booleanReduceStringIterator) reduce() ([]StringPoint, error) {
+func (itr *booleanReduceStringIterator) reduce() error {
+	itr.points.reset()
+
 	// Calculate next window.
 	var (
 		startTime, endTime int64
@@ -12078,7 +13244,7 @@ func (itr *booleanReduceStringIterator) reduce() ([]StringPoint, error) {
 	for {
 		p, err := itr.input.Next()
 		if err != nil || p == nil {
-			return nil, err
+			return err
 		} else if p.Nil {
 			continue
 		}
@@ -12096,7 +13262,7 @@ func (itr *booleanReduceStringIterator) reduce() ([]StringPoint, error) {
 		// Read next point.
 		curr, err := itr.input.NextInWindow(startTime, endTime)
 		if err != nil {
-			return nil, err
+			return err
 		} else if curr == nil {
 			break
 		} else if curr.Nil {
@@ -12135,23 +13301,22 @@ func (itr *booleanReduceStringIterator) reduce() ([]StringPoint, error) {
 		rp.Aggregator.AggregateBoolean(curr)
 	}
 
-	// Reverse sort points by name & tag if our output is supposed to be ordered.
+	// Sort points by name & tag if our output is supposed to be ordered.
 	keys := make([]string, 0, len(m))
 	for k := range m {
 		keys = append(keys, k)
 	}
 	if len(keys) > 1 && itr.opt.Ordered {
-		sort.Sort(reverseStringSlice(keys))
+		sort.Strings(keys)
 	}
 
 	// Assume the points are already sorted until proven otherwise.
 	sortedByTime := true
 	// Emit the points for each name & tag combination.
-	a := make([]StringPoint, 0, len(m))
 	for _, k := range keys {
 		rp := m[k]
 		points := rp.Emitter.Emit()
-		for i := len(points) - 1; i >= 0; i-- {
+		for i := range points {
 			points[i].Name = rp.Name
 			if !itr.keepTags {
 				points[i].Tags = rp.Tags
@@ -12162,16 +13327,18 @@ func (itr *booleanReduceStringIterator) reduce() ([]StringPoint, error) {
 			} else {
 				sortedByTime = false
 			}
-			a = append(a, points[i])
+			if err := itr.points.append(points[i]); err != nil {
+				return err
+			}
 		}
 	}
 
 	// Points may be out of order. Perform a stable sort by time if requested.
+	// Points spilled to disk have already been sorted.
 	if !sortedByTime && itr.opt.Ordered {
-		sort.Stable(sort.Reverse(stringPointsByTime(a)))
+		itr.points.sort()
 	}
-
-	return a, nil
+	return nil
 }
 
 // booleanStreamStringIterator streams inputs into the iterator and emits points gradually.
@@ -12295,7 +13462,7 @@ type booleanReduceBooleanIterator struct {
 	create   func() (BooleanPointAggregator, BooleanPointEmitter)
 	dims     []string
 	opt      IteratorOptions
-	points   []BooleanPoint
+	points   booleanSpillBuffer
 	keepTags bool
 }
 
@@ -12305,6 +13472,7 @@ func newBooleanReduceBooleanIterator(input BooleanIterator, opt IteratorOptions,
 		create: createFn,
 		dims:   opt.GetDimensions(),
 		opt:    opt,
+		points: booleanSpillBuffer{budget: opt.Memory, sorted: opt.Ordered},
 	}
 }
 
@@ -12312,23 +13480,22 @@ func newBooleanReduceBooleanIterator(input BooleanIterator, opt IteratorOptions,
 func (itr *booleanReduceBooleanIterator) Stats() IteratorStats { return itr.input.Stats() }
 
 // Close closes the iterator and all child iterators.
-func (itr *booleanReduceBooleanIterator) Close() error { return itr.input.Close() }
+func (itr *booleanReduceBooleanIterator) Close() error {
+	itr.points.reset()
+	return itr.input.Close()
+}
 
 // Next returns the minimum value for the next available interval.
 func (itr *booleanReduceBooleanIterator) Next() (*BooleanPoint, error) {
-	// Calculate next window if we have no more points.
-	if len(itr.points) == 0 {
-		var err error
-		itr.points, err = itr.reduce()
-		if len(itr.points) == 0 {
-			return nil, err
-		}
+	if p, err := itr.points.Next(); err != nil || p != nil {
+		return p, err
 	}
 
-	// Pop next point off the stack.
-	p := &itr.points[len(itr.points)-1]
-	itr.points = itr.points[:len(itr.points)-1]
-	return p, nil
+	// Calculate next window if we have no more points.
+	if err := itr.reduce(); err != nil {
+		return nil, err
+	}
+	return itr.points.Next()
 }
 
 // booleanReduceBooleanPoint stores the reduced data for a name/tag combination.
@@ -12341,7 +13508,9 @@ type booleanReduceBooleanPoint struct {
 
 // reduce executes fn once for every point in the next window.
 // The previous value for the dimension is passed to fn.
-func (itr *booleanReduceBooleanIterator) reduce() ([]BooleanPoint, error) {
+func (itr *booleanReduceBooleanIterator) reduce() error {
+	itr.points.reset()
+
 	// Calculate next window.
 	var (
 		startTime, endTime int64
@@ -12353,7 +13522,7 @@ func (itr *booleanReduceBooleanIterator) reduce() ([]BooleanPoint, error) {
 	for {
 		p, err := itr.input.Next()
 		if err != nil || p == nil {
-			return nil, err
+			return err
 		} else if p.Nil {
 			continue
 		}
@@ -12371,7 +13540,7 @@ func (itr *booleanReduceBooleanIterator) reduce() ([]BooleanPoint, error) {
 		// Read next point.
 		curr, err := itr.input.NextInWindow(startTime, endTime)
 		if err != nil {
-			return nil, err
+			return err
 		} else if curr == nil {
 			break
 		} else if curr.Nil {
@@ -12410,23 +13579,22 @@ func (itr *booleanReduceBooleanIterator) reduce() ([]BooleanPoint, error) {
 		rp.Aggregator.AggregateBoolean(curr)
 	}
 
-	// Reverse sort points by name & tag if our output is supposed to be ordered.
+	// Sort points by name & tag if our output is supposed to be ordered.
 	keys := make([]string, 0, len(m))
 	for k := range m {
 		keys = append(keys, k)
 	}
 	if len(keys) > 1 && itr.opt.Ordered {
-		sort.Sort(reverseStringSlice(keys))
+		sort.Strings(keys)
 	}
 
 	// Assume the points are already sorted until proven otherwise.
 	sortedByTime := true
 	// Emit the points for each name & tag combination.
-	a := make([]BooleanPoint, 0, len(m))
 	for _, k := range keys {
 		rp := m[k]
 		points := rp.Emitter.Emit()
-		for i := len(points) - 1; i >= 0; i-- {
+		for i := range points {
 			points[i].Name = rp.Name
 			if !itr.keepTags {
 				points[i].Tags = rp.Tags
@@ -12437,16 +13605,18 @@ func (itr *booleanReduceBooleanIterator) reduce() ([]BooleanPoint, error) {
 			} else {
 				sortedByTime = false
 			}
-			a = append(a, points[i])
+			if err := itr.points.append(points[i]); err != nil {
+				return err
+			}
 		}
 	}
 
 	// Points may be out of order. Perform a stable sort by time if requested.
+	// Points spilled to disk have already been sorted.
 	if !sortedByTime && itr.opt.Ordered {
-		sort.Stable(sort.Reverse(booleanPointsByTime(a)))
+		itr.points.sort()
 	}
-
-	return a, nil
+	return nil
 }
 
 // booleanStreamBooleanIterator streams inputs into the iterator and emits points gradually.
@@ -591,6 +591,10 @@ type IteratorOptions struct {
 
 	// Authorizer can limit access to data
 	Authorizer Authorizer
+
+	// Memory limits the points buffered by iterators before they are
+	// spilled to disk. If nil, memory is not limited.
+	Memory *MemoryBudget
 }
 
 // newIteratorOptionsStmt creates the iterator options from stmt.
@@ -686,6 +690,7 @@ func newIteratorOptionsSubstatement(ctx context.Context, stmt *influxql.SelectSt
 		subOpt.GroupBy[d] = struct{}{}
 	}
 	subOpt.InterruptCh = opt.InterruptCh
+	subOpt.Memory = opt.Memory
 
 	// Extract the time range and condition from the condition.
 	cond, t, err := influxql.ConditionExpr(stmt.Condition, nil)
@@ -1300,12 +1305,6 @@ type fastDedupeKey struct {
 	values [2]interface{}
 }
 
-type reverseStringSlice []string
-
-func (p reverseStringSlice) Len() int           { return len(p) }
-func (p reverseStringSlice) Less(i, j int) bool { return p[i] > p[j] }
-func (p reverseStringSlice) Swap(i, j int)      { p[i], p[j] = p[j], p[i] }
-
 func abs(v int64) int64 {
 	if v < 0 {
 		return -v
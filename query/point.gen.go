@@ -518,6 +518,8 @@ func encodeUnsignedPoint(p *UnsignedPoint) *internal.Point {
 		Nil:        proto.Bool(p.Nil),
 		Aux:        encodeAux(p.Aux),
 		Aggregated: proto.Uint32(p.Aggregated),
+
+		UnsignedValue: proto.Uint64(p.Value),
 	}
 }
 
@@ -113,13 +113,26 @@ func (p *preparedStatement) Select(ctx context.Context) (Cursor, error) {
 	// compilation, but that requires too large of a refactor at the moment.
 	ctx = context.WithValue(ctx, "now", p.now)
 
+	// If the query is being tracked by the slow query log, record the cost
+	// and statistics of the iterators that are created.
+	var ic IteratorCreator = p.ic
+	r, _ := ctx.Value(statsRecorderContextKey).(statsRecorder)
+	if r != nil {
+		ic = &costIteratorCreator{IteratorCreator: p.ic, r: r}
+	}
+
 	opt := p.opt
 	opt.InterruptCh = ctx.Done()
-	cur, err := buildCursor(ctx, p.stmt, p.ic, opt)
+	opt.Memory, _ = ctx.Value(memoryBudgetContextKey).(*MemoryBudget)
+	cur, err := buildCursor(ctx, p.stmt, ic, opt)
 	if err != nil {
 		return nil, err
 	}
 
+	if r != nil {
+		cur = &statsCursor{Cursor: cur, r: r}
+	}
+
 	// If a monitor exists and we are told there is a maximum number of points,
 	// register the monitor function.
 	if m := MonitorFromContext(ctx); m != nil {
@@ -0,0 +1,129 @@
+package query
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/influxdata/influxdb/models"
+	"github.com/influxdata/influxql"
+)
+
+// DefaultSlowQueryLogSize is the default number of slow queries retained by a SlowQueryLog.
+const DefaultSlowQueryLogSize = 100
+
+// SlowQuery describes a query which ran for longer than the slow query threshold.
+type SlowQuery struct {
+	ID        uint64        `json:"id"`
+	Query     string        `json:"query"`
+	Database  string        `json:"database"`
+	User      string        `json:"user,omitempty"`
+	StartTime time.Time     `json:"start_time"`
+	Duration  time.Duration `json:"duration"`
+
+	// Cost is the estimated cost of the iterators created for the query.
+	Cost IteratorCost `json:"cost"`
+
+	// SeriesN and PointN are the number of series and points scanned by the query.
+	SeriesN int `json:"series_n"`
+	PointN  int `json:"point_n"`
+}
+
+// Point returns a point representing the slow query, suitable for writing
+// to the monitor database.
+func (q *SlowQuery) Point() (models.Point, error) {
+	tags := map[string]string{"database": q.Database}
+	if q.User != "" {
+		tags["user"] = q.User
+	}
+
+	return models.NewPoint("slowQuery", models.NewTags(tags), map[string]interface{}{
+		"qid":          int64(q.ID),
+		"query":        q.Query,
+		"duration":     int64(q.Duration),
+		"numShards":    q.Cost.NumShards,
+		"numSeries":    q.Cost.NumSeries,
+		"cachedValues": q.Cost.CachedValues,
+		"numFiles":     q.Cost.NumFiles,
+		"blocksRead":   q.Cost.BlocksRead,
+		"blockSize":    q.Cost.BlockSize,
+		"seriesN":      int64(q.SeriesN),
+		"pointN":       int64(q.PointN),
+	}, q.StartTime)
+}
+
+// SlowQueryLog retains the most recent slow queries in a fixed size ring buffer.
+type SlowQueryLog struct {
+	mu      sync.RWMutex
+	queries []SlowQuery
+	next    int
+	full    bool
+}
+
+// NewSlowQueryLog returns a new SlowQueryLog which retains at most size queries.
+func NewSlowQueryLog(size int) *SlowQueryLog {
+	if size <= 0 {
+		size = DefaultSlowQueryLogSize
+	}
+	return &SlowQueryLog{queries: make([]SlowQuery, size)}
+}
+
+// Add records q in the log, replacing the oldest entry if the log is full.
+func (l *SlowQueryLog) Add(q SlowQuery) {
+	l.mu.Lock()
+	l.queries[l.next] = q
+	l.next++
+	if l.next == len(l.queries) {
+		l.next, l.full = 0, true
+	}
+	l.mu.Unlock()
+}
+
+// Queries returns the slow queries in the log, ordered from oldest to newest.
+func (l *SlowQueryLog) Queries() []SlowQuery {
+	if l == nil {
+		return nil
+	}
+
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	if !l.full {
+		return append([]SlowQuery(nil), l.queries[:l.next]...)
+	}
+
+	queries := make([]SlowQuery, 0, len(l.queries))
+	queries = append(queries, l.queries[l.next:]...)
+	return append(queries, l.queries[:l.next]...)
+}
+
+// statsRecorder accumulates the cost and statistics of the iterators
+// created while executing a query.
+type statsRecorder interface {
+	addCost(cost IteratorCost)
+	addStats(stats IteratorStats)
+}
+
+// costIteratorCreator records the cost of each iterator before it is created.
+type costIteratorCreator struct {
+	IteratorCreator
+	r statsRecorder
+}
+
+func (ic *costIteratorCreator) CreateIterator(ctx context.Context, m *influxql.Measurement, opt IteratorOptions) (Iterator, error) {
+	if cost, err := ic.IteratorCreator.IteratorCost(m, opt); err == nil {
+		ic.r.addCost(cost)
+	}
+	return ic.IteratorCreator.CreateIterator(ctx, m, opt)
+}
+
+// statsCursor records the statistics of the underlying cursor when it is closed.
+type statsCursor struct {
+	Cursor
+	r statsRecorder
+}
+
+func (cur *statsCursor) Close() error {
+	cur.r.addStats(cur.Cursor.Stats())
+	return cur.Cursor.Close()
+}
@@ -0,0 +1,37 @@
+package query_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/influxdata/influxdb/query"
+)
+
+func TestSlowQueryLog(t *testing.T) {
+	l := query.NewSlowQueryLog(3)
+	ids := func() []uint64 {
+		var a []uint64
+		for _, q := range l.Queries() {
+			a = append(a, q.ID)
+		}
+		return a
+	}
+
+	if got := ids(); got != nil {
+		t.Fatalf("unexpected queries: %v", got)
+	}
+
+	for i := uint64(1); i <= 2; i++ {
+		l.Add(query.SlowQuery{ID: i})
+	}
+	if got, exp := ids(), []uint64{1, 2}; !reflect.DeepEqual(got, exp) {
+		t.Fatalf("unexpected queries: got=%v exp=%v", got, exp)
+	}
+
+	for i := uint64(3); i <= 5; i++ {
+		l.Add(query.SlowQuery{ID: i})
+	}
+	if got, exp := ids(), []uint64{3, 4, 5}; !reflect.DeepEqual(got, exp) {
+		t.Fatalf("unexpected queries: got=%v exp=%v", got, exp)
+	}
+}
@@ -0,0 +1,134 @@
+package query
+
+import (
+	"bufio"
+	"io"
+	"io/ioutil"
+	"os"
+	"sync/atomic"
+)
+
+const (
+	// pointOverhead is the approximate number of bytes used by a point
+	// excluding its name, tags, auxiliary fields and string value.
+	pointOverhead = 96
+
+	// mergeInputSize is the approximate number of bytes buffered by each
+	// input of a sorted merge, such as the decoded blocks of a shard cursor.
+	mergeInputSize = 16 * 1024
+)
+
+// MemoryBudget limits the memory that the iterators of a query use to buffer
+// points.  Once the budget is exhausted, iterators write the points they would
+// otherwise buffer to temporary files.  A nil MemoryBudget is unlimited.
+type MemoryBudget struct {
+	used    int64 // atomic
+	spilled int64 // atomic
+
+	limit int64
+	dir   string
+}
+
+// NewMemoryBudget returns a MemoryBudget of limit bytes which spills points to
+// temporary files in dir.  If dir is empty, the default temporary directory is
+// used.
+func NewMemoryBudget(limit int64, dir string) *MemoryBudget {
+	return &MemoryBudget{limit: limit, dir: dir}
+}
+
+// Used returns the number of bytes currently used by the iterators of the query.
+func (b *MemoryBudget) Used() int64 {
+	if b == nil {
+		return 0
+	}
+	return atomic.LoadInt64(&b.used)
+}
+
+// Spilled returns the number of bytes written to temporary files.
+func (b *MemoryBudget) Spilled() int64 {
+	if b == nil {
+		return 0
+	}
+	return atomic.LoadInt64(&b.spilled)
+}
+
+// grow adds n bytes to the memory in use.  It returns false if the budget is
+// exceeded, in which case the caller should spill the points it buffers.
+func (b *MemoryBudget) grow(n int64) bool {
+	if b == nil {
+		return true
+	}
+	return atomic.AddInt64(&b.used, n) <= b.limit
+}
+
+// shrink removes n bytes from the memory in use.
+func (b *MemoryBudget) shrink(n int64) {
+	if b == nil {
+		return
+	}
+	atomic.AddInt64(&b.used, -n)
+}
+
+// createSpillFile creates a new temporary file to write points to.
+func (b *MemoryBudget) createSpillFile() (*spillFile, error) {
+	var dir string
+	if b != nil {
+		dir = b.dir
+	}
+
+	f, err := ioutil.TempFile(dir, "influxdb-query-spill-")
+	if err != nil {
+		return nil, err
+	}
+	return &spillFile{budget: b, f: f, w: bufio.NewWriter(f)}, nil
+}
+
+// spillFile is a temporary file holding encoded points.  The file is removed
+// when it is closed.
+type spillFile struct {
+	budget *MemoryBudget
+	f      *os.File
+	w      *bufio.Writer
+}
+
+// Write writes p to the file.
+func (f *spillFile) Write(p []byte) (int, error) {
+	n, err := f.w.Write(p)
+	if f.budget != nil {
+		atomic.AddInt64(&f.budget.spilled, int64(n))
+	}
+	return n, err
+}
+
+// reader flushes the file and returns a reader from its start.  The file must
+// not be written to afterwards.
+func (f *spillFile) reader() (io.Reader, error) {
+	if err := f.w.Flush(); err != nil {
+		return nil, err
+	} else if _, err := f.f.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	return bufio.NewReader(f.f), nil
+}
+
+// Close closes and removes the file.
+func (f *spillFile) Close() error {
+	if err := f.f.Close(); err != nil {
+		os.Remove(f.f.Name())
+		return err
+	}
+	return os.Remove(f.f.Name())
+}
+
+// pointSize returns the approximate number of bytes used by a point with the
+// given name, tags and auxiliary fields.
+func pointSize(name string, tags Tags, aux []interface{}) int64 {
+	n := pointOverhead + len(name) + len(tags.id)
+	for _, v := range aux {
+		n += 16
+		if s, ok := v.(string); ok {
+			n += len(s)
+		}
+	}
+	return int64(n)
+}
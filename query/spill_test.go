@@ -0,0 +1,154 @@
+package query_test
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/davecgh/go-spew/spew"
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/influxdb/pkg/deep"
+	"github.com/influxdata/influxdb/query"
+	"github.com/influxdata/influxql"
+)
+
+// Ensure a query that exceeds its memory budget spills points to disk and
+// returns the same results.
+func TestSelect_MemoryBudget(t *testing.T) {
+	dir, err := ioutil.TempDir("", "influxdb-spill-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	stmt := MustParseSelectStatement(`SELECT top(value, 3) FROM cpu WHERE time >= '1970-01-01T00:00:00Z' AND time < '1970-01-02T00:00:00Z' GROUP BY time(10s), host fill(none)`)
+	stmt.OmitTime = true
+
+	taskManager := query.NewTaskManager()
+	taskManager.MaxQueryMemory = 1
+	taskManager.SpillDir = dir
+	ctx, detach, err := taskManager.AttachQuery(&influxql.Query{
+		Statements: []influxql.Statement{stmt},
+	}, query.ExecutionOptions{}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer detach()
+
+	shardMapper := ShardMapper{
+		MapShardsFn: func(sources influxql.Sources, _ influxql.TimeRange) query.ShardGroup {
+			return &ShardGroup{
+				Fields: map[string]influxql.DataType{
+					"value": influxql.Float,
+				},
+				Dimensions: []string{"host"},
+				CreateIteratorFn: func(ctx context.Context, m *influxql.Measurement, opt query.IteratorOptions) (query.Iterator, error) {
+					return query.Iterators{
+						&FloatIterator{Points: []query.FloatPoint{
+							{Name: "cpu", Tags: ParseTags("host=A"), Time: 1 * Second, Value: 5},
+							{Name: "cpu", Tags: ParseTags("host=A"), Time: 2 * Second, Value: 9},
+							{Name: "cpu", Tags: ParseTags("host=A"), Time: 3 * Second, Value: 7},
+							{Name: "cpu", Tags: ParseTags("host=A"), Time: 11 * Second, Value: 1},
+						}},
+						&FloatIterator{Points: []query.FloatPoint{
+							{Name: "cpu", Tags: ParseTags("host=A"), Time: 4 * Second, Value: 8},
+							{Name: "cpu", Tags: ParseTags("host=B"), Time: 6 * Second, Value: 2},
+						}},
+					}.Merge(opt)
+				},
+			}
+		},
+	}
+
+	cur, err := query.Select(ctx, stmt, &shardMapper, query.SelectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	} else if a, err := ReadCursor(cur); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	} else if diff := cmp.Diff([]query.Row{
+		{Time: 2 * Second, Series: query.Series{Name: "cpu", Tags: ParseTags("host=A")}, Values: []interface{}{float64(9)}},
+		{Time: 3 * Second, Series: query.Series{Name: "cpu", Tags: ParseTags("host=A")}, Values: []interface{}{float64(7)}},
+		{Time: 4 * Second, Series: query.Series{Name: "cpu", Tags: ParseTags("host=A")}, Values: []interface{}{float64(8)}},
+		{Time: 11 * Second, Series: query.Series{Name: "cpu", Tags: ParseTags("host=A")}, Values: []interface{}{float64(1)}},
+		{Time: 6 * Second, Series: query.Series{Name: "cpu", Tags: ParseTags("host=B")}, Values: []interface{}{float64(2)}},
+	}, a); diff != "" {
+		t.Fatalf("unexpected points:\n%s", diff)
+	}
+
+	if ctx.Memory.Spilled() == 0 {
+		t.Fatal("expected points to be spilled")
+	} else if n := ctx.Memory.Used(); n != 0 {
+		t.Fatalf("unexpected memory in use: %d", n)
+	} else if names := mustReadDir(t, dir); len(names) != 0 {
+		t.Fatalf("unexpected spill files: %v", names)
+	}
+}
+
+// Ensure the inputs of a sorted merge are spilled to disk when the memory
+// budget is exhausted.
+func TestSortedMergeIterator_MemoryBudget(t *testing.T) {
+	dir, err := ioutil.TempDir("", "influxdb-spill-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	inputs := []*FloatIterator{
+		{Points: []query.FloatPoint{
+			{Name: "cpu", Tags: ParseTags("host=A"), Time: 0, Value: 1, Aux: []interface{}{"a"}},
+			{Name: "cpu", Tags: ParseTags("host=B"), Time: 1, Value: 2, Aux: []interface{}{"b"}},
+		}},
+		{Points: []query.FloatPoint{
+			{Name: "cpu", Tags: ParseTags("host=A"), Time: 20, Value: 7, Aux: []interface{}{"c"}},
+			{Name: "mem", Tags: ParseTags("host=A"), Time: 25, Value: 9, Aux: []interface{}{"d"}},
+		}},
+	}
+	budget := query.NewMemoryBudget(1, dir)
+	itr := query.NewSortedMergeIterator(FloatIterators(inputs), query.IteratorOptions{
+		Interval: query.Interval{
+			Duration: 10 * time.Nanosecond,
+		},
+		Dimensions: []string{"host"},
+		Ascending:  true,
+		Memory:     budget,
+	})
+	if a, err := Iterators([]query.Iterator{itr}).ReadAll(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	} else if !deep.Equal(a, [][]query.Point{
+		{&query.FloatPoint{Name: "cpu", Tags: ParseTags("host=A"), Time: 0, Value: 1, Aux: []interface{}{"a"}}},
+		{&query.FloatPoint{Name: "cpu", Tags: ParseTags("host=A"), Time: 20, Value: 7, Aux: []interface{}{"c"}}},
+		{&query.FloatPoint{Name: "cpu", Tags: ParseTags("host=B"), Time: 1, Value: 2, Aux: []interface{}{"b"}}},
+		{&query.FloatPoint{Name: "mem", Tags: ParseTags("host=A"), Time: 25, Value: 9, Aux: []interface{}{"d"}}},
+	}) {
+		t.Errorf("unexpected points: %s", spew.Sdump(a))
+	}
+
+	for i, input := range inputs {
+		if !input.Closed {
+			t.Errorf("iterator %d not closed", i)
+		}
+	}
+
+	if budget.Spilled() == 0 {
+		t.Fatal("expected points to be spilled")
+	} else if n := budget.Used(); n != 0 {
+		t.Fatalf("unexpected memory in use: %d", n)
+	} else if names := mustReadDir(t, dir); len(names) != 0 {
+		t.Fatalf("unexpected spill files: %v", names)
+	}
+}
+
+func mustReadDir(t *testing.T, dir string) []string {
+	fis, err := ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	names := make([]string, 0, len(fis))
+	for _, fi := range fis {
+		names = append(names, fi.Name())
+	}
+	return names
+}
@@ -38,6 +38,38 @@ func (t TaskStatus) String() string {
 	panic(fmt.Sprintf("unknown task status: %d", int(t)))
 }
 
+// QueryLimits represents the resource limits which apply to the queries of a
+// user or database. A limit of zero means the limit is not set.
+type QueryLimits struct {
+	MaxConcurrentQueries int `json:"max-concurrent-queries,omitempty"`
+	MaxSelectPointN      int `json:"max-select-point,omitempty"`
+	MaxSelectSeriesN     int `json:"max-select-series,omitempty"`
+	MaxSelectBucketsN    int `json:"max-select-buckets,omitempty"`
+}
+
+// IsZero returns true if none of the limits are set.
+func (l QueryLimits) IsZero() bool {
+	return l == QueryLimits{}
+}
+
+// Merge returns the most restrictive combination of l and other.
+func (l QueryLimits) Merge(other QueryLimits) QueryLimits {
+	return QueryLimits{
+		MaxConcurrentQueries: minLimit(l.MaxConcurrentQueries, other.MaxConcurrentQueries),
+		MaxSelectPointN:      minLimit(l.MaxSelectPointN, other.MaxSelectPointN),
+		MaxSelectSeriesN:     minLimit(l.MaxSelectSeriesN, other.MaxSelectSeriesN),
+		MaxSelectBucketsN:    minLimit(l.MaxSelectBucketsN, other.MaxSelectBucketsN),
+	}
+}
+
+// minLimit returns the smaller of two limits, where zero is unlimited.
+func minLimit(a, b int) int {
+	if a == 0 || (b > 0 && b < a) {
+		return b
+	}
+	return a
+}
+
 // TaskManager takes care of all aspects related to managing running queries.
 type TaskManager struct {
 	// Query execution timeout.
@@ -47,9 +79,36 @@ type TaskManager struct {
 	// If zero, slow queries will never be logged.
 	LogQueriesAfter time.Duration
 
+	// Record queries in SlowQueryLog if they are slower than this time.
+	// If zero or SlowQueryLog is nil, slow queries will never be recorded.
+	SlowQueryThreshold time.Duration
+
+	// SlowQueryLog retains the most recent slow queries.
+	SlowQueryLog *SlowQueryLog
+
+	// SlowQueryWriter, if set, is used to store each slow query as a point.
+	SlowQueryWriter interface {
+		WritePoints(p models.Points) error
+	}
+
 	// Maximum number of concurrent queries.
 	MaxConcurrentQueries int
 
+	// Maximum number of bytes each query may buffer in memory before
+	// spilling points to temporary files in SpillDir.
+	// If zero, memory is not limited.
+	MaxQueryMemory int64
+
+	// Directory for temporary spill files. Defaults to the system
+	// temporary directory.
+	SpillDir string
+
+	// QueryLimiter, if set, provides the limits for individual users and databases.
+	QueryLimiter interface {
+		UserQueryLimits(name string) QueryLimits
+		DatabaseQueryLimits(name string) QueryLimits
+	}
+
 	// Logger to use for all logging.
 	// Defaults to discarding all log output.
 	Logger *zap.Logger
@@ -161,14 +220,32 @@ func (t *TaskManager) AttachQuery(q *influxql.Query, opt ExecutionOptions, inter
 		return nil, nil, ErrMaxConcurrentQueriesLimitExceeded(len(t.queries), t.MaxConcurrentQueries)
 	}
 
+	var limits QueryLimits
+	if t.QueryLimiter != nil {
+		var userLimits, dbLimits QueryLimits
+		if opt.UserID != "" {
+			userLimits = t.QueryLimiter.UserQueryLimits(opt.UserID)
+		}
+		if opt.Database != "" {
+			dbLimits = t.QueryLimiter.DatabaseQueryLimits(opt.Database)
+		}
+
+		if err := t.checkConcurrentQueries(opt, userLimits.MaxConcurrentQueries, dbLimits.MaxConcurrentQueries); err != nil {
+			return nil, nil, err
+		}
+		limits = userLimits.Merge(dbLimits)
+	}
+
 	qid := t.nextID
 	query := &Task{
-		query:     q.String(),
-		database:  opt.Database,
-		status:    RunningTask,
-		startTime: time.Now(),
-		closing:   make(chan struct{}),
-		monitorCh: make(chan error),
+		query:       q.String(),
+		database:    opt.Database,
+		user:        opt.UserID,
+		status:      RunningTask,
+		startTime:   time.Now(),
+		closing:     make(chan struct{}),
+		monitorCh:   make(chan error),
+		recordStats: t.slowQueryLogEnabled(),
 	}
 	t.queries[qid] = query
 
@@ -194,11 +271,41 @@ func (t *TaskManager) AttachQuery(q *influxql.Query, opt ExecutionOptions, inter
 		QueryID:          qid,
 		task:             query,
 		ExecutionOptions: opt,
+		Limits:           limits,
+	}
+	if t.MaxQueryMemory > 0 {
+		ctx.Memory = NewMemoryBudget(t.MaxQueryMemory, t.SpillDir)
 	}
 	ctx.watch()
 	return ctx, func() { t.DetachQuery(qid) }, nil
 }
 
+// checkConcurrentQueries returns an error if attaching a query with the
+// given options would exceed the concurrent query limit of its user or
+// database. The caller must hold the lock.
+func (t *TaskManager) checkConcurrentQueries(opt ExecutionOptions, userLimit, dbLimit int) error {
+	if userLimit == 0 && dbLimit == 0 {
+		return nil
+	}
+
+	var userN, dbN int
+	for _, q := range t.queries {
+		if opt.UserID != "" && q.user == opt.UserID {
+			userN++
+		}
+		if opt.Database != "" && q.database == opt.Database {
+			dbN++
+		}
+	}
+
+	if userLimit > 0 && userN >= userLimit {
+		return ErrUserMaxConcurrentQueriesLimitExceeded(opt.UserID, userN, userLimit)
+	} else if dbLimit > 0 && dbN >= dbLimit {
+		return ErrDatabaseMaxConcurrentQueriesLimitExceeded(opt.Database, dbN, dbLimit)
+	}
+	return nil
+}
+
 // KillQuery enters a query into the killed state and closes the channel
 // from the TaskManager. This method can be used to forcefully terminate a
 // running query.
@@ -217,18 +324,70 @@ func (t *TaskManager) KillQuery(qid uint64) error {
 // killed state, this will also close the related channel.
 func (t *TaskManager) DetachQuery(qid uint64) error {
 	t.mu.Lock()
-	defer t.mu.Unlock()
-
 	query := t.queries[qid]
 	if query == nil {
+		t.mu.Unlock()
 		return fmt.Errorf("no such query id: %d", qid)
 	}
 
 	query.close()
 	delete(t.queries, qid)
+	t.mu.Unlock()
+
+	t.recordSlowQuery(qid, query)
 	return nil
 }
 
+func (t *TaskManager) slowQueryLogEnabled() bool {
+	return t.SlowQueryLog != nil && t.SlowQueryThreshold > 0
+}
+
+// recordSlowQuery adds the query to the slow query log if it ran for longer
+// than the slow query threshold.
+func (t *TaskManager) recordSlowQuery(qid uint64, query *Task) {
+	if !t.slowQueryLogEnabled() {
+		return
+	}
+
+	d := time.Since(query.startTime)
+	if d < t.SlowQueryThreshold {
+		return
+	}
+
+	query.mu.Lock()
+	sq := SlowQuery{
+		ID:        qid,
+		Query:     query.query,
+		Database:  query.database,
+		User:      query.user,
+		StartTime: query.startTime,
+		Duration:  d,
+		Cost:      query.cost,
+		SeriesN:   query.stats.SeriesN,
+		PointN:    query.stats.PointN,
+	}
+	query.mu.Unlock()
+
+	t.SlowQueryLog.Add(sq)
+
+	if t.SlowQueryWriter != nil {
+		go func() {
+			pt, err := sq.Point()
+			if err == nil {
+				err = t.SlowQueryWriter.WritePoints(models.Points{pt})
+			}
+			if err != nil {
+				t.Logger.Info("Failed to store slow query", zap.Error(err))
+			}
+		}()
+	}
+}
+
+// SlowQueries returns the most recent slow queries, ordered from oldest to newest.
+func (t *TaskManager) SlowQueries() []SlowQuery {
+	return t.SlowQueryLog.Queries()
+}
+
 // QueryInfo represents the information for a query.
 type QueryInfo struct {
 	ID       uint64        `json:"id"`
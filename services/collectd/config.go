@@ -6,6 +6,7 @@ import (
 
 	"github.com/influxdata/influxdb/monitor/diagnostics"
 	"github.com/influxdata/influxdb/toml"
+	"github.com/influxdata/influxdb/tsdb"
 )
 
 const (
@@ -67,6 +68,12 @@ type Config struct {
 	SecurityLevel         string        `toml:"security-level"`
 	AuthFile              string        `toml:"auth-file"`
 	ParseMultiValuePlugin string        `toml:"parse-multivalue-plugin"`
+
+	// SpoolDir is the directory of an on-disk spool which holds batches until
+	// they are written.  If empty, batches are dropped if they cannot be written.
+	SpoolDir     string    `toml:"spool-dir"`
+	SpoolMaxSize toml.Size `toml:"spool-max-size"`
+	SpoolPolicy  string    `toml:"spool-policy"`
 }
 
 // NewConfig returns a new instance of Config with defaults.
@@ -123,6 +130,12 @@ func (c *Config) WithDefaults() *Config {
 	if d.ParseMultiValuePlugin == "" {
 		d.ParseMultiValuePlugin = DefaultParseMultiValuePlugin
 	}
+	if d.SpoolMaxSize == 0 {
+		d.SpoolMaxSize = tsdb.DefaultSpoolMaxSize
+	}
+	if d.SpoolPolicy == "" {
+		d.SpoolPolicy = tsdb.SpoolPolicyDropNewest
+	}
 
 	return &d
 }
@@ -141,6 +154,12 @@ func (c *Config) Validate() error {
 		return errors.New(`Invalid value for parse-multivalue-plugin. Valid options are "split" and "join"`)
 	}
 
+	switch c.SpoolPolicy {
+	case "", tsdb.SpoolPolicyDropNewest, tsdb.SpoolPolicyDropOldest:
+	default:
+		return errors.New(`Invalid value for spool-policy. Valid options are "drop-newest" and "drop-oldest"`)
+	}
+
 	return nil
 }
 
@@ -150,7 +169,7 @@ type Configs []Config
 // Diagnostics returns one set of diagnostics for all of the Configs.
 func (c Configs) Diagnostics() (*diagnostics.Diagnostics, error) {
 	d := &diagnostics.Diagnostics{
-		Columns: []string{"enabled", "bind-address", "database", "retention-policy", "batch-size", "batch-pending", "batch-timeout"},
+		Columns: []string{"enabled", "bind-address", "database", "retention-policy", "batch-size", "batch-pending", "batch-timeout", "spool-dir"},
 	}
 
 	for _, cc := range c {
@@ -159,7 +178,7 @@ func (c Configs) Diagnostics() (*diagnostics.Diagnostics, error) {
 			continue
 		}
 
-		r := []interface{}{true, cc.BindAddress, cc.Database, cc.RetentionPolicy, cc.BatchSize, cc.BatchPending, cc.BatchDuration}
+		r := []interface{}{true, cc.BindAddress, cc.Database, cc.RetentionPolicy, cc.BatchSize, cc.BatchPending, cc.BatchDuration, cc.SpoolDir}
 		d.AddRow(r)
 	}
 
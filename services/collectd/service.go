@@ -65,6 +65,7 @@ type Service struct {
 	wg      sync.WaitGroup
 	conn    *net.UDPConn
 	batcher *tsdb.PointBatcher
+	spool   *tsdb.PointSpool
 	popts   network.ParseOpts
 	addr    net.Addr
 
@@ -198,6 +199,15 @@ func (s *Service) Open() error {
 
 	s.Logger.Info("Listening on UDP", zap.Stringer("addr", conn.LocalAddr()))
 
+	// Open the spool which holds batches until they are written.
+	if s.Config.SpoolDir != "" {
+		s.spool = tsdb.NewPointSpool(s.Config.SpoolDir, int64(s.Config.SpoolMaxSize), s.Config.SpoolPolicy)
+		if err := s.spool.Open(); err != nil {
+			s.conn.Close()
+			return err
+		}
+	}
+
 	// Start the points batcher.
 	s.batcher = tsdb.NewPointBatcher(s.Config.BatchSize, s.Config.BatchPending, time.Duration(s.Config.BatchDuration))
 	s.batcher.Start()
@@ -241,8 +251,12 @@ func (s *Service) Close() error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
+	if s.spool != nil {
+		s.spool.Close()
+	}
 	s.conn = nil
 	s.batcher = nil
+	s.spool = nil
 	s.Logger.Info("Closed collectd service")
 	s.done = nil
 	return nil
@@ -297,7 +311,7 @@ type Statistics struct {
 
 // Statistics returns statistics for periodic monitoring.
 func (s *Service) Statistics(tags map[string]string) []models.Statistic {
-	return []models.Statistic{{
+	statistics := []models.Statistic{{
 		Name: "collectd",
 		Tags: s.defaultTags.Merge(tags),
 		Values: map[string]interface{}{
@@ -311,6 +325,16 @@ func (s *Service) Statistics(tags map[string]string) []models.Statistic {
 			statDroppedPointsInvalid: atomic.LoadInt64(&s.stats.InvalidDroppedPoints),
 		},
 	}}
+
+	s.mu.RLock()
+	spool := s.spool
+	s.mu.RUnlock()
+	if spool != nil {
+		spoolTags := s.defaultTags.Merge(tags)
+		spoolTags["service"] = "collectd"
+		statistics = append(statistics, spool.Statistics(spoolTags)...)
+	}
+	return statistics
 }
 
 // SetTypes sets collectd types db.
@@ -388,11 +412,28 @@ func (s *Service) handleMessage(buffer []byte) {
 }
 
 func (s *Service) writePoints() {
+	var retry <-chan time.Time
+	if s.spool != nil {
+		ticker := time.NewTicker(tsdb.SpoolRetryInterval)
+		defer ticker.Stop()
+		retry = ticker.C
+	}
+
 	for {
 		select {
 		case <-s.done:
 			return
+		case <-retry:
+			s.writeSpooled()
 		case batch := <-s.batcher.Out():
+			if s.spool != nil {
+				if err := s.spool.Append(batch); err != nil {
+					s.Logger.Info("Failed to spool point batch", zap.Error(err))
+				}
+				s.writeSpooled()
+				continue
+			}
+
 			// Will attempt to create database if not yet created.
 			if err := s.createInternalStorage(); err != nil {
 				s.Logger.Info("Required database not yet created",
@@ -412,6 +453,21 @@ func (s *Service) writePoints() {
 	}
 }
 
+// writeSpooled writes the batches held by the spool.  Batches which cannot be
+// written remain in the spool and are retried later, unless the database
+// rejects them.
+func (s *Service) writeSpooled() {
+	batchN, pointN, failN := s.spool.WriteSpooled(func(batch []models.Point) error {
+		if err := s.createInternalStorage(); err != nil {
+			return err
+		}
+		return s.PointsWriter.WritePointsPrivileged(s.Config.Database, s.Config.RetentionPolicy, models.ConsistencyLevelAny, batch)
+	}, s.Logger, s.Config.Database)
+	atomic.AddInt64(&s.stats.BatchesTransmitted, batchN)
+	atomic.AddInt64(&s.stats.PointsTransmitted, pointN)
+	atomic.AddInt64(&s.stats.BatchesTransmitFail, failN)
+}
+
 // UnmarshalValueListPacked is an alternative to the original UnmarshalValueList.
 // The difference is that the original provided measurements like (PLUGIN_DSNAME, ["value",xxx])
 // while this one will provide measurements like (PLUGIN, {["DSNAME",xxx]}).
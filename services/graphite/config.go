@@ -8,6 +8,7 @@ import (
 	"github.com/influxdata/influxdb/models"
 	"github.com/influxdata/influxdb/monitor/diagnostics"
 	"github.com/influxdata/influxdb/toml"
+	"github.com/influxdata/influxdb/tsdb"
 )
 
 const (
@@ -65,6 +66,12 @@ type Config struct {
 	Tags             []string      `toml:"tags"`
 	Separator        string        `toml:"separator"`
 	UDPReadBuffer    int           `toml:"udp-read-buffer"`
+
+	// SpoolDir is the directory of an on-disk spool which holds batches until
+	// they are written.  If empty, batches are dropped if they cannot be written.
+	SpoolDir     string    `toml:"spool-dir"`
+	SpoolMaxSize toml.Size `toml:"spool-max-size"`
+	SpoolPolicy  string    `toml:"spool-policy"`
 }
 
 // NewConfig returns a new instance of Config with defaults.
@@ -112,6 +119,12 @@ func (c *Config) WithDefaults() *Config {
 	if d.UDPReadBuffer == 0 {
 		d.UDPReadBuffer = DefaultUDPReadBuffer
 	}
+	if d.SpoolMaxSize == 0 {
+		d.SpoolMaxSize = tsdb.DefaultSpoolMaxSize
+	}
+	if d.SpoolPolicy == "" {
+		d.SpoolPolicy = tsdb.SpoolPolicyDropNewest
+	}
 	return &d
 }
 
@@ -135,6 +148,12 @@ func (c *Config) Validate() error {
 		return err
 	}
 
+	switch c.SpoolPolicy {
+	case "", tsdb.SpoolPolicyDropNewest, tsdb.SpoolPolicyDropOldest:
+	default:
+		return fmt.Errorf("invalid spool policy %q", c.SpoolPolicy)
+	}
+
 	return nil
 }
 
@@ -261,7 +280,7 @@ type Configs []Config
 // Diagnostics returns one set of diagnostics for all of the Configs.
 func (c Configs) Diagnostics() (*diagnostics.Diagnostics, error) {
 	d := &diagnostics.Diagnostics{
-		Columns: []string{"enabled", "bind-address", "protocol", "database", "retention-policy", "batch-size", "batch-pending", "batch-timeout"},
+		Columns: []string{"enabled", "bind-address", "protocol", "database", "retention-policy", "batch-size", "batch-pending", "batch-timeout", "spool-dir"},
 	}
 
 	for _, cc := range c {
@@ -270,7 +289,7 @@ func (c Configs) Diagnostics() (*diagnostics.Diagnostics, error) {
 			continue
 		}
 
-		r := []interface{}{true, cc.BindAddress, cc.Protocol, cc.Database, cc.RetentionPolicy, cc.BatchSize, cc.BatchPending, cc.BatchTimeout}
+		r := []interface{}{true, cc.BindAddress, cc.Protocol, cc.Database, cc.RetentionPolicy, cc.BatchSize, cc.BatchPending, cc.BatchTimeout, cc.SpoolDir}
 		d.AddRow(r)
 	}
 
@@ -53,8 +53,12 @@ type Service struct {
 	batchPending    int
 	batchTimeout    time.Duration
 	udpReadBuffer   int
+	spoolDir        string
+	spoolMaxSize    int64
+	spoolPolicy     string
 
 	batcher *tsdb.PointBatcher
+	spool   *tsdb.PointSpool
 	parser  *Parser
 
 	logger      *zap.Logger
@@ -104,6 +108,9 @@ func NewService(c Config) (*Service, error) {
 		batchPending:    d.BatchPending,
 		udpReadBuffer:   d.UDPReadBuffer,
 		batchTimeout:    time.Duration(d.BatchTimeout),
+		spoolDir:        d.SpoolDir,
+		spoolMaxSize:    int64(d.SpoolMaxSize),
+		spoolPolicy:     d.SpoolPolicy,
 		logger:          zap.NewNop(),
 		stats:           &Statistics{},
 		defaultTags:     models.StatisticTags{"proto": d.Protocol, "bind": d.BindAddress},
@@ -143,6 +150,13 @@ func (s *Service) Open() error {
 		s.Monitor.RegisterDiagnosticsClient(s.diagsKey, s)
 	}
 
+	if s.spoolDir != "" {
+		s.spool = tsdb.NewPointSpool(s.spoolDir, s.spoolMaxSize, s.spoolPolicy)
+		if err := s.spool.Open(); err != nil {
+			return err
+		}
+	}
+
 	s.batcher = tsdb.NewPointBatcher(s.batchSize, s.batchPending, s.batchTimeout)
 	s.batcher.Start()
 
@@ -211,7 +225,11 @@ func (s *Service) Close() error {
 	s.wg.Wait()
 
 	s.mu.Lock()
+	if s.spool != nil {
+		s.spool.Close()
+	}
 	s.done = nil
+	s.spool = nil
 	s.mu.Unlock()
 
 	return nil
@@ -287,7 +305,7 @@ type Statistics struct {
 
 // Statistics returns statistics for periodic monitoring.
 func (s *Service) Statistics(tags map[string]string) []models.Statistic {
-	return []models.Statistic{{
+	statistics := []models.Statistic{{
 		Name: "graphite",
 		Tags: s.defaultTags.Merge(tags),
 		Values: map[string]interface{}{
@@ -302,6 +320,16 @@ func (s *Service) Statistics(tags map[string]string) []models.Statistic {
 			statConnectionsHandled:  atomic.LoadInt64(&s.stats.HandledConnections),
 		},
 	}}
+
+	s.mu.RLock()
+	spool := s.spool
+	s.mu.RUnlock()
+	if spool != nil {
+		spoolTags := s.defaultTags.Merge(tags)
+		spoolTags["service"] = "graphite"
+		statistics = append(statistics, spool.Statistics(spoolTags)...)
+	}
+	return statistics
 }
 
 // Addr returns the address the Service binds to.
@@ -449,9 +477,25 @@ func (s *Service) handleLine(line string) {
 // processBatches continually drains the given batcher and writes the batches to the database.
 func (s *Service) processBatches(batcher *tsdb.PointBatcher) {
 	defer s.wg.Done()
+
+	var retry <-chan time.Time
+	if s.spool != nil {
+		ticker := time.NewTicker(tsdb.SpoolRetryInterval)
+		defer ticker.Stop()
+		retry = ticker.C
+	}
+
 	for {
 		select {
 		case batch := <-batcher.Out():
+			if s.spool != nil {
+				if err := s.spool.Append(batch); err != nil {
+					s.logger.Info("Failed to spool point batch", zap.Error(err))
+				}
+				s.writeSpooled()
+				continue
+			}
+
 			// Will attempt to create database if not yet created.
 			if err := s.createInternalStorage(); err != nil {
 				s.logger.Info("Required database or retention policy do not yet exist", zap.Error(err))
@@ -467,12 +511,30 @@ func (s *Service) processBatches(batcher *tsdb.PointBatcher) {
 				atomic.AddInt64(&s.stats.BatchesTransmitFail, 1)
 			}
 
+		case <-retry:
+			s.writeSpooled()
+
 		case <-s.done:
 			return
 		}
 	}
 }
 
+// writeSpooled writes the batches held by the spool.  Batches which cannot be
+// written remain in the spool and are retried later, unless the database
+// rejects them.
+func (s *Service) writeSpooled() {
+	batchN, pointN, failN := s.spool.WriteSpooled(func(batch []models.Point) error {
+		if err := s.createInternalStorage(); err != nil {
+			return err
+		}
+		return s.PointsWriter.WritePointsPrivileged(s.database, s.retentionPolicy, models.ConsistencyLevelAny, batch)
+	}, s.logger, s.database)
+	atomic.AddInt64(&s.stats.BatchesTransmitted, batchN)
+	atomic.AddInt64(&s.stats.PointsTransmitted, pointN)
+	atomic.AddInt64(&s.stats.BatchesTransmitFail, failN)
+}
+
 // Diagnostics returns diagnostics of the graphite service.
 func (s *Service) Diagnostics() (*diagnostics.Diagnostics, error) {
 	s.tcpConnectionsMu.Lock()
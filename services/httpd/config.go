@@ -1,7 +1,10 @@
 package httpd
 
 import (
+	"fmt"
+
 	"github.com/influxdata/influxdb/monitor/diagnostics"
+	"github.com/influxdata/influxdb/prometheus"
 	"github.com/influxdata/influxdb/toml"
 )
 
@@ -17,47 +20,60 @@ const (
 
 	// DefaultMaxBodySize is the default maximum size of a client request body, in bytes. Specify 0 for no limit.
 	DefaultMaxBodySize = 25e6
+
+	// DefaultPrometheusMetricMapping is the default mapping of Prometheus time series to points.
+	DefaultPrometheusMetricMapping = prometheus.MetricMappingFlat
 )
 
 // Config represents a configuration for a HTTP service.
 type Config struct {
-	Enabled               bool          `toml:"enabled"`
-	BindAddress           string        `toml:"bind-address"`
-	AuthEnabled           bool          `toml:"auth-enabled"`
-	LogEnabled            bool          `toml:"log-enabled"`
-	WriteTracing          bool          `toml:"write-tracing"`
-	PprofEnabled          bool          `toml:"pprof-enabled"`
-	HTTPSEnabled          bool          `toml:"https-enabled"`
-	HTTPSCertificate      string        `toml:"https-certificate"`
-	HTTPSPrivateKey       string        `toml:"https-private-key"`
-	MaxRowLimit           int           `toml:"max-row-limit"`
-	MaxConnectionLimit    int           `toml:"max-connection-limit"`
-	SharedSecret          string        `toml:"shared-secret"`
-	Realm                 string        `toml:"realm"`
-	UnixSocketEnabled     bool          `toml:"unix-socket-enabled"`
-	UnixSocketGroup       *toml.Group   `toml:"unix-socket-group"`
-	UnixSocketPermissions toml.FileMode `toml:"unix-socket-permissions"`
-	BindSocket            string        `toml:"bind-socket"`
-	MaxBodySize           int           `toml:"max-body-size"`
-	AccessLogPath         string        `toml:"access-log-path"`
+	Enabled                 bool          `toml:"enabled"`
+	BindAddress             string        `toml:"bind-address"`
+	AuthEnabled             bool          `toml:"auth-enabled"`
+	LogEnabled              bool          `toml:"log-enabled"`
+	WriteTracing            bool          `toml:"write-tracing"`
+	PprofEnabled            bool          `toml:"pprof-enabled"`
+	HTTPSEnabled            bool          `toml:"https-enabled"`
+	HTTPSCertificate        string        `toml:"https-certificate"`
+	HTTPSPrivateKey         string        `toml:"https-private-key"`
+	MaxRowLimit             int           `toml:"max-row-limit"`
+	MaxConnectionLimit      int           `toml:"max-connection-limit"`
+	SharedSecret            string        `toml:"shared-secret"`
+	Realm                   string        `toml:"realm"`
+	UnixSocketEnabled       bool          `toml:"unix-socket-enabled"`
+	UnixSocketGroup         *toml.Group   `toml:"unix-socket-group"`
+	UnixSocketPermissions   toml.FileMode `toml:"unix-socket-permissions"`
+	BindSocket              string        `toml:"bind-socket"`
+	MaxBodySize             int           `toml:"max-body-size"`
+	AccessLogPath           string        `toml:"access-log-path"`
+	PrometheusMetricMapping string        `toml:"prometheus-metric-mapping"`
 }
 
 // NewConfig returns a new Config with default settings.
 func NewConfig() Config {
 	return Config{
-		Enabled:               true,
-		BindAddress:           DefaultBindAddress,
-		LogEnabled:            true,
-		PprofEnabled:          true,
-		HTTPSEnabled:          false,
-		HTTPSCertificate:      "/etc/ssl/influxdb.pem",
-		MaxRowLimit:           0,
-		Realm:                 DefaultRealm,
-		UnixSocketEnabled:     false,
-		UnixSocketPermissions: 0777,
-		BindSocket:            DefaultBindSocket,
-		MaxBodySize:           DefaultMaxBodySize,
+		Enabled:                 true,
+		BindAddress:             DefaultBindAddress,
+		LogEnabled:              true,
+		PprofEnabled:            true,
+		HTTPSEnabled:            false,
+		HTTPSCertificate:        "/etc/ssl/influxdb.pem",
+		MaxRowLimit:             0,
+		Realm:                   DefaultRealm,
+		UnixSocketEnabled:       false,
+		UnixSocketPermissions:   0777,
+		BindSocket:              DefaultBindSocket,
+		MaxBodySize:             DefaultMaxBodySize,
+		PrometheusMetricMapping: DefaultPrometheusMetricMapping,
+	}
+}
+
+// Validate returns an error if the config is invalid.
+func (c Config) Validate() error {
+	if err := prometheus.ValidateMetricMapping(c.PrometheusMetricMapping); err != nil {
+		return fmt.Errorf("invalid prometheus-metric-mapping: %v", err)
 	}
+	return nil
 }
 
 // Diagnostics returns a diagnostics representation of a subset of the Config.
@@ -69,11 +85,12 @@ func (c Config) Diagnostics() (*diagnostics.Diagnostics, error) {
 	}
 
 	return diagnostics.RowFromMap(map[string]interface{}{
-		"enabled":              true,
-		"bind-address":         c.BindAddress,
-		"https-enabled":        c.HTTPSEnabled,
-		"max-row-limit":        c.MaxRowLimit,
-		"max-connection-limit": c.MaxConnectionLimit,
-		"access-log-path":      c.AccessLogPath,
+		"enabled":                   true,
+		"bind-address":              c.BindAddress,
+		"https-enabled":             c.HTTPSEnabled,
+		"max-row-limit":             c.MaxRowLimit,
+		"max-connection-limit":      c.MaxConnectionLimit,
+		"access-log-path":           c.AccessLogPath,
+		"prometheus-metric-mapping": c.PrometheusMetricMapping,
 	}), nil
 }
@@ -21,6 +21,7 @@ https-certificate = "/dev/null"
 unix-socket-enabled = true
 bind-socket = "/var/run/influxdb.sock"
 max-body-size = 100
+prometheus-metric-mapping = "family"
 `, &c); err != nil {
 		t.Fatal(err)
 	}
@@ -46,6 +47,20 @@ max-body-size = 100
 		t.Fatalf("unexpected bind unix socket: %v", c.BindSocket)
 	} else if c.MaxBodySize != 100 {
 		t.Fatalf("unexpected max-body-size: %v", c.MaxBodySize)
+	} else if c.PrometheusMetricMapping != "family" {
+		t.Fatalf("unexpected prometheus-metric-mapping: %v", c.PrometheusMetricMapping)
+	}
+}
+
+func TestConfig_Validate(t *testing.T) {
+	c := httpd.NewConfig()
+	if err := c.Validate(); err != nil {
+		t.Fatalf("unexpected validation fail from NewConfig: %s", err)
+	}
+
+	c.PrometheusMetricMapping = "nested"
+	if err := c.Validate(); err == nil {
+		t.Fatal("expected error for unknown prometheus-metric-mapping")
 	}
 }
 
@@ -2,6 +2,7 @@ package httpd_test
 
 import (
 	"bytes"
+	"encoding/binary"
 	"errors"
 	"fmt"
 	"io"
@@ -19,12 +20,15 @@ import (
 	"github.com/dgrijalva/jwt-go"
 	"github.com/gogo/protobuf/proto"
 	"github.com/golang/snappy"
+	"github.com/influxdata/influxdb/coordinator"
 	"github.com/influxdata/influxdb/internal"
 	"github.com/influxdata/influxdb/models"
+	"github.com/influxdata/influxdb/prometheus"
 	"github.com/influxdata/influxdb/prometheus/remote"
 	"github.com/influxdata/influxdb/query"
 	"github.com/influxdata/influxdb/services/httpd"
 	"github.com/influxdata/influxdb/services/meta"
+	"github.com/influxdata/influxdb/tsdb"
 	"github.com/influxdata/influxql"
 )
 
@@ -222,6 +226,37 @@ func TestHandler_Query_Auth(t *testing.T) {
 		t.Fatalf("unexpected body: %s", body)
 	}
 
+	// Test the handler with a valid API token.
+	h.MetaClient.AuthenticateTokenFn = func(token string) (meta.User, error) {
+		if token != "0123.abcd" {
+			return nil, meta.ErrAuthenticate
+		}
+		u, _ := h.MetaClient.User("user1")
+		return &meta.TokenUser{
+			UserInfo: u.(*meta.UserInfo),
+			Token:    &meta.TokenInfo{ID: "0123", User: "user1", Privilege: influxql.AllPrivileges},
+		}, nil
+	}
+	req = MustNewJSONRequest("GET", "/query?db=foo&q=SELECT+*+FROM+bar", nil)
+	req.Header.Set("Authorization", "Token 0123.abcd")
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	if w.Code != http.StatusOK {
+		t.Fatalf("unexpected status: %d: %s", w.Code, w.Body.String())
+	} else if body := strings.TrimSpace(w.Body.String()); body != `{"results":[{"statement_id":1,"series":[{"name":"series0"}]},{"statement_id":2,"series":[{"name":"series1"}]}]}` {
+		t.Fatalf("unexpected body: %s", body)
+	}
+
+	// Test the handler with an invalid API token.
+	req.Header.Set("Authorization", "Token 0123.efgh")
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	if w.Code != http.StatusUnauthorized {
+		t.Fatalf("unexpected status: %d: %s", w.Code, w.Body.String())
+	} else if body := strings.TrimSpace(w.Body.String()); body != `{"error":"authorization failed"}` {
+		t.Fatalf("unexpected body: %s", body)
+	}
+
 	// Test the handler with valid user and password in the url and invalid in
 	// basic auth (prioritize url).
 	w = httptest.NewRecorder()
@@ -311,6 +346,32 @@ func TestHandler_Query_Chunked(t *testing.T) {
 	}
 }
 
+// Ensure the handler can parse the explain format query parameter.
+func TestHandler_Query_ExplainFormat(t *testing.T) {
+	h := NewHandler(false)
+	h.StatementExecutor.ExecuteStatementFn = func(stmt influxql.Statement, ctx *query.ExecutionContext) error {
+		if ctx.ExplainFormat != query.ExplainFormatJSON {
+			t.Fatalf("unexpected explain format: %d", ctx.ExplainFormat)
+		}
+		ctx.Results <- &query.Result{StatementID: 1, Series: models.Rows([]*models.Row{{Name: "series0"}})}
+		return nil
+	}
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, MustNewJSONRequest("GET", "/query?db=foo&q=EXPLAIN+ANALYZE+SELECT+*+FROM+bar&explain=json", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("unexpected status: %d", w.Code)
+	}
+
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, MustNewJSONRequest("GET", "/query?db=foo&q=EXPLAIN+ANALYZE+SELECT+*+FROM+bar&explain=xml", nil))
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("unexpected status: %d", w.Code)
+	} else if body := strings.TrimSpace(w.Body.String()); body != `{"error":"unknown explain format: \"xml\""}` {
+		t.Fatalf("unexpected body: %s", body)
+	}
+}
+
 // Ensure the handler can accept an async query.
 func TestHandler_Query_Async(t *testing.T) {
 	done := make(chan struct{})
@@ -615,7 +676,7 @@ func TestHandler_PromRead(t *testing.T) {
 
 	h := NewHandler(false)
 	h.StatementExecutor.ExecuteStatementFn = func(stmt influxql.Statement, ctx *query.ExecutionContext) error {
-		if stmt.String() != `SELECT f64 FROM foo.._ WHERE eq = 'a' AND neq != 'b' AND regex =~ /c/ AND neqregex !~ /d/ AND time >= '1970-01-01T00:00:00.001Z' AND time <= '1970-01-01T00:00:00.002Z' GROUP BY *` {
+		if stmt.String() != `SELECT f64 FROM foo.._ WHERE eq = 'a' AND neq != 'b' AND regex = 'c' AND neqregex != 'd' AND time >= '1970-01-01T00:00:00.001Z' AND time <= '1970-01-01T00:00:00.002Z' GROUP BY *` {
 			t.Fatalf("unexpected query: %s", stmt.String())
 		} else if ctx.Database != `foo` {
 			t.Fatalf("unexpected db: %s", ctx.Database)
@@ -660,6 +721,85 @@ func TestHandler_PromRead(t *testing.T) {
 	}
 }
 
+// Ensure the handler streams chunked responses and pushes aggregation down
+// into the query when the read hints allow it.
+func TestHandler_PromRead_Streamed(t *testing.T) {
+	req := &remote.ReadRequest{
+		Queries: []*remote.Query{{
+			Matchers: []*remote.LabelMatcher{
+				{Type: remote.MatchType_REGEX_MATCH, Name: "__name__", Value: "cpu_.*"},
+			},
+			StartTimestampMs: 0,
+			EndTimestampMs:   120000,
+			Hints:            &remote.ReadHints{StepMs: 60000, EndMs: 120000},
+		}},
+		AcceptedResponseTypes: []remote.ReadRequest_ResponseType{remote.ReadRequest_STREAMED_XOR_CHUNKS},
+	}
+	data, err := proto.Marshal(req)
+	if err != nil {
+		t.Fatal("couldn't marshal prometheus request")
+	}
+	b := bytes.NewReader(snappy.Encode(nil, data))
+
+	h := NewHandler(false)
+	h.StatementExecutor.ExecuteStatementFn = func(stmt influxql.Statement, ctx *query.ExecutionContext) error {
+		if stmt.String() != `SELECT last(f64) AS f64 FROM foo.._ WHERE __name__ =~ /^(?:cpu_.*)$/ AND time >= '1970-01-01T00:00:00Z' AND time <= '1970-01-01T00:02:00Z' GROUP BY *, time(1m, 1ms) fill(none)` {
+			t.Fatalf("unexpected query: %s", stmt.String())
+		}
+		for _, host := range []string{"a", "b"} {
+			row := &models.Row{
+				Name:    "_",
+				Tags:    map[string]string{"__name__": "cpu_seconds", "host": host},
+				Columns: []string{"time", "f64"},
+				Values: [][]interface{}{
+					{time.Unix(0, int64(time.Millisecond)), 1.2},
+					{time.Unix(60, int64(time.Millisecond)), 3.4},
+				},
+			}
+			ctx.Results <- &query.Result{StatementID: 1, Series: models.Rows([]*models.Row{row})}
+		}
+		return nil
+	}
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, MustNewJSONRequest("POST", "/api/v1/prom/read?db=foo", b))
+	if w.Code != http.StatusOK {
+		t.Fatalf("unexpected status: %d", w.Code)
+	} else if ct := w.Header().Get("Content-Type"); ct != prometheus.StreamedContentType {
+		t.Fatalf("unexpected content type: %s", ct)
+	}
+
+	for _, host := range []string{"a", "b"} {
+		size, err := binary.ReadUvarint(w.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		frame := make([]byte, 4+size)
+		if _, err := io.ReadFull(w.Body, frame); err != nil {
+			t.Fatal(err)
+		}
+
+		var resp remote.ChunkedReadResponse
+		if err := proto.Unmarshal(frame[4:], &resp); err != nil {
+			t.Fatal(err)
+		}
+
+		expLabels := []*remote.LabelPair{{Name: "__name__", Value: "cpu_seconds"}, {Name: "host", Value: host}}
+		expChunks := prometheus.SamplesToChunks([]*remote.Sample{
+			{TimestampMs: 60000, Value: 1.2},
+			{TimestampMs: 120000, Value: 3.4},
+		})
+		if series := resp.ChunkedSeries[0]; !reflect.DeepEqual(expLabels, series.Labels) {
+			t.Fatalf("unexpected labels\n\texp: %v\n\tgot: %v", expLabels, series.Labels)
+		} else if !reflect.DeepEqual(expChunks, series.Chunks) {
+			t.Fatalf("unexpected chunks\n\texp: %v\n\tgot: %v", expChunks, series.Chunks)
+		}
+	}
+	if w.Body.Len() != 0 {
+		t.Fatalf("unexpected trailing bytes: %d", w.Body.Len())
+	}
+}
+
 // Ensure the handler handles ping requests correctly.
 // TODO: This should be expanded to verify the MetaClient check in servePing is working correctly
 func TestHandler_Ping(t *testing.T) {
@@ -817,6 +957,177 @@ func TestHandler_Write_NegativeMaxBodySize(t *testing.T) {
 	}
 }
 
+// Ensure the handler rejects writes which exceed the write limits.
+func TestHandler_Write_Limited(t *testing.T) {
+	h := NewHandler(false)
+	h.MetaClient.DatabaseFn = func(name string) *meta.DatabaseInfo {
+		return &meta.DatabaseInfo{}
+	}
+	l := coordinator.NewWriteLimiter([]coordinator.WriteLimitConfig{
+		{Database: "foo", PointsPerSecond: 1},
+	})
+	h.PointsWriter.WritePointsFn = func(database, _ string, _ models.ConsistencyLevel, _ meta.User, points []models.Point) error {
+		return l.AllowWrite(database, "", len(points), 0)
+	}
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, MustNewRequest("POST", "/write?db=foo", bytes.NewReader([]byte(`foo n=1`))))
+	if w.Code != http.StatusNoContent {
+		t.Fatalf("unexpected status: %d", w.Code)
+	}
+
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, MustNewRequest("POST", "/write?db=foo", bytes.NewReader([]byte(`foo n=1`))))
+	if w.Code != http.StatusTooManyRequests {
+		t.Fatalf("unexpected status: %d", w.Code)
+	} else if got := w.Header().Get("Retry-After"); got != "1" {
+		t.Fatalf("unexpected Retry-After: %q", got)
+	}
+}
+
+// Ensure the handler accepts CSV write bodies and reports records which fail to parse.
+func TestHandler_Write_CSV(t *testing.T) {
+	h := NewHandler(false)
+	h.MetaClient.DatabaseFn = func(name string) *meta.DatabaseInfo {
+		return &meta.DatabaseInfo{}
+	}
+	var points []models.Point
+	h.PointsWriter.WritePointsFn = func(_, _ string, _ models.ConsistencyLevel, _ meta.User, p []models.Point) error {
+		points = p
+		return nil
+	}
+
+	body := "m:measurement,host:tag,value:float,n:integer,ok:boolean,:time\n" +
+		"cpu,a,1.5,2,true,10\n" +
+		"cpu,,2.5,,,20\n" +
+		"cpu,b,x,3,false,30\n"
+	req := MustNewRequest("POST", "/write?db=foo&precision=s", strings.NewReader(body))
+	req.Header.Set("Content-Type", "text/csv")
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("unexpected status: %d", w.Code)
+	} else if !strings.Contains(w.Body.String(), "partial write") || !strings.Contains(w.Body.String(), "unable to parse record 3") {
+		t.Fatalf("unexpected body: %s", w.Body.String())
+	}
+
+	var got []string
+	for _, p := range points {
+		got = append(got, p.String())
+	}
+	if exp := []string{
+		"cpu,host=a n=2i,ok=true,value=1.5 10000000000",
+		"cpu value=2.5 20000000000",
+	}; !reflect.DeepEqual(got, exp) {
+		t.Fatalf("unexpected points: %v", got)
+	}
+}
+
+// Ensure the handler accepts JSON write bodies.
+func TestHandler_Write_JSON(t *testing.T) {
+	h := NewHandler(false)
+	h.MetaClient.DatabaseFn = func(name string) *meta.DatabaseInfo {
+		return &meta.DatabaseInfo{}
+	}
+	var points []models.Point
+	h.PointsWriter.WritePointsFn = func(_, _ string, _ models.ConsistencyLevel, _ meta.User, p []models.Point) error {
+		points = p
+		return nil
+	}
+
+	body := `[
+		{"measurement": "cpu", "tags": {"host": "a"}, "fields": {"value": 1, "n": {"type": "integer", "value": 3}, "s": "x"}, "time": 10},
+		{"measurement": "cpu", "fields": {"value": 2.5}, "time": "1970-01-01T00:00:20Z"}
+	]`
+	req := MustNewRequest("POST", "/write?db=foo&precision=s", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	if w.Code != http.StatusNoContent {
+		t.Fatalf("unexpected status: %d: %s", w.Code, w.Body.String())
+	}
+
+	var got []string
+	for _, p := range points {
+		got = append(got, p.String())
+	}
+	if exp := []string{
+		`cpu,host=a n=3i,s="x",value=1 10000000000`,
+		"cpu value=2.5 20000000000",
+	}; !reflect.DeepEqual(got, exp) {
+		t.Fatalf("unexpected points: %v", got)
+	}
+
+	// Unsigned values are rejected unless uint support is enabled.
+	body = `[{"measurement": "cpu", "fields": {"n": {"type": "unsigned", "value": 3}}, "time": 10}]`
+	req = MustNewRequest("POST", "/write?db=foo&precision=s", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("unexpected status: %d", w.Code)
+	} else if !strings.Contains(w.Body.String(), "unsigned values are not supported") {
+		t.Fatalf("unexpected body: %s", w.Body.String())
+	}
+}
+
+func TestHandler_Compactions(t *testing.T) {
+	h := NewHandler(false)
+	h.Handler.TSDBStore = &HandlerTSDBStore{}
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, MustNewRequest("GET", "/api/v1/compactions", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("unexpected status: %d", w.Code)
+	} else if body := strings.TrimSpace(w.Body.String()); body != "[]" {
+		t.Fatalf("unexpected body: %s", body)
+	}
+
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, MustNewRequest("GET", "/api/v1/compactions?shard=x", nil))
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("unexpected status: %d", w.Code)
+	}
+
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, MustNewRequest("POST", "/api/v1/compactions", strings.NewReader(`{"shard_id":1,"action":"pause"}`)))
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("unexpected status: %d", w.Code)
+	}
+}
+
+// Ensure the slow query log requires admin privilege when authentication is enabled.
+func TestHandler_DebugSlowQueries(t *testing.T) {
+	h := NewHandler(true)
+	h.MetaClient.AdminUserExistsFn = func() bool { return true }
+	h.MetaClient.AuthenticateFn = func(u, p string) (meta.User, error) {
+		return &meta.UserInfo{Name: u, Admin: u == "admin"}, nil
+	}
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, MustNewRequest("GET", "/debug/slow-queries", nil))
+	if w.Code != http.StatusUnauthorized {
+		t.Fatalf("unexpected status: %d", w.Code)
+	}
+
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, MustNewRequest("GET", "/debug/slow-queries?u=user1&p=abcd", nil))
+	if w.Code != http.StatusForbidden {
+		t.Fatalf("unexpected status: %d", w.Code)
+	}
+
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, MustNewRequest("GET", "/debug/slow-queries?u=admin&p=abcd", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("unexpected status: %d", w.Code)
+	} else if body := strings.TrimSpace(w.Body.String()); body != "[]" {
+		t.Fatalf("unexpected body: %s", body)
+	}
+}
+
 // Ensure X-Forwarded-For header writes the correct log message.
 func TestHandler_XForwardedFor(t *testing.T) {
 	var buf bytes.Buffer
@@ -940,6 +1251,12 @@ func (e *HandlerStatementExecutor) ExecuteStatement(stmt influxql.Statement, ctx
 	return e.ExecuteStatementFn(stmt, ctx)
 }
 
+// HandlerTSDBStore is a mock implementation of Handler.TSDBStore without shards.
+type HandlerTSDBStore struct{}
+
+func (s *HandlerTSDBStore) Shard(id uint64) *tsdb.Shard { return nil }
+func (s *HandlerTSDBStore) ShardIDs() []uint64          { return nil }
+
 // HandlerQueryAuthorizer is a mock implementation of Handler.QueryAuthorizer.
 type HandlerQueryAuthorizer struct {
 	AuthorizeQueryFn func(u meta.User, query *influxql.Query, database string) error
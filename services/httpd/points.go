@@ -0,0 +1,341 @@
+package httpd
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/influxdata/influxdb/models"
+)
+
+// parsePoints parses the body of a write request according to its content
+// type.  CSV and JSON bodies are accepted in addition to line protocol.  As
+// with line protocol, the points which were parsed are returned along with an
+// error describing any which were not.
+func parsePoints(contentType string, buf []byte, defaultTime time.Time, precision string) ([]models.Point, error) {
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	switch mediaType {
+	case "text/csv":
+		return parseCSVPoints(buf, defaultTime, precision)
+	case "application/json":
+		return parseJSONPoints(buf, defaultTime, precision)
+	default:
+		return models.ParsePointsWithPrecision(buf, defaultTime, precision)
+	}
+}
+
+// CSV column annotations.
+const (
+	csvMeasurement = "measurement"
+	csvTime        = "time"
+	csvTag         = "tag"
+	csvFloat       = "float"
+	csvInteger     = "integer"
+	csvUnsigned    = "unsigned"
+	csvBoolean     = "boolean"
+	csvString      = "string"
+)
+
+// csvColumn is a column of a CSV body.
+type csvColumn struct {
+	name string
+	typ  string
+}
+
+// parseCSVColumns parses the header of a CSV body.  Each column is named and
+// annotated with its type as "name:type".  The type is one of measurement,
+// time, tag, float, integer, unsigned, boolean or string.
+func parseCSVColumns(header []string) ([]csvColumn, error) {
+	var measurementN, timeN, fieldN int
+	columns := make([]csvColumn, len(header))
+	for i, h := range header {
+		j := strings.LastIndexByte(h, ':')
+		if j < 0 {
+			return nil, fmt.Errorf("column %q has no type annotation", h)
+		}
+
+		col := csvColumn{name: h[:j], typ: h[j+1:]}
+		switch col.typ {
+		case csvMeasurement:
+			measurementN++
+		case csvTime:
+			timeN++
+		case csvTag:
+		case csvFloat, csvInteger, csvUnsigned, csvBoolean, csvString:
+			fieldN++
+		default:
+			return nil, fmt.Errorf("column %q has unknown type %q", col.name, col.typ)
+		}
+		if col.name == "" && col.typ != csvMeasurement && col.typ != csvTime {
+			return nil, fmt.Errorf("column %d has no name", i+1)
+		}
+		columns[i] = col
+	}
+
+	if measurementN != 1 {
+		return nil, errors.New("exactly one measurement column is required")
+	} else if timeN > 1 {
+		return nil, errors.New("at most one time column is allowed")
+	} else if fieldN == 0 {
+		return nil, errors.New("at least one field column is required")
+	}
+	return columns, nil
+}
+
+// parseCSVPoints parses a CSV body.  The first record is a header naming and
+// annotating the columns, and each later record is a point.  Empty tag and
+// field values are omitted from the point.
+func parseCSVPoints(buf []byte, defaultTime time.Time, precision string) ([]models.Point, error) {
+	r := csv.NewReader(bytes.NewReader(buf))
+	r.ReuseRecord = true
+
+	header, err := r.Read()
+	if err != nil {
+		return nil, err
+	}
+	columns, err := parseCSVColumns(header)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse header: %v", err)
+	}
+
+	var (
+		points []models.Point
+		failed []string
+	)
+	for n := 1; ; n++ {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			failed = append(failed, fmt.Sprintf("unable to parse record %d: %v", n, err))
+
+			// The reader cannot continue after an error, other than a
+			// record with the wrong number of fields.
+			if perr, ok := err.(*csv.ParseError); ok && perr.Err == csv.ErrFieldCount {
+				continue
+			}
+			break
+		}
+
+		pt, err := parseCSVRecord(columns, record, defaultTime, precision)
+		if err != nil {
+			failed = append(failed, fmt.Sprintf("unable to parse record %d: %v", n, err))
+			continue
+		}
+		points = append(points, pt)
+	}
+
+	if len(failed) > 0 {
+		return points, fmt.Errorf("%s", strings.Join(failed, "\n"))
+	}
+	return points, nil
+}
+
+// parseCSVRecord returns the point held by a record of a CSV body.
+func parseCSVRecord(columns []csvColumn, record []string, defaultTime time.Time, precision string) (models.Point, error) {
+	var name, timestamp string
+	tags := make(map[string]string)
+	fields := make(models.Fields)
+	for i, col := range columns {
+		v := record[i]
+		switch col.typ {
+		case csvMeasurement:
+			name = v
+		case csvTime:
+			timestamp = v
+		case csvTag:
+			if v != "" {
+				tags[col.name] = v
+			}
+		default:
+			if v == "" {
+				continue
+			}
+			value, err := parseFieldValue(col.typ, v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for field %q: %v", col.name, err)
+			}
+			fields[col.name] = value
+		}
+	}
+
+	if name == "" {
+		return nil, errors.New("missing measurement")
+	}
+
+	var t time.Time
+	if timestamp != "" {
+		var err error
+		if t, err = parseTimestamp(timestamp, precision); err != nil {
+			return nil, err
+		}
+	}
+	return newPoint(name, models.NewTags(tags), fields, t, defaultTime, precision)
+}
+
+// parseFieldValue parses a field value of the given type from its string
+// representation.  Unsigned values are rejected unless uint support is
+// enabled, as they are by line protocol.
+func parseFieldValue(typ, v string) (interface{}, error) {
+	switch typ {
+	case csvFloat:
+		return strconv.ParseFloat(v, 64)
+	case csvInteger:
+		return strconv.ParseInt(v, 10, 64)
+	case csvUnsigned:
+		if !models.UintSupportEnabled() {
+			return nil, errors.New("unsigned values are not supported")
+		}
+		return strconv.ParseUint(v, 10, 64)
+	case csvBoolean:
+		return strconv.ParseBool(v)
+	case csvString:
+		return v, nil
+	default:
+		return nil, fmt.Errorf("unknown type %q", typ)
+	}
+}
+
+// parseTimestamp parses a time which is either an integer in the units of
+// precision or an RFC3339 time.
+func parseTimestamp(v, precision string) (time.Time, error) {
+	if ts, err := strconv.ParseInt(v, 10, 64); err == nil {
+		return models.SafeCalcTime(ts, precision)
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, v)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid time %q", v)
+	}
+	t = t.UTC()
+	return t, models.CheckTime(t)
+}
+
+// newPoint returns a point at time t, or at defaultTime rounded to precision
+// if t is zero.
+func newPoint(name string, tags models.Tags, fields models.Fields, t, defaultTime time.Time, precision string) (models.Point, error) {
+	if t.IsZero() {
+		t = defaultTime.Truncate(time.Duration(models.GetPrecisionMultiplier(precision)))
+	}
+	return models.NewPoint(name, tags, fields, t)
+}
+
+// jsonPoint is a point of a JSON body.  Numeric field values are floats unless
+// given as an object with an explicit type, such as
+// {"type": "integer", "value": 10}.
+type jsonPoint struct {
+	Measurement string                     `json:"measurement"`
+	Tags        map[string]string          `json:"tags"`
+	Fields      map[string]json.RawMessage `json:"fields"`
+	Time        json.RawMessage            `json:"time"`
+}
+
+// jsonTypedValue is a field value with an explicit type.
+type jsonTypedValue struct {
+	Type  string      `json:"type"`
+	Value json.Number `json:"value"`
+}
+
+// parseJSONPoints parses a JSON body holding an array of points.
+func parseJSONPoints(buf []byte, defaultTime time.Time, precision string) ([]models.Point, error) {
+	var raw []json.RawMessage
+	dec := json.NewDecoder(bytes.NewReader(buf))
+	if err := dec.Decode(&raw); err != nil {
+		return nil, err
+	}
+
+	var (
+		points []models.Point
+		failed []string
+	)
+	for i, b := range raw {
+		pt, err := parseJSONPoint(b, defaultTime, precision)
+		if err != nil {
+			failed = append(failed, fmt.Sprintf("unable to parse point %d: %v", i+1, err))
+			continue
+		}
+		points = append(points, pt)
+	}
+
+	if len(failed) > 0 {
+		return points, fmt.Errorf("%s", strings.Join(failed, "\n"))
+	}
+	return points, nil
+}
+
+// parseJSONPoint returns the point held by an element of a JSON body.
+func parseJSONPoint(b json.RawMessage, defaultTime time.Time, precision string) (models.Point, error) {
+	var p jsonPoint
+	if err := json.Unmarshal(b, &p); err != nil {
+		return nil, err
+	} else if p.Measurement == "" {
+		return nil, errors.New("missing measurement")
+	}
+
+	fields := make(models.Fields, len(p.Fields))
+	for k, v := range p.Fields {
+		value, err := parseJSONFieldValue(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for field %q: %v", k, err)
+		}
+		fields[k] = value
+	}
+
+	var t time.Time
+	if len(p.Time) > 0 && string(p.Time) != "null" {
+		var v string
+		if err := json.Unmarshal(p.Time, &v); err != nil {
+			// The time is not a string, so it must be a number.
+			var n json.Number
+			if err := json.Unmarshal(p.Time, &n); err != nil {
+				return nil, fmt.Errorf("invalid time %s", p.Time)
+			}
+			v = n.String()
+		}
+
+		var err error
+		if t, err = parseTimestamp(v, precision); err != nil {
+			return nil, err
+		}
+	}
+
+	return newPoint(p.Measurement, models.NewTags(p.Tags), fields, t, defaultTime, precision)
+}
+
+// parseJSONFieldValue parses a field value of a JSON point.
+func parseJSONFieldValue(b json.RawMessage) (interface{}, error) {
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+
+	switch v := v.(type) {
+	case json.Number:
+		return v.Float64()
+	case string, bool:
+		return v, nil
+	case map[string]interface{}:
+		var tv jsonTypedValue
+		if err := json.Unmarshal(b, &tv); err != nil {
+			return nil, err
+		}
+		switch tv.Type {
+		case csvFloat, csvInteger, csvUnsigned:
+			return parseFieldValue(tv.Type, tv.Value.String())
+		default:
+			return nil, fmt.Errorf("unknown type %q", tv.Type)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported value %s", b)
+	}
+}
@@ -208,6 +208,15 @@ func (f *csvFormatter) WriteResponse(w io.Writer, resp Response) (err error) {
 						f.columns[i+2] = strconv.FormatInt(v.UnixNano(), 10)
 					case *float64, *int64, *string, *bool:
 						f.columns[i+2] = ""
+					default:
+						// Values without a scalar representation, such as the
+						// plan of EXPLAIN ANALYZE in the JSON format, are
+						// written as JSON.
+						b, err := json.Marshal(v)
+						if err != nil {
+							return err
+						}
+						f.columns[i+2] = string(b)
 					}
 				}
 				csv.Write(f.columns)
@@ -73,6 +73,46 @@ cpu,"host=server01,region=uswest",70,9223372036854775808
 	}
 }
 
+// Ensure the CSV response writer writes non-scalar values, such as the JSON
+// format of EXPLAIN ANALYZE, as JSON.
+func TestResponseWriter_CSV_ExplainJSON(t *testing.T) {
+	header := make(http.Header)
+	header.Set("Accept", "text/csv")
+	r := &http.Request{
+		Header: header,
+		URL:    &url.URL{},
+	}
+	w := httptest.NewRecorder()
+
+	writer := httpd.NewResponseWriter(w, r)
+	if _, err := writer.WriteResponse(httpd.Response{
+		Results: []*query.Result{
+			{
+				StatementID: 0,
+				Series: []*models.Row{
+					{
+						Columns: []string{"EXPLAIN ANALYZE"},
+						Values: [][]interface{}{
+							{map[string]interface{}{
+								"name":     "select",
+								"children": []interface{}{map[string]interface{}{"name": "execution_time"}},
+							}},
+						},
+					},
+				},
+			},
+		},
+	}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := w.Body.String(), `name,tags,EXPLAIN ANALYZE
+,,"{""children"":[{""name"":""execution_time""}],""name"":""select""}"
+`; got != want {
+		t.Errorf("unexpected output:\n\ngot=%v\nwant=%s", got, want)
+	}
+}
+
 func TestResponseWriter_MessagePack(t *testing.T) {
 	header := make(http.Header)
 	header.Set("Accept", "application/x-msgpack")
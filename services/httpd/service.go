@@ -29,6 +29,7 @@ const (
 	statPointsWrittenOK              = "pointsWrittenOK"      // Number of points written OK.
 	statPointsWrittenDropped         = "pointsWrittenDropped" // Number of points dropped by the storage engine.
 	statPointsWrittenFail            = "pointsWrittenFail"    // Number of points that failed to be written.
+	statPointsWrittenLimited         = "pointsWrittenLimited" // Number of points rejected by write rate limits.
 	statAuthFail                     = "authFail"             // Number of authentication failures.
 	statRequestDuration              = "reqDurationNs"        // Number of (wall-time) nanoseconds spent inside requests.
 	statQueryRequestDuration         = "queryReqDurationNs"   // Number of (wall-time) nanoseconds spent inside query requests.
@@ -42,6 +43,8 @@ const (
 	// Prometheus stats
 	statPromWriteRequest = "promWriteReq" // Number of write requests to the promtheus endpoint
 	statPromReadRequest  = "promReadReq"  // Number of read requests to the prometheus endpoint
+
+	statPromWriteSeriesDropped = "promWriteSeriesDropped" // Number of series without a metric name dropped by the prometheus write endpoint
 )
 
 // Service manages the listener and handler for an HTTP endpoint.
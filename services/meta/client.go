@@ -6,6 +6,8 @@ import (
 	"bytes"
 	crand "crypto/rand"
 	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
 	"errors"
 	"io"
 	"io/ioutil"
@@ -14,11 +16,13 @@ import (
 	"os"
 	"path/filepath"
 	"sort"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/influxdata/influxdb"
 	"github.com/influxdata/influxdb/logger"
+	"github.com/influxdata/influxdb/query"
 	"github.com/influxdata/influxql"
 	"go.uber.org/zap"
 
@@ -29,6 +33,11 @@ const (
 	// SaltBytes is the number of bytes used for salts.
 	SaltBytes = 32
 
+	// TokenIDBytes and TokenSecretBytes are the number of random bytes used
+	// for the public ID and the secret of an API token.
+	TokenIDBytes     = 8
+	TokenSecretBytes = 32
+
 	metaFile = "meta.db"
 
 	// ShardGroupDeletedExpiration is the amount of time before a shard group info will be removed from cached
@@ -497,6 +506,26 @@ func (c *Client) SetPrivilege(username, database string, p influxql.Privilege) e
 	return nil
 }
 
+// SetMeasurementPrivilege sets a privilege for the given user on the
+// measurements of the given database matching measurement, which is a
+// regular expression if regex is true.
+func (c *Client) SetMeasurementPrivilege(username, database, measurement string, regex bool, p influxql.Privilege) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	data := c.cacheData.Clone()
+
+	if err := data.SetMeasurementPrivilege(username, database, measurement, regex, p); err != nil {
+		return err
+	}
+
+	if err := c.commit(data); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // SetAdminPrivilege sets or unsets admin privilege to the given username.
 func (c *Client) SetAdminPrivilege(username string, admin bool) error {
 	c.mu.Lock()
@@ -515,6 +544,66 @@ func (c *Client) SetAdminPrivilege(username string, admin bool) error {
 	return nil
 }
 
+// SetUserQueryLimits sets the query limits for a user.
+func (c *Client) SetUserQueryLimits(username string, limits query.QueryLimits) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	data := c.cacheData.Clone()
+
+	if err := data.SetUserQueryLimits(username, limits); err != nil {
+		return err
+	}
+
+	if err := c.commit(data); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SetDatabaseQueryLimits sets the query limits for a database.
+func (c *Client) SetDatabaseQueryLimits(database string, limits query.QueryLimits) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	data := c.cacheData.Clone()
+
+	if err := data.SetDatabaseQueryLimits(database, limits); err != nil {
+		return err
+	}
+
+	if err := c.commit(data); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// UserQueryLimits returns the query limits for a user. If the user does
+// not exist, no limits are returned.
+func (c *Client) UserQueryLimits(username string) query.QueryLimits {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if ui := c.cacheData.user(username); ui != nil {
+		return ui.QueryLimits
+	}
+	return query.QueryLimits{}
+}
+
+// DatabaseQueryLimits returns the query limits for a database. If the
+// database does not exist, no limits are returned.
+func (c *Client) DatabaseQueryLimits(database string) query.QueryLimits {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if di := c.cacheData.Database(database); di != nil {
+		return di.QueryLimits
+	}
+	return query.QueryLimits{}
+}
+
 // UserPrivileges returns the privileges for a user mapped by database name.
 func (c *Client) UserPrivileges(username string) (map[string]influxql.Privilege, error) {
 	c.mu.RLock()
@@ -585,6 +674,110 @@ func (c *Client) Authenticate(username, password string) (User, error) {
 	return userInfo, nil
 }
 
+// CreateToken creates an API token for a user, optionally restricted to a
+// single database and privilege. A zero expiration never expires. The token
+// is returned in the form "<id>.<secret>"; only a hash of the secret is
+// stored, so the token cannot be retrieved again.
+func (c *Client) CreateToken(username, database string, p influxql.Privilege, expiration time.Time) (string, *TokenInfo, error) {
+	id := make([]byte, TokenIDBytes)
+	if _, err := io.ReadFull(crand.Reader, id); err != nil {
+		return "", nil, err
+	}
+	secret := make([]byte, TokenSecretBytes)
+	if _, err := io.ReadFull(crand.Reader, secret); err != nil {
+		return "", nil, err
+	}
+
+	ti := TokenInfo{
+		ID:         hex.EncodeToString(id),
+		User:       username,
+		Database:   database,
+		Privilege:  p,
+		CreatedAt:  time.Now().UTC(),
+		Expiration: expiration,
+	}
+	if !expiration.IsZero() {
+		ti.Expiration = expiration.UTC()
+	}
+	token := ti.ID + "." + hex.EncodeToString(secret)
+	ti.Hash = hashToken(token)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	data := c.cacheData.Clone()
+
+	if err := data.CreateToken(ti); err != nil {
+		return "", nil, err
+	}
+
+	if err := c.commit(data); err != nil {
+		return "", nil, err
+	}
+
+	return token, &ti, nil
+}
+
+// RevokeToken removes the token with the given ID.
+func (c *Client) RevokeToken(id string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	data := c.cacheData.Clone()
+
+	if err := data.DropToken(id); err != nil {
+		return err
+	}
+
+	if err := c.commit(data); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Tokens returns a slice of TokenInfo representing the currently known tokens.
+func (c *Client) Tokens() []TokenInfo {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.cacheData.CloneTokens()
+}
+
+// AuthenticateToken returns the user for a token created by CreateToken.
+// The returned user is restricted to the privileges of the token.
+func (c *Client) AuthenticateToken(token string) (User, error) {
+	i := strings.IndexByte(token, '.')
+	if i <= 0 {
+		return nil, ErrAuthenticate
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	ti := c.cacheData.Token(token[:i])
+	if ti == nil || subtle.ConstantTimeCompare([]byte(hashToken(token)), []byte(ti.Hash)) != 1 {
+		return nil, ErrAuthenticate
+	} else if ti.Expired(time.Now()) {
+		return nil, ErrTokenExpired
+	}
+
+	ui := c.cacheData.user(ti.User)
+	if ui == nil {
+		return nil, ErrUserNotFound
+	}
+
+	tc := *ti
+	return &TokenUser{UserInfo: ui, Token: &tc}, nil
+}
+
+// hashToken returns the hex encoded SHA-256 hash of token. Tokens are
+// random, so they do not require a salt or a slow hash.
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
 // UserCount returns the number of users stored.
 func (c *Client) UserCount() int {
 	c.mu.RLock()
@@ -758,6 +758,87 @@ func TestMetaClient_UpdateUser(t *testing.T) {
 	}
 }
 
+func TestMetaClient_Tokens(t *testing.T) {
+	t.Parallel()
+
+	d, c := newClient()
+	defer os.RemoveAll(d)
+	defer c.Close()
+
+	if _, err := c.CreateUser("fred", "supersecure", false); err != nil {
+		t.Fatal(err)
+	} else if _, err := c.CreateDatabase("db0"); err != nil {
+		t.Fatal(err)
+	}
+
+	// Creating a token for a user that doesn't exist should return an error.
+	if _, _, err := c.CreateToken("wilma", "", influxql.AllPrivileges, time.Time{}); err != meta.ErrUserNotFound {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	token, ti, err := c.CreateToken("fred", "db0", influxql.ReadPrivilege, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	} else if !strings.HasPrefix(token, ti.ID+".") {
+		t.Fatalf("unexpected token: %s", token)
+	}
+
+	// The token authenticates as its user, restricted to its database and privilege.
+	u, err := c.AuthenticateToken(token)
+	if err != nil {
+		t.Fatal(err)
+	} else if u.ID() != "fred" {
+		t.Fatalf("unexpected user: %s", u.ID())
+	} else if u.AuthorizeDatabase(influxql.ReadPrivilege, "db0") {
+		t.Fatal("expected user privileges to be required")
+	}
+
+	if err := c.SetPrivilege("fred", "db0", influxql.AllPrivileges); err != nil {
+		t.Fatal(err)
+	}
+	if u, err = c.AuthenticateToken(token); err != nil {
+		t.Fatal(err)
+	} else if !u.AuthorizeDatabase(influxql.ReadPrivilege, "db0") {
+		t.Fatal("expected read privilege on db0")
+	} else if u.AuthorizeDatabase(influxql.WritePrivilege, "db0") {
+		t.Fatal("expected write privilege to be restricted by token")
+	}
+
+	// Invalid secrets and unknown tokens should not authenticate.
+	if _, err := c.AuthenticateToken(ti.ID + ".bad"); err != meta.ErrAuthenticate {
+		t.Fatalf("unexpected error: %v", err)
+	} else if _, err := c.AuthenticateToken("bad"); err != meta.ErrAuthenticate {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if tokens := c.Tokens(); len(tokens) != 1 || tokens[0].ID != ti.ID {
+		t.Fatalf("unexpected tokens: %+v", tokens)
+	}
+
+	if err := c.RevokeToken(ti.ID); err != nil {
+		t.Fatal(err)
+	} else if err := c.RevokeToken(ti.ID); err != meta.ErrTokenNotFound {
+		t.Fatalf("unexpected error: %v", err)
+	} else if _, err := c.AuthenticateToken(token); err != meta.ErrAuthenticate {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Expired tokens should not authenticate.
+	token, _, err = c.CreateToken("fred", "", influxql.AllPrivileges, time.Now().Add(-time.Second))
+	if err != nil {
+		t.Fatal(err)
+	} else if _, err := c.AuthenticateToken(token); err != meta.ErrTokenExpired {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Dropping the user should drop their tokens.
+	if err := c.DropUser("fred"); err != nil {
+		t.Fatal(err)
+	} else if tokens := c.Tokens(); len(tokens) != 0 {
+		t.Fatalf("unexpected tokens: %+v", tokens)
+	}
+}
+
 func TestMetaClient_ContinuousQueries(t *testing.T) {
 	t.Parallel()
 
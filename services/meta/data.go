@@ -4,6 +4,7 @@ import (
 	"errors"
 	"net"
 	"net/url"
+	"regexp"
 	"sort"
 	"strings"
 	"sync"
@@ -43,6 +44,7 @@ type Data struct {
 	ClusterID uint64
 	Databases []DatabaseInfo
 	Users     []UserInfo
+	Tokens    []TokenInfo
 
 	// adminUserExists provides a constant time mechanism for determining
 	// if there is at least one admin user.
@@ -571,6 +573,7 @@ func (data *Data) DropUser(name string) error {
 		if data.Users[i].Name == name {
 			wasAdmin := data.Users[i].Admin
 			data.Users = append(data.Users[:i], data.Users[i+1:]...)
+			data.dropUserTokens(name)
 
 			// Maybe we dropped the only admin user?
 			if wasAdmin {
@@ -626,6 +629,43 @@ func (data *Data) SetPrivilege(name, database string, p influxql.Privilege) erro
 	return nil
 }
 
+// SetMeasurementPrivilege sets a privilege for a user on the measurements
+// of a database matching measurement, which is a regular expression if regex
+// is true. Setting NoPrivileges removes the privilege.
+func (data *Data) SetMeasurementPrivilege(name, database, measurement string, regex bool, p influxql.Privilege) error {
+	ui := data.user(name)
+	if ui == nil {
+		return ErrUserNotFound
+	}
+
+	if data.Database(database) == nil {
+		return influxdb.ErrDatabaseNotFound(database)
+	}
+
+	mp := MeasurementPrivilege{
+		Database:    database,
+		Measurement: measurement,
+		Regex:       regex,
+		Privilege:   p,
+	}
+	if err := mp.compile(); err != nil {
+		return err
+	}
+
+	privileges := ui.MeasurementPrivileges[:0]
+	for _, other := range ui.MeasurementPrivileges {
+		if other.Database != database || other.Measurement != measurement || other.Regex != regex {
+			privileges = append(privileges, other)
+		}
+	}
+	if p != influxql.NoPrivileges {
+		privileges = append(privileges, mp)
+	}
+	ui.MeasurementPrivileges = privileges
+
+	return nil
+}
+
 // SetAdminPrivilege sets the admin privilege for a user.
 func (data *Data) SetAdminPrivilege(name string, admin bool) error {
 	ui := data.user(name)
@@ -641,6 +681,99 @@ func (data *Data) SetAdminPrivilege(name string, admin bool) error {
 	return nil
 }
 
+// SetUserQueryLimits sets the query limits for a user.
+func (data *Data) SetUserQueryLimits(name string, limits query.QueryLimits) error {
+	if !validQueryLimits(limits) {
+		return ErrInvalidQueryLimits
+	}
+
+	ui := data.user(name)
+	if ui == nil {
+		return ErrUserNotFound
+	}
+
+	ui.QueryLimits = limits
+	return nil
+}
+
+// SetDatabaseQueryLimits sets the query limits for a database.
+func (data *Data) SetDatabaseQueryLimits(name string, limits query.QueryLimits) error {
+	if !validQueryLimits(limits) {
+		return ErrInvalidQueryLimits
+	}
+
+	di := data.Database(name)
+	if di == nil {
+		return influxdb.ErrDatabaseNotFound(name)
+	}
+
+	di.QueryLimits = limits
+	return nil
+}
+
+// Token returns a token by ID.
+func (data *Data) Token(id string) *TokenInfo {
+	for i := range data.Tokens {
+		if data.Tokens[i].ID == id {
+			return &data.Tokens[i]
+		}
+	}
+	return nil
+}
+
+// CreateToken creates a new API token. The token's user and, if set,
+// database must exist.
+func (data *Data) CreateToken(ti TokenInfo) error {
+	if ti.ID == "" {
+		return ErrTokenIDRequired
+	} else if data.Token(ti.ID) != nil {
+		return ErrTokenExists
+	} else if data.user(ti.User) == nil {
+		return ErrUserNotFound
+	} else if ti.Database != "" && data.Database(ti.Database) == nil {
+		return influxdb.ErrDatabaseNotFound(ti.Database)
+	}
+
+	switch ti.Privilege {
+	case influxql.ReadPrivilege, influxql.WritePrivilege, influxql.AllPrivileges:
+	default:
+		return ErrInvalidTokenPrivilege
+	}
+
+	data.Tokens = append(data.Tokens, ti)
+	return nil
+}
+
+// DropToken removes an existing token by ID.
+func (data *Data) DropToken(id string) error {
+	for i := range data.Tokens {
+		if data.Tokens[i].ID == id {
+			data.Tokens = append(data.Tokens[:i], data.Tokens[i+1:]...)
+			return nil
+		}
+	}
+	return ErrTokenNotFound
+}
+
+// dropUserTokens removes all tokens belonging to a user.
+func (data *Data) dropUserTokens(name string) {
+	tokens := data.Tokens[:0]
+	for _, ti := range data.Tokens {
+		if ti.User != name {
+			tokens = append(tokens, ti)
+		}
+	}
+	data.Tokens = tokens
+}
+
+// CloneTokens returns a copy of the token infos.
+func (data *Data) CloneTokens() []TokenInfo {
+	if len(data.Tokens) == 0 {
+		return nil
+	}
+	return append([]TokenInfo(nil), data.Tokens...)
+}
+
 // AdminUserExists returns true if an admin user exists.
 func (data Data) AdminUserExists() bool {
 	return data.adminUserExists
@@ -678,6 +811,7 @@ func (data *Data) Clone() *Data {
 
 	other.Databases = data.CloneDatabases()
 	other.Users = data.CloneUsers()
+	other.Tokens = data.CloneTokens()
 
 	return &other
 }
@@ -706,6 +840,11 @@ func (data *Data) marshal() *internal.Data {
 		pb.Users[i] = data.Users[i].marshal()
 	}
 
+	pb.Tokens = make([]*internal.TokenInfo, len(data.Tokens))
+	for i := range data.Tokens {
+		pb.Tokens[i] = data.Tokens[i].marshal()
+	}
+
 	return pb
 }
 
@@ -728,6 +867,13 @@ func (data *Data) unmarshal(pb *internal.Data) {
 		data.Users[i].unmarshal(x)
 	}
 
+	data.Tokens = nil
+	for _, x := range pb.GetTokens() {
+		var ti TokenInfo
+		ti.unmarshal(x)
+		data.Tokens = append(data.Tokens, ti)
+	}
+
 	// Exhaustively determine if there is an admin user. The marshalled cache
 	// value may not be correct.
 	data.adminUserExists = data.hasAdminUser()
@@ -910,6 +1056,7 @@ type DatabaseInfo struct {
 	DefaultRetentionPolicy string
 	RetentionPolicies      []RetentionPolicyInfo
 	ContinuousQueries      []ContinuousQueryInfo
+	QueryLimits            query.QueryLimits
 }
 
 // RetentionPolicy returns a retention policy by name.
@@ -991,6 +1138,10 @@ func (di DatabaseInfo) marshal() *internal.DatabaseInfo {
 	for i := range di.ContinuousQueries {
 		pb.ContinuousQueries[i] = di.ContinuousQueries[i].marshal()
 	}
+
+	if !di.QueryLimits.IsZero() {
+		pb.QueryLimits = marshalQueryLimits(di.QueryLimits)
+	}
 	return pb
 }
 
@@ -1012,6 +1163,8 @@ func (di *DatabaseInfo) unmarshal(pb *internal.DatabaseInfo) {
 			di.ContinuousQueries[i].unmarshal(x)
 		}
 	}
+
+	di.QueryLimits = unmarshalQueryLimits(pb.GetQueryLimits())
 }
 
 // RetentionPolicySpec represents the specification for a new retention policy.
@@ -1574,6 +1727,12 @@ type UserInfo struct {
 
 	// Map of database name to granted privilege.
 	Privileges map[string]influxql.Privilege
+
+	// Privileges granted on individual measurements.
+	MeasurementPrivileges []MeasurementPrivilege
+
+	// Resource limits applied to the user's queries.
+	QueryLimits query.QueryLimits
 }
 
 type User interface {
@@ -1594,14 +1753,42 @@ func (ui *UserInfo) AuthorizeDatabase(privilege influxql.Privilege, database str
 	return ok && (p == privilege || p == influxql.AllPrivileges)
 }
 
-// AuthorizeSeriesRead is used to limit access per-series (enterprise only)
+// AuthorizeMeasurement returns true if the user is authorized for the given
+// privilege on the given measurement, either by a privilege on its database
+// or by a measurement privilege.
+func (ui *UserInfo) AuthorizeMeasurement(privilege influxql.Privilege, database string, measurement []byte) bool {
+	if ui.AuthorizeDatabase(privilege, database) {
+		return true
+	}
+	for i := range ui.MeasurementPrivileges {
+		mp := &ui.MeasurementPrivileges[i]
+		if mp.Database == database && mp.grants(privilege) && mp.Match(measurement) {
+			return true
+		}
+	}
+	return false
+}
+
+// HasMeasurementPrivilege returns true if the user has been granted the
+// given privilege on any measurement in the database.
+func (ui *UserInfo) HasMeasurementPrivilege(privilege influxql.Privilege, database string) bool {
+	for i := range ui.MeasurementPrivileges {
+		mp := &ui.MeasurementPrivileges[i]
+		if mp.Database == database && mp.grants(privilege) {
+			return true
+		}
+	}
+	return false
+}
+
+// AuthorizeSeriesRead returns true if the user may read the series.
 func (u *UserInfo) AuthorizeSeriesRead(database string, measurement []byte, tags models.Tags) bool {
-	return true
+	return u.AuthorizeMeasurement(influxql.ReadPrivilege, database, measurement)
 }
 
-// AuthorizeSeriesWrite is used to limit access per-series (enterprise only)
+// AuthorizeSeriesWrite returns true if the user may write the series.
 func (u *UserInfo) AuthorizeSeriesWrite(database string, measurement []byte, tags models.Tags) bool {
-	return true
+	return u.AuthorizeMeasurement(influxql.WritePrivilege, database, measurement)
 }
 
 // clone returns a deep copy of si.
@@ -1615,6 +1802,10 @@ func (ui UserInfo) clone() UserInfo {
 		}
 	}
 
+	if ui.MeasurementPrivileges != nil {
+		other.MeasurementPrivileges = append([]MeasurementPrivilege(nil), ui.MeasurementPrivileges...)
+	}
+
 	return other
 }
 
@@ -1633,6 +1824,14 @@ func (ui UserInfo) marshal() *internal.UserInfo {
 		})
 	}
 
+	if !ui.QueryLimits.IsZero() {
+		pb.QueryLimits = marshalQueryLimits(ui.QueryLimits)
+	}
+
+	for i := range ui.MeasurementPrivileges {
+		pb.MeasurementPrivileges = append(pb.MeasurementPrivileges, ui.MeasurementPrivileges[i].marshal())
+	}
+
 	return pb
 }
 
@@ -1646,6 +1845,252 @@ func (ui *UserInfo) unmarshal(pb *internal.UserInfo) {
 	for _, p := range pb.GetPrivileges() {
 		ui.Privileges[p.GetDatabase()] = influxql.Privilege(p.GetPrivilege())
 	}
+
+	ui.QueryLimits = unmarshalQueryLimits(pb.GetQueryLimits())
+
+	ui.MeasurementPrivileges = nil
+	for _, x := range pb.GetMeasurementPrivileges() {
+		var mp MeasurementPrivilege
+		mp.unmarshal(x)
+		ui.MeasurementPrivileges = append(ui.MeasurementPrivileges, mp)
+	}
+}
+
+// MeasurementPrivilege represents a privilege granted on the measurements of
+// a database which match a name or a regular expression.
+type MeasurementPrivilege struct {
+	Database    string
+	Measurement string
+	Regex       bool
+	Privilege   influxql.Privilege
+
+	re *regexp.Regexp
+}
+
+// Match returns true if the privilege applies to the measurement.
+func (mp *MeasurementPrivilege) Match(measurement []byte) bool {
+	if !mp.Regex {
+		return mp.Measurement == string(measurement)
+	}
+
+	re := mp.re
+	if re == nil {
+		var err error
+		if re, err = regexp.Compile(mp.Measurement); err != nil {
+			return false
+		}
+	}
+	return re.Match(measurement)
+}
+
+// grants returns true if the privilege includes p.
+func (mp *MeasurementPrivilege) grants(p influxql.Privilege) bool {
+	return mp.Privilege == p || mp.Privilege == influxql.AllPrivileges
+}
+
+// compile validates the measurement and compiles it if it is a regular expression.
+func (mp *MeasurementPrivilege) compile() error {
+	if mp.Measurement == "" {
+		return ErrMeasurementRequired
+	} else if !mp.Regex {
+		return nil
+	}
+
+	re, err := regexp.Compile(mp.Measurement)
+	if err != nil {
+		return fmt.Errorf("invalid measurement regex: %s", err)
+	}
+	mp.re = re
+	return nil
+}
+
+// marshal serializes to a protobuf representation.
+func (mp MeasurementPrivilege) marshal() *internal.MeasurementPrivilege {
+	return &internal.MeasurementPrivilege{
+		Database:    proto.String(mp.Database),
+		Measurement: proto.String(mp.Measurement),
+		Regex:       proto.Bool(mp.Regex),
+		Privilege:   proto.Int32(int32(mp.Privilege)),
+	}
+}
+
+// unmarshal deserializes from a protobuf representation.
+func (mp *MeasurementPrivilege) unmarshal(pb *internal.MeasurementPrivilege) {
+	mp.Database = pb.GetDatabase()
+	mp.Measurement = pb.GetMeasurement()
+	mp.Regex = pb.GetRegex()
+	mp.Privilege = influxql.Privilege(pb.GetPrivilege())
+
+	// Privileges with an invalid regex never match.
+	mp.compile()
+}
+
+// TokenInfo represents metadata about an API token. A token authenticates
+// as its user, optionally restricted to a single database, a single
+// privilege, or both.
+type TokenInfo struct {
+	// Public identifier of the token.
+	ID string
+
+	// Hashed token secret.
+	Hash string
+
+	// Name of the user the token authenticates as.
+	User string
+
+	// Database the token is restricted to. Empty for all databases.
+	Database string
+
+	// Privilege the token is restricted to. AllPrivileges is unrestricted.
+	Privilege influxql.Privilege
+
+	CreatedAt time.Time
+
+	// Time after which the token is no longer valid. Zero never expires.
+	Expiration time.Time
+}
+
+// Expired returns true if the token has expired at time t.
+func (ti *TokenInfo) Expired(t time.Time) bool {
+	return !ti.Expiration.IsZero() && !t.Before(ti.Expiration)
+}
+
+// Unrestricted returns true if the token has the same privileges as its user.
+func (ti *TokenInfo) Unrestricted() bool {
+	return ti.Database == "" && ti.Privilege == influxql.AllPrivileges
+}
+
+// Authorize returns true if the token's restrictions permit privilege p on
+// database. The token's user must also hold the privilege.
+func (ti *TokenInfo) Authorize(p influxql.Privilege, database string) bool {
+	if p == influxql.NoPrivileges {
+		return true
+	} else if ti.Database != "" && ti.Database != database {
+		return false
+	}
+	return ti.Privilege == influxql.AllPrivileges || ti.Privilege == p
+}
+
+// marshal serializes to a protobuf representation.
+func (ti TokenInfo) marshal() *internal.TokenInfo {
+	pb := &internal.TokenInfo{
+		ID:        proto.String(ti.ID),
+		Hash:      proto.String(ti.Hash),
+		User:      proto.String(ti.User),
+		Privilege: proto.Int32(int32(ti.Privilege)),
+		CreatedAt: proto.Int64(MarshalTime(ti.CreatedAt)),
+	}
+	if ti.Database != "" {
+		pb.Database = proto.String(ti.Database)
+	}
+	if !ti.Expiration.IsZero() {
+		pb.Expiration = proto.Int64(MarshalTime(ti.Expiration))
+	}
+	return pb
+}
+
+// unmarshal deserializes from a protobuf representation.
+func (ti *TokenInfo) unmarshal(pb *internal.TokenInfo) {
+	ti.ID = pb.GetID()
+	ti.Hash = pb.GetHash()
+	ti.User = pb.GetUser()
+	ti.Database = pb.GetDatabase()
+	ti.Privilege = influxql.Privilege(pb.GetPrivilege())
+	ti.CreatedAt = UnmarshalTime(pb.GetCreatedAt())
+	if pb.Expiration != nil {
+		ti.Expiration = UnmarshalTime(pb.GetExpiration())
+	}
+}
+
+var _ User = (*TokenUser)(nil)
+
+// TokenUser is a user which authenticated with an API token. The user's
+// privileges are further limited by the restrictions of the token.
+type TokenUser struct {
+	*UserInfo
+	Token *TokenInfo
+}
+
+// AuthorizeDatabase returns true if both the user and the token are
+// authorized for the given privilege on the given database.
+func (u *TokenUser) AuthorizeDatabase(privilege influxql.Privilege, database string) bool {
+	return u.Token.Authorize(privilege, database) && u.UserInfo.AuthorizeDatabase(privilege, database)
+}
+
+// AuthorizeQuery returns an error if either the user or the token is not
+// authorized to execute query. Statements requiring admin privilege are
+// only permitted with unrestricted tokens.
+func (u *TokenUser) AuthorizeQuery(database string, query *influxql.Query) error {
+	for _, stmt := range query.Statements {
+		privs, err := stmt.RequiredPrivileges()
+		if err != nil {
+			return err
+		}
+
+		for _, p := range privs {
+			if p.Admin {
+				if u.Token.Unrestricted() {
+					continue
+				}
+				return &ErrAuthorize{
+					Query:    query,
+					User:     u.Name,
+					Database: database,
+					Message:  fmt.Sprintf("statement '%s', requires admin privilege, which token %s does not grant", stmt, u.Token.ID),
+				}
+			}
+
+			db := p.Name
+			if db == "" {
+				db = database
+			}
+			if !u.Token.Authorize(p.Privilege, db) {
+				return &ErrAuthorize{
+					Query:    query,
+					User:     u.Name,
+					Database: database,
+					Message:  fmt.Sprintf("statement '%s', requires %s on %s, which token %s does not grant", stmt, p.Privilege.String(), db, u.Token.ID),
+				}
+			}
+		}
+	}
+	return u.UserInfo.AuthorizeQuery(database, query)
+}
+
+// AuthorizeSeriesRead returns true if both the user and the token may read the series.
+func (u *TokenUser) AuthorizeSeriesRead(database string, measurement []byte, tags models.Tags) bool {
+	return u.Token.Authorize(influxql.ReadPrivilege, database) && u.UserInfo.AuthorizeSeriesRead(database, measurement, tags)
+}
+
+// AuthorizeSeriesWrite returns true if both the user and the token may write the series.
+func (u *TokenUser) AuthorizeSeriesWrite(database string, measurement []byte, tags models.Tags) bool {
+	return u.Token.Authorize(influxql.WritePrivilege, database) && u.UserInfo.AuthorizeSeriesWrite(database, measurement, tags)
+}
+
+// validQueryLimits returns true if none of the limits are negative.
+func validQueryLimits(l query.QueryLimits) bool {
+	return l.MaxConcurrentQueries >= 0 && l.MaxSelectPointN >= 0 &&
+		l.MaxSelectSeriesN >= 0 && l.MaxSelectBucketsN >= 0
+}
+
+// marshalQueryLimits serializes query limits to a protobuf representation.
+func marshalQueryLimits(l query.QueryLimits) *internal.QueryLimits {
+	return &internal.QueryLimits{
+		MaxConcurrentQueries: proto.Int64(int64(l.MaxConcurrentQueries)),
+		MaxSelectPointN:      proto.Int64(int64(l.MaxSelectPointN)),
+		MaxSelectSeriesN:     proto.Int64(int64(l.MaxSelectSeriesN)),
+		MaxSelectBucketsN:    proto.Int64(int64(l.MaxSelectBucketsN)),
+	}
+}
+
+// unmarshalQueryLimits deserializes query limits from a protobuf representation.
+func unmarshalQueryLimits(pb *internal.QueryLimits) query.QueryLimits {
+	return query.QueryLimits{
+		MaxConcurrentQueries: int(pb.GetMaxConcurrentQueries()),
+		MaxSelectPointN:      int(pb.GetMaxSelectPointN()),
+		MaxSelectSeriesN:     int(pb.GetMaxSelectSeriesN()),
+		MaxSelectBucketsN:    int(pb.GetMaxSelectBucketsN()),
+	}
 }
 
 // Lease represents a lease held on a resource.
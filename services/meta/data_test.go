@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/influxdata/influxdb"
+	"github.com/influxdata/influxdb/query"
 	"github.com/influxdata/influxql"
 
 	"github.com/influxdata/influxdb/services/meta"
@@ -215,6 +216,55 @@ func TestData_SetPrivilege(t *testing.T) {
 	}
 }
 
+func TestData_SetQueryLimits(t *testing.T) {
+	data := meta.Data{}
+	if err := data.CreateDatabase("db0"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := data.CreateUser("user1", "", false); err != nil {
+		t.Fatal(err)
+	}
+
+	userLimits := query.QueryLimits{MaxConcurrentQueries: 2, MaxSelectPointN: 1000}
+	dbLimits := query.QueryLimits{MaxSelectSeriesN: 10, MaxSelectBucketsN: 100}
+
+	if got, exp := data.SetUserQueryLimits("not a user", userLimits), meta.ErrUserNotFound; got != exp {
+		t.Fatalf("got %v, expected %v", got, exp)
+	}
+	if got, exp := data.SetDatabaseQueryLimits("db1", dbLimits), influxdb.ErrDatabaseNotFound("db1"); got == nil || got.Error() != exp.Error() {
+		t.Fatalf("got %v, expected %v", got, exp)
+	}
+	if got, exp := data.SetUserQueryLimits("user1", query.QueryLimits{MaxSelectPointN: -1}), meta.ErrInvalidQueryLimits; got != exp {
+		t.Fatalf("got %v, expected %v", got, exp)
+	}
+
+	if err := data.SetUserQueryLimits("user1", userLimits); err != nil {
+		t.Fatal(err)
+	}
+	if err := data.SetDatabaseQueryLimits("db0", dbLimits); err != nil {
+		t.Fatal(err)
+	}
+
+	// Ensure the limits survive a round trip through the protobuf representation.
+	buf, err := data.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var other meta.Data
+	if err := other.UnmarshalBinary(buf); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := other.Users[0].QueryLimits; got != userLimits {
+		t.Fatalf("unexpected user limits: got=%+v exp=%+v", got, userLimits)
+	}
+	if got := other.Database("db0").QueryLimits; got != dbLimits {
+		t.Fatalf("unexpected database limits: got=%+v exp=%+v", got, dbLimits)
+	}
+}
+
 func TestData_TruncateShardGroups(t *testing.T) {
 	data := &meta.Data{}
 
@@ -313,6 +363,150 @@ func TestData_TruncateShardGroups(t *testing.T) {
 	}
 }
 
+func TestData_CreateToken(t *testing.T) {
+	data := meta.Data{}
+	if err := data.CreateDatabase("db0"); err != nil {
+		t.Fatal(err)
+	} else if err := data.CreateUser("user1", "", false); err != nil {
+		t.Fatal(err)
+	}
+
+	ti := meta.TokenInfo{
+		ID:         "0123456789abcdef",
+		Hash:       "hash",
+		User:       "user1",
+		Database:   "db0",
+		Privilege:  influxql.WritePrivilege,
+		CreatedAt:  time.Unix(0, 1).UTC(),
+		Expiration: time.Unix(0, 2).UTC(),
+	}
+
+	if got, exp := data.CreateToken(meta.TokenInfo{ID: "a", User: "user2"}), meta.ErrUserNotFound; got != exp {
+		t.Fatalf("got %v, expected %v", got, exp)
+	}
+	if got, exp := data.CreateToken(meta.TokenInfo{ID: "a", User: "user1", Privilege: influxql.NoPrivileges}), meta.ErrInvalidTokenPrivilege; got != exp {
+		t.Fatalf("got %v, expected %v", got, exp)
+	}
+	if err := data.CreateToken(ti); err != nil {
+		t.Fatal(err)
+	} else if got, exp := data.CreateToken(ti), meta.ErrTokenExists; got != exp {
+		t.Fatalf("got %v, expected %v", got, exp)
+	}
+
+	// Ensure the token survives a round trip through the protobuf representation.
+	buf, err := data.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var other meta.Data
+	if err := other.UnmarshalBinary(buf); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := other.Token(ti.ID); got == nil || !reflect.DeepEqual(*got, ti) {
+		t.Fatalf("unexpected token: got=%+v exp=%+v", got, ti)
+	}
+}
+
+func TestData_SetMeasurementPrivilege(t *testing.T) {
+	data := meta.Data{}
+	if err := data.CreateDatabase("db0"); err != nil {
+		t.Fatal(err)
+	} else if err := data.CreateUser("user1", "", false); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, exp := data.SetMeasurementPrivilege("user2", "db0", "cpu", false, influxql.ReadPrivilege), meta.ErrUserNotFound; got != exp {
+		t.Fatalf("got %v, expected %v", got, exp)
+	}
+	if got, exp := data.SetMeasurementPrivilege("user1", "db0", "", false, influxql.ReadPrivilege), meta.ErrMeasurementRequired; got != exp {
+		t.Fatalf("got %v, expected %v", got, exp)
+	}
+	if err := data.SetMeasurementPrivilege("user1", "db0", "(", true, influxql.ReadPrivilege); err == nil {
+		t.Fatal("expected error for invalid regex")
+	}
+
+	if err := data.SetMeasurementPrivilege("user1", "db0", "cpu", false, influxql.ReadPrivilege); err != nil {
+		t.Fatal(err)
+	} else if err := data.SetMeasurementPrivilege("user1", "db0", "^mem", true, influxql.WritePrivilege); err != nil {
+		t.Fatal(err)
+	} else if err := data.SetMeasurementPrivilege("user1", "db0", "cpu", false, influxql.AllPrivileges); err != nil {
+		t.Fatal(err)
+	}
+
+	// Ensure the privileges survive a round trip through the protobuf representation.
+	buf, err := data.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var other meta.Data
+	if err := other.UnmarshalBinary(buf); err != nil {
+		t.Fatal(err)
+	}
+
+	ui := &other.Users[0]
+	if n := len(ui.MeasurementPrivileges); n != 2 {
+		t.Fatalf("unexpected number of measurement privileges: %d", n)
+	}
+	if !ui.AuthorizeSeriesWrite("db0", []byte("cpu"), nil) {
+		t.Fatal("expected write privilege on cpu")
+	} else if !ui.AuthorizeSeriesWrite("db0", []byte("mem_used"), nil) {
+		t.Fatal("expected write privilege on mem_used")
+	} else if ui.AuthorizeSeriesRead("db0", []byte("mem_used"), nil) {
+		t.Fatal("unexpected read privilege on mem_used")
+	} else if ui.AuthorizeSeriesRead("db0", []byte("disk"), nil) {
+		t.Fatal("unexpected read privilege on disk")
+	}
+
+	// Setting no privileges removes the privilege.
+	if err := other.SetMeasurementPrivilege("user1", "db0", "cpu", false, influxql.NoPrivileges); err != nil {
+		t.Fatal(err)
+	} else if other.Users[0].AuthorizeSeriesRead("db0", []byte("cpu"), nil) {
+		t.Fatal("unexpected read privilege on cpu")
+	}
+}
+
+func TestUserInfo_AuthorizeQuery_MeasurementPrivileges(t *testing.T) {
+	u := &meta.UserInfo{
+		Name:       "contractor",
+		Privileges: map[string]influxql.Privilege{"db1": influxql.ReadPrivilege},
+		MeasurementPrivileges: []meta.MeasurementPrivilege{
+			{Database: "db0", Measurement: "cpu", Privilege: influxql.ReadPrivilege},
+			{Database: "db0", Measurement: "^mem", Regex: true, Privilege: influxql.AllPrivileges},
+		},
+	}
+
+	for _, tt := range []struct {
+		q   string
+		err bool
+	}{
+		{q: `SELECT value FROM cpu`},
+		{q: `SELECT value FROM cpu, mem_free`},
+		{q: `SELECT value FROM disk`, err: true},
+		{q: `SELECT value FROM cpu, disk`, err: true},
+		{q: `SELECT max(value) FROM (SELECT value FROM cpu)`},
+		{q: `SELECT max(value) FROM (SELECT value FROM disk)`, err: true},
+		{q: `SELECT value FROM /c.*/`},
+		{q: `SELECT value FROM db1..disk`},
+		{q: `SELECT value INTO mem_copy FROM cpu`},
+		{q: `SELECT value INTO cpu_copy FROM cpu`, err: true},
+		{q: `SHOW MEASUREMENTS`},
+		{q: `SHOW TAG KEYS`},
+		{q: `SHOW FIELD KEYS`, err: true},
+		{q: `DROP MEASUREMENT cpu`, err: true},
+	} {
+		q, err := influxql.ParseQuery(tt.q)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := u.AuthorizeQuery("db0", q); (err != nil) != tt.err {
+			t.Errorf("%s: unexpected error: %v", tt.q, err)
+		}
+	}
+}
+
 func TestUserInfo_AuthorizeDatabase(t *testing.T) {
 	emptyUser := &meta.UserInfo{}
 	if !emptyUser.AuthorizeDatabase(influxql.NoPrivileges, "anydb") {
@@ -327,3 +521,45 @@ func TestUserInfo_AuthorizeDatabase(t *testing.T) {
 		t.Fatalf("expected admin to be authorized but it wasn't")
 	}
 }
+
+func TestTokenUser_AuthorizeQuery(t *testing.T) {
+	u := &meta.TokenUser{
+		UserInfo: &meta.UserInfo{
+			Name:  "fred",
+			Admin: true,
+		},
+		Token: &meta.TokenInfo{
+			ID:        "0123456789abcdef",
+			Database:  "db0",
+			Privilege: influxql.ReadPrivilege,
+		},
+	}
+
+	for _, tt := range []struct {
+		q   string
+		err bool
+	}{
+		{q: `SELECT value FROM cpu`},
+		{q: `SELECT value FROM db1..cpu`, err: true},
+		{q: `SELECT value INTO cpu_copy FROM cpu`, err: true},
+		{q: `DROP MEASUREMENT cpu`, err: true},
+		{q: `CREATE DATABASE db1`, err: true},
+	} {
+		q, err := influxql.ParseQuery(tt.q)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := u.AuthorizeQuery("db0", q); (err != nil) != tt.err {
+			t.Errorf("%s: unexpected error: %v", tt.q, err)
+		}
+	}
+
+	// Unrestricted tokens have the same privileges as the user.
+	u.Token.Database, u.Token.Privilege = "", influxql.AllPrivileges
+	q, err := influxql.ParseQuery(`CREATE DATABASE db1`)
+	if err != nil {
+		t.Fatal(err)
+	} else if err := u.AuthorizeQuery("db0", q); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -112,4 +112,31 @@ var (
 
 	// ErrAuthenticate is returned when authentication fails.
 	ErrAuthenticate = errors.New("authentication failed")
+
+	// ErrMeasurementRequired is returned when granting a privilege on a
+	// measurement without a measurement name or regex.
+	ErrMeasurementRequired = errors.New("measurement required")
+)
+
+var (
+	// ErrTokenExists is returned when creating a token with an existing ID.
+	ErrTokenExists = errors.New("token already exists")
+
+	// ErrTokenNotFound is returned when revoking a token that doesn't exist.
+	ErrTokenNotFound = errors.New("token not found")
+
+	// ErrTokenIDRequired is returned when creating a token without an ID.
+	ErrTokenIDRequired = errors.New("token id required")
+
+	// ErrInvalidTokenPrivilege is returned when creating a token with a
+	// privilege other than read, write or all.
+	ErrInvalidTokenPrivilege = errors.New("token privilege must be read, write or all")
+
+	// ErrTokenExpired is returned when authenticating with an expired token.
+	ErrTokenExpired = errors.New("token expired")
+)
+
+var (
+	// ErrInvalidQueryLimits is returned when setting a negative query limit.
+	ErrInvalidQueryLimits = errors.New("query limits must not be negative")
 )
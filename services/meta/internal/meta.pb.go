@@ -6,52 +6,57 @@
 Package meta is a generated protocol buffer package.
 
 It is generated from these files:
-	internal/meta.proto
+
+internal/meta.proto
 
 It has these top-level messages:
-	Data
-	NodeInfo
-	DatabaseInfo
-	RetentionPolicySpec
-	RetentionPolicyInfo
-	ShardGroupInfo
-	ShardInfo
-	SubscriptionInfo
-	ShardOwner
-	ContinuousQueryInfo
-	UserInfo
-	UserPrivilege
-	Command
-	CreateNodeCommand
-	DeleteNodeCommand
-	CreateDatabaseCommand
-	DropDatabaseCommand
-	CreateRetentionPolicyCommand
-	DropRetentionPolicyCommand
-	SetDefaultRetentionPolicyCommand
-	UpdateRetentionPolicyCommand
-	CreateShardGroupCommand
-	DeleteShardGroupCommand
-	CreateContinuousQueryCommand
-	DropContinuousQueryCommand
-	CreateUserCommand
-	DropUserCommand
-	UpdateUserCommand
-	SetPrivilegeCommand
-	SetDataCommand
-	SetAdminPrivilegeCommand
-	UpdateNodeCommand
-	CreateSubscriptionCommand
-	DropSubscriptionCommand
-	RemovePeerCommand
-	CreateMetaNodeCommand
-	CreateDataNodeCommand
-	UpdateDataNodeCommand
-	DeleteMetaNodeCommand
-	DeleteDataNodeCommand
-	Response
-	SetMetaNodeCommand
-	DropShardCommand
+
+Data
+NodeInfo
+DatabaseInfo
+RetentionPolicySpec
+RetentionPolicyInfo
+ShardGroupInfo
+ShardInfo
+SubscriptionInfo
+ShardOwner
+ContinuousQueryInfo
+UserInfo
+UserPrivilege
+MeasurementPrivilege
+QueryLimits
+TokenInfo
+Command
+CreateNodeCommand
+DeleteNodeCommand
+CreateDatabaseCommand
+DropDatabaseCommand
+CreateRetentionPolicyCommand
+DropRetentionPolicyCommand
+SetDefaultRetentionPolicyCommand
+UpdateRetentionPolicyCommand
+CreateShardGroupCommand
+DeleteShardGroupCommand
+CreateContinuousQueryCommand
+DropContinuousQueryCommand
+CreateUserCommand
+DropUserCommand
+UpdateUserCommand
+SetPrivilegeCommand
+SetDataCommand
+SetAdminPrivilegeCommand
+UpdateNodeCommand
+CreateSubscriptionCommand
+DropSubscriptionCommand
+RemovePeerCommand
+CreateMetaNodeCommand
+CreateDataNodeCommand
+UpdateDataNodeCommand
+DeleteMetaNodeCommand
+DeleteDataNodeCommand
+Response
+SetMetaNodeCommand
+DropShardCommand
 */
 package meta
 
@@ -183,7 +188,7 @@ func (x *Command_Type) UnmarshalJSON(data []byte) error {
 	*x = Command_Type(value)
 	return nil
 }
-func (Command_Type) EnumDescriptor() ([]byte, []int) { return fileDescriptorMeta, []int{12, 0} }
+func (Command_Type) EnumDescriptor() ([]byte, []int) { return fileDescriptorMeta, []int{15, 0} }
 
 type Data struct {
 	Term            *uint64         `protobuf:"varint,1,req,name=Term" json:"Term,omitempty"`
@@ -196,9 +201,10 @@ type Data struct {
 	MaxShardGroupID *uint64         `protobuf:"varint,8,req,name=MaxShardGroupID" json:"MaxShardGroupID,omitempty"`
 	MaxShardID      *uint64         `protobuf:"varint,9,req,name=MaxShardID" json:"MaxShardID,omitempty"`
 	// added for 0.10.0
-	DataNodes        []*NodeInfo `protobuf:"bytes,10,rep,name=DataNodes" json:"DataNodes,omitempty"`
-	MetaNodes        []*NodeInfo `protobuf:"bytes,11,rep,name=MetaNodes" json:"MetaNodes,omitempty"`
-	XXX_unrecognized []byte      `json:"-"`
+	DataNodes        []*NodeInfo  `protobuf:"bytes,10,rep,name=DataNodes" json:"DataNodes,omitempty"`
+	MetaNodes        []*NodeInfo  `protobuf:"bytes,11,rep,name=MetaNodes" json:"MetaNodes,omitempty"`
+	Tokens           []*TokenInfo `protobuf:"bytes,12,rep,name=Tokens" json:"Tokens,omitempty"`
+	XXX_unrecognized []byte       `json:"-"`
 }
 
 func (m *Data) Reset()                    { *m = Data{} }
@@ -283,6 +289,13 @@ func (m *Data) GetMetaNodes() []*NodeInfo {
 	return nil
 }
 
+func (m *Data) GetTokens() []*TokenInfo {
+	if m != nil {
+		return m.Tokens
+	}
+	return nil
+}
+
 type NodeInfo struct {
 	ID               *uint64 `protobuf:"varint,1,req,name=ID" json:"ID,omitempty"`
 	Host             *string `protobuf:"bytes,2,req,name=Host" json:"Host,omitempty"`
@@ -321,6 +334,7 @@ type DatabaseInfo struct {
 	DefaultRetentionPolicy *string                `protobuf:"bytes,2,req,name=DefaultRetentionPolicy" json:"DefaultRetentionPolicy,omitempty"`
 	RetentionPolicies      []*RetentionPolicyInfo `protobuf:"bytes,3,rep,name=RetentionPolicies" json:"RetentionPolicies,omitempty"`
 	ContinuousQueries      []*ContinuousQueryInfo `protobuf:"bytes,4,rep,name=ContinuousQueries" json:"ContinuousQueries,omitempty"`
+	QueryLimits            *QueryLimits           `protobuf:"bytes,5,opt,name=QueryLimits" json:"QueryLimits,omitempty"`
 	XXX_unrecognized       []byte                 `json:"-"`
 }
 
@@ -357,6 +371,13 @@ func (m *DatabaseInfo) GetContinuousQueries() []*ContinuousQueryInfo {
 	return nil
 }
 
+func (m *DatabaseInfo) GetQueryLimits() *QueryLimits {
+	if m != nil {
+		return m.QueryLimits
+	}
+	return nil
+}
+
 type RetentionPolicySpec struct {
 	Name               *string `protobuf:"bytes,1,opt,name=Name" json:"Name,omitempty"`
 	Duration           *int64  `protobuf:"varint,2,opt,name=Duration" json:"Duration,omitempty"`
@@ -621,11 +642,13 @@ func (m *ContinuousQueryInfo) GetQuery() string {
 }
 
 type UserInfo struct {
-	Name             *string          `protobuf:"bytes,1,req,name=Name" json:"Name,omitempty"`
-	Hash             *string          `protobuf:"bytes,2,req,name=Hash" json:"Hash,omitempty"`
-	Admin            *bool            `protobuf:"varint,3,req,name=Admin" json:"Admin,omitempty"`
-	Privileges       []*UserPrivilege `protobuf:"bytes,4,rep,name=Privileges" json:"Privileges,omitempty"`
-	XXX_unrecognized []byte           `json:"-"`
+	Name                  *string                 `protobuf:"bytes,1,req,name=Name" json:"Name,omitempty"`
+	Hash                  *string                 `protobuf:"bytes,2,req,name=Hash" json:"Hash,omitempty"`
+	Admin                 *bool                   `protobuf:"varint,3,req,name=Admin" json:"Admin,omitempty"`
+	Privileges            []*UserPrivilege        `protobuf:"bytes,4,rep,name=Privileges" json:"Privileges,omitempty"`
+	QueryLimits           *QueryLimits            `protobuf:"bytes,5,opt,name=QueryLimits" json:"QueryLimits,omitempty"`
+	MeasurementPrivileges []*MeasurementPrivilege `protobuf:"bytes,6,rep,name=MeasurementPrivileges" json:"MeasurementPrivileges,omitempty"`
+	XXX_unrecognized      []byte                  `json:"-"`
 }
 
 func (m *UserInfo) Reset()                    { *m = UserInfo{} }
@@ -661,6 +684,20 @@ func (m *UserInfo) GetPrivileges() []*UserPrivilege {
 	return nil
 }
 
+func (m *UserInfo) GetQueryLimits() *QueryLimits {
+	if m != nil {
+		return m.QueryLimits
+	}
+	return nil
+}
+
+func (m *UserInfo) GetMeasurementPrivileges() []*MeasurementPrivilege {
+	if m != nil {
+		return m.MeasurementPrivileges
+	}
+	return nil
+}
+
 type UserPrivilege struct {
 	Database         *string `protobuf:"bytes,1,req,name=Database" json:"Database,omitempty"`
 	Privilege        *int32  `protobuf:"varint,2,req,name=Privilege" json:"Privilege,omitempty"`
@@ -686,6 +723,153 @@ func (m *UserPrivilege) GetPrivilege() int32 {
 	return 0
 }
 
+type MeasurementPrivilege struct {
+	Database         *string `protobuf:"bytes,1,req,name=Database" json:"Database,omitempty"`
+	Measurement      *string `protobuf:"bytes,2,req,name=Measurement" json:"Measurement,omitempty"`
+	Regex            *bool   `protobuf:"varint,3,req,name=Regex" json:"Regex,omitempty"`
+	Privilege        *int32  `protobuf:"varint,4,req,name=Privilege" json:"Privilege,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *MeasurementPrivilege) Reset()                    { *m = MeasurementPrivilege{} }
+func (m *MeasurementPrivilege) String() string            { return proto.CompactTextString(m) }
+func (*MeasurementPrivilege) ProtoMessage()               {}
+func (*MeasurementPrivilege) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{12} }
+
+func (m *MeasurementPrivilege) GetDatabase() string {
+	if m != nil && m.Database != nil {
+		return *m.Database
+	}
+	return ""
+}
+
+func (m *MeasurementPrivilege) GetMeasurement() string {
+	if m != nil && m.Measurement != nil {
+		return *m.Measurement
+	}
+	return ""
+}
+
+func (m *MeasurementPrivilege) GetRegex() bool {
+	if m != nil && m.Regex != nil {
+		return *m.Regex
+	}
+	return false
+}
+
+func (m *MeasurementPrivilege) GetPrivilege() int32 {
+	if m != nil && m.Privilege != nil {
+		return *m.Privilege
+	}
+	return 0
+}
+
+type QueryLimits struct {
+	MaxConcurrentQueries *int64 `protobuf:"varint,1,opt,name=MaxConcurrentQueries" json:"MaxConcurrentQueries,omitempty"`
+	MaxSelectPointN      *int64 `protobuf:"varint,2,opt,name=MaxSelectPointN" json:"MaxSelectPointN,omitempty"`
+	MaxSelectSeriesN     *int64 `protobuf:"varint,3,opt,name=MaxSelectSeriesN" json:"MaxSelectSeriesN,omitempty"`
+	MaxSelectBucketsN    *int64 `protobuf:"varint,4,opt,name=MaxSelectBucketsN" json:"MaxSelectBucketsN,omitempty"`
+	XXX_unrecognized     []byte `json:"-"`
+}
+
+func (m *QueryLimits) Reset()                    { *m = QueryLimits{} }
+func (m *QueryLimits) String() string            { return proto.CompactTextString(m) }
+func (*QueryLimits) ProtoMessage()               {}
+func (*QueryLimits) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{13} }
+
+func (m *QueryLimits) GetMaxConcurrentQueries() int64 {
+	if m != nil && m.MaxConcurrentQueries != nil {
+		return *m.MaxConcurrentQueries
+	}
+	return 0
+}
+
+func (m *QueryLimits) GetMaxSelectPointN() int64 {
+	if m != nil && m.MaxSelectPointN != nil {
+		return *m.MaxSelectPointN
+	}
+	return 0
+}
+
+func (m *QueryLimits) GetMaxSelectSeriesN() int64 {
+	if m != nil && m.MaxSelectSeriesN != nil {
+		return *m.MaxSelectSeriesN
+	}
+	return 0
+}
+
+func (m *QueryLimits) GetMaxSelectBucketsN() int64 {
+	if m != nil && m.MaxSelectBucketsN != nil {
+		return *m.MaxSelectBucketsN
+	}
+	return 0
+}
+
+type TokenInfo struct {
+	ID               *string `protobuf:"bytes,1,req,name=ID" json:"ID,omitempty"`
+	Hash             *string `protobuf:"bytes,2,req,name=Hash" json:"Hash,omitempty"`
+	User             *string `protobuf:"bytes,3,req,name=User" json:"User,omitempty"`
+	Database         *string `protobuf:"bytes,4,opt,name=Database" json:"Database,omitempty"`
+	Privilege        *int32  `protobuf:"varint,5,req,name=Privilege" json:"Privilege,omitempty"`
+	CreatedAt        *int64  `protobuf:"varint,6,req,name=CreatedAt" json:"CreatedAt,omitempty"`
+	Expiration       *int64  `protobuf:"varint,7,opt,name=Expiration" json:"Expiration,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *TokenInfo) Reset()                    { *m = TokenInfo{} }
+func (m *TokenInfo) String() string            { return proto.CompactTextString(m) }
+func (*TokenInfo) ProtoMessage()               {}
+func (*TokenInfo) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{14} }
+
+func (m *TokenInfo) GetID() string {
+	if m != nil && m.ID != nil {
+		return *m.ID
+	}
+	return ""
+}
+
+func (m *TokenInfo) GetHash() string {
+	if m != nil && m.Hash != nil {
+		return *m.Hash
+	}
+	return ""
+}
+
+func (m *TokenInfo) GetUser() string {
+	if m != nil && m.User != nil {
+		return *m.User
+	}
+	return ""
+}
+
+func (m *TokenInfo) GetDatabase() string {
+	if m != nil && m.Database != nil {
+		return *m.Database
+	}
+	return ""
+}
+
+func (m *TokenInfo) GetPrivilege() int32 {
+	if m != nil && m.Privilege != nil {
+		return *m.Privilege
+	}
+	return 0
+}
+
+func (m *TokenInfo) GetCreatedAt() int64 {
+	if m != nil && m.CreatedAt != nil {
+		return *m.CreatedAt
+	}
+	return 0
+}
+
+func (m *TokenInfo) GetExpiration() int64 {
+	if m != nil && m.Expiration != nil {
+		return *m.Expiration
+	}
+	return 0
+}
+
 type Command struct {
 	Type                         *Command_Type `protobuf:"varint,1,req,name=type,enum=meta.Command_Type" json:"type,omitempty"`
 	proto.XXX_InternalExtensions `json:"-"`
@@ -695,7 +879,7 @@ type Command struct {
 func (m *Command) Reset()                    { *m = Command{} }
 func (m *Command) String() string            { return proto.CompactTextString(m) }
 func (*Command) ProtoMessage()               {}
-func (*Command) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{12} }
+func (*Command) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{15} }
 
 var extRange_Command = []proto.ExtensionRange{
 	{Start: 100, End: 536870911},
@@ -723,7 +907,7 @@ type CreateNodeCommand struct {
 func (m *CreateNodeCommand) Reset()                    { *m = CreateNodeCommand{} }
 func (m *CreateNodeCommand) String() string            { return proto.CompactTextString(m) }
 func (*CreateNodeCommand) ProtoMessage()               {}
-func (*CreateNodeCommand) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{13} }
+func (*CreateNodeCommand) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{16} }
 
 func (m *CreateNodeCommand) GetHost() string {
 	if m != nil && m.Host != nil {
@@ -757,7 +941,7 @@ type DeleteNodeCommand struct {
 func (m *DeleteNodeCommand) Reset()                    { *m = DeleteNodeCommand{} }
 func (m *DeleteNodeCommand) String() string            { return proto.CompactTextString(m) }
 func (*DeleteNodeCommand) ProtoMessage()               {}
-func (*DeleteNodeCommand) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{14} }
+func (*DeleteNodeCommand) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{17} }
 
 func (m *DeleteNodeCommand) GetID() uint64 {
 	if m != nil && m.ID != nil {
@@ -791,7 +975,7 @@ type CreateDatabaseCommand struct {
 func (m *CreateDatabaseCommand) Reset()                    { *m = CreateDatabaseCommand{} }
 func (m *CreateDatabaseCommand) String() string            { return proto.CompactTextString(m) }
 func (*CreateDatabaseCommand) ProtoMessage()               {}
-func (*CreateDatabaseCommand) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{15} }
+func (*CreateDatabaseCommand) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{18} }
 
 func (m *CreateDatabaseCommand) GetName() string {
 	if m != nil && m.Name != nil {
@@ -824,7 +1008,7 @@ type DropDatabaseCommand struct {
 func (m *DropDatabaseCommand) Reset()                    { *m = DropDatabaseCommand{} }
 func (m *DropDatabaseCommand) String() string            { return proto.CompactTextString(m) }
 func (*DropDatabaseCommand) ProtoMessage()               {}
-func (*DropDatabaseCommand) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{16} }
+func (*DropDatabaseCommand) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{19} }
 
 func (m *DropDatabaseCommand) GetName() string {
 	if m != nil && m.Name != nil {
@@ -852,7 +1036,7 @@ func (m *CreateRetentionPolicyCommand) Reset()         { *m = CreateRetentionPol
 func (m *CreateRetentionPolicyCommand) String() string { return proto.CompactTextString(m) }
 func (*CreateRetentionPolicyCommand) ProtoMessage()    {}
 func (*CreateRetentionPolicyCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptorMeta, []int{17}
+	return fileDescriptorMeta, []int{20}
 }
 
 func (m *CreateRetentionPolicyCommand) GetDatabase() string {
@@ -887,7 +1071,7 @@ type DropRetentionPolicyCommand struct {
 func (m *DropRetentionPolicyCommand) Reset()                    { *m = DropRetentionPolicyCommand{} }
 func (m *DropRetentionPolicyCommand) String() string            { return proto.CompactTextString(m) }
 func (*DropRetentionPolicyCommand) ProtoMessage()               {}
-func (*DropRetentionPolicyCommand) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{18} }
+func (*DropRetentionPolicyCommand) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{21} }
 
 func (m *DropRetentionPolicyCommand) GetDatabase() string {
 	if m != nil && m.Database != nil {
@@ -922,7 +1106,7 @@ func (m *SetDefaultRetentionPolicyCommand) Reset()         { *m = SetDefaultRete
 func (m *SetDefaultRetentionPolicyCommand) String() string { return proto.CompactTextString(m) }
 func (*SetDefaultRetentionPolicyCommand) ProtoMessage()    {}
 func (*SetDefaultRetentionPolicyCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptorMeta, []int{19}
+	return fileDescriptorMeta, []int{22}
 }
 
 func (m *SetDefaultRetentionPolicyCommand) GetDatabase() string {
@@ -961,7 +1145,7 @@ func (m *UpdateRetentionPolicyCommand) Reset()         { *m = UpdateRetentionPol
 func (m *UpdateRetentionPolicyCommand) String() string { return proto.CompactTextString(m) }
 func (*UpdateRetentionPolicyCommand) ProtoMessage()    {}
 func (*UpdateRetentionPolicyCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptorMeta, []int{20}
+	return fileDescriptorMeta, []int{23}
 }
 
 func (m *UpdateRetentionPolicyCommand) GetDatabase() string {
@@ -1018,7 +1202,7 @@ type CreateShardGroupCommand struct {
 func (m *CreateShardGroupCommand) Reset()                    { *m = CreateShardGroupCommand{} }
 func (m *CreateShardGroupCommand) String() string            { return proto.CompactTextString(m) }
 func (*CreateShardGroupCommand) ProtoMessage()               {}
-func (*CreateShardGroupCommand) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{21} }
+func (*CreateShardGroupCommand) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{24} }
 
 func (m *CreateShardGroupCommand) GetDatabase() string {
 	if m != nil && m.Database != nil {
@@ -1060,7 +1244,7 @@ type DeleteShardGroupCommand struct {
 func (m *DeleteShardGroupCommand) Reset()                    { *m = DeleteShardGroupCommand{} }
 func (m *DeleteShardGroupCommand) String() string            { return proto.CompactTextString(m) }
 func (*DeleteShardGroupCommand) ProtoMessage()               {}
-func (*DeleteShardGroupCommand) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{22} }
+func (*DeleteShardGroupCommand) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{25} }
 
 func (m *DeleteShardGroupCommand) GetDatabase() string {
 	if m != nil && m.Database != nil {
@@ -1103,7 +1287,7 @@ func (m *CreateContinuousQueryCommand) Reset()         { *m = CreateContinuousQu
 func (m *CreateContinuousQueryCommand) String() string { return proto.CompactTextString(m) }
 func (*CreateContinuousQueryCommand) ProtoMessage()    {}
 func (*CreateContinuousQueryCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptorMeta, []int{23}
+	return fileDescriptorMeta, []int{26}
 }
 
 func (m *CreateContinuousQueryCommand) GetDatabase() string {
@@ -1145,7 +1329,7 @@ type DropContinuousQueryCommand struct {
 func (m *DropContinuousQueryCommand) Reset()                    { *m = DropContinuousQueryCommand{} }
 func (m *DropContinuousQueryCommand) String() string            { return proto.CompactTextString(m) }
 func (*DropContinuousQueryCommand) ProtoMessage()               {}
-func (*DropContinuousQueryCommand) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{24} }
+func (*DropContinuousQueryCommand) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{27} }
 
 func (m *DropContinuousQueryCommand) GetDatabase() string {
 	if m != nil && m.Database != nil {
@@ -1180,7 +1364,7 @@ type CreateUserCommand struct {
 func (m *CreateUserCommand) Reset()                    { *m = CreateUserCommand{} }
 func (m *CreateUserCommand) String() string            { return proto.CompactTextString(m) }
 func (*CreateUserCommand) ProtoMessage()               {}
-func (*CreateUserCommand) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{25} }
+func (*CreateUserCommand) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{28} }
 
 func (m *CreateUserCommand) GetName() string {
 	if m != nil && m.Name != nil {
@@ -1220,7 +1404,7 @@ type DropUserCommand struct {
 func (m *DropUserCommand) Reset()                    { *m = DropUserCommand{} }
 func (m *DropUserCommand) String() string            { return proto.CompactTextString(m) }
 func (*DropUserCommand) ProtoMessage()               {}
-func (*DropUserCommand) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{26} }
+func (*DropUserCommand) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{29} }
 
 func (m *DropUserCommand) GetName() string {
 	if m != nil && m.Name != nil {
@@ -1247,7 +1431,7 @@ type UpdateUserCommand struct {
 func (m *UpdateUserCommand) Reset()                    { *m = UpdateUserCommand{} }
 func (m *UpdateUserCommand) String() string            { return proto.CompactTextString(m) }
 func (*UpdateUserCommand) ProtoMessage()               {}
-func (*UpdateUserCommand) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{27} }
+func (*UpdateUserCommand) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{30} }
 
 func (m *UpdateUserCommand) GetName() string {
 	if m != nil && m.Name != nil {
@@ -1282,7 +1466,7 @@ type SetPrivilegeCommand struct {
 func (m *SetPrivilegeCommand) Reset()                    { *m = SetPrivilegeCommand{} }
 func (m *SetPrivilegeCommand) String() string            { return proto.CompactTextString(m) }
 func (*SetPrivilegeCommand) ProtoMessage()               {}
-func (*SetPrivilegeCommand) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{28} }
+func (*SetPrivilegeCommand) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{31} }
 
 func (m *SetPrivilegeCommand) GetUsername() string {
 	if m != nil && m.Username != nil {
@@ -1322,7 +1506,7 @@ type SetDataCommand struct {
 func (m *SetDataCommand) Reset()                    { *m = SetDataCommand{} }
 func (m *SetDataCommand) String() string            { return proto.CompactTextString(m) }
 func (*SetDataCommand) ProtoMessage()               {}
-func (*SetDataCommand) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{29} }
+func (*SetDataCommand) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{32} }
 
 func (m *SetDataCommand) GetData() *Data {
 	if m != nil {
@@ -1349,7 +1533,7 @@ type SetAdminPrivilegeCommand struct {
 func (m *SetAdminPrivilegeCommand) Reset()                    { *m = SetAdminPrivilegeCommand{} }
 func (m *SetAdminPrivilegeCommand) String() string            { return proto.CompactTextString(m) }
 func (*SetAdminPrivilegeCommand) ProtoMessage()               {}
-func (*SetAdminPrivilegeCommand) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{30} }
+func (*SetAdminPrivilegeCommand) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{33} }
 
 func (m *SetAdminPrivilegeCommand) GetUsername() string {
 	if m != nil && m.Username != nil {
@@ -1383,7 +1567,7 @@ type UpdateNodeCommand struct {
 func (m *UpdateNodeCommand) Reset()                    { *m = UpdateNodeCommand{} }
 func (m *UpdateNodeCommand) String() string            { return proto.CompactTextString(m) }
 func (*UpdateNodeCommand) ProtoMessage()               {}
-func (*UpdateNodeCommand) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{31} }
+func (*UpdateNodeCommand) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{34} }
 
 func (m *UpdateNodeCommand) GetID() uint64 {
 	if m != nil && m.ID != nil {
@@ -1420,7 +1604,7 @@ type CreateSubscriptionCommand struct {
 func (m *CreateSubscriptionCommand) Reset()                    { *m = CreateSubscriptionCommand{} }
 func (m *CreateSubscriptionCommand) String() string            { return proto.CompactTextString(m) }
 func (*CreateSubscriptionCommand) ProtoMessage()               {}
-func (*CreateSubscriptionCommand) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{32} }
+func (*CreateSubscriptionCommand) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{35} }
 
 func (m *CreateSubscriptionCommand) GetName() string {
 	if m != nil && m.Name != nil {
@@ -1476,7 +1660,7 @@ type DropSubscriptionCommand struct {
 func (m *DropSubscriptionCommand) Reset()                    { *m = DropSubscriptionCommand{} }
 func (m *DropSubscriptionCommand) String() string            { return proto.CompactTextString(m) }
 func (*DropSubscriptionCommand) ProtoMessage()               {}
-func (*DropSubscriptionCommand) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{33} }
+func (*DropSubscriptionCommand) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{36} }
 
 func (m *DropSubscriptionCommand) GetName() string {
 	if m != nil && m.Name != nil {
@@ -1517,7 +1701,7 @@ type RemovePeerCommand struct {
 func (m *RemovePeerCommand) Reset()                    { *m = RemovePeerCommand{} }
 func (m *RemovePeerCommand) String() string            { return proto.CompactTextString(m) }
 func (*RemovePeerCommand) ProtoMessage()               {}
-func (*RemovePeerCommand) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{34} }
+func (*RemovePeerCommand) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{37} }
 
 func (m *RemovePeerCommand) GetID() uint64 {
 	if m != nil && m.ID != nil {
@@ -1552,7 +1736,7 @@ type CreateMetaNodeCommand struct {
 func (m *CreateMetaNodeCommand) Reset()                    { *m = CreateMetaNodeCommand{} }
 func (m *CreateMetaNodeCommand) String() string            { return proto.CompactTextString(m) }
 func (*CreateMetaNodeCommand) ProtoMessage()               {}
-func (*CreateMetaNodeCommand) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{35} }
+func (*CreateMetaNodeCommand) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{38} }
 
 func (m *CreateMetaNodeCommand) GetHTTPAddr() string {
 	if m != nil && m.HTTPAddr != nil {
@@ -1593,7 +1777,7 @@ type CreateDataNodeCommand struct {
 func (m *CreateDataNodeCommand) Reset()                    { *m = CreateDataNodeCommand{} }
 func (m *CreateDataNodeCommand) String() string            { return proto.CompactTextString(m) }
 func (*CreateDataNodeCommand) ProtoMessage()               {}
-func (*CreateDataNodeCommand) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{36} }
+func (*CreateDataNodeCommand) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{39} }
 
 func (m *CreateDataNodeCommand) GetHTTPAddr() string {
 	if m != nil && m.HTTPAddr != nil {
@@ -1628,7 +1812,7 @@ type UpdateDataNodeCommand struct {
 func (m *UpdateDataNodeCommand) Reset()                    { *m = UpdateDataNodeCommand{} }
 func (m *UpdateDataNodeCommand) String() string            { return proto.CompactTextString(m) }
 func (*UpdateDataNodeCommand) ProtoMessage()               {}
-func (*UpdateDataNodeCommand) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{37} }
+func (*UpdateDataNodeCommand) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{40} }
 
 func (m *UpdateDataNodeCommand) GetID() uint64 {
 	if m != nil && m.ID != nil {
@@ -1668,7 +1852,7 @@ type DeleteMetaNodeCommand struct {
 func (m *DeleteMetaNodeCommand) Reset()                    { *m = DeleteMetaNodeCommand{} }
 func (m *DeleteMetaNodeCommand) String() string            { return proto.CompactTextString(m) }
 func (*DeleteMetaNodeCommand) ProtoMessage()               {}
-func (*DeleteMetaNodeCommand) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{38} }
+func (*DeleteMetaNodeCommand) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{41} }
 
 func (m *DeleteMetaNodeCommand) GetID() uint64 {
 	if m != nil && m.ID != nil {
@@ -1694,7 +1878,7 @@ type DeleteDataNodeCommand struct {
 func (m *DeleteDataNodeCommand) Reset()                    { *m = DeleteDataNodeCommand{} }
 func (m *DeleteDataNodeCommand) String() string            { return proto.CompactTextString(m) }
 func (*DeleteDataNodeCommand) ProtoMessage()               {}
-func (*DeleteDataNodeCommand) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{39} }
+func (*DeleteDataNodeCommand) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{42} }
 
 func (m *DeleteDataNodeCommand) GetID() uint64 {
 	if m != nil && m.ID != nil {
@@ -1722,7 +1906,7 @@ type Response struct {
 func (m *Response) Reset()                    { *m = Response{} }
 func (m *Response) String() string            { return proto.CompactTextString(m) }
 func (*Response) ProtoMessage()               {}
-func (*Response) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{40} }
+func (*Response) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{43} }
 
 func (m *Response) GetOK() bool {
 	if m != nil && m.OK != nil {
@@ -1757,7 +1941,7 @@ type SetMetaNodeCommand struct {
 func (m *SetMetaNodeCommand) Reset()                    { *m = SetMetaNodeCommand{} }
 func (m *SetMetaNodeCommand) String() string            { return proto.CompactTextString(m) }
 func (*SetMetaNodeCommand) ProtoMessage()               {}
-func (*SetMetaNodeCommand) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{41} }
+func (*SetMetaNodeCommand) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{44} }
 
 func (m *SetMetaNodeCommand) GetHTTPAddr() string {
 	if m != nil && m.HTTPAddr != nil {
@@ -1797,7 +1981,7 @@ type DropShardCommand struct {
 func (m *DropShardCommand) Reset()                    { *m = DropShardCommand{} }
 func (m *DropShardCommand) String() string            { return proto.CompactTextString(m) }
 func (*DropShardCommand) ProtoMessage()               {}
-func (*DropShardCommand) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{42} }
+func (*DropShardCommand) Descriptor() ([]byte, []int) { return fileDescriptorMeta, []int{45} }
 
 func (m *DropShardCommand) GetID() uint64 {
 	if m != nil && m.ID != nil {
@@ -1828,6 +2012,9 @@ func init() {
 	proto.RegisterType((*ContinuousQueryInfo)(nil), "meta.ContinuousQueryInfo")
 	proto.RegisterType((*UserInfo)(nil), "meta.UserInfo")
 	proto.RegisterType((*UserPrivilege)(nil), "meta.UserPrivilege")
+	proto.RegisterType((*MeasurementPrivilege)(nil), "meta.MeasurementPrivilege")
+	proto.RegisterType((*QueryLimits)(nil), "meta.QueryLimits")
+	proto.RegisterType((*TokenInfo)(nil), "meta.TokenInfo")
 	proto.RegisterType((*Command)(nil), "meta.Command")
 	proto.RegisterType((*CreateNodeCommand)(nil), "meta.CreateNodeCommand")
 	proto.RegisterType((*DeleteNodeCommand)(nil), "meta.DeleteNodeCommand")
@@ -1894,118 +2081,132 @@ func init() {
 func init() { proto.RegisterFile("internal/meta.proto", fileDescriptorMeta) }
 
 var fileDescriptorMeta = []byte{
-	// 1808 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0x4b, 0x6f, 0x1c, 0xc7,
-	0x11, 0x46, 0xcf, 0x3e, 0xb8, 0x5b, 0x7c, 0xaa, 0xf9, 0x1a, 0x4a, 0x14, 0xb3, 0x18, 0x08, 0xca,
-	0x22, 0x08, 0x98, 0x60, 0x03, 0xe8, 0x94, 0x97, 0xc4, 0x95, 0xc4, 0x85, 0xc0, 0x47, 0x66, 0xa9,
-	0x6b, 0x80, 0x11, 0xb7, 0x25, 0x6e, 0xb2, 0x3b, 0xb3, 0x99, 0x99, 0x95, 0xc4, 0x28, 0x4c, 0x18,
-	0x5f, 0x7c, 0xb5, 0x61, 0x18, 0x3e, 0xe8, 0x66, 0x1f, 0x7c, 0x34, 0x0c, 0x03, 0x06, 0x0c, 0x9f,
-	0x7c, 0xf7, 0x1f, 0xf0, 0x7f, 0xb0, 0xcf, 0xbe, 0x1a, 0xdd, 0x3d, 0x3d, 0xdd, 0x33, 0xd3, 0x3d,
-	0x24, 0x65, 0xf9, 0x36, 0x5d, 0x55, 0xdd, 0xf5, 0x55, 0x75, 0x75, 0x75, 0x55, 0x0f, 0x2c, 0x0f,
-	0xfd, 0x98, 0x84, 0xbe, 0x37, 0xfa, 0xdd, 0x98, 0xc4, 0xde, 0xf6, 0x24, 0x0c, 0xe2, 0x00, 0x57,
-	0xe9, 0xb7, 0xf3, 0x5e, 0x05, 0xaa, 0x5d, 0x2f, 0xf6, 0x30, 0x86, 0xea, 0x11, 0x09, 0xc7, 0x36,
-	0x6a, 0x59, 0xed, 0xaa, 0xcb, 0xbe, 0xf1, 0x0a, 0xd4, 0x7a, 0xfe, 0x80, 0xbc, 0xb4, 0x2d, 0x46,
-	0xe4, 0x03, 0xbc, 0x09, 0xcd, 0x9d, 0xd1, 0x34, 0x8a, 0x49, 0xd8, 0xeb, 0xda, 0x15, 0xc6, 0x91,
-	0x04, 0x7c, 0x0b, 0x6a, 0xfb, 0xc1, 0x80, 0x44, 0x76, 0xb5, 0x55, 0x69, 0xcf, 0x76, 0x16, 0xb6,
-	0x99, 0x4a, 0x4a, 0xea, 0xf9, 0x4f, 0x03, 0x97, 0x33, 0xf1, 0xef, 0xa1, 0x49, 0xb5, 0x3e, 0xf1,
-	0x22, 0x12, 0xd9, 0x35, 0x26, 0x89, 0xb9, 0xa4, 0x20, 0x33, 0x69, 0x29, 0x44, 0xd7, 0x7d, 0x1c,
-	0x91, 0x30, 0xb2, 0xeb, 0xea, 0xba, 0x94, 0xc4, 0xd7, 0x65, 0x4c, 0x8a, 0x6d, 0xcf, 0x7b, 0xc9,
-	0xb4, 0x75, 0xed, 0x19, 0x8e, 0x2d, 0x25, 0xe0, 0x36, 0x2c, 0xee, 0x79, 0x2f, 0xfb, 0x27, 0x5e,
-	0x38, 0x78, 0x18, 0x06, 0xd3, 0x49, 0xaf, 0x6b, 0x37, 0x98, 0x4c, 0x9e, 0x8c, 0xb7, 0x00, 0x04,
-	0xa9, 0xd7, 0xb5, 0x9b, 0x4c, 0x48, 0xa1, 0xe0, 0xdf, 0x72, 0xfc, 0xdc, 0x52, 0xd0, 0x5a, 0x2a,
-	0x05, 0xa8, 0xf4, 0x1e, 0x11, 0xd2, 0xb3, 0x7a, 0xe9, 0x54, 0xc0, 0xd9, 0x85, 0x86, 0x20, 0xe3,
-	0x05, 0xb0, 0x7a, 0xdd, 0x64, 0x4f, 0xac, 0x5e, 0x97, 0xee, 0xd2, 0x6e, 0x10, 0xc5, 0x6c, 0x43,
-	0x9a, 0x2e, 0xfb, 0xc6, 0x36, 0xcc, 0x1c, 0xed, 0x1c, 0x32, 0x72, 0xa5, 0x85, 0xda, 0x4d, 0x57,
-	0x0c, 0x9d, 0xef, 0x11, 0xcc, 0xa9, 0xfe, 0xa4, 0xd3, 0xf7, 0xbd, 0x31, 0x61, 0x0b, 0x36, 0x5d,
-	0xf6, 0x8d, 0xef, 0xc0, 0x5a, 0x97, 0x3c, 0xf5, 0xa6, 0xa3, 0xd8, 0x25, 0x31, 0xf1, 0xe3, 0x61,
-	0xe0, 0x1f, 0x06, 0xa3, 0xe1, 0xf1, 0x69, 0xa2, 0xc4, 0xc0, 0xc5, 0x0f, 0xe1, 0x5a, 0x96, 0x34,
-	0x24, 0x91, 0x5d, 0x61, 0xc6, 0x6d, 0x70, 0xe3, 0x72, 0x33, 0x98, 0x9d, 0xc5, 0x39, 0x74, 0xa1,
-	0x9d, 0xc0, 0x8f, 0x87, 0xfe, 0x34, 0x98, 0x46, 0x7f, 0x9b, 0x92, 0x70, 0x98, 0x46, 0x4f, 0xb2,
-	0x50, 0x96, 0x9d, 0x2c, 0x54, 0x98, 0xe3, 0xbc, 0x8f, 0x60, 0x39, 0xa7, 0xb3, 0x3f, 0x21, 0xc7,
-	0x8a, 0xd5, 0x28, 0xb5, 0xfa, 0x3a, 0x34, 0xba, 0xd3, 0xd0, 0xa3, 0x92, 0xb6, 0xd5, 0x42, 0xed,
-	0x8a, 0x9b, 0x8e, 0xf1, 0x36, 0x60, 0x19, 0x0c, 0xa9, 0x54, 0x85, 0x49, 0x69, 0x38, 0x74, 0x2d,
-	0x97, 0x4c, 0x46, 0xc3, 0x63, 0x6f, 0xdf, 0xae, 0xb6, 0x50, 0x7b, 0xde, 0x4d, 0xc7, 0xce, 0xbb,
-	0x56, 0x01, 0x93, 0x71, 0x27, 0xb2, 0x98, 0xac, 0x4b, 0x61, 0xb2, 0x2e, 0x85, 0xc9, 0x52, 0x31,
-	0xe1, 0x3b, 0x30, 0x2b, 0x67, 0x88, 0xe3, 0xb7, 0xc2, 0x5d, 0xad, 0x9c, 0x02, 0xea, 0x65, 0x55,
-	0x10, 0xff, 0x11, 0xe6, 0xfb, 0xd3, 0x27, 0xd1, 0x71, 0x38, 0x9c, 0x50, 0x1d, 0xe2, 0x28, 0xae,
-	0x25, 0x33, 0x15, 0x16, 0x9b, 0x9b, 0x15, 0x76, 0xbe, 0x41, 0xb0, 0x90, 0x5d, 0xbd, 0x10, 0xdd,
-	0x9b, 0xd0, 0xec, 0xc7, 0x5e, 0x18, 0x1f, 0x0d, 0xc7, 0x24, 0xf1, 0x80, 0x24, 0xd0, 0x38, 0xbf,
-	0xef, 0x0f, 0x18, 0x8f, 0xdb, 0x2d, 0x86, 0x74, 0x5e, 0x97, 0x8c, 0x48, 0x4c, 0x06, 0x77, 0x63,
-	0x66, 0x6d, 0xc5, 0x95, 0x04, 0xfc, 0x6b, 0xa8, 0x33, 0xbd, 0xc2, 0xd2, 0x45, 0xc5, 0x52, 0x06,
-	0x34, 0x61, 0xe3, 0x16, 0xcc, 0x1e, 0x85, 0x53, 0xff, 0xd8, 0xe3, 0x0b, 0xd5, 0xd9, 0x86, 0xab,
-	0x24, 0x87, 0x40, 0x33, 0x9d, 0x56, 0x40, 0xbf, 0x05, 0x8d, 0x83, 0x17, 0x3e, 0x4d, 0x82, 0x91,
-	0x6d, 0xb5, 0x2a, 0xed, 0xea, 0x3d, 0xcb, 0x46, 0x6e, 0x4a, 0xc3, 0x6d, 0xa8, 0xb3, 0x6f, 0x71,
-	0x4a, 0x96, 0x14, 0x1c, 0x8c, 0xe1, 0x26, 0x7c, 0xe7, 0xef, 0xb0, 0x94, 0xf7, 0xa6, 0x36, 0x60,
-	0x30, 0x54, 0xf7, 0x82, 0x01, 0x11, 0xd9, 0x80, 0x7e, 0x63, 0x07, 0xe6, 0xba, 0x24, 0x8a, 0x87,
-	0xbe, 0xc7, 0xf7, 0x88, 0xea, 0x6a, 0xba, 0x19, 0x9a, 0x73, 0x0b, 0x40, 0x6a, 0xc5, 0x6b, 0x50,
-	0x4f, 0x12, 0x26, 0xb7, 0x25, 0x19, 0x39, 0x7f, 0x81, 0x65, 0xcd, 0xc1, 0xd3, 0x02, 0x59, 0x81,
-	0x1a, 0x13, 0x48, 0x90, 0xf0, 0x81, 0x73, 0x06, 0x0d, 0x91, 0x9f, 0x4d, 0xf0, 0x77, 0xbd, 0xe8,
-	0x24, 0x4d, 0x66, 0x5e, 0x74, 0x42, 0x57, 0xba, 0x3b, 0x18, 0x0f, 0x79, 0x68, 0x37, 0x5c, 0x3e,
-	0xc0, 0x7f, 0x00, 0x38, 0x0c, 0x87, 0xcf, 0x87, 0x23, 0xf2, 0x2c, 0xcd, 0x0d, 0xcb, 0xf2, 0x06,
-	0x48, 0x79, 0xae, 0x22, 0xe6, 0xf4, 0x60, 0x3e, 0xc3, 0x64, 0xe7, 0x2b, 0xc9, 0x86, 0x09, 0x8e,
-	0x74, 0x4c, 0x43, 0x28, 0x15, 0x64, 0x80, 0x6a, 0xae, 0x24, 0x38, 0xdf, 0xd5, 0x61, 0x66, 0x27,
-	0x18, 0x8f, 0x3d, 0x7f, 0x80, 0x6f, 0x43, 0x35, 0x3e, 0x9d, 0xf0, 0x15, 0x16, 0xc4, 0xad, 0x95,
-	0x30, 0xb7, 0x8f, 0x4e, 0x27, 0xc4, 0x65, 0x7c, 0xe7, 0x75, 0x1d, 0xaa, 0x74, 0x88, 0x57, 0xe1,
-	0xda, 0x4e, 0x48, 0xbc, 0x98, 0x50, 0xbf, 0x26, 0x82, 0x4b, 0x88, 0x92, 0x79, 0x8c, 0xaa, 0x64,
-	0x0b, 0x6f, 0xc0, 0x2a, 0x97, 0x16, 0xd0, 0x04, 0xab, 0x82, 0xd7, 0x61, 0xb9, 0x1b, 0x06, 0x93,
-	0x3c, 0xa3, 0x8a, 0x5b, 0xb0, 0xc9, 0xe7, 0xe4, 0x32, 0x8d, 0x90, 0xa8, 0xe1, 0x2d, 0xb8, 0x4e,
-	0xa7, 0x1a, 0xf8, 0x75, 0x7c, 0x0b, 0x5a, 0x7d, 0x12, 0xeb, 0x33, 0xbd, 0x90, 0x9a, 0xa1, 0x7a,
-	0x1e, 0x4f, 0x06, 0x66, 0x3d, 0x0d, 0x7c, 0x03, 0xd6, 0x39, 0x12, 0x79, 0xd2, 0x05, 0xb3, 0x49,
-	0x99, 0xdc, 0xe2, 0x22, 0x13, 0xa4, 0x0d, 0xb9, 0x98, 0x13, 0x12, 0xb3, 0xc2, 0x06, 0x03, 0x7f,
-	0x4e, 0xfa, 0x99, 0xee, 0xba, 0x20, 0xcf, 0xe3, 0x65, 0x58, 0xa4, 0xd3, 0x54, 0xe2, 0x02, 0x95,
-	0xe5, 0x96, 0xa8, 0xe4, 0x45, 0xea, 0xe1, 0x3e, 0x89, 0xd3, 0x7d, 0x17, 0x8c, 0x25, 0x8c, 0x61,
-	0x81, 0xfa, 0xc7, 0x8b, 0x3d, 0x41, 0xbb, 0x86, 0x37, 0xc1, 0xee, 0x93, 0x98, 0x05, 0x68, 0x61,
-	0x06, 0x96, 0x1a, 0xd4, 0xed, 0x5d, 0xc6, 0x37, 0x61, 0x23, 0x71, 0x90, 0x72, 0xc0, 0x05, 0x7b,
-	0x95, 0xb9, 0x28, 0x0c, 0x26, 0x3a, 0xe6, 0x1a, 0x5d, 0xd2, 0x25, 0xe3, 0xe0, 0x39, 0x39, 0x24,
-	0x12, 0xf4, 0xba, 0x8c, 0x18, 0x51, 0x42, 0x08, 0x96, 0x9d, 0x0d, 0x26, 0x95, 0xb5, 0x41, 0x59,
-	0x1c, 0x5f, 0x9e, 0x75, 0x9d, 0xb2, 0xf8, 0x3e, 0xe5, 0x17, 0xbc, 0x21, 0x59, 0xf9, 0x59, 0x9b,
-	0x78, 0x0d, 0x70, 0x9f, 0xc4, 0xf9, 0x29, 0x37, 0xf1, 0x0a, 0x2c, 0x31, 0x93, 0xe8, 0x9e, 0x0b,
-	0xea, 0xd6, 0x6f, 0x1a, 0x8d, 0xc1, 0xd2, 0xf9, 0xf9, 0xf9, 0xb9, 0xe5, 0x9c, 0x69, 0x8e, 0x47,
-	0x5a, 0xe7, 0x20, 0xa5, 0xce, 0xc1, 0x50, 0x75, 0x3d, 0x7f, 0x90, 0x14, 0xa3, 0xec, 0xbb, 0xf3,
-	0x57, 0x98, 0x39, 0x4e, 0xa6, 0xcc, 0x67, 0x4e, 0xa2, 0x4d, 0x5a, 0xa8, 0x3d, 0xdb, 0x59, 0x4f,
-	0x88, 0x79, 0x05, 0xae, 0x98, 0xe6, 0xbc, 0xd2, 0x1c, 0xc3, 0x42, 0x6a, 0x5f, 0x81, 0xda, 0x83,
-	0x20, 0x3c, 0xe6, 0x99, 0xa1, 0xe1, 0xf2, 0x41, 0x89, 0xf2, 0xa7, 0xaa, 0xf2, 0xc2, 0xf2, 0x52,
-	0xf9, 0x97, 0xc8, 0x70, 0xda, 0xb5, 0xf9, 0x72, 0x07, 0x16, 0x8b, 0x25, 0x1a, 0x2a, 0xaf, 0xb7,
-	0xf2, 0x33, 0x3a, 0x5d, 0x23, 0xe8, 0x67, 0x6c, 0xad, 0x1b, 0xaa, 0xc7, 0x72, 0xa8, 0x24, 0xf0,
-	0xb1, 0x36, 0x15, 0xe9, 0x50, 0x77, 0xee, 0x19, 0x15, 0x9e, 0xa8, 0xe0, 0x35, 0xcb, 0x49, 0x75,
-	0xdf, 0xa2, 0xf2, 0x0c, 0x57, 0x9a, 0xda, 0xb5, 0x6e, 0xb3, 0xae, 0xe8, 0xb6, 0x47, 0x46, 0x2b,
-	0x86, 0xcc, 0x0a, 0x47, 0x75, 0x9b, 0x1e, 0xa4, 0x34, 0xe7, 0x23, 0x54, 0x96, 0x8e, 0x4b, 0x8d,
-	0x11, 0x1e, 0xb6, 0x14, 0x0f, 0xf7, 0x8c, 0xd8, 0xfe, 0xc1, 0xb0, 0xb5, 0xa4, 0x87, 0x2f, 0x42,
-	0xf6, 0x09, 0xba, 0xf8, 0x22, 0xb8, 0x32, 0xbe, 0x03, 0x23, 0xbe, 0x7f, 0x32, 0x7c, 0xb7, 0x93,
-	0x42, 0xe8, 0x02, 0xbd, 0x12, 0xe5, 0x0f, 0xa8, 0xfc, 0x22, 0xba, 0x2a, 0x42, 0x5a, 0x5a, 0xee,
-	0x93, 0x17, 0x8c, 0x9c, 0xb4, 0x50, 0xc9, 0x30, 0x53, 0x93, 0x57, 0x73, 0x7d, 0x82, 0x5a, 0x63,
-	0xd7, 0xb2, 0x75, 0x7f, 0x49, 0xbc, 0x8c, 0xd4, 0x78, 0x29, 0xb3, 0x42, 0xda, 0xfb, 0x05, 0x32,
-	0x5e, 0xab, 0xa5, 0xa6, 0xae, 0x41, 0x3d, 0xd3, 0xca, 0x25, 0x23, 0x5a, 0xec, 0xd0, 0xba, 0x39,
-	0x8a, 0xbd, 0xf1, 0x24, 0xa9, 0xa5, 0x25, 0xa1, 0xf3, 0xc0, 0x08, 0x7d, 0xcc, 0xa0, 0xdf, 0x54,
-	0x43, 0xbd, 0x00, 0x48, 0xa2, 0xfe, 0x0a, 0x19, 0xef, 0xfb, 0x37, 0x42, 0xed, 0xc0, 0x5c, 0xa6,
-	0x75, 0xe7, 0x4f, 0x0f, 0x19, 0x5a, 0x09, 0x76, 0x5f, 0xc5, 0x6e, 0x80, 0x25, 0xb1, 0x7f, 0x8e,
-	0xca, 0xcb, 0x91, 0x2b, 0x47, 0x58, 0x5a, 0x21, 0x57, 0x94, 0x0a, 0xb9, 0x24, 0x4a, 0x82, 0x62,
-	0x56, 0xd1, 0x23, 0x29, 0x66, 0x95, 0xb7, 0x83, 0xb8, 0x24, 0xab, 0x4c, 0xf2, 0x59, 0xe5, 0x22,
-	0x64, 0x1f, 0x20, 0x4d, 0x69, 0xf6, 0xf3, 0x5a, 0x82, 0x92, 0xcb, 0xf7, 0x5f, 0xc5, 0x9b, 0x5f,
-	0x51, 0x2b, 0x51, 0x91, 0x42, 0x61, 0xa8, 0xbd, 0xbf, 0xfe, 0x6c, 0x54, 0x14, 0x32, 0x45, 0xab,
-	0xd2, 0x0f, 0x5a, 0x35, 0x67, 0x9a, 0x52, 0xf3, 0xb2, 0xb6, 0x97, 0x58, 0x19, 0xa9, 0x56, 0x16,
-	0x14, 0x48, 0xf5, 0x9f, 0x21, 0x6d, 0x4d, 0x4b, 0xc3, 0x81, 0xca, 0xfb, 0x12, 0x45, 0x3a, 0xce,
-	0x84, 0x8a, 0x55, 0xd6, 0x28, 0x55, 0x72, 0x8d, 0x52, 0xc9, 0x65, 0x1f, 0xab, 0x97, 0xbd, 0x06,
-	0x90, 0x44, 0x1c, 0xe4, 0x6b, 0x6d, 0xbc, 0xc5, 0xdf, 0x28, 0x19, 0xce, 0xd9, 0x0e, 0xc8, 0x87,
-	0x42, 0x97, 0xd1, 0x3b, 0x7f, 0x32, 0x6a, 0x9d, 0x32, 0xad, 0x2b, 0xf2, 0x82, 0x91, 0xab, 0x4a,
-	0x85, 0x1f, 0x22, 0x73, 0x25, 0x5f, 0xea, 0xa7, 0x34, 0x32, 0x2d, 0x35, 0x32, 0x1f, 0x1a, 0xd1,
-	0x3c, 0x67, 0x68, 0xb6, 0x52, 0x34, 0x5a, 0x8d, 0x12, 0xd7, 0xa9, 0xa6, 0x85, 0xb8, 0xcc, 0x8b,
-	0x60, 0x49, 0xd4, 0xbc, 0x28, 0x46, 0x8d, 0xb6, 0x30, 0xfd, 0x11, 0x95, 0xf4, 0x29, 0xc6, 0xc7,
-	0x2b, 0x53, 0xcc, 0xb4, 0x8b, 0x15, 0x18, 0x4f, 0x83, 0x79, 0x72, 0xfa, 0xa2, 0x51, 0x2d, 0x79,
-	0xd1, 0xa8, 0x15, 0x5f, 0x34, 0x3a, 0xbb, 0x46, 0x8b, 0x4f, 0x99, 0xc5, 0xbf, 0xca, 0xdc, 0x59,
-	0x45, 0x93, 0xa4, 0xe5, 0x5f, 0x23, 0x63, 0x0b, 0xf6, 0xcb, 0xd9, 0x5d, 0x72, 0x6f, 0xfd, 0x3b,
-	0x73, 0x6f, 0xe9, 0x81, 0x65, 0x42, 0xa6, 0xd0, 0x22, 0xa6, 0x21, 0x83, 0x64, 0xc8, 0xdc, 0x1d,
-	0x0c, 0x42, 0x11, 0x32, 0xf4, 0xbb, 0x24, 0x64, 0x5e, 0xa9, 0x21, 0x53, 0x58, 0x5c, 0xaa, 0xfe,
-	0x14, 0x19, 0xfa, 0x50, 0xea, 0xa2, 0xdd, 0xa3, 0xa3, 0x43, 0xa6, 0x33, 0x39, 0x42, 0x62, 0x9c,
-	0x3c, 0x5e, 0x2b, 0x70, 0xc4, 0x30, 0x6d, 0xf7, 0x2a, 0x4a, 0xbb, 0x67, 0x6e, 0x5e, 0xfe, 0x53,
-	0x6c, 0x5e, 0x72, 0x30, 0x32, 0xd7, 0x91, 0xbe, 0x2d, 0x7e, 0x33, 0xa4, 0x25, 0xa8, 0xce, 0xf4,
-	0x2d, 0x95, 0x16, 0xd5, 0x6b, 0x64, 0xe8, 0xc8, 0xaf, 0xfe, 0x13, 0xc0, 0x52, 0x7e, 0x02, 0x94,
-	0xa0, 0xfb, 0xaf, 0x8a, 0x4e, 0xab, 0x5a, 0x6d, 0xf8, 0xf4, 0x6f, 0x02, 0x79, 0x70, 0x25, 0xea,
-	0xfe, 0xa7, 0xaa, 0xd3, 0x2e, 0x26, 0xd5, 0xf9, 0x86, 0x77, 0x86, 0x82, 0xba, 0xfb, 0x46, 0x75,
-	0xe7, 0xa8, 0xa8, 0xcf, 0x68, 0xde, 0x03, 0x5a, 0xca, 0x47, 0x93, 0xc0, 0x8f, 0x08, 0x55, 0x71,
-	0xf0, 0x88, 0xa9, 0x68, 0xb8, 0xd6, 0xc1, 0x23, 0x9a, 0xe5, 0xef, 0x87, 0x61, 0x10, 0xb2, 0x66,
-	0xbb, 0xe9, 0xf2, 0x81, 0xfc, 0x37, 0x56, 0x61, 0xe7, 0x8a, 0x0f, 0x9c, 0x8f, 0x91, 0xee, 0x15,
-	0xe4, 0x2d, 0x9e, 0x00, 0xf3, 0x05, 0xfb, 0x7f, 0x6e, 0xaf, 0x9d, 0xde, 0x2e, 0x46, 0xe7, 0x0e,
-	0x8a, 0x2f, 0x32, 0x05, 0xbf, 0x9a, 0xf3, 0xc1, 0x3b, 0x5c, 0xcf, 0x9a, 0x92, 0x91, 0x94, 0x85,
-	0x52, 0x2d, 0x3f, 0x05, 0x00, 0x00, 0xff, 0xff, 0xc7, 0x04, 0x86, 0xd9, 0x75, 0x1c, 0x00, 0x00,
+	// 2030 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0xcd, 0x8f, 0xdc, 0x48,
+	0x15, 0x57, 0xb9, 0xdd, 0x3d, 0xdd, 0xaf, 0xe7, 0xb3, 0xe6, 0x23, 0x4e, 0x32, 0x19, 0x5a, 0x56,
+	0xb4, 0xb4, 0x56, 0xab, 0x80, 0x7a, 0xa5, 0x3d, 0xf1, 0x95, 0x4c, 0x27, 0x99, 0x56, 0x98, 0xc9,
+	0xe0, 0x9e, 0xbd, 0x22, 0x79, 0xbb, 0x2b, 0x89, 0x49, 0xb7, 0xdd, 0xd8, 0xee, 0x64, 0x86, 0x25,
+	0x30, 0x20, 0x24, 0xb8, 0x22, 0x84, 0x38, 0xec, 0x09, 0x38, 0x70, 0x44, 0x08, 0x09, 0x69, 0xb5,
+	0x27, 0x0e, 0xdc, 0xf8, 0x07, 0xf8, 0x23, 0x38, 0x73, 0x45, 0x55, 0xe5, 0x72, 0x95, 0xed, 0x2a,
+	0x67, 0x26, 0x2c, 0x37, 0xd7, 0x7b, 0xaf, 0xea, 0xfd, 0xde, 0xab, 0x57, 0xef, 0xd5, 0x2b, 0xc3,
+	0x76, 0x10, 0xa6, 0x24, 0x0e, 0xfd, 0xd9, 0xd7, 0xe6, 0x24, 0xf5, 0xef, 0x2d, 0xe2, 0x28, 0x8d,
+	0xb0, 0x4d, 0xbf, 0xdd, 0xcf, 0x1b, 0x60, 0x0f, 0xfd, 0xd4, 0xc7, 0x18, 0xec, 0x33, 0x12, 0xcf,
+	0x1d, 0xd4, 0xb3, 0xfa, 0xb6, 0xc7, 0xbe, 0xf1, 0x0e, 0x34, 0x47, 0xe1, 0x94, 0x9c, 0x3b, 0x16,
+	0x23, 0xf2, 0x01, 0xde, 0x87, 0xce, 0xe1, 0x6c, 0x99, 0xa4, 0x24, 0x1e, 0x0d, 0x9d, 0x06, 0xe3,
+	0x48, 0x02, 0xbe, 0x0b, 0xcd, 0x93, 0x68, 0x4a, 0x12, 0xc7, 0xee, 0x35, 0xfa, 0xdd, 0xc1, 0xfa,
+	0x3d, 0xa6, 0x92, 0x92, 0x46, 0xe1, 0xb3, 0xc8, 0xe3, 0x4c, 0xfc, 0x75, 0xe8, 0x50, 0xad, 0x9f,
+	0xf8, 0x09, 0x49, 0x9c, 0x26, 0x93, 0xc4, 0x5c, 0x52, 0x90, 0x99, 0xb4, 0x14, 0xa2, 0xeb, 0x7e,
+	0x9c, 0x90, 0x38, 0x71, 0x5a, 0xea, 0xba, 0x94, 0xc4, 0xd7, 0x65, 0x4c, 0x8a, 0xed, 0xd8, 0x3f,
+	0x67, 0xda, 0x86, 0xce, 0x0a, 0xc7, 0x96, 0x13, 0x70, 0x1f, 0x36, 0x8e, 0xfd, 0xf3, 0xf1, 0x0b,
+	0x3f, 0x9e, 0x3e, 0x8e, 0xa3, 0xe5, 0x62, 0x34, 0x74, 0xda, 0x4c, 0xa6, 0x4c, 0xc6, 0x07, 0x00,
+	0x82, 0x34, 0x1a, 0x3a, 0x1d, 0x26, 0xa4, 0x50, 0xf0, 0x07, 0x1c, 0x3f, 0xb7, 0x14, 0xb4, 0x96,
+	0x4a, 0x01, 0x2a, 0x7d, 0x4c, 0x84, 0x74, 0x57, 0x2f, 0x9d, 0x0b, 0xe0, 0xaf, 0x42, 0xeb, 0x2c,
+	0x7a, 0x49, 0xc2, 0xc4, 0x59, 0x65, 0xa2, 0x1b, 0x5c, 0x94, 0xd1, 0x98, 0x6c, 0xc6, 0x76, 0x8f,
+	0xa0, 0x2d, 0xe6, 0xe3, 0x75, 0xb0, 0x46, 0xc3, 0x6c, 0xf3, 0xac, 0xd1, 0x90, 0x6e, 0xe7, 0x51,
+	0x94, 0xa4, 0x6c, 0xe7, 0x3a, 0x1e, 0xfb, 0xc6, 0x0e, 0xac, 0x9c, 0x1d, 0x9e, 0x32, 0x72, 0xa3,
+	0x87, 0xfa, 0x1d, 0x4f, 0x0c, 0xdd, 0xdf, 0x5b, 0xb0, 0xaa, 0x3a, 0x9e, 0x4e, 0x3f, 0xf1, 0xe7,
+	0x84, 0x2d, 0xd8, 0xf1, 0xd8, 0x37, 0xfe, 0x08, 0xf6, 0x86, 0xe4, 0x99, 0xbf, 0x9c, 0xa5, 0x1e,
+	0x49, 0x49, 0x98, 0x06, 0x51, 0x78, 0x1a, 0xcd, 0x82, 0xc9, 0x45, 0xa6, 0xc4, 0xc0, 0xc5, 0x8f,
+	0x61, 0xab, 0x48, 0x0a, 0x48, 0xe2, 0x34, 0x98, 0x69, 0x37, 0xb9, 0x69, 0xa5, 0x19, 0xcc, 0xc8,
+	0xea, 0x1c, 0xba, 0xd0, 0x61, 0x14, 0xa6, 0x41, 0xb8, 0x8c, 0x96, 0xc9, 0xf7, 0x96, 0x24, 0x0e,
+	0xf2, 0x30, 0xcb, 0x16, 0x2a, 0xb2, 0xb3, 0x85, 0x2a, 0x73, 0xf0, 0x87, 0xd0, 0x65, 0xfc, 0xef,
+	0x06, 0xf3, 0x20, 0xa5, 0xf1, 0x87, 0xfa, 0xdd, 0xc1, 0x16, 0x5f, 0x42, 0x61, 0x78, 0xaa, 0x94,
+	0xfb, 0x6b, 0x04, 0xdb, 0x25, 0xa0, 0xe3, 0x05, 0x99, 0x28, 0xae, 0x42, 0xb9, 0xab, 0x6e, 0x41,
+	0x7b, 0xb8, 0x8c, 0x7d, 0x2a, 0xe9, 0x58, 0x3d, 0xd4, 0x6f, 0x78, 0xf9, 0x18, 0xdf, 0x03, 0x2c,
+	0x43, 0x2d, 0x97, 0x6a, 0x30, 0x29, 0x0d, 0x87, 0xae, 0xe5, 0x91, 0xc5, 0x2c, 0x98, 0xf8, 0x27,
+	0x8e, 0xdd, 0x43, 0xfd, 0x35, 0x2f, 0x1f, 0xbb, 0xbf, 0xb4, 0x2a, 0x98, 0x8c, 0xdb, 0x57, 0xc4,
+	0x64, 0x5d, 0x09, 0x93, 0x75, 0x25, 0x4c, 0x96, 0x8a, 0x09, 0x7f, 0x04, 0x5d, 0x39, 0x43, 0x1c,
+	0xee, 0x1d, 0xee, 0x5c, 0xe5, 0x8c, 0xd1, 0xad, 0x51, 0x05, 0xf1, 0x37, 0x60, 0x6d, 0xbc, 0xfc,
+	0x24, 0x99, 0xc4, 0xc1, 0x82, 0xea, 0x10, 0x07, 0x7d, 0x2f, 0x9b, 0xa9, 0xb0, 0xd8, 0xdc, 0xa2,
+	0xb0, 0xfb, 0x77, 0x04, 0xeb, 0xc5, 0xd5, 0x2b, 0x47, 0x62, 0x1f, 0x3a, 0xe3, 0xd4, 0x8f, 0xd3,
+	0xb3, 0x60, 0x4e, 0x32, 0x0f, 0x48, 0x02, 0x3d, 0x1c, 0x0f, 0xc3, 0x29, 0xe3, 0x71, 0xbb, 0xc5,
+	0x90, 0xce, 0x1b, 0x92, 0x19, 0x49, 0xc9, 0xf4, 0x7e, 0xca, 0xac, 0x6d, 0x78, 0x92, 0x40, 0x4f,
+	0x2b, 0xd3, 0x2b, 0x2c, 0xdd, 0x50, 0x2c, 0xe5, 0xa7, 0x95, 0xb3, 0x71, 0x0f, 0xba, 0x67, 0xf1,
+	0x32, 0x9c, 0xf8, 0x7c, 0xa1, 0x16, 0xdb, 0x70, 0x95, 0xe4, 0x12, 0xe8, 0xe4, 0xd3, 0x2a, 0xe8,
+	0x0f, 0xa0, 0xfd, 0xf4, 0x75, 0x48, 0x53, 0x6c, 0xe2, 0x58, 0xbd, 0x46, 0xdf, 0x7e, 0x60, 0x39,
+	0xc8, 0xcb, 0x69, 0xb8, 0x0f, 0x2d, 0xf6, 0x2d, 0x8e, 0xd6, 0xa6, 0x82, 0x83, 0x31, 0xbc, 0x8c,
+	0xef, 0x7e, 0x1f, 0x36, 0xcb, 0xde, 0xd4, 0x06, 0x0c, 0x06, 0xfb, 0x38, 0x9a, 0x12, 0x91, 0x42,
+	0xe8, 0x37, 0x76, 0x61, 0x75, 0x48, 0x92, 0x34, 0x08, 0x7d, 0xbe, 0x47, 0x54, 0x57, 0xc7, 0x2b,
+	0xd0, 0xdc, 0xbb, 0x00, 0x52, 0x2b, 0xde, 0x83, 0x56, 0x96, 0x8e, 0xb9, 0x2d, 0xd9, 0xc8, 0xfd,
+	0x36, 0x6c, 0x6b, 0x4e, 0xab, 0x16, 0xc8, 0x0e, 0x34, 0x99, 0x40, 0x86, 0x84, 0x0f, 0xdc, 0x5f,
+	0x58, 0xd0, 0x16, 0xe9, 0xdf, 0x84, 0xff, 0xc8, 0x4f, 0x5e, 0xe4, 0x29, 0xd0, 0x4f, 0x5e, 0xd0,
+	0xa5, 0xee, 0x4f, 0xe7, 0x01, 0x8f, 0xed, 0xb6, 0xc7, 0x07, 0xf8, 0x43, 0x80, 0xd3, 0x38, 0x78,
+	0x15, 0xcc, 0xc8, 0xf3, 0x3c, 0xa3, 0x6c, 0xcb, 0x02, 0x93, 0xf3, 0x3c, 0x45, 0xec, 0x9d, 0x92,
+	0x08, 0x3e, 0x85, 0xdd, 0x63, 0xe2, 0x27, 0xcb, 0x98, 0xcc, 0x49, 0x98, 0x2a, 0x4a, 0x79, 0xb0,
+	0xdf, 0xe2, 0xd3, 0x75, 0x22, 0x9e, 0x7e, 0xa2, 0x3b, 0x82, 0xb5, 0x02, 0x46, 0x76, 0xce, 0xb3,
+	0x54, 0x9e, 0xb9, 0x23, 0x1f, 0xd3, 0x50, 0xce, 0x05, 0x99, 0x5f, 0x9a, 0x9e, 0x24, 0xb8, 0xbf,
+	0x42, 0xb0, 0xa3, 0x53, 0x52, 0xbb, 0x64, 0x0f, 0xba, 0xca, 0x9c, 0xcc, 0xd9, 0x2a, 0x89, 0xfa,
+	0xdc, 0x23, 0xcf, 0xc9, 0xb9, 0xf0, 0x39, 0x1b, 0x14, 0xa1, 0xd8, 0x65, 0x28, 0xff, 0x40, 0x05,
+	0xef, 0xe2, 0x01, 0xec, 0x1c, 0xfb, 0xe7, 0x87, 0x51, 0x38, 0x59, 0xc6, 0x31, 0x09, 0x53, 0x91,
+	0xfd, 0x11, 0x3b, 0x45, 0x5a, 0x9e, 0xa8, 0xf6, 0x64, 0x46, 0x26, 0xe9, 0x69, 0x14, 0x84, 0xe9,
+	0x49, 0x96, 0x8b, 0xcb, 0x64, 0xfc, 0x3e, 0x6c, 0xe6, 0xa4, 0x31, 0x9b, 0x7c, 0x92, 0x25, 0xe4,
+	0x0a, 0x1d, 0x7f, 0x00, 0x5b, 0x39, 0xed, 0xc1, 0x72, 0xf2, 0x92, 0xa4, 0x09, 0xcf, 0xcb, 0x0d,
+	0xaf, 0xca, 0x70, 0xbf, 0x40, 0xd0, 0xc9, 0x0b, 0xb7, 0x72, 0xa6, 0x3b, 0x79, 0x91, 0x2e, 0x47,
+	0x28, 0x06, 0x9b, 0xee, 0x27, 0x73, 0x56, 0xc7, 0x63, 0xdf, 0x05, 0xff, 0xdb, 0xac, 0xcc, 0x18,
+	0xb6, 0xb4, 0x59, 0xf2, 0x23, 0xe5, 0x1e, 0xc6, 0x24, 0x4f, 0x39, 0x2c, 0x77, 0xe5, 0x04, 0x7a,
+	0xcb, 0x79, 0x78, 0xbe, 0x08, 0xb2, 0x74, 0xbf, 0xc2, 0x8c, 0x50, 0x28, 0xee, 0xbf, 0x5a, 0xb0,
+	0x72, 0x18, 0xcd, 0xe7, 0x7e, 0x38, 0xc5, 0xef, 0x81, 0x9d, 0x5e, 0x2c, 0xf8, 0xfe, 0xaf, 0x8b,
+	0xcb, 0x5a, 0xc6, 0xbc, 0x77, 0x76, 0xb1, 0x20, 0x1e, 0xe3, 0xbb, 0x9f, 0xb5, 0xc0, 0xa6, 0x43,
+	0xbc, 0x0b, 0x5b, 0x5c, 0x13, 0x3d, 0xf0, 0x99, 0xe0, 0x26, 0xa2, 0x64, 0x9e, 0x3c, 0x55, 0xb2,
+	0x85, 0x6f, 0xc2, 0x2e, 0x97, 0x16, 0x86, 0x09, 0x56, 0x03, 0xdf, 0x80, 0xed, 0x61, 0x1c, 0x2d,
+	0xca, 0x0c, 0x1b, 0xf7, 0x60, 0x9f, 0xcf, 0x29, 0x95, 0x40, 0x21, 0xd1, 0xc4, 0x07, 0x70, 0x8b,
+	0x4e, 0x35, 0xf0, 0x5b, 0xf8, 0x2e, 0xf4, 0xc6, 0x24, 0xd5, 0xdf, 0x5b, 0x84, 0xd4, 0x0a, 0xd5,
+	0xf3, 0xf1, 0x62, 0x6a, 0xd6, 0xd3, 0xc6, 0xb7, 0xe1, 0x06, 0x47, 0x22, 0x4b, 0x90, 0x60, 0x76,
+	0x28, 0x93, 0x5b, 0x5c, 0x65, 0x82, 0xb4, 0xa1, 0x94, 0x0c, 0x85, 0x44, 0x57, 0xd8, 0x60, 0xe0,
+	0xaf, 0x4a, 0x3f, 0xd3, 0x50, 0x11, 0xe4, 0x35, 0xbc, 0x0d, 0x1b, 0x74, 0x9a, 0x4a, 0x5c, 0xa7,
+	0xb2, 0xdc, 0x12, 0x95, 0xbc, 0x41, 0x3d, 0x3c, 0x26, 0xf2, 0xbc, 0x0b, 0xc6, 0x26, 0xc6, 0xb0,
+	0x4e, 0xfd, 0xe3, 0xa7, 0xbe, 0xa0, 0x6d, 0xe1, 0x7d, 0x70, 0xc6, 0x24, 0x65, 0x89, 0xb3, 0x32,
+	0x03, 0x4b, 0x0d, 0xea, 0xf6, 0x6e, 0xe3, 0x3b, 0x70, 0x33, 0x73, 0x90, 0x52, 0x79, 0x04, 0x7b,
+	0x97, 0xb9, 0x28, 0x8e, 0x16, 0x3a, 0xe6, 0x1e, 0x5d, 0xd2, 0x23, 0xf3, 0xe8, 0x15, 0x39, 0x25,
+	0x12, 0xf4, 0x0d, 0x19, 0x31, 0xe2, 0xe6, 0x2c, 0x58, 0x4e, 0x31, 0x98, 0x54, 0xd6, 0x4d, 0xca,
+	0xe2, 0xf8, 0xca, 0xac, 0x5b, 0x94, 0xc5, 0xf7, 0xa9, 0xbc, 0xe0, 0x6d, 0xc9, 0x2a, 0xcf, 0xda,
+	0xc7, 0x7b, 0x80, 0xc7, 0x24, 0x2d, 0x4f, 0xb9, 0x83, 0x77, 0x60, 0x93, 0x99, 0x44, 0xf7, 0x5c,
+	0x50, 0x0f, 0xde, 0x6f, 0xb7, 0xa7, 0x9b, 0x97, 0x97, 0x97, 0x97, 0x96, 0xfb, 0x46, 0x73, 0x3c,
+	0xf2, 0x5b, 0x3b, 0x52, 0x6e, 0xed, 0x18, 0x6c, 0xcf, 0x0f, 0xa7, 0x59, 0x0f, 0xc6, 0xbe, 0x07,
+	0xdf, 0x81, 0x95, 0x49, 0x36, 0x65, 0xad, 0x70, 0x12, 0x1d, 0xc2, 0xca, 0xd0, 0x8d, 0x8c, 0x58,
+	0x56, 0xe0, 0x89, 0x69, 0xee, 0xa7, 0x9a, 0x63, 0x58, 0xb9, 0x73, 0xec, 0x40, 0xf3, 0x51, 0x14,
+	0x4f, 0x78, 0xa9, 0x68, 0x7b, 0x7c, 0x50, 0xa3, 0xfc, 0x99, 0xaa, 0xbc, 0xb2, 0xbc, 0x54, 0xfe,
+	0x37, 0x64, 0x38, 0xed, 0xda, 0x3a, 0x7e, 0x08, 0x1b, 0xd5, 0x86, 0x03, 0xd5, 0x77, 0x0f, 0xe5,
+	0x19, 0x83, 0xa1, 0x11, 0xf4, 0x73, 0xb6, 0xd6, 0x6d, 0xd5, 0x63, 0x25, 0x54, 0x12, 0xf8, 0x5c,
+	0x9b, 0x8a, 0x74, 0xa8, 0x07, 0x0f, 0x8c, 0x0a, 0x5f, 0xa8, 0xe0, 0x35, 0xcb, 0x49, 0x75, 0xff,
+	0x44, 0xf5, 0x19, 0xae, 0xb6, 0x30, 0x6b, 0xdd, 0x66, 0x5d, 0xd3, 0x6d, 0x4f, 0x8c, 0x56, 0x04,
+	0xcc, 0x0a, 0x57, 0x75, 0x9b, 0x1e, 0xa4, 0x34, 0xe7, 0x77, 0xa8, 0x2e, 0x1d, 0xd7, 0x1a, 0x23,
+	0x3c, 0x6c, 0x29, 0x1e, 0x1e, 0x19, 0xb1, 0xfd, 0x80, 0x61, 0xeb, 0x49, 0x0f, 0xbf, 0x0d, 0xd9,
+	0x1f, 0xd1, 0xdb, 0x0b, 0xc1, 0xb5, 0xf1, 0x3d, 0x35, 0xe2, 0x7b, 0xc9, 0xf0, 0xbd, 0xc7, 0x89,
+	0x6f, 0xd3, 0x2b, 0x51, 0xfe, 0x1b, 0xd5, 0x17, 0xa2, 0xeb, 0x22, 0xa4, 0x3d, 0xcf, 0x09, 0x79,
+	0xcd, 0xc8, 0xd9, 0x83, 0x40, 0x36, 0x2c, 0x34, 0x8b, 0x76, 0xa9, 0x81, 0x55, 0x9b, 0xbf, 0x66,
+	0xb1, 0x21, 0xad, 0x89, 0x97, 0x99, 0x1a, 0x2f, 0x75, 0x56, 0x48, 0x7b, 0xff, 0x8a, 0x8c, 0x65,
+	0xb5, 0xd6, 0xd4, 0x3d, 0x68, 0x15, 0x1e, 0x26, 0xb2, 0x11, 0xbd, 0x0c, 0xd1, 0x86, 0x2e, 0x49,
+	0xfd, 0xf9, 0x22, 0x6b, 0xf2, 0x24, 0x61, 0xf0, 0xc8, 0x08, 0x7d, 0xce, 0xa0, 0xdf, 0x51, 0x43,
+	0xbd, 0x02, 0x48, 0xa2, 0xfe, 0x1c, 0x19, 0xeb, 0xfd, 0x3b, 0xa1, 0x76, 0x61, 0xb5, 0xf0, 0x62,
+	0xc5, 0x5f, 0xdc, 0x0a, 0xb4, 0x1a, 0xec, 0xa1, 0x8a, 0xdd, 0x00, 0x4b, 0x62, 0xff, 0x0b, 0xaa,
+	0xbf, 0x8e, 0x5c, 0x3b, 0xc2, 0xf2, 0xd6, 0xad, 0xa1, 0xb4, 0x6e, 0x35, 0x51, 0x12, 0x55, 0xb3,
+	0x8a, 0x1e, 0x49, 0x35, 0xab, 0x7c, 0x39, 0x88, 0x6b, 0xb2, 0xca, 0xa2, 0x9c, 0x55, 0xde, 0x86,
+	0xec, 0x37, 0x48, 0x73, 0x35, 0xfb, 0xdf, 0x5a, 0xd5, 0x9a, 0xe2, 0xfb, 0xc3, 0x6a, 0xe5, 0x57,
+	0xd4, 0x4a, 0x54, 0xa4, 0x72, 0x31, 0xd4, 0xd6, 0xaf, 0x6f, 0x19, 0x15, 0xc5, 0x4c, 0xd1, 0xae,
+	0xf4, 0x83, 0x56, 0xcd, 0x1b, 0xcd, 0x55, 0xf3, 0xaa, 0xb6, 0xd7, 0x58, 0x99, 0xa8, 0x56, 0x56,
+	0x14, 0x48, 0xf5, 0x7f, 0x46, 0xda, 0x3b, 0x2d, 0x0d, 0x07, 0x2a, 0x1f, 0x4a, 0x14, 0xf9, 0xb8,
+	0x10, 0x2a, 0x56, 0x5d, 0xe7, 0xdc, 0x28, 0xb5, 0x59, 0x35, 0xc5, 0x3e, 0x55, 0x8b, 0xbd, 0x06,
+	0x90, 0x44, 0x1c, 0x95, 0xef, 0xda, 0xf8, 0x80, 0x3f, 0xcd, 0x33, 0x9c, 0xdd, 0x01, 0xc8, 0xf7,
+	0x71, 0x8f, 0xd1, 0x07, 0xdf, 0x34, 0x6a, 0x5d, 0xf6, 0x90, 0xf2, 0xe8, 0x56, 0x58, 0x55, 0x2a,
+	0xfc, 0x2d, 0x32, 0xdf, 0xe4, 0x6b, 0xfd, 0x94, 0x47, 0xa6, 0xa5, 0x46, 0xe6, 0x63, 0x23, 0x9a,
+	0x57, 0x0c, 0xcd, 0x41, 0x8e, 0x46, 0xab, 0x51, 0xe2, 0xba, 0xd0, 0xb4, 0x10, 0x57, 0x79, 0xdf,
+	0xae, 0x89, 0x9a, 0xd7, 0xd5, 0xa8, 0xd1, 0x5e, 0x4c, 0xff, 0x83, 0x6a, 0xfa, 0x14, 0xe3, 0xab,
+	0xaa, 0x29, 0x66, 0xfa, 0xd5, 0x1b, 0x18, 0x4f, 0x83, 0x65, 0x72, 0xfe, 0xd4, 0x66, 0xd7, 0x3c,
+	0xb5, 0x35, 0xab, 0x4f, 0x6d, 0x83, 0x23, 0xa3, 0xc5, 0x17, 0xcc, 0xe2, 0xaf, 0x14, 0x6a, 0x56,
+	0xd5, 0x24, 0x69, 0xf9, 0x17, 0xc8, 0xd8, 0x82, 0xfd, 0xff, 0xec, 0xae, 0xa9, 0x5b, 0x3f, 0x2a,
+	0xd4, 0x2d, 0x3d, 0xb0, 0x42, 0xc8, 0x54, 0x5a, 0xc4, 0x3c, 0x64, 0x90, 0x0c, 0x99, 0xfb, 0xd3,
+	0x69, 0x2c, 0x42, 0x86, 0x7e, 0xd7, 0x84, 0xcc, 0xa7, 0x6a, 0xc8, 0x54, 0x16, 0x97, 0xaa, 0xff,
+	0x84, 0x0c, 0x7d, 0x28, 0x75, 0xd1, 0xd1, 0xd9, 0xd9, 0x29, 0xd3, 0x99, 0x1d, 0x21, 0x31, 0xce,
+	0x7e, 0xc5, 0x28, 0x70, 0xc4, 0x30, 0x6f, 0xf7, 0x1a, 0x4a, 0xbb, 0x67, 0x6e, 0x5e, 0x7e, 0x5c,
+	0x6d, 0x5e, 0x4a, 0x30, 0x0a, 0xe5, 0x48, 0xdf, 0x16, 0xbf, 0x1b, 0xd2, 0x1a, 0x54, 0x6f, 0xf4,
+	0x2d, 0x95, 0x16, 0xd5, 0x67, 0xc8, 0xd0, 0x91, 0x5f, 0xff, 0x97, 0x96, 0xa5, 0xfc, 0xd2, 0xaa,
+	0x41, 0xf7, 0x13, 0x15, 0x9d, 0x56, 0xb5, 0xda, 0xf0, 0xe9, 0xdf, 0x04, 0xca, 0xe0, 0x6a, 0xd4,
+	0xfd, 0x54, 0x55, 0xa7, 0x5d, 0x4c, 0xaa, 0x0b, 0x0d, 0xef, 0x0c, 0x15, 0x75, 0x0f, 0x8d, 0xea,
+	0x2e, 0x51, 0x55, 0x9f, 0xd1, 0xbc, 0x47, 0xf4, 0x2a, 0x9f, 0x2c, 0xa2, 0x30, 0x21, 0x54, 0xc5,
+	0xd3, 0x27, 0x4c, 0x45, 0xdb, 0xb3, 0x9e, 0x3e, 0xa1, 0x59, 0xfe, 0x61, 0x1c, 0x47, 0x31, 0x6b,
+	0xb6, 0x3b, 0x1e, 0x1f, 0xc8, 0x5f, 0xc2, 0x0d, 0x76, 0xae, 0xf8, 0xc0, 0xfd, 0x03, 0xd2, 0xbd,
+	0x82, 0x7c, 0x89, 0x27, 0xc0, 0x5c, 0x60, 0x7f, 0xc6, 0xed, 0x75, 0xf2, 0xea, 0x62, 0x74, 0xee,
+	0xb4, 0xfa, 0x22, 0x53, 0xf1, 0xab, 0x39, 0x1f, 0xfc, 0x9c, 0xeb, 0xd9, 0x53, 0x32, 0x92, 0xb2,
+	0x50, 0xae, 0xe5, 0xbf, 0x03, 0x00, 0xa9, 0x5d, 0x2e, 0xb2, 0x6c, 0x1f, 0x00, 0x00,
 }
@@ -87,7 +87,7 @@ func (u *UserInfo) AuthorizeQuery(database string, query *influxql.Query) error
 			if db == "" {
 				db = database
 			}
-			if !u.AuthorizeDatabase(p.Privilege, db) {
+			if !u.AuthorizeDatabase(p.Privilege, db) && !u.authorizeStatementMeasurements(stmt, database) {
 				return &ErrAuthorize{
 					Query:    query,
 					User:     u.Name,
@@ -100,6 +100,69 @@ func (u *UserInfo) AuthorizeQuery(database string, query *influxql.Query) error
 	return nil
 }
 
+// authorizeStatementMeasurements returns true if the user holds privileges
+// on every measurement accessed by stmt, including the sources of any
+// subqueries and the target of an INTO clause. Sources given as a regex only
+// require a privilege on some measurement of the database, as the series
+// read are limited by AuthorizeSeriesRead. The SHOW statements which are
+// limited the same way only require a read privilege on some measurement.
+func (u *UserInfo) authorizeStatementMeasurements(stmt influxql.Statement, database string) bool {
+	if len(u.MeasurementPrivileges) == 0 {
+		return false
+	}
+
+	switch stmt := stmt.(type) {
+	case *influxql.SelectStatement:
+		if stmt.Target != nil && !u.authorizeMeasurement(influxql.WritePrivilege, stmt.Target.Measurement, database) {
+			return false
+		}
+		return u.authorizeSources(stmt.Sources, database)
+	case *influxql.ShowMeasurementsStatement:
+		return u.HasMeasurementPrivilege(influxql.ReadPrivilege, defaultDatabase(stmt.Database, database))
+	case *influxql.ShowSeriesStatement:
+		return u.HasMeasurementPrivilege(influxql.ReadPrivilege, defaultDatabase(stmt.Database, database))
+	case *influxql.ShowTagKeysStatement:
+		return u.HasMeasurementPrivilege(influxql.ReadPrivilege, defaultDatabase(stmt.Database, database))
+	case *influxql.ShowTagValuesStatement:
+		return u.HasMeasurementPrivilege(influxql.ReadPrivilege, defaultDatabase(stmt.Database, database))
+	}
+	return false
+}
+
+// authorizeSources returns true if the user may read all of the sources.
+func (u *UserInfo) authorizeSources(sources influxql.Sources, database string) bool {
+	for _, source := range sources {
+		switch source := source.(type) {
+		case *influxql.Measurement:
+			if !u.authorizeMeasurement(influxql.ReadPrivilege, source, database) {
+				return false
+			}
+		case *influxql.SubQuery:
+			if !u.authorizeSources(source.Statement.Sources, database) {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+func (u *UserInfo) authorizeMeasurement(p influxql.Privilege, m *influxql.Measurement, database string) bool {
+	db := defaultDatabase(m.Database, database)
+	if m.Regex != nil {
+		return u.AuthorizeDatabase(p, db) || u.HasMeasurementPrivilege(p, db)
+	}
+	return u.AuthorizeMeasurement(p, db, []byte(m.Name))
+}
+
+func defaultDatabase(db, database string) string {
+	if db == "" {
+		return database
+	}
+	return db
+}
+
 // ErrAuthorize represents an authorization error.
 type ErrAuthorize struct {
 	Query    *influxql.Query
@@ -3,6 +3,7 @@ package meta
 import (
 	"fmt"
 
+	"github.com/influxdata/influxdb/models"
 	"github.com/influxdata/influxql"
 )
 
@@ -27,3 +28,40 @@ func (a WriteAuthorizer) AuthorizeWrite(username, database string) error {
 	}
 	return nil
 }
+
+// AuthorizeUserWrite returns nil if u has permission to write to the
+// database. Unlike AuthorizeWrite, the restrictions of the token used to
+// authenticate u, if any, are also enforced, and users with write privileges
+// on only some measurements of the database are permitted. The points
+// written by such users must be checked with AuthorizeWritePoints.
+func (a WriteAuthorizer) AuthorizeUserWrite(u User, database string) error {
+	if tu, ok := u.(*TokenUser); ok && !tu.Token.Authorize(influxql.WritePrivilege, database) {
+		return &ErrAuthorize{
+			Database: database,
+			Message:  fmt.Sprintf("token %s not authorized to write to %s", tu.Token.ID, database),
+		}
+	}
+
+	if ui, err := a.Client.User(u.ID()); err == nil && ui.(*UserInfo).HasMeasurementPrivilege(influxql.WritePrivilege, database) {
+		return nil
+	}
+	return a.AuthorizeWrite(u.ID(), database)
+}
+
+// AuthorizeWritePoints returns nil if u has permission to write all of the
+// points to the database.
+func (a WriteAuthorizer) AuthorizeWritePoints(u User, database string, points []models.Point) error {
+	if u.AuthorizeDatabase(influxql.WritePrivilege, database) {
+		return nil
+	}
+
+	for _, p := range points {
+		if !u.AuthorizeSeriesWrite(database, p.Name(), p.Tags()) {
+			return &ErrAuthorize{
+				Database: database,
+				Message:  fmt.Sprintf("%s not authorized to write to measurement %s in %s", u.ID(), p.Name(), database),
+			}
+		}
+	}
+	return nil
+}
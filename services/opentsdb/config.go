@@ -5,6 +5,7 @@ import (
 
 	"github.com/influxdata/influxdb/monitor/diagnostics"
 	"github.com/influxdata/influxdb/toml"
+	"github.com/influxdata/influxdb/tsdb"
 )
 
 const (
@@ -46,6 +47,13 @@ type Config struct {
 	BatchPending     int           `toml:"batch-pending"`
 	BatchTimeout     toml.Duration `toml:"batch-timeout"`
 	LogPointErrors   bool          `toml:"log-point-errors"`
+
+	// SpoolDir is the directory of an on-disk spool which holds telnet batches
+	// until they are written.  If empty, batches are dropped if they cannot be
+	// written.
+	SpoolDir     string    `toml:"spool-dir"`
+	SpoolMaxSize toml.Size `toml:"spool-max-size"`
+	SpoolPolicy  string    `toml:"spool-policy"`
 }
 
 // NewConfig returns a new config for the service.
@@ -92,6 +100,12 @@ func (c *Config) WithDefaults() *Config {
 	if d.BatchTimeout == 0 {
 		d.BatchTimeout = toml.Duration(DefaultBatchTimeout)
 	}
+	if d.SpoolMaxSize == 0 {
+		d.SpoolMaxSize = tsdb.DefaultSpoolMaxSize
+	}
+	if d.SpoolPolicy == "" {
+		d.SpoolPolicy = tsdb.SpoolPolicyDropNewest
+	}
 
 	return &d
 }
@@ -102,7 +116,7 @@ type Configs []Config
 // Diagnostics returns one set of diagnostics for all of the Configs.
 func (c Configs) Diagnostics() (*diagnostics.Diagnostics, error) {
 	d := &diagnostics.Diagnostics{
-		Columns: []string{"enabled", "bind-address", "database", "retention-policy", "batch-size", "batch-pending", "batch-timeout"},
+		Columns: []string{"enabled", "bind-address", "database", "retention-policy", "batch-size", "batch-pending", "batch-timeout", "spool-dir"},
 	}
 
 	for _, cc := range c {
@@ -111,7 +125,7 @@ func (c Configs) Diagnostics() (*diagnostics.Diagnostics, error) {
 			continue
 		}
 
-		r := []interface{}{true, cc.BindAddress, cc.Database, cc.RetentionPolicy, cc.BatchSize, cc.BatchPending, cc.BatchTimeout}
+		r := []interface{}{true, cc.BindAddress, cc.Database, cc.RetentionPolicy, cc.BatchSize, cc.BatchPending, cc.BatchTimeout, cc.SpoolDir}
 		d.AddRow(r)
 	}
 
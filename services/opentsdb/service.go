@@ -72,6 +72,12 @@ type Service struct {
 	batchTimeout time.Duration
 	batcher      *tsdb.PointBatcher
 
+	// Batches are held in an optional spool until they are written.
+	spoolDir     string
+	spoolMaxSize int64
+	spoolPolicy  string
+	spool        *tsdb.PointSpool
+
 	LogPointErrors bool
 	Logger         *zap.Logger
 
@@ -93,6 +99,9 @@ func NewService(c Config) (*Service, error) {
 		batchSize:       d.BatchSize,
 		batchPending:    d.BatchPending,
 		batchTimeout:    time.Duration(d.BatchTimeout),
+		spoolDir:        d.SpoolDir,
+		spoolMaxSize:    int64(d.SpoolMaxSize),
+		spoolPolicy:     d.SpoolPolicy,
 		Logger:          zap.NewNop(),
 		LogPointErrors:  d.LogPointErrors,
 		stats:           &Statistics{},
@@ -113,6 +122,13 @@ func (s *Service) Open() error {
 
 	s.Logger.Info("Starting OpenTSDB service")
 
+	if s.spoolDir != "" {
+		s.spool = tsdb.NewPointSpool(s.spoolDir, s.spoolMaxSize, s.spoolPolicy)
+		if err := s.spool.Open(); err != nil {
+			return err
+		}
+	}
+
 	s.batcher = tsdb.NewPointBatcher(s.batchSize, s.batchPending, s.batchTimeout)
 	s.batcher.Start()
 
@@ -187,7 +203,11 @@ func (s *Service) Close() error {
 	s.wg.Wait()
 
 	s.mu.Lock()
+	if s.spool != nil {
+		s.spool.Close()
+	}
 	s.done = nil
+	s.spool = nil
 	s.mu.Unlock()
 
 	return nil
@@ -257,7 +277,7 @@ type Statistics struct {
 
 // Statistics returns statistics for periodic monitoring.
 func (s *Service) Statistics(tags map[string]string) []models.Statistic {
-	return []models.Statistic{{
+	statistics := []models.Statistic{{
 		Name: "opentsdb",
 		Tags: s.defaultTags.Merge(tags),
 		Values: map[string]interface{}{
@@ -279,6 +299,16 @@ func (s *Service) Statistics(tags map[string]string) []models.Statistic {
 			statDroppedPointsInvalid:     atomic.LoadInt64(&s.stats.InvalidDroppedPoints),
 		},
 	}}
+
+	s.mu.RLock()
+	spool := s.spool
+	s.mu.RUnlock()
+	if spool != nil {
+		spoolTags := s.defaultTags.Merge(tags)
+		spoolTags["service"] = "opentsdb"
+		statistics = append(statistics, spool.Statistics(spoolTags)...)
+	}
+	return statistics
 }
 
 // Addr returns the listener's address. Returns nil if listener is closed.
@@ -458,11 +488,28 @@ func (s *Service) serveHTTP() {
 
 // processBatches continually drains the given batcher and writes the batches to the database.
 func (s *Service) processBatches(batcher *tsdb.PointBatcher) {
+	var retry <-chan time.Time
+	if s.spool != nil {
+		ticker := time.NewTicker(tsdb.SpoolRetryInterval)
+		defer ticker.Stop()
+		retry = ticker.C
+	}
+
 	for {
 		select {
 		case <-s.done:
 			return
+		case <-retry:
+			s.writeSpooled()
 		case batch := <-batcher.Out():
+			if s.spool != nil {
+				if err := s.spool.Append(batch); err != nil {
+					s.Logger.Info("Failed to spool point batch", zap.Error(err))
+				}
+				s.writeSpooled()
+				continue
+			}
+
 			// Will attempt to create database if not yet created.
 			if err := s.createInternalStorage(); err != nil {
 				s.Logger.Info("Required database does not yet exist", logger.Database(s.Database), zap.Error(err))
@@ -480,3 +527,18 @@ func (s *Service) processBatches(batcher *tsdb.PointBatcher) {
 		}
 	}
 }
+
+// writeSpooled writes the batches held by the spool.  Batches which cannot be
+// written remain in the spool and are retried later, unless the database
+// rejects them.
+func (s *Service) writeSpooled() {
+	batchN, pointN, failN := s.spool.WriteSpooled(func(batch []models.Point) error {
+		if err := s.createInternalStorage(); err != nil {
+			return err
+		}
+		return s.PointsWriter.WritePointsPrivileged(s.Database, s.RetentionPolicy, models.ConsistencyLevelAny, batch)
+	}, s.Logger, s.Database)
+	atomic.AddInt64(&s.stats.BatchesTransmitted, batchN)
+	atomic.AddInt64(&s.stats.PointsTransmitted, pointN)
+	atomic.AddInt64(&s.stats.BatchesTransmitFail, failN)
+}
@@ -0,0 +1,120 @@
+package scraper
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/influxdata/influxdb/monitor/diagnostics"
+	"github.com/influxdata/influxdb/prometheus"
+	"github.com/influxdata/influxdb/toml"
+)
+
+const (
+	// DefaultDatabase is the default database scraped samples are written to.
+	DefaultDatabase = "prometheus"
+
+	// DefaultRetentionPolicy is the default retention policy used for writes.
+	DefaultRetentionPolicy = ""
+
+	// DefaultScrapeInterval is the default interval between two scrapes of a target.
+	DefaultScrapeInterval = 15 * time.Second
+
+	// DefaultScrapeTimeout is the default time a scrape of a target may take.
+	DefaultScrapeTimeout = 10 * time.Second
+
+	// DefaultMetricMapping is the default mapping of scraped metrics to measurements.
+	DefaultMetricMapping = prometheus.MetricMappingFlat
+
+	// DefaultMaxBodySize is the default maximum size of a scraped response body in bytes.
+	DefaultMaxBodySize = 10000000
+)
+
+// Config represents the configuration for the scraper service.
+type Config struct {
+	Enabled         bool          `toml:"enabled"`
+	Database        string        `toml:"database"`
+	RetentionPolicy string        `toml:"retention-policy"`
+	ScrapeInterval  toml.Duration `toml:"scrape-interval"`
+	ScrapeTimeout   toml.Duration `toml:"scrape-timeout"`
+	MetricMapping   string        `toml:"metric-mapping"`
+	MaxBodySize     int           `toml:"max-body-size"`
+
+	// Targets are the URLs of the Prometheus metrics endpoints to scrape.
+	Targets []string `toml:"targets"`
+}
+
+// NewConfig returns a new Config with defaults.
+func NewConfig() Config {
+	return Config{
+		Enabled:         false,
+		Database:        DefaultDatabase,
+		RetentionPolicy: DefaultRetentionPolicy,
+		ScrapeInterval:  toml.Duration(DefaultScrapeInterval),
+		ScrapeTimeout:   toml.Duration(DefaultScrapeTimeout),
+		MetricMapping:   DefaultMetricMapping,
+		MaxBodySize:     DefaultMaxBodySize,
+	}
+}
+
+// Validate returns an error if the Config is invalid.
+func (c Config) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	if c.Database == "" {
+		return errors.New("database must be specified")
+	}
+	if c.ScrapeInterval <= 0 {
+		return errors.New("scrape-interval must be positive")
+	}
+	if c.ScrapeTimeout <= 0 {
+		return errors.New("scrape-timeout must be positive")
+	}
+	if c.ScrapeTimeout > c.ScrapeInterval {
+		return errors.New("scrape-timeout must not be greater than scrape-interval")
+	}
+	if err := prometheus.ValidateMetricMapping(c.MetricMapping); err != nil {
+		return err
+	}
+	if c.MaxBodySize < 0 {
+		return errors.New("max-body-size must not be negative")
+	}
+
+	for _, target := range c.Targets {
+		u, err := url.Parse(target)
+		if err != nil {
+			return fmt.Errorf("invalid target %q: %s", target, err)
+		}
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return fmt.Errorf("invalid target %q: scheme must be http or https", target)
+		}
+		if u.Host == "" {
+			return fmt.Errorf("invalid target %q: missing host", target)
+		}
+	}
+
+	return nil
+}
+
+// Diagnostics returns a diagnostics representation of a subset of the Config.
+func (c Config) Diagnostics() (*diagnostics.Diagnostics, error) {
+	if !c.Enabled {
+		return diagnostics.RowFromMap(map[string]interface{}{
+			"enabled": false,
+		}), nil
+	}
+
+	return diagnostics.RowFromMap(map[string]interface{}{
+		"enabled":          true,
+		"database":         c.Database,
+		"retention-policy": c.RetentionPolicy,
+		"scrape-interval":  c.ScrapeInterval,
+		"scrape-timeout":   c.ScrapeTimeout,
+		"metric-mapping":   c.MetricMapping,
+		"max-body-size":    c.MaxBodySize,
+		"targets":          len(c.Targets),
+	}), nil
+}
@@ -0,0 +1,82 @@
+package scraper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"github.com/influxdata/influxdb/services/scraper"
+)
+
+func TestConfig_Parse(t *testing.T) {
+	// Parse configuration.
+	var c scraper.Config
+	if _, err := toml.Decode(`
+enabled = true
+database = "edge"
+retention-policy = "week"
+scrape-interval = "30s"
+scrape-timeout = "5s"
+metric-mapping = "family"
+max-body-size = 1000
+targets = ["http://localhost:9100/metrics", "https://10.0.0.1:9090/metrics"]
+`, &c); err != nil {
+		t.Fatal(err)
+	}
+
+	// Validate configuration.
+	if !c.Enabled {
+		t.Fatalf("unexpected enabled state: %v", c.Enabled)
+	} else if c.Database != "edge" {
+		t.Fatalf("unexpected database: %s", c.Database)
+	} else if c.RetentionPolicy != "week" {
+		t.Fatalf("unexpected retention policy: %s", c.RetentionPolicy)
+	} else if time.Duration(c.ScrapeInterval) != 30*time.Second {
+		t.Fatalf("unexpected scrape interval: %s", c.ScrapeInterval)
+	} else if time.Duration(c.ScrapeTimeout) != 5*time.Second {
+		t.Fatalf("unexpected scrape timeout: %s", c.ScrapeTimeout)
+	} else if c.MetricMapping != "family" {
+		t.Fatalf("unexpected metric mapping: %s", c.MetricMapping)
+	} else if c.MaxBodySize != 1000 {
+		t.Fatalf("unexpected max body size: %d", c.MaxBodySize)
+	} else if len(c.Targets) != 2 || c.Targets[1] != "https://10.0.0.1:9090/metrics" {
+		t.Fatalf("unexpected targets: %v", c.Targets)
+	}
+}
+
+func TestConfig_Validate(t *testing.T) {
+	c := scraper.NewConfig()
+	c.Enabled = true
+	c.Targets = []string{"http://localhost:9100/metrics"}
+	if err := c.Validate(); err != nil {
+		t.Fatalf("unexpected validation fail from NewConfig: %s", err)
+	}
+
+	for _, tt := range []struct {
+		name string
+		fn   func(c *scraper.Config)
+	}{
+		{name: "database", fn: func(c *scraper.Config) { c.Database = "" }},
+		{name: "scrape-interval", fn: func(c *scraper.Config) { c.ScrapeInterval = 0 }},
+		{name: "scrape-timeout", fn: func(c *scraper.Config) { c.ScrapeTimeout = c.ScrapeInterval + 1 }},
+		{name: "metric-mapping", fn: func(c *scraper.Config) { c.MetricMapping = "nested" }},
+		{name: "max-body-size", fn: func(c *scraper.Config) { c.MaxBodySize = -1 }},
+		{name: "scheme", fn: func(c *scraper.Config) { c.Targets = []string{"ftp://localhost/metrics"} }},
+		{name: "host", fn: func(c *scraper.Config) { c.Targets = []string{"http:///metrics"} }},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			c := scraper.NewConfig()
+			c.Enabled = true
+			tt.fn(&c)
+			if err := c.Validate(); err == nil {
+				t.Fatal("expected error, got nil")
+			}
+
+			// A disabled service is not validated.
+			c.Enabled = false
+			if err := c.Validate(); err != nil {
+				t.Fatalf("unexpected validation fail: %s", err)
+			}
+		})
+	}
+}
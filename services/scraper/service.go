@@ -0,0 +1,431 @@
+// Package scraper provides a service for InfluxDB to scrape Prometheus metrics endpoints.
+package scraper // import "github.com/influxdata/influxdb/services/scraper"
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/influxdata/influxdb/logger"
+	"github.com/influxdata/influxdb/models"
+	"github.com/influxdata/influxdb/prometheus"
+	"github.com/influxdata/influxdb/prometheus/remote"
+	"github.com/influxdata/influxdb/services/meta"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	"go.uber.org/zap"
+)
+
+// acceptHeader is the Accept header sent with scrapes. Only the text exposition format is
+// supported.
+const acceptHeader = "text/plain;version=0.0.4;q=1,*/*;q=0.1"
+
+// Labels identifying the target of the scraped series.
+const (
+	instanceLabel         = "instance"
+	exportedInstanceLabel = "exported_instance"
+)
+
+// Metrics describing the health of a scrape.
+const (
+	scrapeHealthMetric   = "up"
+	scrapeDurationMetric = "scrape_duration_seconds"
+	scrapeSamplesMetric  = "scrape_samples_scraped"
+)
+
+// scrapeTimeoutHeader tells the target how long the scrape may take.
+const scrapeTimeoutHeader = "X-Prometheus-Scrape-Timeout-Seconds"
+
+// statistics gathered by the scraper service.
+const (
+	statScrapesOK            = "scrapesOk"
+	statScrapesFail          = "scrapesFail"
+	statSamplesReceived      = "samplesRx"
+	statSamplesDropped       = "samplesDropped"
+	statPointsTransmitted    = "pointsTx"
+	statPointsTransmitFail   = "pointsTxFail"
+	statLastScrapeDurationNs = "lastScrapeDurationNs"
+)
+
+// target is a metrics endpoint scraped by the service.
+type target struct {
+	url      string
+	instance string
+	stats    *Statistics
+}
+
+// Service represents a service scraping Prometheus metrics endpoints.
+type Service struct {
+	database        string
+	retentionPolicy string
+	interval        time.Duration
+	timeout         time.Duration
+	mapping         string
+	maxBodySize     int64
+	targets         []*target
+
+	client *http.Client
+	logger *zap.Logger
+
+	wg sync.WaitGroup
+
+	mu     sync.RWMutex
+	ready  bool               // Has the required database been created?
+	cancel context.CancelFunc // Cancels the scrapes of an open service.
+
+	PointsWriter interface {
+		WritePointsPrivileged(database, retentionPolicy string, consistencyLevel models.ConsistencyLevel, points []models.Point) error
+	}
+	MetaClient interface {
+		CreateDatabaseWithRetentionPolicy(name string, spec *meta.RetentionPolicySpec) (*meta.DatabaseInfo, error)
+		CreateRetentionPolicy(database string, spec *meta.RetentionPolicySpec, makeDefault bool) (*meta.RetentionPolicyInfo, error)
+		Database(name string) *meta.DatabaseInfo
+		RetentionPolicy(database, name string) (*meta.RetentionPolicyInfo, error)
+	}
+}
+
+// NewService returns an instance of the scraper service.
+func NewService(c Config) (*Service, error) {
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+
+	s := &Service{
+		database:        c.Database,
+		retentionPolicy: c.RetentionPolicy,
+		interval:        time.Duration(c.ScrapeInterval),
+		timeout:         time.Duration(c.ScrapeTimeout),
+		mapping:         c.MetricMapping,
+		maxBodySize:     int64(c.MaxBodySize),
+		client:          &http.Client{Timeout: time.Duration(c.ScrapeTimeout)},
+		logger:          zap.NewNop(),
+	}
+	for _, t := range c.Targets {
+		u, err := url.Parse(t)
+		if err != nil {
+			return nil, err
+		}
+		s.targets = append(s.targets, &target{url: t, instance: u.Host, stats: &Statistics{}})
+	}
+	return s, nil
+}
+
+// Open starts scraping the targets.
+func (s *Service) Open() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.cancel != nil {
+		return nil // Already open.
+	}
+
+	s.logger.Info("Starting scraper service",
+		zap.Int("targets", len(s.targets)),
+		logger.DurationLiteral("scrape_interval", s.interval))
+
+	var ctx context.Context
+	ctx, s.cancel = context.WithCancel(context.Background())
+	for _, t := range s.targets {
+		s.wg.Add(1)
+		go s.runScrapes(ctx, t)
+	}
+	return nil
+}
+
+// Close stops scraping the targets and waits for running scrapes to finish.
+func (s *Service) Close() error {
+	s.mu.Lock()
+	cancel := s.cancel
+	s.cancel = nil
+	s.mu.Unlock()
+
+	if cancel == nil {
+		return nil // Already closed.
+	}
+	cancel()
+	s.wg.Wait()
+	return nil
+}
+
+// runScrapes scrapes a target once every interval until ctx is canceled.
+func (s *Service) runScrapes(ctx context.Context, t *target) {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		s.scrapeAndWrite(ctx, t, time.Now())
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// scrapeAndWrite scrapes a target and writes its samples, along with points for the health of
+// the scrape, to the database.
+func (s *Service) scrapeAndWrite(ctx context.Context, t *target, now time.Time) {
+	timestampMs := now.UnixNano() / int64(time.Millisecond)
+
+	series, n, err := s.scrape(ctx, t, timestampMs)
+	duration := time.Since(now)
+	atomic.StoreInt64(&t.stats.LastScrapeDurationNs, int64(duration))
+	if err != nil {
+		if ctx.Err() != nil {
+			return // The service is closing.
+		}
+		atomic.AddInt64(&t.stats.ScrapesFail, 1)
+		s.logger.Info("Failed to scrape target", zap.String("target", t.url), zap.Error(err))
+	} else {
+		atomic.AddInt64(&t.stats.ScrapesOK, 1)
+		atomic.AddInt64(&t.stats.SamplesReceived, int64(n))
+		atomic.AddInt64(&t.stats.SamplesDropped, int64(n-len(series)))
+	}
+
+	up := 1.0
+	if err != nil {
+		up = 0
+	}
+	series = append(series,
+		healthSeries(scrapeHealthMetric, t.instance, timestampMs, up),
+		healthSeries(scrapeDurationMetric, t.instance, timestampMs, duration.Seconds()),
+		healthSeries(scrapeSamplesMetric, t.instance, timestampMs, float64(n)),
+	)
+
+	points, err := prometheus.WriteRequestToPointsWithMapping(&remote.WriteRequest{Timeseries: series}, s.mapping)
+	if err != nil && err != prometheus.ErrNaNDropped {
+		atomic.AddInt64(&t.stats.PointsTransmitFail, int64(len(series)))
+		s.logger.Info("Failed to convert scraped samples", zap.String("target", t.url), zap.Error(err))
+		return
+	}
+
+	if err := s.createInternalStorage(); err != nil {
+		atomic.AddInt64(&t.stats.PointsTransmitFail, int64(len(points)))
+		s.logger.Info("Required database or retention policy do not yet exist",
+			logger.Database(s.database), logger.RetentionPolicy(s.retentionPolicy), zap.Error(err))
+		return
+	}
+
+	if err := s.PointsWriter.WritePointsPrivileged(s.database, s.retentionPolicy, models.ConsistencyLevelAny, points); err != nil {
+		atomic.AddInt64(&t.stats.PointsTransmitFail, int64(len(points)))
+		s.logger.Info("Failed to write scraped points", zap.String("target", t.url), zap.Error(err))
+		return
+	}
+	atomic.AddInt64(&t.stats.PointsTransmitted, int64(len(points)))
+}
+
+// scrape scrapes a target and returns a time series for each of its samples with a finite
+// value, along with the number of scraped samples. Samples without a timestamp are given the
+// timestamp of the scrape.
+func (s *Service) scrape(ctx context.Context, t *target, timestampMs int64) ([]*remote.TimeSeries, int, error) {
+	req, err := http.NewRequest("GET", t.url, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+	req.Header.Set("Accept", acceptHeader)
+	req.Header.Set(scrapeTimeoutHeader, strconv.FormatFloat(s.timeout.Seconds(), 'f', -1, 64))
+
+	resp, err := s.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, 0, fmt.Errorf("server returned HTTP status %s", resp.Status)
+	}
+
+	// Read one byte past the limit to tell a body of the maximum size from a
+	// larger one.
+	body := io.Reader(resp.Body)
+	if s.maxBodySize > 0 {
+		body = io.LimitReader(body, s.maxBodySize+1)
+	}
+	buf, err := ioutil.ReadAll(body)
+	if err != nil {
+		return nil, 0, err
+	} else if s.maxBodySize > 0 && int64(len(buf)) > s.maxBodySize {
+		return nil, 0, fmt.Errorf("body exceeds max-body-size of %d bytes", s.maxBodySize)
+	}
+
+	var parser expfmt.TextParser
+	families, err := parser.TextToMetricFamilies(bytes.NewReader(buf))
+	if err != nil {
+		return nil, 0, err
+	}
+	series, n := familiesToTimeSeries(families, t.instance, timestampMs)
+	return series, n, nil
+}
+
+// familiesToTimeSeries converts the metric families of a scrape into time series following the
+// naming of the Prometheus text exposition format. Summaries and histograms are split into
+// their quantile or bucket series and their _sum and _count series. Every series is labeled
+// with the instance of the target, and an instance label of a scraped metric is renamed to
+// exported_instance. Samples which are not finite are dropped, since they cannot be written to
+// InfluxDB. The number of samples, including the dropped ones, is returned as well.
+func familiesToTimeSeries(families map[string]*dto.MetricFamily, instance string, timestampMs int64) ([]*remote.TimeSeries, int) {
+	names := make([]string, 0, len(families))
+	for name := range families {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var series []*remote.TimeSeries
+	var n int
+	for _, name := range names {
+		mf := families[name]
+		for _, m := range mf.Metric {
+			ts := timestampMs
+			if m.TimestampMs != nil {
+				ts = m.GetTimestampMs()
+			}
+
+			add := func(name string, v float64, extra ...*remote.LabelPair) {
+				n++
+				if math.IsNaN(v) || math.IsInf(v, 0) {
+					return
+				}
+				series = append(series, &remote.TimeSeries{
+					Labels:  metricLabels(name, instance, m.Label, extra...),
+					Samples: []*remote.Sample{{TimestampMs: ts, Value: v}},
+				})
+			}
+
+			switch mf.GetType() {
+			case dto.MetricType_COUNTER:
+				add(name, m.GetCounter().GetValue())
+			case dto.MetricType_GAUGE:
+				add(name, m.GetGauge().GetValue())
+			case dto.MetricType_SUMMARY:
+				for _, q := range m.GetSummary().GetQuantile() {
+					add(name, q.GetValue(), &remote.LabelPair{Name: prometheus.QuantileLabel, Value: formatFloat(q.GetQuantile())})
+				}
+				add(name+prometheus.SumSuffix, m.GetSummary().GetSampleSum())
+				add(name+prometheus.CountSuffix, float64(m.GetSummary().GetSampleCount()))
+			case dto.MetricType_HISTOGRAM:
+				for _, b := range m.GetHistogram().GetBucket() {
+					add(name+prometheus.BucketSuffix, float64(b.GetCumulativeCount()), &remote.LabelPair{Name: prometheus.BucketLabel, Value: formatFloat(b.GetUpperBound())})
+				}
+				add(name+prometheus.SumSuffix, m.GetHistogram().GetSampleSum())
+				add(name+prometheus.CountSuffix, float64(m.GetHistogram().GetSampleCount()))
+			default:
+				add(name, m.GetUntyped().GetValue())
+			}
+		}
+	}
+	return series, n
+}
+
+// metricLabels returns the labels of a scraped series.
+func metricLabels(name, instance string, pairs []*dto.LabelPair, extra ...*remote.LabelPair) []*remote.LabelPair {
+	labels := make([]*remote.LabelPair, 0, len(pairs)+len(extra)+2)
+	labels = append(labels, &remote.LabelPair{Name: prometheus.MetricNameLabel, Value: name})
+	for _, p := range pairs {
+		l := &remote.LabelPair{Name: p.GetName(), Value: p.GetValue()}
+		if l.Name == instanceLabel {
+			l.Name = exportedInstanceLabel
+		}
+		labels = append(labels, l)
+	}
+	labels = append(labels, extra...)
+	return append(labels, &remote.LabelPair{Name: instanceLabel, Value: instance})
+}
+
+// healthSeries returns a series describing the health of a scrape.
+func healthSeries(name, instance string, timestampMs int64, v float64) *remote.TimeSeries {
+	return &remote.TimeSeries{
+		Labels:  metricLabels(name, instance, nil),
+		Samples: []*remote.Sample{{TimestampMs: timestampMs, Value: v}},
+	}
+}
+
+// formatFloat formats a bucket bound or quantile the way Prometheus does.
+func formatFloat(f float64) string {
+	switch {
+	case math.IsInf(f, 1):
+		return "+Inf"
+	case math.IsInf(f, -1):
+		return "-Inf"
+	default:
+		return strconv.FormatFloat(f, 'g', -1, 64)
+	}
+}
+
+// createInternalStorage ensures that the required database has been created.
+func (s *Service) createInternalStorage() error {
+	s.mu.RLock()
+	ready := s.ready
+	s.mu.RUnlock()
+	if ready {
+		return nil
+	}
+
+	if db := s.MetaClient.Database(s.database); db != nil {
+		if rp, _ := s.MetaClient.RetentionPolicy(s.database, s.retentionPolicy); rp == nil {
+			spec := meta.RetentionPolicySpec{Name: s.retentionPolicy}
+			if _, err := s.MetaClient.CreateRetentionPolicy(s.database, &spec, true); err != nil {
+				return err
+			}
+		}
+	} else {
+		spec := meta.RetentionPolicySpec{Name: s.retentionPolicy}
+		if _, err := s.MetaClient.CreateDatabaseWithRetentionPolicy(s.database, &spec); err != nil {
+			return err
+		}
+	}
+
+	// The service is now ready.
+	s.mu.Lock()
+	s.ready = true
+	s.mu.Unlock()
+	return nil
+}
+
+// WithLogger sets the logger on the service.
+func (s *Service) WithLogger(log *zap.Logger) {
+	s.logger = log.With(zap.String("service", "scraper"))
+}
+
+// Statistics maintains the statistics of a target of the scraper service.
+type Statistics struct {
+	ScrapesOK            int64
+	ScrapesFail          int64
+	SamplesReceived      int64
+	SamplesDropped       int64
+	PointsTransmitted    int64
+	PointsTransmitFail   int64
+	LastScrapeDurationNs int64
+}
+
+// Statistics returns statistics of each target for periodic monitoring.
+func (s *Service) Statistics(tags map[string]string) []models.Statistic {
+	statistics := make([]models.Statistic, 0, len(s.targets))
+	for _, t := range s.targets {
+		statistics = append(statistics, models.Statistic{
+			Name: "scraper",
+			Tags: models.StatisticTags{"target": t.url, "database": s.database}.Merge(tags),
+			Values: map[string]interface{}{
+				statScrapesOK:            atomic.LoadInt64(&t.stats.ScrapesOK),
+				statScrapesFail:          atomic.LoadInt64(&t.stats.ScrapesFail),
+				statSamplesReceived:      atomic.LoadInt64(&t.stats.SamplesReceived),
+				statSamplesDropped:       atomic.LoadInt64(&t.stats.SamplesDropped),
+				statPointsTransmitted:    atomic.LoadInt64(&t.stats.PointsTransmitted),
+				statPointsTransmitFail:   atomic.LoadInt64(&t.stats.PointsTransmitFail),
+				statLastScrapeDurationNs: atomic.LoadInt64(&t.stats.LastScrapeDurationNs),
+			},
+		})
+	}
+	return statistics
+}
@@ -0,0 +1,278 @@
+package scraper
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/influxdata/influxdb/internal"
+	"github.com/influxdata/influxdb/logger"
+	"github.com/influxdata/influxdb/models"
+	"github.com/influxdata/influxdb/services/meta"
+	"github.com/influxdata/influxdb/toml"
+)
+
+func Test_Service_OpenClose(t *testing.T) {
+	service := NewTestService(nil)
+
+	// Closing a closed service is fine.
+	if err := service.Service.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := service.Service.Open(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Opening an already open service is fine.
+	if err := service.Service.Open(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Reopening a previously opened service is fine.
+	if err := service.Service.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := service.Service.Open(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Tidy up.
+	if err := service.Service.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// Ensure scraped samples are written along with the health of the scrape.
+func TestService_Scrape(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get(scrapeTimeoutHeader) != "10" {
+			t.Errorf("unexpected scrape timeout header: %q", r.Header.Get(scrapeTimeoutHeader))
+		}
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+		fmt.Fprint(w, `# HELP http_requests_total The total number of HTTP requests.
+# TYPE http_requests_total counter
+http_requests_total{code="200",instance="a"} 1027 1395066363000
+# TYPE temperature gauge
+temperature +Inf
+# TYPE rpc_duration_seconds summary
+rpc_duration_seconds{quantile="0.5"} 0.05
+rpc_duration_seconds_sum 1.5
+rpc_duration_seconds_count 30
+# TYPE request_size histogram
+request_size_bucket{le="100"} 3
+request_size_bucket{le="+Inf"} 5
+request_size_sum 420
+request_size_count 5
+`)
+	}))
+	defer ts.Close()
+
+	c := NewConfig()
+	c.Targets = []string{ts.URL + "/metrics"}
+	s := NewTestService(&c)
+	instance := strings.TrimPrefix(ts.URL, "http://")
+
+	points := s.scrapeOnce(t)
+	if len(points) != 11 {
+		t.Fatalf("unexpected points: %v", points)
+	}
+
+	var got []string
+	for _, p := range points {
+		if string(p.Name()) != "_" {
+			t.Fatalf("unexpected measurement: %s", p.Name())
+		}
+		if name := p.Tags().GetString("__name__"); name == scrapeDurationMetric {
+			continue
+		} else if name == "http_requests_total" && p.UnixNano() != 1395066363000*int64(time.Millisecond) {
+			t.Fatalf("unexpected timestamp: %d", p.UnixNano())
+		}
+		line := p.String()
+		got = append(got, line[:strings.LastIndex(line, " ")])
+	}
+	sort.Strings(got)
+
+	exp := []string{
+		`_,__name__=http_requests_total,code=200,exported_instance=a,instance=` + instance + ` f64=1027`,
+		`_,__name__=request_size_bucket,instance=` + instance + `,le=+Inf f64=5`,
+		`_,__name__=request_size_bucket,instance=` + instance + `,le=100 f64=3`,
+		`_,__name__=request_size_count,instance=` + instance + ` f64=5`,
+		`_,__name__=request_size_sum,instance=` + instance + ` f64=420`,
+		`_,__name__=rpc_duration_seconds,instance=` + instance + `,quantile=0.5 f64=0.05`,
+		`_,__name__=rpc_duration_seconds_count,instance=` + instance + ` f64=30`,
+		`_,__name__=rpc_duration_seconds_sum,instance=` + instance + ` f64=1.5`,
+		`_,__name__=scrape_samples_scraped,instance=` + instance + ` f64=9`,
+		`_,__name__=up,instance=` + instance + ` f64=1`,
+	}
+	if !reflect.DeepEqual(got, exp) {
+		t.Fatalf("unexpected points\n\texp: %v\n\tgot: %v", exp, got)
+	}
+
+	stats := s.Service.Statistics(nil)
+	if len(stats) != 1 || stats[0].Tags["target"] != c.Targets[0] {
+		t.Fatalf("unexpected statistics: %v", stats)
+	}
+	for k, v := range map[string]int64{
+		statScrapesOK:          1,
+		statScrapesFail:        0,
+		statSamplesReceived:    9,
+		statSamplesDropped:     1,
+		statPointsTransmitted:  11,
+		statPointsTransmitFail: 0,
+	} {
+		if stats[0].Values[k] != v {
+			t.Errorf("unexpected %s: %v", k, stats[0].Values[k])
+		}
+	}
+}
+
+// Ensure a failed scrape is recorded as an unhealthy target.
+func TestService_Scrape_Fail(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.NotFoundHandler())
+	defer ts.Close()
+
+	c := NewConfig()
+	c.Targets = []string{ts.URL + "/metrics"}
+	s := NewTestService(&c)
+
+	points := s.scrapeOnce(t)
+	if len(points) != 3 {
+		t.Fatalf("unexpected points: %v", points)
+	}
+	for _, p := range points {
+		fields, err := p.Fields()
+		if err != nil {
+			t.Fatal(err)
+		}
+		switch p.Tags().GetString("__name__") {
+		case scrapeHealthMetric, scrapeSamplesMetric:
+			if fields["f64"] != float64(0) {
+				t.Fatalf("unexpected point: %s", p)
+			}
+		case scrapeDurationMetric:
+		default:
+			t.Fatalf("unexpected point: %s", p)
+		}
+	}
+
+	stats := s.Service.Statistics(nil)
+	if stats[0].Values[statScrapesFail] != int64(1) || stats[0].Values[statScrapesOK] != int64(0) {
+		t.Fatalf("unexpected statistics: %v", stats[0].Values)
+	}
+}
+
+// Ensure a scrape whose body exceeds the maximum size fails.
+func TestService_Scrape_MaxBodySize(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintln(w, "# TYPE temperature gauge")
+		fmt.Fprintln(w, "temperature 21.5")
+	}))
+	defer ts.Close()
+
+	c := NewConfig()
+	c.Targets = []string{ts.URL + "/metrics"}
+	c.MaxBodySize = 32
+	s := NewTestService(&c)
+
+	points := s.scrapeOnce(t)
+	for _, p := range points {
+		if p.Tags().GetString("__name__") == scrapeHealthMetric {
+			if fields, err := p.Fields(); err != nil {
+				t.Fatal(err)
+			} else if fields["f64"] != float64(0) {
+				t.Fatalf("unexpected point: %s", p)
+			}
+		}
+	}
+
+	stats := s.Service.Statistics(nil)
+	if stats[0].Values[statScrapesFail] != int64(1) || stats[0].Values[statScrapesOK] != int64(0) {
+		t.Fatalf("unexpected statistics: %v", stats[0].Values)
+	}
+}
+
+type TestService struct {
+	Service       *Service
+	MetaClient    *internal.MetaClientMock
+	WritePointsFn func(database, retentionPolicy string, consistencyLevel models.ConsistencyLevel, points []models.Point) error
+}
+
+func NewTestService(c *Config) *TestService {
+	if c == nil {
+		defaultC := NewConfig()
+		c = &defaultC
+	}
+	c.Enabled = true
+	c.ScrapeInterval = toml.Duration(time.Hour)
+
+	srv, err := NewService(*c)
+	if err != nil {
+		panic(err)
+	}
+
+	service := &TestService{
+		Service:    srv,
+		MetaClient: &internal.MetaClientMock{},
+	}
+
+	service.MetaClient.CreateDatabaseWithRetentionPolicyFn = func(string, *meta.RetentionPolicySpec) (*meta.DatabaseInfo, error) {
+		return nil, nil
+	}
+
+	service.MetaClient.DatabaseFn = func(string) *meta.DatabaseInfo {
+		return nil
+	}
+
+	if testing.Verbose() {
+		service.Service.WithLogger(logger.New(os.Stderr))
+	}
+
+	// Set the Meta Client and PointsWriter.
+	service.Service.MetaClient = service.MetaClient
+	service.Service.PointsWriter = service
+
+	return service
+}
+
+// scrapeOnce opens the service and returns the points written by the first scrape of its target.
+func (s *TestService) scrapeOnce(t *testing.T) []models.Point {
+	written := make(chan []models.Point, 1)
+	s.WritePointsFn = func(database, retentionPolicy string, _ models.ConsistencyLevel, points []models.Point) error {
+		if database != DefaultDatabase || retentionPolicy != DefaultRetentionPolicy {
+			t.Errorf("unexpected destination: %s.%s", database, retentionPolicy)
+		}
+		written <- points
+		return nil
+	}
+
+	if err := s.Service.Open(); err != nil {
+		t.Fatal(err)
+	}
+	defer s.Service.Close()
+
+	select {
+	case points := <-written:
+		return points
+	case <-time.After(5 * time.Second):
+		t.Fatal("target was not scraped")
+	}
+	return nil
+}
+
+func (s *TestService) WritePointsPrivileged(database, retentionPolicy string, consistencyLevel models.ConsistencyLevel, points []models.Point) error {
+	return s.WritePointsFn(database, retentionPolicy, consistencyLevel, points)
+}
@@ -160,53 +160,245 @@ func (c *floatMultiShardBatchCursor) nextBatchCursor() bool {
 	return ok
 }
 
-type floatSumBatchCursor struct {
+type floatWindowCursor struct {
 	tsdb.FloatBatchCursor
-	ts [1]int64
-	vs [1]float64
+	win window
+	ks  []int64
+	vs  []float64
+}
+
+// nextWindow calls fn with successive batches of points belonging to the next window
+// and returns the timestamp of the window. ok is false when the cursor is exhausted.
+func (c *floatWindowCursor) nextWindow(fn func(ks []int64, vs []float64)) (ts int64, ok bool) {
+	if len(c.ks) == 0 {
+		c.ks, c.vs = c.FloatBatchCursor.Next()
+		if len(c.ks) == 0 {
+			return 0, false
+		}
+	}
+
+	start := c.win.start(c.ks[0])
+	ts = c.win.timestamp(start, c.ks[0])
+	for len(c.ks) > 0 {
+		i := 0
+		for i < len(c.ks) && c.win.start(c.ks[i]) == start {
+			i++
+		}
+		fn(c.ks[:i], c.vs[:i])
+		if i < len(c.ks) {
+			c.ks, c.vs = c.ks[i:], c.vs[i:]
+			return ts, true
+		}
+		c.ks, c.vs = c.FloatBatchCursor.Next()
+	}
+
+	return ts, true
+}
+
+type floatSumBatchCursor struct {
+	floatWindowCursor
+	ts []int64
+	vs []float64
 }
 
 func (c *floatSumBatchCursor) Next() (key []int64, value []float64) {
-	ks, vs := c.FloatBatchCursor.Next()
-	if len(ks) == 0 {
-		return nil, nil
+	c.ts, c.vs = c.ts[:0], c.vs[:0]
+	for len(c.ts) < batchSize {
+		var acc float64
+		ts, ok := c.nextWindow(func(_ []int64, vs []float64) {
+			for _, v := range vs {
+				acc += v
+			}
+		})
+		if !ok {
+			break
+		}
+		c.ts = append(c.ts, ts)
+		c.vs = append(c.vs, acc)
 	}
+	return c.ts, c.vs
+}
 
-	ts := ks[0]
-	var acc float64
+type floatMinBatchCursor struct {
+	floatWindowCursor
+	ts []int64
+	vs []float64
+}
 
-	for {
-		for _, v := range vs {
-			acc += v
+func (c *floatMinBatchCursor) Next() (key []int64, value []float64) {
+	c.ts, c.vs = c.ts[:0], c.vs[:0]
+	for len(c.ts) < batchSize {
+		var (
+			mint int64
+			minv float64
+			set  bool
+		)
+		ts, ok := c.nextWindow(func(ks []int64, vs []float64) {
+			for i, v := range vs {
+				if !set || v < minv {
+					mint, minv, set = ks[i], v, true
+				}
+			}
+		})
+		if !ok {
+			break
 		}
-		ks, vs = c.FloatBatchCursor.Next()
-		if len(ks) == 0 {
-			c.ts[0] = ts
-			c.vs[0] = acc
-			return c.ts[:], c.vs[:]
+		if c.win.every == 0 {
+			ts = mint
 		}
+		c.ts = append(c.ts, ts)
+		c.vs = append(c.vs, minv)
 	}
+	return c.ts, c.vs
 }
 
-type integerFloatCountBatchCursor struct {
-	tsdb.FloatBatchCursor
+type floatMaxBatchCursor struct {
+	floatWindowCursor
+	ts []int64
+	vs []float64
 }
 
-func (c *integerFloatCountBatchCursor) Next() (key []int64, value []int64) {
-	ks, _ := c.FloatBatchCursor.Next()
-	if len(ks) == 0 {
-		return nil, nil
+func (c *floatMaxBatchCursor) Next() (key []int64, value []float64) {
+	c.ts, c.vs = c.ts[:0], c.vs[:0]
+	for len(c.ts) < batchSize {
+		var (
+			maxt int64
+			maxv float64
+			set  bool
+		)
+		ts, ok := c.nextWindow(func(ks []int64, vs []float64) {
+			for i, v := range vs {
+				if !set || v > maxv {
+					maxt, maxv, set = ks[i], v, true
+				}
+			}
+		})
+		if !ok {
+			break
+		}
+		if c.win.every == 0 {
+			ts = maxt
+		}
+		c.ts = append(c.ts, ts)
+		c.vs = append(c.vs, maxv)
 	}
+	return c.ts, c.vs
+}
 
-	ts := ks[0]
-	var acc int64
-	for {
-		acc += int64(len(ks))
-		ks, _ = c.FloatBatchCursor.Next()
-		if len(ks) == 0 {
-			return []int64{ts}, []int64{acc}
+type floatFloatMeanBatchCursor struct {
+	floatWindowCursor
+	ts []int64
+	vs []float64
+}
+
+func (c *floatFloatMeanBatchCursor) Next() (key []int64, value []float64) {
+	c.ts, c.vs = c.ts[:0], c.vs[:0]
+	for len(c.ts) < batchSize {
+		var (
+			sum   float64
+			count int
+		)
+		ts, ok := c.nextWindow(func(_ []int64, vs []float64) {
+			for _, v := range vs {
+				sum += float64(v)
+			}
+			count += len(vs)
+		})
+		if !ok {
+			break
+		}
+		c.ts = append(c.ts, ts)
+		c.vs = append(c.vs, sum/float64(count))
+	}
+	return c.ts, c.vs
+}
+
+type floatFirstBatchCursor struct {
+	floatWindowCursor
+	ts []int64
+	vs []float64
+}
+
+func (c *floatFirstBatchCursor) Next() (key []int64, value []float64) {
+	c.ts, c.vs = c.ts[:0], c.vs[:0]
+	for len(c.ts) < batchSize {
+		var (
+			firstt int64
+			firstv float64
+			set    bool
+		)
+		ts, ok := c.nextWindow(func(ks []int64, vs []float64) {
+			for i, k := range ks {
+				if !set || k < firstt {
+					firstt, firstv, set = k, vs[i], true
+				}
+			}
+		})
+		if !ok {
+			break
+		}
+		if c.win.every == 0 {
+			ts = firstt
+		}
+		c.ts = append(c.ts, ts)
+		c.vs = append(c.vs, firstv)
+	}
+	return c.ts, c.vs
+}
+
+type floatLastBatchCursor struct {
+	floatWindowCursor
+	ts []int64
+	vs []float64
+}
+
+func (c *floatLastBatchCursor) Next() (key []int64, value []float64) {
+	c.ts, c.vs = c.ts[:0], c.vs[:0]
+	for len(c.ts) < batchSize {
+		var (
+			lastt int64
+			lastv float64
+			set   bool
+		)
+		ts, ok := c.nextWindow(func(ks []int64, vs []float64) {
+			for i, k := range ks {
+				if !set || k > lastt {
+					lastt, lastv, set = k, vs[i], true
+				}
+			}
+		})
+		if !ok {
+			break
+		}
+		if c.win.every == 0 {
+			ts = lastt
 		}
+		c.ts = append(c.ts, ts)
+		c.vs = append(c.vs, lastv)
 	}
+	return c.ts, c.vs
+}
+
+type integerFloatCountBatchCursor struct {
+	floatWindowCursor
+	ts []int64
+	vs []int64
+}
+
+func (c *integerFloatCountBatchCursor) Next() (key []int64, value []int64) {
+	c.ts, c.vs = c.ts[:0], c.vs[:0]
+	for len(c.ts) < batchSize {
+		var acc int64
+		ts, ok := c.nextWindow(func(ks []int64, _ []float64) {
+			acc += int64(len(ks))
+		})
+		if !ok {
+			break
+		}
+		c.ts = append(c.ts, ts)
+		c.vs = append(c.vs, acc)
+	}
+	return c.ts, c.vs
 }
 
 type floatEmptyBatchCursor struct{}
@@ -364,53 +556,245 @@ func (c *integerMultiShardBatchCursor) nextBatchCursor() bool {
 	return ok
 }
 
-type integerSumBatchCursor struct {
+type integerWindowCursor struct {
 	tsdb.IntegerBatchCursor
-	ts [1]int64
-	vs [1]int64
+	win window
+	ks  []int64
+	vs  []int64
+}
+
+// nextWindow calls fn with successive batches of points belonging to the next window
+// and returns the timestamp of the window. ok is false when the cursor is exhausted.
+func (c *integerWindowCursor) nextWindow(fn func(ks []int64, vs []int64)) (ts int64, ok bool) {
+	if len(c.ks) == 0 {
+		c.ks, c.vs = c.IntegerBatchCursor.Next()
+		if len(c.ks) == 0 {
+			return 0, false
+		}
+	}
+
+	start := c.win.start(c.ks[0])
+	ts = c.win.timestamp(start, c.ks[0])
+	for len(c.ks) > 0 {
+		i := 0
+		for i < len(c.ks) && c.win.start(c.ks[i]) == start {
+			i++
+		}
+		fn(c.ks[:i], c.vs[:i])
+		if i < len(c.ks) {
+			c.ks, c.vs = c.ks[i:], c.vs[i:]
+			return ts, true
+		}
+		c.ks, c.vs = c.IntegerBatchCursor.Next()
+	}
+
+	return ts, true
+}
+
+type integerSumBatchCursor struct {
+	integerWindowCursor
+	ts []int64
+	vs []int64
 }
 
 func (c *integerSumBatchCursor) Next() (key []int64, value []int64) {
-	ks, vs := c.IntegerBatchCursor.Next()
-	if len(ks) == 0 {
-		return nil, nil
+	c.ts, c.vs = c.ts[:0], c.vs[:0]
+	for len(c.ts) < batchSize {
+		var acc int64
+		ts, ok := c.nextWindow(func(_ []int64, vs []int64) {
+			for _, v := range vs {
+				acc += v
+			}
+		})
+		if !ok {
+			break
+		}
+		c.ts = append(c.ts, ts)
+		c.vs = append(c.vs, acc)
 	}
+	return c.ts, c.vs
+}
 
-	ts := ks[0]
-	var acc int64
+type integerMinBatchCursor struct {
+	integerWindowCursor
+	ts []int64
+	vs []int64
+}
 
-	for {
-		for _, v := range vs {
-			acc += v
+func (c *integerMinBatchCursor) Next() (key []int64, value []int64) {
+	c.ts, c.vs = c.ts[:0], c.vs[:0]
+	for len(c.ts) < batchSize {
+		var (
+			mint int64
+			minv int64
+			set  bool
+		)
+		ts, ok := c.nextWindow(func(ks []int64, vs []int64) {
+			for i, v := range vs {
+				if !set || v < minv {
+					mint, minv, set = ks[i], v, true
+				}
+			}
+		})
+		if !ok {
+			break
 		}
-		ks, vs = c.IntegerBatchCursor.Next()
-		if len(ks) == 0 {
-			c.ts[0] = ts
-			c.vs[0] = acc
-			return c.ts[:], c.vs[:]
+		if c.win.every == 0 {
+			ts = mint
 		}
+		c.ts = append(c.ts, ts)
+		c.vs = append(c.vs, minv)
 	}
+	return c.ts, c.vs
 }
 
-type integerIntegerCountBatchCursor struct {
-	tsdb.IntegerBatchCursor
+type integerMaxBatchCursor struct {
+	integerWindowCursor
+	ts []int64
+	vs []int64
 }
 
-func (c *integerIntegerCountBatchCursor) Next() (key []int64, value []int64) {
-	ks, _ := c.IntegerBatchCursor.Next()
-	if len(ks) == 0 {
-		return nil, nil
+func (c *integerMaxBatchCursor) Next() (key []int64, value []int64) {
+	c.ts, c.vs = c.ts[:0], c.vs[:0]
+	for len(c.ts) < batchSize {
+		var (
+			maxt int64
+			maxv int64
+			set  bool
+		)
+		ts, ok := c.nextWindow(func(ks []int64, vs []int64) {
+			for i, v := range vs {
+				if !set || v > maxv {
+					maxt, maxv, set = ks[i], v, true
+				}
+			}
+		})
+		if !ok {
+			break
+		}
+		if c.win.every == 0 {
+			ts = maxt
+		}
+		c.ts = append(c.ts, ts)
+		c.vs = append(c.vs, maxv)
 	}
+	return c.ts, c.vs
+}
 
-	ts := ks[0]
-	var acc int64
-	for {
-		acc += int64(len(ks))
-		ks, _ = c.IntegerBatchCursor.Next()
-		if len(ks) == 0 {
-			return []int64{ts}, []int64{acc}
+type floatIntegerMeanBatchCursor struct {
+	integerWindowCursor
+	ts []int64
+	vs []float64
+}
+
+func (c *floatIntegerMeanBatchCursor) Next() (key []int64, value []float64) {
+	c.ts, c.vs = c.ts[:0], c.vs[:0]
+	for len(c.ts) < batchSize {
+		var (
+			sum   float64
+			count int
+		)
+		ts, ok := c.nextWindow(func(_ []int64, vs []int64) {
+			for _, v := range vs {
+				sum += float64(v)
+			}
+			count += len(vs)
+		})
+		if !ok {
+			break
+		}
+		c.ts = append(c.ts, ts)
+		c.vs = append(c.vs, sum/float64(count))
+	}
+	return c.ts, c.vs
+}
+
+type integerFirstBatchCursor struct {
+	integerWindowCursor
+	ts []int64
+	vs []int64
+}
+
+func (c *integerFirstBatchCursor) Next() (key []int64, value []int64) {
+	c.ts, c.vs = c.ts[:0], c.vs[:0]
+	for len(c.ts) < batchSize {
+		var (
+			firstt int64
+			firstv int64
+			set    bool
+		)
+		ts, ok := c.nextWindow(func(ks []int64, vs []int64) {
+			for i, k := range ks {
+				if !set || k < firstt {
+					firstt, firstv, set = k, vs[i], true
+				}
+			}
+		})
+		if !ok {
+			break
+		}
+		if c.win.every == 0 {
+			ts = firstt
+		}
+		c.ts = append(c.ts, ts)
+		c.vs = append(c.vs, firstv)
+	}
+	return c.ts, c.vs
+}
+
+type integerLastBatchCursor struct {
+	integerWindowCursor
+	ts []int64
+	vs []int64
+}
+
+func (c *integerLastBatchCursor) Next() (key []int64, value []int64) {
+	c.ts, c.vs = c.ts[:0], c.vs[:0]
+	for len(c.ts) < batchSize {
+		var (
+			lastt int64
+			lastv int64
+			set   bool
+		)
+		ts, ok := c.nextWindow(func(ks []int64, vs []int64) {
+			for i, k := range ks {
+				if !set || k > lastt {
+					lastt, lastv, set = k, vs[i], true
+				}
+			}
+		})
+		if !ok {
+			break
 		}
+		if c.win.every == 0 {
+			ts = lastt
+		}
+		c.ts = append(c.ts, ts)
+		c.vs = append(c.vs, lastv)
 	}
+	return c.ts, c.vs
+}
+
+type integerIntegerCountBatchCursor struct {
+	integerWindowCursor
+	ts []int64
+	vs []int64
+}
+
+func (c *integerIntegerCountBatchCursor) Next() (key []int64, value []int64) {
+	c.ts, c.vs = c.ts[:0], c.vs[:0]
+	for len(c.ts) < batchSize {
+		var acc int64
+		ts, ok := c.nextWindow(func(ks []int64, _ []int64) {
+			acc += int64(len(ks))
+		})
+		if !ok {
+			break
+		}
+		c.ts = append(c.ts, ts)
+		c.vs = append(c.vs, acc)
+	}
+	return c.ts, c.vs
 }
 
 type integerEmptyBatchCursor struct{}
@@ -568,53 +952,245 @@ func (c *unsignedMultiShardBatchCursor) nextBatchCursor() bool {
 	return ok
 }
 
-type unsignedSumBatchCursor struct {
+type unsignedWindowCursor struct {
 	tsdb.UnsignedBatchCursor
-	ts [1]int64
-	vs [1]uint64
+	win window
+	ks  []int64
+	vs  []uint64
+}
+
+// nextWindow calls fn with successive batches of points belonging to the next window
+// and returns the timestamp of the window. ok is false when the cursor is exhausted.
+func (c *unsignedWindowCursor) nextWindow(fn func(ks []int64, vs []uint64)) (ts int64, ok bool) {
+	if len(c.ks) == 0 {
+		c.ks, c.vs = c.UnsignedBatchCursor.Next()
+		if len(c.ks) == 0 {
+			return 0, false
+		}
+	}
+
+	start := c.win.start(c.ks[0])
+	ts = c.win.timestamp(start, c.ks[0])
+	for len(c.ks) > 0 {
+		i := 0
+		for i < len(c.ks) && c.win.start(c.ks[i]) == start {
+			i++
+		}
+		fn(c.ks[:i], c.vs[:i])
+		if i < len(c.ks) {
+			c.ks, c.vs = c.ks[i:], c.vs[i:]
+			return ts, true
+		}
+		c.ks, c.vs = c.UnsignedBatchCursor.Next()
+	}
+
+	return ts, true
+}
+
+type unsignedSumBatchCursor struct {
+	unsignedWindowCursor
+	ts []int64
+	vs []uint64
 }
 
 func (c *unsignedSumBatchCursor) Next() (key []int64, value []uint64) {
-	ks, vs := c.UnsignedBatchCursor.Next()
-	if len(ks) == 0 {
-		return nil, nil
+	c.ts, c.vs = c.ts[:0], c.vs[:0]
+	for len(c.ts) < batchSize {
+		var acc uint64
+		ts, ok := c.nextWindow(func(_ []int64, vs []uint64) {
+			for _, v := range vs {
+				acc += v
+			}
+		})
+		if !ok {
+			break
+		}
+		c.ts = append(c.ts, ts)
+		c.vs = append(c.vs, acc)
 	}
+	return c.ts, c.vs
+}
 
-	ts := ks[0]
-	var acc uint64
+type unsignedMinBatchCursor struct {
+	unsignedWindowCursor
+	ts []int64
+	vs []uint64
+}
 
-	for {
-		for _, v := range vs {
-			acc += v
+func (c *unsignedMinBatchCursor) Next() (key []int64, value []uint64) {
+	c.ts, c.vs = c.ts[:0], c.vs[:0]
+	for len(c.ts) < batchSize {
+		var (
+			mint int64
+			minv uint64
+			set  bool
+		)
+		ts, ok := c.nextWindow(func(ks []int64, vs []uint64) {
+			for i, v := range vs {
+				if !set || v < minv {
+					mint, minv, set = ks[i], v, true
+				}
+			}
+		})
+		if !ok {
+			break
 		}
-		ks, vs = c.UnsignedBatchCursor.Next()
-		if len(ks) == 0 {
-			c.ts[0] = ts
-			c.vs[0] = acc
-			return c.ts[:], c.vs[:]
+		if c.win.every == 0 {
+			ts = mint
 		}
+		c.ts = append(c.ts, ts)
+		c.vs = append(c.vs, minv)
 	}
+	return c.ts, c.vs
 }
 
-type integerUnsignedCountBatchCursor struct {
-	tsdb.UnsignedBatchCursor
+type unsignedMaxBatchCursor struct {
+	unsignedWindowCursor
+	ts []int64
+	vs []uint64
 }
 
-func (c *integerUnsignedCountBatchCursor) Next() (key []int64, value []int64) {
-	ks, _ := c.UnsignedBatchCursor.Next()
-	if len(ks) == 0 {
-		return nil, nil
+func (c *unsignedMaxBatchCursor) Next() (key []int64, value []uint64) {
+	c.ts, c.vs = c.ts[:0], c.vs[:0]
+	for len(c.ts) < batchSize {
+		var (
+			maxt int64
+			maxv uint64
+			set  bool
+		)
+		ts, ok := c.nextWindow(func(ks []int64, vs []uint64) {
+			for i, v := range vs {
+				if !set || v > maxv {
+					maxt, maxv, set = ks[i], v, true
+				}
+			}
+		})
+		if !ok {
+			break
+		}
+		if c.win.every == 0 {
+			ts = maxt
+		}
+		c.ts = append(c.ts, ts)
+		c.vs = append(c.vs, maxv)
 	}
+	return c.ts, c.vs
+}
 
-	ts := ks[0]
-	var acc int64
-	for {
-		acc += int64(len(ks))
-		ks, _ = c.UnsignedBatchCursor.Next()
-		if len(ks) == 0 {
-			return []int64{ts}, []int64{acc}
+type floatUnsignedMeanBatchCursor struct {
+	unsignedWindowCursor
+	ts []int64
+	vs []float64
+}
+
+func (c *floatUnsignedMeanBatchCursor) Next() (key []int64, value []float64) {
+	c.ts, c.vs = c.ts[:0], c.vs[:0]
+	for len(c.ts) < batchSize {
+		var (
+			sum   float64
+			count int
+		)
+		ts, ok := c.nextWindow(func(_ []int64, vs []uint64) {
+			for _, v := range vs {
+				sum += float64(v)
+			}
+			count += len(vs)
+		})
+		if !ok {
+			break
+		}
+		c.ts = append(c.ts, ts)
+		c.vs = append(c.vs, sum/float64(count))
+	}
+	return c.ts, c.vs
+}
+
+type unsignedFirstBatchCursor struct {
+	unsignedWindowCursor
+	ts []int64
+	vs []uint64
+}
+
+func (c *unsignedFirstBatchCursor) Next() (key []int64, value []uint64) {
+	c.ts, c.vs = c.ts[:0], c.vs[:0]
+	for len(c.ts) < batchSize {
+		var (
+			firstt int64
+			firstv uint64
+			set    bool
+		)
+		ts, ok := c.nextWindow(func(ks []int64, vs []uint64) {
+			for i, k := range ks {
+				if !set || k < firstt {
+					firstt, firstv, set = k, vs[i], true
+				}
+			}
+		})
+		if !ok {
+			break
+		}
+		if c.win.every == 0 {
+			ts = firstt
+		}
+		c.ts = append(c.ts, ts)
+		c.vs = append(c.vs, firstv)
+	}
+	return c.ts, c.vs
+}
+
+type unsignedLastBatchCursor struct {
+	unsignedWindowCursor
+	ts []int64
+	vs []uint64
+}
+
+func (c *unsignedLastBatchCursor) Next() (key []int64, value []uint64) {
+	c.ts, c.vs = c.ts[:0], c.vs[:0]
+	for len(c.ts) < batchSize {
+		var (
+			lastt int64
+			lastv uint64
+			set   bool
+		)
+		ts, ok := c.nextWindow(func(ks []int64, vs []uint64) {
+			for i, k := range ks {
+				if !set || k > lastt {
+					lastt, lastv, set = k, vs[i], true
+				}
+			}
+		})
+		if !ok {
+			break
+		}
+		if c.win.every == 0 {
+			ts = lastt
 		}
+		c.ts = append(c.ts, ts)
+		c.vs = append(c.vs, lastv)
 	}
+	return c.ts, c.vs
+}
+
+type integerUnsignedCountBatchCursor struct {
+	unsignedWindowCursor
+	ts []int64
+	vs []int64
+}
+
+func (c *integerUnsignedCountBatchCursor) Next() (key []int64, value []int64) {
+	c.ts, c.vs = c.ts[:0], c.vs[:0]
+	for len(c.ts) < batchSize {
+		var acc int64
+		ts, ok := c.nextWindow(func(ks []int64, _ []uint64) {
+			acc += int64(len(ks))
+		})
+		if !ok {
+			break
+		}
+		c.ts = append(c.ts, ts)
+		c.vs = append(c.vs, acc)
+	}
+	return c.ts, c.vs
 }
 
 type unsignedEmptyBatchCursor struct{}
@@ -772,25 +1348,127 @@ func (c *stringMultiShardBatchCursor) nextBatchCursor() bool {
 	return ok
 }
 
-type integerStringCountBatchCursor struct {
+type stringWindowCursor struct {
 	tsdb.StringBatchCursor
+	win window
+	ks  []int64
+	vs  []string
 }
 
-func (c *integerStringCountBatchCursor) Next() (key []int64, value []int64) {
-	ks, _ := c.StringBatchCursor.Next()
-	if len(ks) == 0 {
-		return nil, nil
+// nextWindow calls fn with successive batches of points belonging to the next window
+// and returns the timestamp of the window. ok is false when the cursor is exhausted.
+func (c *stringWindowCursor) nextWindow(fn func(ks []int64, vs []string)) (ts int64, ok bool) {
+	if len(c.ks) == 0 {
+		c.ks, c.vs = c.StringBatchCursor.Next()
+		if len(c.ks) == 0 {
+			return 0, false
+		}
 	}
 
-	ts := ks[0]
-	var acc int64
-	for {
-		acc += int64(len(ks))
-		ks, _ = c.StringBatchCursor.Next()
-		if len(ks) == 0 {
-			return []int64{ts}, []int64{acc}
+	start := c.win.start(c.ks[0])
+	ts = c.win.timestamp(start, c.ks[0])
+	for len(c.ks) > 0 {
+		i := 0
+		for i < len(c.ks) && c.win.start(c.ks[i]) == start {
+			i++
+		}
+		fn(c.ks[:i], c.vs[:i])
+		if i < len(c.ks) {
+			c.ks, c.vs = c.ks[i:], c.vs[i:]
+			return ts, true
+		}
+		c.ks, c.vs = c.StringBatchCursor.Next()
+	}
+
+	return ts, true
+}
+
+type stringFirstBatchCursor struct {
+	stringWindowCursor
+	ts []int64
+	vs []string
+}
+
+func (c *stringFirstBatchCursor) Next() (key []int64, value []string) {
+	c.ts, c.vs = c.ts[:0], c.vs[:0]
+	for len(c.ts) < batchSize {
+		var (
+			firstt int64
+			firstv string
+			set    bool
+		)
+		ts, ok := c.nextWindow(func(ks []int64, vs []string) {
+			for i, k := range ks {
+				if !set || k < firstt {
+					firstt, firstv, set = k, vs[i], true
+				}
+			}
+		})
+		if !ok {
+			break
+		}
+		if c.win.every == 0 {
+			ts = firstt
 		}
+		c.ts = append(c.ts, ts)
+		c.vs = append(c.vs, firstv)
 	}
+	return c.ts, c.vs
+}
+
+type stringLastBatchCursor struct {
+	stringWindowCursor
+	ts []int64
+	vs []string
+}
+
+func (c *stringLastBatchCursor) Next() (key []int64, value []string) {
+	c.ts, c.vs = c.ts[:0], c.vs[:0]
+	for len(c.ts) < batchSize {
+		var (
+			lastt int64
+			lastv string
+			set   bool
+		)
+		ts, ok := c.nextWindow(func(ks []int64, vs []string) {
+			for i, k := range ks {
+				if !set || k > lastt {
+					lastt, lastv, set = k, vs[i], true
+				}
+			}
+		})
+		if !ok {
+			break
+		}
+		if c.win.every == 0 {
+			ts = lastt
+		}
+		c.ts = append(c.ts, ts)
+		c.vs = append(c.vs, lastv)
+	}
+	return c.ts, c.vs
+}
+
+type integerStringCountBatchCursor struct {
+	stringWindowCursor
+	ts []int64
+	vs []int64
+}
+
+func (c *integerStringCountBatchCursor) Next() (key []int64, value []int64) {
+	c.ts, c.vs = c.ts[:0], c.vs[:0]
+	for len(c.ts) < batchSize {
+		var acc int64
+		ts, ok := c.nextWindow(func(ks []int64, _ []string) {
+			acc += int64(len(ks))
+		})
+		if !ok {
+			break
+		}
+		c.ts = append(c.ts, ts)
+		c.vs = append(c.vs, acc)
+	}
+	return c.ts, c.vs
 }
 
 type stringEmptyBatchCursor struct{}
@@ -948,25 +1626,127 @@ func (c *booleanMultiShardBatchCursor) nextBatchCursor() bool {
 	return ok
 }
 
-type integerBooleanCountBatchCursor struct {
+type booleanWindowCursor struct {
 	tsdb.BooleanBatchCursor
+	win window
+	ks  []int64
+	vs  []bool
 }
 
-func (c *integerBooleanCountBatchCursor) Next() (key []int64, value []int64) {
-	ks, _ := c.BooleanBatchCursor.Next()
-	if len(ks) == 0 {
-		return nil, nil
+// nextWindow calls fn with successive batches of points belonging to the next window
+// and returns the timestamp of the window. ok is false when the cursor is exhausted.
+func (c *booleanWindowCursor) nextWindow(fn func(ks []int64, vs []bool)) (ts int64, ok bool) {
+	if len(c.ks) == 0 {
+		c.ks, c.vs = c.BooleanBatchCursor.Next()
+		if len(c.ks) == 0 {
+			return 0, false
+		}
 	}
 
-	ts := ks[0]
-	var acc int64
-	for {
-		acc += int64(len(ks))
-		ks, _ = c.BooleanBatchCursor.Next()
-		if len(ks) == 0 {
-			return []int64{ts}, []int64{acc}
+	start := c.win.start(c.ks[0])
+	ts = c.win.timestamp(start, c.ks[0])
+	for len(c.ks) > 0 {
+		i := 0
+		for i < len(c.ks) && c.win.start(c.ks[i]) == start {
+			i++
+		}
+		fn(c.ks[:i], c.vs[:i])
+		if i < len(c.ks) {
+			c.ks, c.vs = c.ks[i:], c.vs[i:]
+			return ts, true
+		}
+		c.ks, c.vs = c.BooleanBatchCursor.Next()
+	}
+
+	return ts, true
+}
+
+type booleanFirstBatchCursor struct {
+	booleanWindowCursor
+	ts []int64
+	vs []bool
+}
+
+func (c *booleanFirstBatchCursor) Next() (key []int64, value []bool) {
+	c.ts, c.vs = c.ts[:0], c.vs[:0]
+	for len(c.ts) < batchSize {
+		var (
+			firstt int64
+			firstv bool
+			set    bool
+		)
+		ts, ok := c.nextWindow(func(ks []int64, vs []bool) {
+			for i, k := range ks {
+				if !set || k < firstt {
+					firstt, firstv, set = k, vs[i], true
+				}
+			}
+		})
+		if !ok {
+			break
+		}
+		if c.win.every == 0 {
+			ts = firstt
+		}
+		c.ts = append(c.ts, ts)
+		c.vs = append(c.vs, firstv)
+	}
+	return c.ts, c.vs
+}
+
+type booleanLastBatchCursor struct {
+	booleanWindowCursor
+	ts []int64
+	vs []bool
+}
+
+func (c *booleanLastBatchCursor) Next() (key []int64, value []bool) {
+	c.ts, c.vs = c.ts[:0], c.vs[:0]
+	for len(c.ts) < batchSize {
+		var (
+			lastt int64
+			lastv bool
+			set   bool
+		)
+		ts, ok := c.nextWindow(func(ks []int64, vs []bool) {
+			for i, k := range ks {
+				if !set || k > lastt {
+					lastt, lastv, set = k, vs[i], true
+				}
+			}
+		})
+		if !ok {
+			break
+		}
+		if c.win.every == 0 {
+			ts = lastt
+		}
+		c.ts = append(c.ts, ts)
+		c.vs = append(c.vs, lastv)
+	}
+	return c.ts, c.vs
+}
+
+type integerBooleanCountBatchCursor struct {
+	booleanWindowCursor
+	ts []int64
+	vs []int64
+}
+
+func (c *integerBooleanCountBatchCursor) Next() (key []int64, value []int64) {
+	c.ts, c.vs = c.ts[:0], c.vs[:0]
+	for len(c.ts) < batchSize {
+		var acc int64
+		ts, ok := c.nextWindow(func(ks []int64, _ []bool) {
+			acc += int64(len(ks))
+		})
+		if !ok {
+			break
 		}
+		c.ts = append(c.ts, ts)
+		c.vs = append(c.vs, acc)
 	}
+	return c.ts, c.vs
 }
 
 type booleanEmptyBatchCursor struct{}
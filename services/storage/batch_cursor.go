@@ -3,6 +3,7 @@ package storage
 import (
 	"context"
 	"fmt"
+	"math"
 
 	"github.com/influxdata/influxdb/tsdb"
 )
@@ -15,48 +16,159 @@ func (v *singleValue) Value(key string) (interface{}, bool) {
 	return v.v, true
 }
 
-func newAggregateBatchCursor(ctx context.Context, agg *Aggregate, cursor tsdb.Cursor) tsdb.Cursor {
+// window groups timestamps into fixed intervals of time.
+type window struct {
+	every, offset int64
+}
+
+// start returns the start time of the window containing t. If no
+// window is specified, all timestamps belong to a single window.
+func (w window) start(t int64) int64 {
+	if w.every == 0 {
+		return math.MinInt64
+	}
+
+	d := (t - w.offset) % w.every
+	if d < 0 {
+		d += w.every
+	}
+	return t - d
+}
+
+// timestamp returns the timestamp for an aggregate of the window beginning at start,
+// which is the window start time or, if no window is specified, the time of the first point.
+func (w window) timestamp(start, first int64) int64 {
+	if w.every == 0 {
+		return first
+	}
+	return start
+}
+
+func newAggregateBatchCursor(ctx context.Context, agg Aggregate, win window, cursor tsdb.Cursor) tsdb.Cursor {
 	if cursor == nil {
 		return nil
 	}
 
 	switch agg.Type {
 	case AggregateTypeSum:
-		return newSumBatchCursor(cursor)
+		return newSumBatchCursor(cursor, win)
 	case AggregateTypeCount:
-		return newCountBatchCursor(cursor)
+		return newCountBatchCursor(cursor, win)
+	case AggregateTypeMin:
+		return newMinBatchCursor(cursor, win)
+	case AggregateTypeMax:
+		return newMaxBatchCursor(cursor, win)
+	case AggregateTypeMean:
+		return newMeanBatchCursor(cursor, win)
+	case AggregateTypeFirst:
+		return newFirstBatchCursor(cursor, win)
+	case AggregateTypeLast:
+		return newLastBatchCursor(cursor, win)
 	default:
 		// TODO(sgc): should be validated higher up
 		panic("invalid aggregate")
 	}
 }
 
-func newSumBatchCursor(cur tsdb.Cursor) tsdb.Cursor {
+func newSumBatchCursor(cur tsdb.Cursor, win window) tsdb.Cursor {
 	switch cur := cur.(type) {
 	case tsdb.FloatBatchCursor:
-		return &floatSumBatchCursor{FloatBatchCursor: cur}
+		return &floatSumBatchCursor{floatWindowCursor: floatWindowCursor{FloatBatchCursor: cur, win: win}}
 	case tsdb.IntegerBatchCursor:
-		return &integerSumBatchCursor{IntegerBatchCursor: cur}
+		return &integerSumBatchCursor{integerWindowCursor: integerWindowCursor{IntegerBatchCursor: cur, win: win}}
 	case tsdb.UnsignedBatchCursor:
-		return &unsignedSumBatchCursor{UnsignedBatchCursor: cur}
+		return &unsignedSumBatchCursor{unsignedWindowCursor: unsignedWindowCursor{UnsignedBatchCursor: cur, win: win}}
 	default:
 		// TODO(sgc): propagate an error instead?
 		return nil
 	}
 }
 
-func newCountBatchCursor(cur tsdb.Cursor) tsdb.Cursor {
+func newMinBatchCursor(cur tsdb.Cursor, win window) tsdb.Cursor {
+	switch cur := cur.(type) {
+	case tsdb.FloatBatchCursor:
+		return &floatMinBatchCursor{floatWindowCursor: floatWindowCursor{FloatBatchCursor: cur, win: win}}
+	case tsdb.IntegerBatchCursor:
+		return &integerMinBatchCursor{integerWindowCursor: integerWindowCursor{IntegerBatchCursor: cur, win: win}}
+	case tsdb.UnsignedBatchCursor:
+		return &unsignedMinBatchCursor{unsignedWindowCursor: unsignedWindowCursor{UnsignedBatchCursor: cur, win: win}}
+	default:
+		return nil
+	}
+}
+
+func newMaxBatchCursor(cur tsdb.Cursor, win window) tsdb.Cursor {
+	switch cur := cur.(type) {
+	case tsdb.FloatBatchCursor:
+		return &floatMaxBatchCursor{floatWindowCursor: floatWindowCursor{FloatBatchCursor: cur, win: win}}
+	case tsdb.IntegerBatchCursor:
+		return &integerMaxBatchCursor{integerWindowCursor: integerWindowCursor{IntegerBatchCursor: cur, win: win}}
+	case tsdb.UnsignedBatchCursor:
+		return &unsignedMaxBatchCursor{unsignedWindowCursor: unsignedWindowCursor{UnsignedBatchCursor: cur, win: win}}
+	default:
+		return nil
+	}
+}
+
+func newMeanBatchCursor(cur tsdb.Cursor, win window) tsdb.Cursor {
+	switch cur := cur.(type) {
+	case tsdb.FloatBatchCursor:
+		return &floatFloatMeanBatchCursor{floatWindowCursor: floatWindowCursor{FloatBatchCursor: cur, win: win}}
+	case tsdb.IntegerBatchCursor:
+		return &floatIntegerMeanBatchCursor{integerWindowCursor: integerWindowCursor{IntegerBatchCursor: cur, win: win}}
+	case tsdb.UnsignedBatchCursor:
+		return &floatUnsignedMeanBatchCursor{unsignedWindowCursor: unsignedWindowCursor{UnsignedBatchCursor: cur, win: win}}
+	default:
+		return nil
+	}
+}
+
+func newFirstBatchCursor(cur tsdb.Cursor, win window) tsdb.Cursor {
+	switch cur := cur.(type) {
+	case tsdb.FloatBatchCursor:
+		return &floatFirstBatchCursor{floatWindowCursor: floatWindowCursor{FloatBatchCursor: cur, win: win}}
+	case tsdb.IntegerBatchCursor:
+		return &integerFirstBatchCursor{integerWindowCursor: integerWindowCursor{IntegerBatchCursor: cur, win: win}}
+	case tsdb.UnsignedBatchCursor:
+		return &unsignedFirstBatchCursor{unsignedWindowCursor: unsignedWindowCursor{UnsignedBatchCursor: cur, win: win}}
+	case tsdb.StringBatchCursor:
+		return &stringFirstBatchCursor{stringWindowCursor: stringWindowCursor{StringBatchCursor: cur, win: win}}
+	case tsdb.BooleanBatchCursor:
+		return &booleanFirstBatchCursor{booleanWindowCursor: booleanWindowCursor{BooleanBatchCursor: cur, win: win}}
+	default:
+		panic(fmt.Sprintf("unreachable: %T", cur))
+	}
+}
+
+func newLastBatchCursor(cur tsdb.Cursor, win window) tsdb.Cursor {
+	switch cur := cur.(type) {
+	case tsdb.FloatBatchCursor:
+		return &floatLastBatchCursor{floatWindowCursor: floatWindowCursor{FloatBatchCursor: cur, win: win}}
+	case tsdb.IntegerBatchCursor:
+		return &integerLastBatchCursor{integerWindowCursor: integerWindowCursor{IntegerBatchCursor: cur, win: win}}
+	case tsdb.UnsignedBatchCursor:
+		return &unsignedLastBatchCursor{unsignedWindowCursor: unsignedWindowCursor{UnsignedBatchCursor: cur, win: win}}
+	case tsdb.StringBatchCursor:
+		return &stringLastBatchCursor{stringWindowCursor: stringWindowCursor{StringBatchCursor: cur, win: win}}
+	case tsdb.BooleanBatchCursor:
+		return &booleanLastBatchCursor{booleanWindowCursor: booleanWindowCursor{BooleanBatchCursor: cur, win: win}}
+	default:
+		panic(fmt.Sprintf("unreachable: %T", cur))
+	}
+}
+
+func newCountBatchCursor(cur tsdb.Cursor, win window) tsdb.Cursor {
 	switch cur := cur.(type) {
 	case tsdb.FloatBatchCursor:
-		return &integerFloatCountBatchCursor{FloatBatchCursor: cur}
+		return &integerFloatCountBatchCursor{floatWindowCursor: floatWindowCursor{FloatBatchCursor: cur, win: win}}
 	case tsdb.IntegerBatchCursor:
-		return &integerIntegerCountBatchCursor{IntegerBatchCursor: cur}
+		return &integerIntegerCountBatchCursor{integerWindowCursor: integerWindowCursor{IntegerBatchCursor: cur, win: win}}
 	case tsdb.UnsignedBatchCursor:
-		return &integerUnsignedCountBatchCursor{UnsignedBatchCursor: cur}
+		return &integerUnsignedCountBatchCursor{unsignedWindowCursor: unsignedWindowCursor{UnsignedBatchCursor: cur, win: win}}
 	case tsdb.StringBatchCursor:
-		return &integerStringCountBatchCursor{StringBatchCursor: cur}
+		return &integerStringCountBatchCursor{stringWindowCursor: stringWindowCursor{StringBatchCursor: cur, win: win}}
 	case tsdb.BooleanBatchCursor:
-		return &integerBooleanCountBatchCursor{BooleanBatchCursor: cur}
+		return &integerBooleanCountBatchCursor{booleanWindowCursor: booleanWindowCursor{BooleanBatchCursor: cur, win: win}}
 	default:
 		panic(fmt.Sprintf("unreachable: %T", cur))
 	}
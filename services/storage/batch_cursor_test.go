@@ -0,0 +1,115 @@
+package storage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/influxdb/tsdb"
+)
+
+func TestWindow_Start(t *testing.T) {
+	tests := []struct {
+		name string
+		win  window
+		t    int64
+		exp  int64
+	}{
+		{name: "aligned", win: window{every: 10}, t: 20, exp: 20},
+		{name: "unaligned", win: window{every: 10}, t: 29, exp: 20},
+		{name: "negative", win: window{every: 10}, t: -1, exp: -10},
+		{name: "offset", win: window{every: 10, offset: 5}, t: 24, exp: 15},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.win.start(tt.t); got != tt.exp {
+				t.Errorf("unexpected start, got %d, exp %d", got, tt.exp)
+			}
+		})
+	}
+}
+
+func TestAggregateBatchCursor(t *testing.T) {
+	// points are split across batches to ensure windows span calls to Next
+	batches := []floatBatch{
+		{ks: []int64{0, 5, 10}, vs: []float64{1, 4, 2}},
+		{ks: []int64{12, 25}, vs: []float64{6, 3}},
+	}
+
+	tests := []struct {
+		name string
+		agg  Aggregate_AggregateType
+		win  window
+		expK []int64
+		expV interface{}
+	}{
+		{name: "sum", agg: AggregateTypeSum, expK: []int64{0}, expV: []float64{16}},
+		{name: "sum window", agg: AggregateTypeSum, win: window{every: 10}, expK: []int64{0, 10, 20}, expV: []float64{5, 8, 3}},
+		{name: "count window", agg: AggregateTypeCount, win: window{every: 10}, expK: []int64{0, 10, 20}, expV: []int64{2, 2, 1}},
+		{name: "min", agg: AggregateTypeMin, expK: []int64{0}, expV: []float64{1}},
+		{name: "max", agg: AggregateTypeMax, expK: []int64{12}, expV: []float64{6}},
+		{name: "max window", agg: AggregateTypeMax, win: window{every: 10}, expK: []int64{0, 10, 20}, expV: []float64{4, 6, 3}},
+		{name: "mean window", agg: AggregateTypeMean, win: window{every: 20}, expK: []int64{0, 20}, expV: []float64{3.25, 3}},
+		{name: "first", agg: AggregateTypeFirst, expK: []int64{0}, expV: []float64{1}},
+		{name: "last window", agg: AggregateTypeLast, win: window{every: 10, offset: 5}, expK: []int64{-5, 5, 25}, expV: []float64{1, 6, 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cur := newAggregateBatchCursor(context.Background(), Aggregate{Type: tt.agg}, tt.win, newFloatSliceBatchCursor(batches))
+
+			var ks []int64
+			var vs interface{}
+			switch cur := cur.(type) {
+			case tsdb.FloatBatchCursor:
+				var a []float64
+				for k, v := cur.Next(); len(k) > 0; k, v = cur.Next() {
+					ks, a = append(ks, k...), append(a, v...)
+				}
+				vs = a
+			case tsdb.IntegerBatchCursor:
+				var a []int64
+				for k, v := cur.Next(); len(k) > 0; k, v = cur.Next() {
+					ks, a = append(ks, k...), append(a, v...)
+				}
+				vs = a
+			default:
+				t.Fatalf("unexpected cursor type: %T", cur)
+			}
+
+			if !cmp.Equal(ks, tt.expK) {
+				t.Errorf("unexpected keys; -got/+exp\n%s", cmp.Diff(ks, tt.expK))
+			}
+			if !cmp.Equal(vs, tt.expV) {
+				t.Errorf("unexpected values; -got/+exp\n%s", cmp.Diff(vs, tt.expV))
+			}
+		})
+	}
+}
+
+type floatBatch struct {
+	ks []int64
+	vs []float64
+}
+
+// floatSliceBatchCursor is a tsdb.FloatBatchCursor that reads from a slice of batches.
+type floatSliceBatchCursor struct {
+	batches []floatBatch
+}
+
+func newFloatSliceBatchCursor(batches []floatBatch) *floatSliceBatchCursor {
+	return &floatSliceBatchCursor{batches: batches}
+}
+
+func (c *floatSliceBatchCursor) Close()     {}
+func (c *floatSliceBatchCursor) Err() error { return nil }
+
+func (c *floatSliceBatchCursor) Next() (keys []int64, values []float64) {
+	if len(c.batches) == 0 {
+		return nil, nil
+	}
+	b := c.batches[0]
+	c.batches = c.batches[1:]
+	return b.ks, b.vs
+}
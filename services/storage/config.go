@@ -11,17 +11,19 @@ const (
 
 // Config represents a configuration for a HTTP service.
 type Config struct {
-	Enabled     bool   `toml:"enabled"`
-	LogEnabled  bool   `toml:"log-enabled"` // verbose logging
-	BindAddress string `toml:"bind-address"`
+	Enabled      bool   `toml:"enabled"`
+	LogEnabled   bool   `toml:"log-enabled"` // verbose logging
+	BindAddress  string `toml:"bind-address"`
+	WriteEnabled bool   `toml:"write-enabled"`
 }
 
 // NewConfig returns a new Config with default settings.
 func NewConfig() Config {
 	return Config{
-		Enabled:     false,
-		LogEnabled:  true,
-		BindAddress: DefaultBindAddress,
+		Enabled:      false,
+		LogEnabled:   true,
+		BindAddress:  DefaultBindAddress,
+		WriteEnabled: false,
 	}
 }
 
@@ -34,8 +36,9 @@ func (c Config) Diagnostics() (*diagnostics.Diagnostics, error) {
 	}
 
 	return diagnostics.RowFromMap(map[string]interface{}{
-		"enabled":      true,
-		"log-enabled":  c.LogEnabled,
-		"bind-address": c.BindAddress,
+		"enabled":       true,
+		"log-enabled":   c.LogEnabled,
+		"bind-address":  c.BindAddress,
+		"write-enabled": c.WriteEnabled,
 	}), nil
 }
@@ -0,0 +1,233 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/influxdata/influxdb/tsdb"
+	"github.com/influxdata/influxql"
+)
+
+// MeasurementNames returns the sorted names of the measurements with series matching req.
+func (s *Store) MeasurementNames(ctx context.Context, req *MeasurementNamesRequest) ([][]byte, error) {
+	is, cond, err := s.metadataIndexSet(req.Database, req.TimestampRange, req.Predicate)
+	if err != nil || len(is.Indexes) == 0 {
+		return nil, err
+	}
+
+	names, err := is.MeasurementNamesByExpr(nil, cond)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Limit > 0 && uint64(len(names)) > req.Limit {
+		names = names[:req.Limit]
+	}
+	return names, nil
+}
+
+// TagKeys returns the sorted tag keys of the series matching req.
+func (s *Store) TagKeys(ctx context.Context, req *TagKeysRequest) ([][]byte, error) {
+	is, cond, err := s.metadataIndexSet(req.Database, req.TimestampRange, req.Predicate)
+	if err != nil || len(is.Indexes) == 0 {
+		return nil, err
+	}
+
+	names, err := is.MeasurementNamesByExpr(nil, cond)
+	if err != nil {
+		return nil, err
+	}
+
+	tagCond := tagOnlyCondition(cond)
+	itrs := make([]tsdb.TagKeyIterator, 0, len(names))
+	defer func() { tsdb.TagKeyIterators(itrs).Close() }()
+
+	for _, name := range names {
+		if tagCond == nil {
+			itr, err := is.TagKeyIterator(name)
+			if err != nil {
+				return nil, err
+			} else if itr != nil {
+				itrs = append(itrs, itr)
+			}
+			continue
+		}
+
+		// a tag condition is present, so only include keys with matching values
+		keySet, err := is.MeasurementTagKeysByExpr(name, nil)
+		if err != nil {
+			return nil, err
+		} else if len(keySet) == 0 {
+			continue
+		}
+
+		keys := make([]string, 0, len(keySet))
+		for k := range keySet {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		values, err := is.MeasurementTagKeyValuesByExpr(nil, name, keys, tagCond, true)
+		if err != nil {
+			return nil, err
+		}
+
+		matched := make([][]byte, 0, len(keys))
+		for i, k := range keys {
+			if len(values[i]) > 0 {
+				matched = append(matched, []byte(k))
+			}
+		}
+		itrs = append(itrs, tsdb.NewTagKeySliceIterator(matched))
+	}
+
+	itr := tsdb.MergeTagKeyIterators(itrs...)
+	if itr == nil {
+		return nil, nil
+	}
+	return readIterator(itr, req.Limit)
+}
+
+// TagValues returns the sorted values of the tag key specified by req for the series
+// matching req. The _measurement key returns the measurement names.
+func (s *Store) TagValues(ctx context.Context, req *TagValuesRequest) ([][]byte, error) {
+	switch req.TagKey {
+	case "":
+		return nil, errors.New("tag key is required")
+	case string(measurementKey):
+		return s.MeasurementNames(ctx, &MeasurementNamesRequest{
+			Database:       req.Database,
+			TimestampRange: req.TimestampRange,
+			Predicate:      req.Predicate,
+			Limit:          req.Limit,
+		})
+	case string(fieldKey):
+		return nil, errors.New("field keys are not supported")
+	}
+
+	is, cond, err := s.metadataIndexSet(req.Database, req.TimestampRange, req.Predicate)
+	if err != nil || len(is.Indexes) == 0 {
+		return nil, err
+	}
+
+	names, err := is.MeasurementNamesByExpr(nil, cond)
+	if err != nil {
+		return nil, err
+	}
+
+	key := []byte(req.TagKey)
+	tagCond := tagOnlyCondition(cond)
+	itrs := make([]tsdb.TagValueIterator, 0, len(names))
+	defer func() { tsdb.TagValueIterators(itrs).Close() }()
+
+	for _, name := range names {
+		if tagCond == nil {
+			itr, err := is.TagValueIterator(name, key)
+			if err != nil {
+				return nil, err
+			} else if itr != nil {
+				itrs = append(itrs, itr)
+			}
+			continue
+		}
+
+		values, err := is.MeasurementTagKeyValuesByExpr(nil, name, []string{req.TagKey}, tagCond, true)
+		if err != nil {
+			return nil, err
+		} else if len(values[0]) == 0 {
+			continue
+		}
+
+		matched := make([][]byte, 0, len(values[0]))
+		for _, v := range values[0] {
+			matched = append(matched, []byte(v))
+		}
+		itrs = append(itrs, tsdb.NewTagValueSliceIterator(matched))
+	}
+
+	itr := tsdb.MergeTagValueIterators(itrs...)
+	if itr == nil {
+		return nil, nil
+	}
+	return readIterator(itr, req.Limit)
+}
+
+// metadataIndexSet returns an IndexSet for the shards of database overlapping tr and the
+// InfluxQL condition for the predicate. The IndexSet has no indexes if no shards match.
+func (s *Store) metadataIndexSet(database string, tr TimestampRange, pred *Predicate) (tsdb.IndexSet, influxql.Expr, error) {
+	var cond influxql.Expr
+	if root := pred.GetRoot(); root != nil {
+		var err error
+		if cond, err = NodeToExpr(root, measurementRemap); err != nil {
+			return tsdb.IndexSet{}, nil, err
+		}
+
+		// metadata is not associated with field keys or values
+		cond = influxql.Reduce(RewriteExprRemoveFieldKeyAndValue(cond), nil)
+		if b, ok := cond.(*influxql.BooleanLiteral); ok {
+			if !b.Val {
+				return tsdb.IndexSet{}, nil, nil
+			}
+			cond = nil
+		}
+	}
+
+	db, rp := splitDatabase(database)
+	_, _, shardIDs, err := s.findShardIDs(db, rp, tr, false)
+	if err != nil || len(shardIDs) == 0 {
+		return tsdb.IndexSet{}, nil, err
+	}
+
+	is, err := tsdb.Shards(s.TSDBStore.Shards(shardIDs)).IndexSet()
+	if err != nil {
+		return tsdb.IndexSet{}, nil, err
+	}
+
+	return is, cond, nil
+}
+
+// tagOnlyCondition returns a copy of cond with all comparisons not
+// referring to tag keys removed, or nil if no tag comparisons remain.
+func tagOnlyCondition(cond influxql.Expr) influxql.Expr {
+	if cond == nil {
+		return nil
+	}
+
+	expr := influxql.Reduce(influxql.RewriteExpr(influxql.CloneExpr(cond), func(e influxql.Expr) influxql.Expr {
+		if e, ok := e.(*influxql.BinaryExpr); ok {
+			switch e.Op {
+			case influxql.EQ, influxql.NEQ, influxql.EQREGEX, influxql.NEQREGEX:
+				if ref, ok := e.LHS.(*influxql.VarRef); !ok || ref.Val == "_name" {
+					return &influxql.BooleanLiteral{Val: true}
+				}
+			}
+		}
+		return e
+	}), nil)
+
+	if isBooleanLiteral(expr) {
+		return nil
+	}
+	return expr
+}
+
+// bytesIterator is implemented by tsdb.TagKeyIterator and tsdb.TagValueIterator.
+type bytesIterator interface {
+	Next() ([]byte, error)
+}
+
+// readIterator reads up to limit values from itr. A limit of 0 reads all values.
+func readIterator(itr bytesIterator, limit uint64) ([][]byte, error) {
+	var a [][]byte
+	for limit == 0 || uint64(len(a)) < limit {
+		v, err := itr.Next()
+		if err != nil {
+			return nil, err
+		} else if v == nil {
+			break
+		}
+		a = append(a, v)
+	}
+	return a, nil
+}
@@ -0,0 +1,56 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/influxdata/influxdb/tsdb"
+	"github.com/influxdata/influxql"
+)
+
+func TestTagOnlyCondition(t *testing.T) {
+	tests := []struct {
+		cond string
+		exp  string
+	}{
+		{cond: `_name = 'cpu'`, exp: ``},
+		{cond: `_name = 'cpu' AND host = 'a'`, exp: `host = 'a'`},
+		{cond: `_name =~ /^c/ AND (host = 'a' OR region != 'west')`, exp: `host = 'a' OR region != 'west'`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.cond, func(t *testing.T) {
+			cond, err := influxql.ParseExpr(tt.cond)
+			if err != nil {
+				t.Fatal("ParseExpr", err)
+			}
+
+			var got string
+			if expr := tagOnlyCondition(cond); expr != nil {
+				got = expr.String()
+			}
+			if got != tt.exp {
+				t.Errorf("unexpected condition, got %q, exp %q", got, tt.exp)
+			}
+		})
+	}
+}
+
+func TestReadIterator_Limit(t *testing.T) {
+	itr := tsdb.MergeTagValueIterators(
+		tsdb.NewTagValueSliceIterator([][]byte{[]byte("a"), []byte("c")}),
+		tsdb.NewTagValueSliceIterator([][]byte{[]byte("b"), []byte("c"), []byte("d")}),
+	)
+
+	values, err := readIterator(itr, 3)
+	if err != nil {
+		t.Fatal("readIterator", err)
+	}
+
+	var got string
+	for _, v := range values {
+		got += string(v)
+	}
+	if exp := "abc"; got != exp {
+		t.Errorf("unexpected values, got %q, exp %q", got, exp)
+	}
+}
@@ -2,23 +2,30 @@ package storage
 
 import (
 	"context"
+	"strings"
 
 	"github.com/influxdata/influxdb/models"
 	"github.com/influxdata/influxdb/tsdb"
 )
 
+var aggregateKey = []byte("_aggregate")
+
 type readRequest struct {
-	ctx        context.Context
-	start, end int64
-	asc        bool
-	limit      uint64
-	aggregate  *Aggregate
+	ctx           context.Context
+	start, end    int64
+	asc           bool
+	limit         uint64
+	aggregates    []Aggregate
+	window        window
+	tagAggregates bool // add the _aggregate tag to each series
 }
 
 type ResultSet struct {
-	req readRequest
-	cur seriesCursor
-	row seriesRow
+	req  readRequest
+	cur  seriesCursor
+	row  seriesRow
+	n    int // remaining aggregates for the current row
+	tags models.Tags
 }
 
 func (r *ResultSet) Close() {
@@ -27,24 +34,42 @@ func (r *ResultSet) Close() {
 }
 
 func (r *ResultSet) Next() bool {
-	row := r.cur.Next()
-	if row == nil {
-		return false
+	if r.n > 1 {
+		r.n--
+	} else {
+		row := r.cur.Next()
+		if row == nil {
+			return false
+		}
+
+		r.row = *row
+		r.n = len(r.req.aggregates)
 	}
 
-	r.row = *row
+	if r.req.tagAggregates {
+		r.tags = copyTags(r.tags, r.row.tags)
+		r.tags.Set(aggregateKey, []byte(strings.ToLower(r.aggregate().Type.String())))
+	}
 
 	return true
 }
 
+// aggregate returns the aggregate to apply to the current row.
+func (r *ResultSet) aggregate() Aggregate {
+	return r.req.aggregates[len(r.req.aggregates)-r.n]
+}
+
 func (r *ResultSet) Cursor() tsdb.Cursor {
 	cur := newMultiShardBatchCursor(r.req.ctx, r.row, &r.req)
-	if r.req.aggregate != nil {
-		cur = newAggregateBatchCursor(r.req.ctx, r.req.aggregate, cur)
+	if len(r.req.aggregates) > 0 {
+		cur = newAggregateBatchCursor(r.req.ctx, r.aggregate(), r.req.window, cur)
 	}
 	return cur
 }
 
 func (r *ResultSet) Tags() models.Tags {
+	if r.req.tagAggregates {
+		return r.tags
+	}
 	return r.row.tags
 }
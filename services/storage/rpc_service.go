@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"math"
 	"strings"
 
@@ -41,6 +42,106 @@ func (r *rpcService) Hints(context.Context, *types.Empty) (*HintsResponse, error
 	return nil, errors.New("not implemented")
 }
 
+func (r *rpcService) Explain(ctx context.Context, req *ExplainRequest) (*ExplainResponse, error) {
+	if r.loggingEnabled {
+		r.Logger.Info("explain request",
+			zap.String("database", req.ReadRequest.Database),
+			zap.String("predicate", truncateString(PredicateToExprString(req.ReadRequest.Predicate))),
+		)
+	}
+
+	res, err := r.Store.Explain(ctx, &req.ReadRequest)
+	if err != nil {
+		r.Logger.Error("Store.Explain failed", zap.Error(err))
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *rpcService) Write(stream Storage_WriteServer) error {
+	// TODO(sgc): use yarpc stream.Context() once implemented
+	ctx := context.Background()
+
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		res, err := r.Store.Write(ctx, req.Database, req.Frames)
+		if err != nil {
+			r.Logger.Error("Store.Write failed", zap.Error(err))
+			return err
+		}
+
+		if r.loggingEnabled && res.Error != "" {
+			r.Logger.Info("write request",
+				zap.String("database", req.Database),
+				zap.Int64("points_written", res.PointsN),
+				zap.Int64("points_dropped", res.DroppedN),
+				zap.String("error", res.Error),
+			)
+		}
+
+		if err := stream.Send(res); err != nil {
+			r.Logger.Error("stream.Send failed", zap.Error(err))
+			return err
+		}
+	}
+}
+
+func (r *rpcService) TagKeys(req *TagKeysRequest, stream Storage_TagKeysServer) error {
+	keys, err := r.Store.TagKeys(context.Background(), req)
+	if err != nil {
+		r.Logger.Error("Store.TagKeys failed", zap.Error(err))
+		return err
+	}
+	return r.sendStringValues(stream, keys)
+}
+
+func (r *rpcService) TagValues(req *TagValuesRequest, stream Storage_TagValuesServer) error {
+	values, err := r.Store.TagValues(context.Background(), req)
+	if err != nil {
+		r.Logger.Error("Store.TagValues failed", zap.Error(err))
+		return err
+	}
+	return r.sendStringValues(stream, values)
+}
+
+func (r *rpcService) MeasurementNames(req *MeasurementNamesRequest, stream Storage_MeasurementNamesServer) error {
+	names, err := r.Store.MeasurementNames(context.Background(), req)
+	if err != nil {
+		r.Logger.Error("Store.MeasurementNames failed", zap.Error(err))
+		return err
+	}
+	return r.sendStringValues(stream, names)
+}
+
+// stringValuesServer is implemented by the servers of the metadata RPCs.
+type stringValuesServer interface {
+	Send(*StringValuesResponse) error
+}
+
+// sendStringValues streams values in batches of at most batchSize.
+func (r *rpcService) sendStringValues(stream stringValuesServer, values [][]byte) error {
+	for len(values) > 0 {
+		n := len(values)
+		if n > batchSize {
+			n = batchSize
+		}
+
+		if err := stream.Send(&StringValuesResponse{Values: values[:n]}); err != nil {
+			r.Logger.Error("stream.Send failed", zap.Error(err))
+			return err
+		}
+		values = values[n:]
+	}
+	return nil
+}
+
 func (r *rpcService) Read(req *ReadRequest, stream Storage_ReadServer) error {
 	// TODO(sgc): implement frameWriter that handles the details of streaming frames
 	var err error
@@ -62,10 +163,14 @@ func (r *rpcService) Read(req *ReadRequest, stream Storage_ReadServer) error {
 	// TODO(sgc): this should be available via a generic API, such as tsdb.Store
 	ctx = tsm1.NewContextWithMetricsGroup(ctx)
 
-	var agg Aggregate_AggregateType
+	var aggs []string
 	if req.Aggregate != nil {
-		agg = req.Aggregate.Type
+		aggs = append(aggs, req.Aggregate.Type.String())
+	}
+	for _, agg := range req.Aggregates {
+		aggs = append(aggs, agg.Type.String())
 	}
+	agg := strings.Join(aggs, ",")
 	pred := truncateString(PredicateToExprString(req.Predicate))
 	groupKeys := truncateString(strings.Join(req.Grouping, ","))
 	span.
@@ -77,7 +182,9 @@ func (r *rpcService) Read(req *ReadRequest, stream Storage_ReadServer) error {
 		SetTag("end", req.TimestampRange.End).
 		SetTag("desc", req.Descending).
 		SetTag("group_keys", groupKeys).
-		SetTag("aggregate", agg.String())
+		SetTag("aggregate", agg).
+		SetTag("window_every", req.Window.Every).
+		SetTag("window_offset", req.Window.Offset)
 
 	if r.loggingEnabled {
 		r.Logger.Info("request",
@@ -90,7 +197,9 @@ func (r *rpcService) Read(req *ReadRequest, stream Storage_ReadServer) error {
 			zap.Int64("end", req.TimestampRange.End),
 			zap.Bool("desc", req.Descending),
 			zap.String("group_keys", groupKeys),
-			zap.String("aggregate", agg.String()),
+			zap.String("aggregate", agg),
+			zap.Int64("window_every", req.Window.Every),
+			zap.Int64("window_offset", req.Window.Offset),
 		)
 	}
 
@@ -51,6 +51,7 @@ type indexSeriesCursor struct {
 	filterset       mapValuer
 	cond            influxql.Expr
 	measurementCond influxql.Expr
+	seriesCond      influxql.Expr
 	row             seriesRow
 	eof             bool
 	hasFieldExpr    bool
@@ -118,6 +119,8 @@ func newIndexSeriesCursor(ctx context.Context, req *ReadRequest, shards []*tsdb.
 		}
 	}
 
+	p.seriesCond = opt.Condition
+
 	sg := tsdb.Shards(shards)
 	p.sqry, err = sg.CreateSeriesCursor(ctx, tsdb.SeriesCursorRequest{Measurements: mi}, opt.Condition)
 	if p.sqry != nil && err == nil {
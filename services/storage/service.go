@@ -3,6 +3,7 @@ package storage
 import (
 	"time"
 
+	"github.com/influxdata/influxdb/models"
 	"github.com/influxdata/influxdb/services/meta"
 	"github.com/influxdata/influxdb/tsdb"
 	"go.uber.org/zap"
@@ -18,11 +19,16 @@ type Service struct {
 	addr           string
 	yarpc          *yarpcServer
 	loggingEnabled bool
+	writeEnabled   bool
 	logger         *zap.Logger
 
 	Store      *Store
 	TSDBStore  *tsdb.Store
 	MetaClient StorageMetaClient
+
+	PointsWriter interface {
+		WritePointsPrivileged(database, retentionPolicy string, consistencyLevel models.ConsistencyLevel, points []models.Point) error
+	}
 }
 
 // NewService returns a new instance of Service.
@@ -30,6 +36,7 @@ func NewService(c Config) *Service {
 	s := &Service{
 		addr:           c.BindAddress,
 		loggingEnabled: c.LogEnabled,
+		writeEnabled:   c.WriteEnabled,
 		logger:         zap.NewNop(),
 	}
 
@@ -49,6 +56,9 @@ func (s *Service) Open() error {
 	store.TSDBStore = s.TSDBStore
 	store.MetaClient = s.MetaClient
 	store.Logger = s.logger
+	if s.writeEnabled {
+		store.PointsWriter = s.PointsWriter
+	}
 
 	yarpc := &yarpcServer{
 		addr:           s.addr,
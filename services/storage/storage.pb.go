@@ -11,11 +11,21 @@
 	It has these top-level messages:
 		ReadRequest
 		Aggregate
+		Window
 		Tag
 		ReadResponse
+		WriteRequest
+		WriteResponse
+		TagKeysRequest
+		TagValuesRequest
+		MeasurementNamesRequest
+		StringValuesResponse
 		CapabilitiesResponse
 		HintsResponse
 		TimestampRange
+		ExplainRequest
+		ExplainResponse
+		Cost
 		Node
 		Predicate
 */
@@ -28,6 +38,8 @@ import _ "github.com/gogo/protobuf/gogoproto"
 import _ "github.com/gogo/protobuf/types"
 import _ "github.com/influxdata/yarpc/yarpcproto"
 
+import binary "encoding/binary"
+
 import io "io"
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -68,17 +80,32 @@ const (
 	AggregateTypeNone  Aggregate_AggregateType = 0
 	AggregateTypeSum   Aggregate_AggregateType = 1
 	AggregateTypeCount Aggregate_AggregateType = 2
+	AggregateTypeMin   Aggregate_AggregateType = 3
+	AggregateTypeMax   Aggregate_AggregateType = 4
+	AggregateTypeMean  Aggregate_AggregateType = 5
+	AggregateTypeFirst Aggregate_AggregateType = 6
+	AggregateTypeLast  Aggregate_AggregateType = 7
 )
 
 var Aggregate_AggregateType_name = map[int32]string{
 	0: "NONE",
 	1: "SUM",
 	2: "COUNT",
+	3: "MIN",
+	4: "MAX",
+	5: "MEAN",
+	6: "FIRST",
+	7: "LAST",
 }
 var Aggregate_AggregateType_value = map[string]int32{
 	"NONE":  0,
 	"SUM":   1,
 	"COUNT": 2,
+	"MIN":   3,
+	"MAX":   4,
+	"MEAN":  5,
+	"FIRST": 6,
+	"LAST":  7,
 }
 
 func (x Aggregate_AggregateType) String() string {
@@ -108,7 +135,7 @@ func (x ReadResponse_FrameType) String() string {
 	return proto.EnumName(ReadResponse_FrameType_name, int32(x))
 }
 func (ReadResponse_FrameType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptorStorage, []int{3, 0}
+	return fileDescriptorStorage, []int{4, 0}
 }
 
 type ReadResponse_DataType int32
@@ -140,7 +167,7 @@ func (x ReadResponse_DataType) String() string {
 	return proto.EnumName(ReadResponse_DataType_name, int32(x))
 }
 func (ReadResponse_DataType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptorStorage, []int{3, 1}
+	return fileDescriptorStorage, []int{4, 1}
 }
 
 // Request message for Storage.Read.
@@ -157,8 +184,13 @@ type ReadRequest struct {
 	// Grouping specifies a list of tags used to order the data
 	Grouping []string `protobuf:"bytes,4,rep,name=grouping" json:"grouping,omitempty"`
 	// Aggregate specifies an optional aggregate to apply to the data.
-	// TODO(sgc): switch to slice for multiple aggregates in a single request
+	// Deprecated: use Aggregates, which takes precedence when specified.
 	Aggregate *Aggregate `protobuf:"bytes,9,opt,name=aggregate" json:"aggregate,omitempty"`
+	// Aggregates specifies an optional list of aggregates to apply to the data.
+	// Each series is returned once per aggregate, identified by the _aggregate tag.
+	Aggregates []Aggregate `protobuf:"bytes,13,rep,name=aggregates" json:"aggregates"`
+	// Window specifies an optional time window used to group points when applying aggregates.
+	Window    Window     `protobuf:"bytes,14,opt,name=window" json:"window"`
 	Predicate *Predicate `protobuf:"bytes,5,opt,name=predicate" json:"predicate,omitempty"`
 	// SeriesLimit determines the maximum number of series to be returned for the request. Specify 0 for no limit.
 	SeriesLimit uint64 `protobuf:"varint,6,opt,name=series_limit,json=seriesLimit,proto3" json:"series_limit,omitempty"`
@@ -185,6 +217,20 @@ func (m *Aggregate) String() string            { return proto.CompactTextString(
 func (*Aggregate) ProtoMessage()               {}
 func (*Aggregate) Descriptor() ([]byte, []int) { return fileDescriptorStorage, []int{1} }
 
+// Window groups points into fixed intervals of time.
+type Window struct {
+	// Every specifies the duration of each window in nanoseconds.
+	// Specify 0 to aggregate all points of a series into a single value.
+	Every int64 `protobuf:"varint,1,opt,name=every,proto3" json:"every,omitempty"`
+	// Offset specifies a duration in nanoseconds used to shift the window boundaries.
+	Offset int64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+}
+
+func (m *Window) Reset()                    { *m = Window{} }
+func (m *Window) String() string            { return proto.CompactTextString(m) }
+func (*Window) ProtoMessage()               {}
+func (*Window) Descriptor() ([]byte, []int) { return fileDescriptorStorage, []int{2} }
+
 type Tag struct {
 	Key   []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
@@ -193,7 +239,7 @@ type Tag struct {
 func (m *Tag) Reset()                    { *m = Tag{} }
 func (m *Tag) String() string            { return proto.CompactTextString(m) }
 func (*Tag) ProtoMessage()               {}
-func (*Tag) Descriptor() ([]byte, []int) { return fileDescriptorStorage, []int{2} }
+func (*Tag) Descriptor() ([]byte, []int) { return fileDescriptorStorage, []int{3} }
 
 // Response message for Storage.Read.
 type ReadResponse struct {
@@ -203,7 +249,7 @@ type ReadResponse struct {
 func (m *ReadResponse) Reset()                    { *m = ReadResponse{} }
 func (m *ReadResponse) String() string            { return proto.CompactTextString(m) }
 func (*ReadResponse) ProtoMessage()               {}
-func (*ReadResponse) Descriptor() ([]byte, []int) { return fileDescriptorStorage, []int{3} }
+func (*ReadResponse) Descriptor() ([]byte, []int) { return fileDescriptorStorage, []int{4} }
 
 type ReadResponse_Frame struct {
 	// Types that are valid to be assigned to Data:
@@ -219,7 +265,7 @@ type ReadResponse_Frame struct {
 func (m *ReadResponse_Frame) Reset()                    { *m = ReadResponse_Frame{} }
 func (m *ReadResponse_Frame) String() string            { return proto.CompactTextString(m) }
 func (*ReadResponse_Frame) ProtoMessage()               {}
-func (*ReadResponse_Frame) Descriptor() ([]byte, []int) { return fileDescriptorStorage, []int{3, 0} }
+func (*ReadResponse_Frame) Descriptor() ([]byte, []int) { return fileDescriptorStorage, []int{4, 0} }
 
 type isReadResponse_Frame_Data interface {
 	isReadResponse_Frame_Data()
@@ -461,7 +507,7 @@ func (m *ReadResponse_SeriesFrame) Reset()         { *m = ReadResponse_SeriesFra
 func (m *ReadResponse_SeriesFrame) String() string { return proto.CompactTextString(m) }
 func (*ReadResponse_SeriesFrame) ProtoMessage()    {}
 func (*ReadResponse_SeriesFrame) Descriptor() ([]byte, []int) {
-	return fileDescriptorStorage, []int{3, 1}
+	return fileDescriptorStorage, []int{4, 1}
 }
 
 type ReadResponse_FloatPointsFrame struct {
@@ -473,7 +519,7 @@ func (m *ReadResponse_FloatPointsFrame) Reset()         { *m = ReadResponse_Floa
 func (m *ReadResponse_FloatPointsFrame) String() string { return proto.CompactTextString(m) }
 func (*ReadResponse_FloatPointsFrame) ProtoMessage()    {}
 func (*ReadResponse_FloatPointsFrame) Descriptor() ([]byte, []int) {
-	return fileDescriptorStorage, []int{3, 2}
+	return fileDescriptorStorage, []int{4, 2}
 }
 
 type ReadResponse_IntegerPointsFrame struct {
@@ -485,7 +531,7 @@ func (m *ReadResponse_IntegerPointsFrame) Reset()         { *m = ReadResponse_In
 func (m *ReadResponse_IntegerPointsFrame) String() string { return proto.CompactTextString(m) }
 func (*ReadResponse_IntegerPointsFrame) ProtoMessage()    {}
 func (*ReadResponse_IntegerPointsFrame) Descriptor() ([]byte, []int) {
-	return fileDescriptorStorage, []int{3, 3}
+	return fileDescriptorStorage, []int{4, 3}
 }
 
 type ReadResponse_UnsignedPointsFrame struct {
@@ -497,7 +543,7 @@ func (m *ReadResponse_UnsignedPointsFrame) Reset()         { *m = ReadResponse_U
 func (m *ReadResponse_UnsignedPointsFrame) String() string { return proto.CompactTextString(m) }
 func (*ReadResponse_UnsignedPointsFrame) ProtoMessage()    {}
 func (*ReadResponse_UnsignedPointsFrame) Descriptor() ([]byte, []int) {
-	return fileDescriptorStorage, []int{3, 4}
+	return fileDescriptorStorage, []int{4, 4}
 }
 
 type ReadResponse_BooleanPointsFrame struct {
@@ -509,7 +555,7 @@ func (m *ReadResponse_BooleanPointsFrame) Reset()         { *m = ReadResponse_Bo
 func (m *ReadResponse_BooleanPointsFrame) String() string { return proto.CompactTextString(m) }
 func (*ReadResponse_BooleanPointsFrame) ProtoMessage()    {}
 func (*ReadResponse_BooleanPointsFrame) Descriptor() ([]byte, []int) {
-	return fileDescriptorStorage, []int{3, 5}
+	return fileDescriptorStorage, []int{4, 5}
 }
 
 type ReadResponse_StringPointsFrame struct {
@@ -521,9 +567,99 @@ func (m *ReadResponse_StringPointsFrame) Reset()         { *m = ReadResponse_Str
 func (m *ReadResponse_StringPointsFrame) String() string { return proto.CompactTextString(m) }
 func (*ReadResponse_StringPointsFrame) ProtoMessage()    {}
 func (*ReadResponse_StringPointsFrame) Descriptor() ([]byte, []int) {
-	return fileDescriptorStorage, []int{3, 6}
+	return fileDescriptorStorage, []int{4, 6}
+}
+
+// Request message for Storage.Write.
+type WriteRequest struct {
+	// Database specifies the database name, optionally followed by a slash and the retention policy.
+	Database string `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
+	// Frames contains the series to be written, using the same layout as ReadResponse.
+	// Each series frame, which must include the _measurement and _field tags, is followed by
+	// one or more points frames matching the data type of the series.
+	Frames []ReadResponse_Frame `protobuf:"bytes,2,rep,name=frames" json:"frames"`
+}
+
+func (m *WriteRequest) Reset()                    { *m = WriteRequest{} }
+func (m *WriteRequest) String() string            { return proto.CompactTextString(m) }
+func (*WriteRequest) ProtoMessage()               {}
+func (*WriteRequest) Descriptor() ([]byte, []int) { return fileDescriptorStorage, []int{5} }
+
+// Response message for Storage.Write, sent for each WriteRequest.
+type WriteResponse struct {
+	// PointsN is the number of points written from the batch.
+	PointsN int64 `protobuf:"varint,1,opt,name=points_n,json=pointsN,proto3" json:"points_n,omitempty"`
+	// DroppedN is the number of points from the batch that were not written.
+	DroppedN int64 `protobuf:"varint,2,opt,name=dropped_n,json=droppedN,proto3" json:"dropped_n,omitempty"`
+	// Error describes why points were not written. Empty if the batch was written in its entirety.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *WriteResponse) Reset()                    { *m = WriteResponse{} }
+func (m *WriteResponse) String() string            { return proto.CompactTextString(m) }
+func (*WriteResponse) ProtoMessage()               {}
+func (*WriteResponse) Descriptor() ([]byte, []int) { return fileDescriptorStorage, []int{6} }
+
+// Request message for Storage.TagKeys.
+type TagKeysRequest struct {
+	// Database specifies the database name, optionally followed by a slash and the retention policy.
+	Database       string         `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
+	TimestampRange TimestampRange `protobuf:"bytes,2,opt,name=timestamp_range,json=timestampRange" json:"timestamp_range"`
+	// Predicate filters the series by measurement and tag values. Field references are ignored.
+	Predicate *Predicate `protobuf:"bytes,3,opt,name=predicate" json:"predicate,omitempty"`
+	// Limit determines the maximum number of values to be returned. Specify 0 for no limit.
+	Limit uint64 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (m *TagKeysRequest) Reset()                    { *m = TagKeysRequest{} }
+func (m *TagKeysRequest) String() string            { return proto.CompactTextString(m) }
+func (*TagKeysRequest) ProtoMessage()               {}
+func (*TagKeysRequest) Descriptor() ([]byte, []int) { return fileDescriptorStorage, []int{7} }
+
+// Request message for Storage.TagValues.
+type TagValuesRequest struct {
+	// Database specifies the database name, optionally followed by a slash and the retention policy.
+	Database       string         `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
+	TimestampRange TimestampRange `protobuf:"bytes,2,opt,name=timestamp_range,json=timestampRange" json:"timestamp_range"`
+	// Predicate filters the series by measurement and tag values. Field references are ignored.
+	Predicate *Predicate `protobuf:"bytes,3,opt,name=predicate" json:"predicate,omitempty"`
+	// TagKey specifies the tag key for which values are returned.
+	TagKey string `protobuf:"bytes,4,opt,name=tag_key,json=tagKey,proto3" json:"tag_key,omitempty"`
+	// Limit determines the maximum number of values to be returned. Specify 0 for no limit.
+	Limit uint64 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (m *TagValuesRequest) Reset()                    { *m = TagValuesRequest{} }
+func (m *TagValuesRequest) String() string            { return proto.CompactTextString(m) }
+func (*TagValuesRequest) ProtoMessage()               {}
+func (*TagValuesRequest) Descriptor() ([]byte, []int) { return fileDescriptorStorage, []int{8} }
+
+// Request message for Storage.MeasurementNames.
+type MeasurementNamesRequest struct {
+	// Database specifies the database name, optionally followed by a slash and the retention policy.
+	Database       string         `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
+	TimestampRange TimestampRange `protobuf:"bytes,2,opt,name=timestamp_range,json=timestampRange" json:"timestamp_range"`
+	// Predicate filters the series by measurement and tag values. Field references are ignored.
+	Predicate *Predicate `protobuf:"bytes,3,opt,name=predicate" json:"predicate,omitempty"`
+	// Limit determines the maximum number of values to be returned. Specify 0 for no limit.
+	Limit uint64 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
+func (m *MeasurementNamesRequest) Reset()                    { *m = MeasurementNamesRequest{} }
+func (m *MeasurementNamesRequest) String() string            { return proto.CompactTextString(m) }
+func (*MeasurementNamesRequest) ProtoMessage()               {}
+func (*MeasurementNamesRequest) Descriptor() ([]byte, []int) { return fileDescriptorStorage, []int{9} }
+
+// Response message for Storage.TagKeys, Storage.TagValues and Storage.MeasurementNames.
+type StringValuesResponse struct {
+	Values [][]byte `protobuf:"bytes,1,rep,name=values" json:"values,omitempty"`
+}
+
+func (m *StringValuesResponse) Reset()                    { *m = StringValuesResponse{} }
+func (m *StringValuesResponse) String() string            { return proto.CompactTextString(m) }
+func (*StringValuesResponse) ProtoMessage()               {}
+func (*StringValuesResponse) Descriptor() ([]byte, []int) { return fileDescriptorStorage, []int{10} }
+
 type CapabilitiesResponse struct {
 	Caps map[string]string `protobuf:"bytes,1,rep,name=caps" json:"caps,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
@@ -531,7 +667,7 @@ type CapabilitiesResponse struct {
 func (m *CapabilitiesResponse) Reset()                    { *m = CapabilitiesResponse{} }
 func (m *CapabilitiesResponse) String() string            { return proto.CompactTextString(m) }
 func (*CapabilitiesResponse) ProtoMessage()               {}
-func (*CapabilitiesResponse) Descriptor() ([]byte, []int) { return fileDescriptorStorage, []int{4} }
+func (*CapabilitiesResponse) Descriptor() ([]byte, []int) { return fileDescriptorStorage, []int{11} }
 
 type HintsResponse struct {
 }
@@ -539,7 +675,7 @@ type HintsResponse struct {
 func (m *HintsResponse) Reset()                    { *m = HintsResponse{} }
 func (m *HintsResponse) String() string            { return proto.CompactTextString(m) }
 func (*HintsResponse) ProtoMessage()               {}
-func (*HintsResponse) Descriptor() ([]byte, []int) { return fileDescriptorStorage, []int{5} }
+func (*HintsResponse) Descriptor() ([]byte, []int) { return fileDescriptorStorage, []int{12} }
 
 // Specifies a continuous range of nanosecond timestamps.
 type TimestampRange struct {
@@ -552,11 +688,67 @@ type TimestampRange struct {
 func (m *TimestampRange) Reset()                    { *m = TimestampRange{} }
 func (m *TimestampRange) String() string            { return proto.CompactTextString(m) }
 func (*TimestampRange) ProtoMessage()               {}
-func (*TimestampRange) Descriptor() ([]byte, []int) { return fileDescriptorStorage, []int{6} }
+func (*TimestampRange) Descriptor() ([]byte, []int) { return fileDescriptorStorage, []int{13} }
+
+// Request message for Storage.Explain.
+type ExplainRequest struct {
+	ReadRequest ReadRequest `protobuf:"bytes,1,opt,name=read_request,json=readRequest" json:"read_request"`
+}
+
+func (m *ExplainRequest) Reset()                    { *m = ExplainRequest{} }
+func (m *ExplainRequest) String() string            { return proto.CompactTextString(m) }
+func (*ExplainRequest) ProtoMessage()               {}
+func (*ExplainRequest) Descriptor() ([]byte, []int) { return fileDescriptorStorage, []int{14} }
+
+// Response message for Storage.Explain.
+type ExplainResponse struct {
+	// ShardIDs contains the identifiers of the shards touched by the request.
+	ShardIDs []uint64 `protobuf:"varint,1,rep,packed,name=shard_ids,json=shardIds" json:"shard_ids,omitempty"`
+	// SeriesN is the number of series keys matched by the index.
+	SeriesN int64 `protobuf:"varint,2,opt,name=series_n,json=seriesN,proto3" json:"series_n,omitempty"`
+	// CursorN is the number of series key and field combinations, which matches the
+	// number of cursors created when executing the request.
+	CursorN int64 `protobuf:"varint,3,opt,name=cursor_n,json=cursorN,proto3" json:"cursor_n,omitempty"`
+	// Predicate is the request predicate after being converted to an InfluxQL expression.
+	Predicate string `protobuf:"bytes,4,opt,name=predicate,proto3" json:"predicate,omitempty"`
+	// SeriesPredicate is the predicate used to filter series keys in the index,
+	// after removing field key and value references.
+	SeriesPredicate string `protobuf:"bytes,5,opt,name=series_predicate,json=seriesPredicate,proto3" json:"series_predicate,omitempty"`
+	// Cost contains the estimated cost of reading the matched series.
+	Cost Cost `protobuf:"bytes,6,opt,name=cost" json:"cost"`
+}
+
+func (m *ExplainResponse) Reset()                    { *m = ExplainResponse{} }
+func (m *ExplainResponse) String() string            { return proto.CompactTextString(m) }
+func (*ExplainResponse) ProtoMessage()               {}
+func (*ExplainResponse) Descriptor() ([]byte, []int) { return fileDescriptorStorage, []int{15} }
+
+// Cost contains statistics for explaining what potential costs may be
+// incurred by reading a set of series.
+type Cost struct {
+	// NumShards is the total number of shards that are touched.
+	NumShards int64 `protobuf:"varint,1,opt,name=num_shards,json=numShards,proto3" json:"num_shards,omitempty"`
+	// NumSeries is the total number of non-unique series that are accessed.
+	NumSeries int64 `protobuf:"varint,2,opt,name=num_series,json=numSeries,proto3" json:"num_series,omitempty"`
+	// CachedValues is the number of cached values that may be read.
+	CachedValues int64 `protobuf:"varint,3,opt,name=cached_values,json=cachedValues,proto3" json:"cached_values,omitempty"`
+	// NumFiles is the total number of non-unique files that may be accessed.
+	NumFiles int64 `protobuf:"varint,4,opt,name=num_files,json=numFiles,proto3" json:"num_files,omitempty"`
+	// BlocksRead is the number of blocks that had the potential to be accessed.
+	BlocksRead int64 `protobuf:"varint,5,opt,name=blocks_read,json=blocksRead,proto3" json:"blocks_read,omitempty"`
+	// BlockSize is the amount of data in bytes that can be potentially read.
+	BlockSize int64 `protobuf:"varint,6,opt,name=block_size,json=blockSize,proto3" json:"block_size,omitempty"`
+}
+
+func (m *Cost) Reset()                    { *m = Cost{} }
+func (m *Cost) String() string            { return proto.CompactTextString(m) }
+func (*Cost) ProtoMessage()               {}
+func (*Cost) Descriptor() ([]byte, []int) { return fileDescriptorStorage, []int{16} }
 
 func init() {
 	proto.RegisterType((*ReadRequest)(nil), "storage.ReadRequest")
 	proto.RegisterType((*Aggregate)(nil), "storage.Aggregate")
+	proto.RegisterType((*Window)(nil), "storage.Window")
 	proto.RegisterType((*Tag)(nil), "storage.Tag")
 	proto.RegisterType((*ReadResponse)(nil), "storage.ReadResponse")
 	proto.RegisterType((*ReadResponse_Frame)(nil), "storage.ReadResponse.Frame")
@@ -566,9 +758,18 @@ func init() {
 	proto.RegisterType((*ReadResponse_UnsignedPointsFrame)(nil), "storage.ReadResponse.UnsignedPointsFrame")
 	proto.RegisterType((*ReadResponse_BooleanPointsFrame)(nil), "storage.ReadResponse.BooleanPointsFrame")
 	proto.RegisterType((*ReadResponse_StringPointsFrame)(nil), "storage.ReadResponse.StringPointsFrame")
+	proto.RegisterType((*WriteRequest)(nil), "storage.WriteRequest")
+	proto.RegisterType((*WriteResponse)(nil), "storage.WriteResponse")
+	proto.RegisterType((*TagKeysRequest)(nil), "storage.TagKeysRequest")
+	proto.RegisterType((*TagValuesRequest)(nil), "storage.TagValuesRequest")
+	proto.RegisterType((*MeasurementNamesRequest)(nil), "storage.MeasurementNamesRequest")
+	proto.RegisterType((*StringValuesResponse)(nil), "storage.StringValuesResponse")
 	proto.RegisterType((*CapabilitiesResponse)(nil), "storage.CapabilitiesResponse")
 	proto.RegisterType((*HintsResponse)(nil), "storage.HintsResponse")
 	proto.RegisterType((*TimestampRange)(nil), "storage.TimestampRange")
+	proto.RegisterType((*ExplainRequest)(nil), "storage.ExplainRequest")
+	proto.RegisterType((*ExplainResponse)(nil), "storage.ExplainResponse")
+	proto.RegisterType((*Cost)(nil), "storage.Cost")
 	proto.RegisterEnum("storage.ReadRequest_Type", ReadRequest_Type_name, ReadRequest_Type_value)
 	proto.RegisterEnum("storage.Aggregate_AggregateType", Aggregate_AggregateType_name, Aggregate_AggregateType_value)
 	proto.RegisterEnum("storage.ReadResponse_FrameType", ReadResponse_FrameType_name, ReadResponse_FrameType_value)
@@ -691,6 +892,26 @@ func (m *ReadRequest) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintStorage(dAtA, i, uint64(len(m.OrgID)))
 		i += copy(dAtA[i:], m.OrgID)
 	}
+	if len(m.Aggregates) > 0 {
+		for _, msg := range m.Aggregates {
+			dAtA[i] = 0x6a
+			i++
+			i = encodeVarintStorage(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	dAtA[i] = 0x72
+	i++
+	i = encodeVarintStorage(dAtA, i, uint64(m.Window.Size()))
+	n4, err := m.Window.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n4
 	return i, nil
 }
 
@@ -717,6 +938,34 @@ func (m *Aggregate) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *Window) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Window) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Every != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintStorage(dAtA, i, uint64(m.Every))
+	}
+	if m.Offset != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintStorage(dAtA, i, uint64(m.Offset))
+	}
+	return i, nil
+}
+
 func (m *Tag) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -793,11 +1042,11 @@ func (m *ReadResponse_Frame) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Data != nil {
-		nn4, err := m.Data.MarshalTo(dAtA[i:])
+		nn5, err := m.Data.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += nn4
+		i += nn5
 	}
 	return i, nil
 }
@@ -808,11 +1057,11 @@ func (m *ReadResponse_Frame_Series) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintStorage(dAtA, i, uint64(m.Series.Size()))
-		n5, err := m.Series.MarshalTo(dAtA[i:])
+		n6, err := m.Series.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n5
+		i += n6
 	}
 	return i, nil
 }
@@ -822,11 +1071,11 @@ func (m *ReadResponse_Frame_FloatPoints) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintStorage(dAtA, i, uint64(m.FloatPoints.Size()))
-		n6, err := m.FloatPoints.MarshalTo(dAtA[i:])
+		n7, err := m.FloatPoints.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n6
+		i += n7
 	}
 	return i, nil
 }
@@ -836,11 +1085,11 @@ func (m *ReadResponse_Frame_IntegerPoints) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintStorage(dAtA, i, uint64(m.IntegerPoints.Size()))
-		n7, err := m.IntegerPoints.MarshalTo(dAtA[i:])
+		n8, err := m.IntegerPoints.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n7
+		i += n8
 	}
 	return i, nil
 }
@@ -850,11 +1099,11 @@ func (m *ReadResponse_Frame_UnsignedPoints) MarshalTo(dAtA []byte) (int, error)
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintStorage(dAtA, i, uint64(m.UnsignedPoints.Size()))
-		n8, err := m.UnsignedPoints.MarshalTo(dAtA[i:])
+		n9, err := m.UnsignedPoints.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n8
+		i += n9
 	}
 	return i, nil
 }
@@ -864,11 +1113,11 @@ func (m *ReadResponse_Frame_BooleanPoints) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x2a
 		i++
 		i = encodeVarintStorage(dAtA, i, uint64(m.BooleanPoints.Size()))
-		n9, err := m.BooleanPoints.MarshalTo(dAtA[i:])
+		n10, err := m.BooleanPoints.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n9
+		i += n10
 	}
 	return i, nil
 }
@@ -878,11 +1127,11 @@ func (m *ReadResponse_Frame_StringPoints) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x32
 		i++
 		i = encodeVarintStorage(dAtA, i, uint64(m.StringPoints.Size()))
-		n10, err := m.StringPoints.MarshalTo(dAtA[i:])
+		n11, err := m.StringPoints.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n10
+		i += n11
 	}
 	return i, nil
 }
@@ -941,22 +1190,8 @@ func (m *ReadResponse_FloatPointsFrame) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintStorage(dAtA, i, uint64(len(m.Timestamps)*8))
 		for _, num := range m.Timestamps {
-			dAtA[i] = uint8(num)
-			i++
-			dAtA[i] = uint8(num >> 8)
-			i++
-			dAtA[i] = uint8(num >> 16)
-			i++
-			dAtA[i] = uint8(num >> 24)
-			i++
-			dAtA[i] = uint8(num >> 32)
-			i++
-			dAtA[i] = uint8(num >> 40)
-			i++
-			dAtA[i] = uint8(num >> 48)
-			i++
-			dAtA[i] = uint8(num >> 56)
-			i++
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(num))
+			i += 8
 		}
 	}
 	if len(m.Values) > 0 {
@@ -964,23 +1199,9 @@ func (m *ReadResponse_FloatPointsFrame) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintStorage(dAtA, i, uint64(len(m.Values)*8))
 		for _, num := range m.Values {
-			f11 := math.Float64bits(float64(num))
-			dAtA[i] = uint8(f11)
-			i++
-			dAtA[i] = uint8(f11 >> 8)
-			i++
-			dAtA[i] = uint8(f11 >> 16)
-			i++
-			dAtA[i] = uint8(f11 >> 24)
-			i++
-			dAtA[i] = uint8(f11 >> 32)
-			i++
-			dAtA[i] = uint8(f11 >> 40)
-			i++
-			dAtA[i] = uint8(f11 >> 48)
-			i++
-			dAtA[i] = uint8(f11 >> 56)
-			i++
+			f12 := math.Float64bits(float64(num))
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(f12))
+			i += 8
 		}
 	}
 	return i, nil
@@ -1006,41 +1227,27 @@ func (m *ReadResponse_IntegerPointsFrame) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintStorage(dAtA, i, uint64(len(m.Timestamps)*8))
 		for _, num := range m.Timestamps {
-			dAtA[i] = uint8(num)
-			i++
-			dAtA[i] = uint8(num >> 8)
-			i++
-			dAtA[i] = uint8(num >> 16)
-			i++
-			dAtA[i] = uint8(num >> 24)
-			i++
-			dAtA[i] = uint8(num >> 32)
-			i++
-			dAtA[i] = uint8(num >> 40)
-			i++
-			dAtA[i] = uint8(num >> 48)
-			i++
-			dAtA[i] = uint8(num >> 56)
-			i++
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(num))
+			i += 8
 		}
 	}
 	if len(m.Values) > 0 {
-		dAtA13 := make([]byte, len(m.Values)*10)
-		var j12 int
+		dAtA14 := make([]byte, len(m.Values)*10)
+		var j13 int
 		for _, num1 := range m.Values {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA13[j12] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA14[j13] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j12++
+				j13++
 			}
-			dAtA13[j12] = uint8(num)
-			j12++
+			dAtA14[j13] = uint8(num)
+			j13++
 		}
 		dAtA[i] = 0x12
 		i++
-		i = encodeVarintStorage(dAtA, i, uint64(j12))
-		i += copy(dAtA[i:], dAtA13[:j12])
+		i = encodeVarintStorage(dAtA, i, uint64(j13))
+		i += copy(dAtA[i:], dAtA14[:j13])
 	}
 	return i, nil
 }
@@ -1065,40 +1272,26 @@ func (m *ReadResponse_UnsignedPointsFrame) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintStorage(dAtA, i, uint64(len(m.Timestamps)*8))
 		for _, num := range m.Timestamps {
-			dAtA[i] = uint8(num)
-			i++
-			dAtA[i] = uint8(num >> 8)
-			i++
-			dAtA[i] = uint8(num >> 16)
-			i++
-			dAtA[i] = uint8(num >> 24)
-			i++
-			dAtA[i] = uint8(num >> 32)
-			i++
-			dAtA[i] = uint8(num >> 40)
-			i++
-			dAtA[i] = uint8(num >> 48)
-			i++
-			dAtA[i] = uint8(num >> 56)
-			i++
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(num))
+			i += 8
 		}
 	}
 	if len(m.Values) > 0 {
-		dAtA15 := make([]byte, len(m.Values)*10)
-		var j14 int
+		dAtA16 := make([]byte, len(m.Values)*10)
+		var j15 int
 		for _, num := range m.Values {
 			for num >= 1<<7 {
-				dAtA15[j14] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA16[j15] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j14++
+				j15++
 			}
-			dAtA15[j14] = uint8(num)
-			j14++
+			dAtA16[j15] = uint8(num)
+			j15++
 		}
 		dAtA[i] = 0x12
 		i++
-		i = encodeVarintStorage(dAtA, i, uint64(j14))
-		i += copy(dAtA[i:], dAtA15[:j14])
+		i = encodeVarintStorage(dAtA, i, uint64(j15))
+		i += copy(dAtA[i:], dAtA16[:j15])
 	}
 	return i, nil
 }
@@ -1123,22 +1316,8 @@ func (m *ReadResponse_BooleanPointsFrame) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintStorage(dAtA, i, uint64(len(m.Timestamps)*8))
 		for _, num := range m.Timestamps {
-			dAtA[i] = uint8(num)
-			i++
-			dAtA[i] = uint8(num >> 8)
-			i++
-			dAtA[i] = uint8(num >> 16)
-			i++
-			dAtA[i] = uint8(num >> 24)
-			i++
-			dAtA[i] = uint8(num >> 32)
-			i++
-			dAtA[i] = uint8(num >> 40)
-			i++
-			dAtA[i] = uint8(num >> 48)
-			i++
-			dAtA[i] = uint8(num >> 56)
-			i++
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(num))
+			i += 8
 		}
 	}
 	if len(m.Values) > 0 {
@@ -1177,22 +1356,8 @@ func (m *ReadResponse_StringPointsFrame) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintStorage(dAtA, i, uint64(len(m.Timestamps)*8))
 		for _, num := range m.Timestamps {
-			dAtA[i] = uint8(num)
-			i++
-			dAtA[i] = uint8(num >> 8)
-			i++
-			dAtA[i] = uint8(num >> 16)
-			i++
-			dAtA[i] = uint8(num >> 24)
-			i++
-			dAtA[i] = uint8(num >> 32)
-			i++
-			dAtA[i] = uint8(num >> 40)
-			i++
-			dAtA[i] = uint8(num >> 48)
-			i++
-			dAtA[i] = uint8(num >> 56)
-			i++
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(num))
+			i += 8
 		}
 	}
 	if len(m.Values) > 0 {
@@ -1213,7 +1378,7 @@ func (m *ReadResponse_StringPointsFrame) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *CapabilitiesResponse) Marshal() (dAtA []byte, err error) {
+func (m *WriteRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -1223,32 +1388,33 @@ func (m *CapabilitiesResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CapabilitiesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *WriteRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Caps) > 0 {
-		for k, _ := range m.Caps {
-			dAtA[i] = 0xa
-			i++
-			v := m.Caps[k]
-			mapSize := 1 + len(k) + sovStorage(uint64(len(k))) + 1 + len(v) + sovStorage(uint64(len(v)))
-			i = encodeVarintStorage(dAtA, i, uint64(mapSize))
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintStorage(dAtA, i, uint64(len(k)))
-			i += copy(dAtA[i:], k)
+	if len(m.Database) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintStorage(dAtA, i, uint64(len(m.Database)))
+		i += copy(dAtA[i:], m.Database)
+	}
+	if len(m.Frames) > 0 {
+		for _, msg := range m.Frames {
 			dAtA[i] = 0x12
 			i++
-			i = encodeVarintStorage(dAtA, i, uint64(len(v)))
-			i += copy(dAtA[i:], v)
+			i = encodeVarintStorage(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
 	}
 	return i, nil
 }
 
-func (m *HintsResponse) Marshal() (dAtA []byte, err error) {
+func (m *WriteResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -1258,15 +1424,31 @@ func (m *HintsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *HintsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *WriteResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
+	if m.PointsN != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintStorage(dAtA, i, uint64(m.PointsN))
+	}
+	if m.DroppedN != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintStorage(dAtA, i, uint64(m.DroppedN))
+	}
+	if len(m.Error) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintStorage(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
+	}
 	return i, nil
 }
 
-func (m *TimestampRange) Marshal() (dAtA []byte, err error) {
+func (m *TagKeysRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -1276,334 +1458,1532 @@ func (m *TimestampRange) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TimestampRange) MarshalTo(dAtA []byte) (int, error) {
+func (m *TagKeysRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Start != 0 {
-		dAtA[i] = 0x8
+	if len(m.Database) > 0 {
+		dAtA[i] = 0xa
 		i++
-		i = encodeVarintStorage(dAtA, i, uint64(m.Start))
+		i = encodeVarintStorage(dAtA, i, uint64(len(m.Database)))
+		i += copy(dAtA[i:], m.Database)
 	}
-	if m.End != 0 {
-		dAtA[i] = 0x10
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintStorage(dAtA, i, uint64(m.TimestampRange.Size()))
+	n17, err := m.TimestampRange.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n17
+	if m.Predicate != nil {
+		dAtA[i] = 0x1a
 		i++
-		i = encodeVarintStorage(dAtA, i, uint64(m.End))
+		i = encodeVarintStorage(dAtA, i, uint64(m.Predicate.Size()))
+		n18, err := m.Predicate.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n18
+	}
+	if m.Limit != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintStorage(dAtA, i, uint64(m.Limit))
 	}
 	return i, nil
 }
 
-func encodeFixed64Storage(dAtA []byte, offset int, v uint64) int {
-	dAtA[offset] = uint8(v)
-	dAtA[offset+1] = uint8(v >> 8)
-	dAtA[offset+2] = uint8(v >> 16)
-	dAtA[offset+3] = uint8(v >> 24)
-	dAtA[offset+4] = uint8(v >> 32)
-	dAtA[offset+5] = uint8(v >> 40)
-	dAtA[offset+6] = uint8(v >> 48)
-	dAtA[offset+7] = uint8(v >> 56)
-	return offset + 8
-}
-func encodeFixed32Storage(dAtA []byte, offset int, v uint32) int {
-	dAtA[offset] = uint8(v)
-	dAtA[offset+1] = uint8(v >> 8)
-	dAtA[offset+2] = uint8(v >> 16)
-	dAtA[offset+3] = uint8(v >> 24)
-	return offset + 4
-}
-func encodeVarintStorage(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *TagValuesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	return dAtA[:n], nil
 }
-func (m *ReadRequest) Size() (n int) {
+
+func (m *TagValuesRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Database)
-	if l > 0 {
-		n += 1 + l + sovStorage(uint64(l))
-	}
-	l = m.TimestampRange.Size()
-	n += 1 + l + sovStorage(uint64(l))
-	if m.Descending {
-		n += 2
+	if len(m.Database) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintStorage(dAtA, i, uint64(len(m.Database)))
+		i += copy(dAtA[i:], m.Database)
 	}
-	if len(m.Grouping) > 0 {
-		for _, s := range m.Grouping {
-			l = len(s)
-			n += 1 + l + sovStorage(uint64(l))
-		}
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintStorage(dAtA, i, uint64(m.TimestampRange.Size()))
+	n19, err := m.TimestampRange.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
+	i += n19
 	if m.Predicate != nil {
-		l = m.Predicate.Size()
-		n += 1 + l + sovStorage(uint64(l))
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintStorage(dAtA, i, uint64(m.Predicate.Size()))
+		n20, err := m.Predicate.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n20
 	}
-	if m.SeriesLimit != 0 {
-		n += 1 + sovStorage(uint64(m.SeriesLimit))
+	if len(m.TagKey) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintStorage(dAtA, i, uint64(len(m.TagKey)))
+		i += copy(dAtA[i:], m.TagKey)
 	}
-	if m.SeriesOffset != 0 {
-		n += 1 + sovStorage(uint64(m.SeriesOffset))
+	if m.Limit != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintStorage(dAtA, i, uint64(m.Limit))
 	}
-	if m.PointsLimit != 0 {
-		n += 1 + sovStorage(uint64(m.PointsLimit))
-	}
-	if m.Aggregate != nil {
-		l = m.Aggregate.Size()
-		n += 1 + l + sovStorage(uint64(l))
-	}
-	if len(m.Trace) > 0 {
-		for k, v := range m.Trace {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovStorage(uint64(len(k))) + 1 + len(v) + sovStorage(uint64(len(v)))
-			n += mapEntrySize + 1 + sovStorage(uint64(mapEntrySize))
-		}
-	}
-	if m.RequestType != 0 {
-		n += 1 + sovStorage(uint64(m.RequestType))
-	}
-	l = len(m.OrgID)
-	if l > 0 {
-		n += 1 + l + sovStorage(uint64(l))
-	}
-	return n
+	return i, nil
 }
 
-func (m *Aggregate) Size() (n int) {
-	var l int
-	_ = l
-	if m.Type != 0 {
-		n += 1 + sovStorage(uint64(m.Type))
+func (m *MeasurementNamesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Tag) Size() (n int) {
+func (m *MeasurementNamesRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovStorage(uint64(l))
+	if len(m.Database) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintStorage(dAtA, i, uint64(len(m.Database)))
+		i += copy(dAtA[i:], m.Database)
 	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovStorage(uint64(l))
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintStorage(dAtA, i, uint64(m.TimestampRange.Size()))
+	n21, err := m.TimestampRange.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	return n
-}
-
-func (m *ReadResponse) Size() (n int) {
-	var l int
-	_ = l
-	if len(m.Frames) > 0 {
-		for _, e := range m.Frames {
-			l = e.Size()
-			n += 1 + l + sovStorage(uint64(l))
+	i += n21
+	if m.Predicate != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintStorage(dAtA, i, uint64(m.Predicate.Size()))
+		n22, err := m.Predicate.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n22
 	}
-	return n
+	if m.Limit != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintStorage(dAtA, i, uint64(m.Limit))
+	}
+	return i, nil
 }
 
-func (m *ReadResponse_Frame) Size() (n int) {
-	var l int
-	_ = l
-	if m.Data != nil {
-		n += m.Data.Size()
+func (m *StringValuesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ReadResponse_Frame_Series) Size() (n int) {
+func (m *StringValuesResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Series != nil {
-		l = m.Series.Size()
-		n += 1 + l + sovStorage(uint64(l))
+	if len(m.Values) > 0 {
+		for _, b := range m.Values {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintStorage(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
 	}
-	return n
+	return i, nil
 }
-func (m *ReadResponse_Frame_FloatPoints) Size() (n int) {
-	var l int
-	_ = l
-	if m.FloatPoints != nil {
-		l = m.FloatPoints.Size()
-		n += 1 + l + sovStorage(uint64(l))
+
+func (m *CapabilitiesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
-func (m *ReadResponse_Frame_IntegerPoints) Size() (n int) {
+
+func (m *CapabilitiesResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.IntegerPoints != nil {
-		l = m.IntegerPoints.Size()
-		n += 1 + l + sovStorage(uint64(l))
+	if len(m.Caps) > 0 {
+		for k, _ := range m.Caps {
+			dAtA[i] = 0xa
+			i++
+			v := m.Caps[k]
+			mapSize := 1 + len(k) + sovStorage(uint64(len(k))) + 1 + len(v) + sovStorage(uint64(len(v)))
+			i = encodeVarintStorage(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintStorage(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintStorage(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
 	}
-	return n
+	return i, nil
 }
-func (m *ReadResponse_Frame_UnsignedPoints) Size() (n int) {
-	var l int
-	_ = l
-	if m.UnsignedPoints != nil {
-		l = m.UnsignedPoints.Size()
-		n += 1 + l + sovStorage(uint64(l))
+
+func (m *HintsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
-func (m *ReadResponse_Frame_BooleanPoints) Size() (n int) {
+
+func (m *HintsResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.BooleanPoints != nil {
-		l = m.BooleanPoints.Size()
-		n += 1 + l + sovStorage(uint64(l))
-	}
-	return n
+	return i, nil
 }
-func (m *ReadResponse_Frame_StringPoints) Size() (n int) {
-	var l int
-	_ = l
-	if m.StringPoints != nil {
-		l = m.StringPoints.Size()
-		n += 1 + l + sovStorage(uint64(l))
+
+func (m *TimestampRange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
-func (m *ReadResponse_SeriesFrame) Size() (n int) {
+
+func (m *TimestampRange) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.Tags) > 0 {
-		for _, e := range m.Tags {
-			l = e.Size()
-			n += 1 + l + sovStorage(uint64(l))
-		}
+	if m.Start != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintStorage(dAtA, i, uint64(m.Start))
 	}
-	if m.DataType != 0 {
-		n += 1 + sovStorage(uint64(m.DataType))
+	if m.End != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintStorage(dAtA, i, uint64(m.End))
 	}
-	return n
+	return i, nil
 }
 
-func (m *ReadResponse_FloatPointsFrame) Size() (n int) {
-	var l int
-	_ = l
-	if len(m.Timestamps) > 0 {
-		n += 1 + sovStorage(uint64(len(m.Timestamps)*8)) + len(m.Timestamps)*8
-	}
-	if len(m.Values) > 0 {
-		n += 1 + sovStorage(uint64(len(m.Values)*8)) + len(m.Values)*8
+func (m *ExplainRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ReadResponse_IntegerPointsFrame) Size() (n int) {
+func (m *ExplainRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.Timestamps) > 0 {
-		n += 1 + sovStorage(uint64(len(m.Timestamps)*8)) + len(m.Timestamps)*8
-	}
-	if len(m.Values) > 0 {
-		l = 0
-		for _, e := range m.Values {
-			l += sovStorage(uint64(e))
-		}
-		n += 1 + sovStorage(uint64(l)) + l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintStorage(dAtA, i, uint64(m.ReadRequest.Size()))
+	n23, err := m.ReadRequest.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	return n
+	i += n23
+	return i, nil
 }
 
-func (m *ReadResponse_UnsignedPointsFrame) Size() (n int) {
-	var l int
-	_ = l
-	if len(m.Timestamps) > 0 {
-		n += 1 + sovStorage(uint64(len(m.Timestamps)*8)) + len(m.Timestamps)*8
-	}
-	if len(m.Values) > 0 {
-		l = 0
-		for _, e := range m.Values {
-			l += sovStorage(uint64(e))
-		}
-		n += 1 + sovStorage(uint64(l)) + l
+func (m *ExplainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ReadResponse_BooleanPointsFrame) Size() (n int) {
+func (m *ExplainResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.Timestamps) > 0 {
-		n += 1 + sovStorage(uint64(len(m.Timestamps)*8)) + len(m.Timestamps)*8
+	if len(m.ShardIDs) > 0 {
+		dAtA25 := make([]byte, len(m.ShardIDs)*10)
+		var j24 int
+		for _, num := range m.ShardIDs {
+			for num >= 1<<7 {
+				dAtA25[j24] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j24++
+			}
+			dAtA25[j24] = uint8(num)
+			j24++
+		}
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintStorage(dAtA, i, uint64(j24))
+		i += copy(dAtA[i:], dAtA25[:j24])
 	}
-	if len(m.Values) > 0 {
-		n += 1 + sovStorage(uint64(len(m.Values))) + len(m.Values)*1
+	if m.SeriesN != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintStorage(dAtA, i, uint64(m.SeriesN))
+	}
+	if m.CursorN != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintStorage(dAtA, i, uint64(m.CursorN))
+	}
+	if len(m.Predicate) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintStorage(dAtA, i, uint64(len(m.Predicate)))
+		i += copy(dAtA[i:], m.Predicate)
+	}
+	if len(m.SeriesPredicate) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintStorage(dAtA, i, uint64(len(m.SeriesPredicate)))
+		i += copy(dAtA[i:], m.SeriesPredicate)
+	}
+	dAtA[i] = 0x32
+	i++
+	i = encodeVarintStorage(dAtA, i, uint64(m.Cost.Size()))
+	n26, err := m.Cost.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n26
+	return i, nil
+}
+
+func (m *Cost) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Cost) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.NumShards != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintStorage(dAtA, i, uint64(m.NumShards))
+	}
+	if m.NumSeries != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintStorage(dAtA, i, uint64(m.NumSeries))
+	}
+	if m.CachedValues != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintStorage(dAtA, i, uint64(m.CachedValues))
+	}
+	if m.NumFiles != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintStorage(dAtA, i, uint64(m.NumFiles))
+	}
+	if m.BlocksRead != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintStorage(dAtA, i, uint64(m.BlocksRead))
+	}
+	if m.BlockSize != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintStorage(dAtA, i, uint64(m.BlockSize))
+	}
+	return i, nil
+}
+
+func encodeVarintStorage(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *ReadRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Database)
+	if l > 0 {
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	l = m.TimestampRange.Size()
+	n += 1 + l + sovStorage(uint64(l))
+	if m.Descending {
+		n += 2
+	}
+	if len(m.Grouping) > 0 {
+		for _, s := range m.Grouping {
+			l = len(s)
+			n += 1 + l + sovStorage(uint64(l))
+		}
+	}
+	if m.Predicate != nil {
+		l = m.Predicate.Size()
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	if m.SeriesLimit != 0 {
+		n += 1 + sovStorage(uint64(m.SeriesLimit))
+	}
+	if m.SeriesOffset != 0 {
+		n += 1 + sovStorage(uint64(m.SeriesOffset))
+	}
+	if m.PointsLimit != 0 {
+		n += 1 + sovStorage(uint64(m.PointsLimit))
+	}
+	if m.Aggregate != nil {
+		l = m.Aggregate.Size()
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	if len(m.Trace) > 0 {
+		for k, v := range m.Trace {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovStorage(uint64(len(k))) + 1 + len(v) + sovStorage(uint64(len(v)))
+			n += mapEntrySize + 1 + sovStorage(uint64(mapEntrySize))
+		}
 	}
+	if m.RequestType != 0 {
+		n += 1 + sovStorage(uint64(m.RequestType))
+	}
+	l = len(m.OrgID)
+	if l > 0 {
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	if len(m.Aggregates) > 0 {
+		for _, e := range m.Aggregates {
+			l = e.Size()
+			n += 1 + l + sovStorage(uint64(l))
+		}
+	}
+	l = m.Window.Size()
+	n += 1 + l + sovStorage(uint64(l))
 	return n
 }
 
-func (m *ReadResponse_StringPointsFrame) Size() (n int) {
+func (m *Aggregate) Size() (n int) {
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovStorage(uint64(m.Type))
+	}
+	return n
+}
+
+func (m *Window) Size() (n int) {
+	var l int
+	_ = l
+	if m.Every != 0 {
+		n += 1 + sovStorage(uint64(m.Every))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovStorage(uint64(m.Offset))
+	}
+	return n
+}
+
+func (m *Tag) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	return n
+}
+
+func (m *ReadResponse) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Frames) > 0 {
+		for _, e := range m.Frames {
+			l = e.Size()
+			n += 1 + l + sovStorage(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ReadResponse_Frame) Size() (n int) {
+	var l int
+	_ = l
+	if m.Data != nil {
+		n += m.Data.Size()
+	}
+	return n
+}
+
+func (m *ReadResponse_Frame_Series) Size() (n int) {
+	var l int
+	_ = l
+	if m.Series != nil {
+		l = m.Series.Size()
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	return n
+}
+func (m *ReadResponse_Frame_FloatPoints) Size() (n int) {
+	var l int
+	_ = l
+	if m.FloatPoints != nil {
+		l = m.FloatPoints.Size()
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	return n
+}
+func (m *ReadResponse_Frame_IntegerPoints) Size() (n int) {
+	var l int
+	_ = l
+	if m.IntegerPoints != nil {
+		l = m.IntegerPoints.Size()
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	return n
+}
+func (m *ReadResponse_Frame_UnsignedPoints) Size() (n int) {
+	var l int
+	_ = l
+	if m.UnsignedPoints != nil {
+		l = m.UnsignedPoints.Size()
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	return n
+}
+func (m *ReadResponse_Frame_BooleanPoints) Size() (n int) {
+	var l int
+	_ = l
+	if m.BooleanPoints != nil {
+		l = m.BooleanPoints.Size()
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	return n
+}
+func (m *ReadResponse_Frame_StringPoints) Size() (n int) {
+	var l int
+	_ = l
+	if m.StringPoints != nil {
+		l = m.StringPoints.Size()
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	return n
+}
+func (m *ReadResponse_SeriesFrame) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Tags) > 0 {
+		for _, e := range m.Tags {
+			l = e.Size()
+			n += 1 + l + sovStorage(uint64(l))
+		}
+	}
+	if m.DataType != 0 {
+		n += 1 + sovStorage(uint64(m.DataType))
+	}
+	return n
+}
+
+func (m *ReadResponse_FloatPointsFrame) Size() (n int) {
 	var l int
 	_ = l
 	if len(m.Timestamps) > 0 {
 		n += 1 + sovStorage(uint64(len(m.Timestamps)*8)) + len(m.Timestamps)*8
 	}
 	if len(m.Values) > 0 {
-		for _, s := range m.Values {
-			l = len(s)
-			n += 1 + l + sovStorage(uint64(l))
-		}
+		n += 1 + sovStorage(uint64(len(m.Values)*8)) + len(m.Values)*8
 	}
 	return n
 }
 
-func (m *CapabilitiesResponse) Size() (n int) {
+func (m *ReadResponse_IntegerPointsFrame) Size() (n int) {
 	var l int
 	_ = l
-	if len(m.Caps) > 0 {
-		for k, v := range m.Caps {
-			_ = k
+	if len(m.Timestamps) > 0 {
+		n += 1 + sovStorage(uint64(len(m.Timestamps)*8)) + len(m.Timestamps)*8
+	}
+	if len(m.Values) > 0 {
+		l = 0
+		for _, e := range m.Values {
+			l += sovStorage(uint64(e))
+		}
+		n += 1 + sovStorage(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *ReadResponse_UnsignedPointsFrame) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Timestamps) > 0 {
+		n += 1 + sovStorage(uint64(len(m.Timestamps)*8)) + len(m.Timestamps)*8
+	}
+	if len(m.Values) > 0 {
+		l = 0
+		for _, e := range m.Values {
+			l += sovStorage(uint64(e))
+		}
+		n += 1 + sovStorage(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *ReadResponse_BooleanPointsFrame) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Timestamps) > 0 {
+		n += 1 + sovStorage(uint64(len(m.Timestamps)*8)) + len(m.Timestamps)*8
+	}
+	if len(m.Values) > 0 {
+		n += 1 + sovStorage(uint64(len(m.Values))) + len(m.Values)*1
+	}
+	return n
+}
+
+func (m *ReadResponse_StringPointsFrame) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Timestamps) > 0 {
+		n += 1 + sovStorage(uint64(len(m.Timestamps)*8)) + len(m.Timestamps)*8
+	}
+	if len(m.Values) > 0 {
+		for _, s := range m.Values {
+			l = len(s)
+			n += 1 + l + sovStorage(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *WriteRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Database)
+	if l > 0 {
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	if len(m.Frames) > 0 {
+		for _, e := range m.Frames {
+			l = e.Size()
+			n += 1 + l + sovStorage(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *WriteResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.PointsN != 0 {
+		n += 1 + sovStorage(uint64(m.PointsN))
+	}
+	if m.DroppedN != 0 {
+		n += 1 + sovStorage(uint64(m.DroppedN))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	return n
+}
+
+func (m *TagKeysRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Database)
+	if l > 0 {
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	l = m.TimestampRange.Size()
+	n += 1 + l + sovStorage(uint64(l))
+	if m.Predicate != nil {
+		l = m.Predicate.Size()
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovStorage(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *TagValuesRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Database)
+	if l > 0 {
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	l = m.TimestampRange.Size()
+	n += 1 + l + sovStorage(uint64(l))
+	if m.Predicate != nil {
+		l = m.Predicate.Size()
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	l = len(m.TagKey)
+	if l > 0 {
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovStorage(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *MeasurementNamesRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Database)
+	if l > 0 {
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	l = m.TimestampRange.Size()
+	n += 1 + l + sovStorage(uint64(l))
+	if m.Predicate != nil {
+		l = m.Predicate.Size()
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovStorage(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *StringValuesResponse) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Values) > 0 {
+		for _, b := range m.Values {
+			l = len(b)
+			n += 1 + l + sovStorage(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CapabilitiesResponse) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Caps) > 0 {
+		for k, v := range m.Caps {
+			_ = k
 			_ = v
 			mapEntrySize := 1 + len(k) + sovStorage(uint64(len(k))) + 1 + len(v) + sovStorage(uint64(len(v)))
 			n += mapEntrySize + 1 + sovStorage(uint64(mapEntrySize))
 		}
 	}
-	return n
-}
-
-func (m *HintsResponse) Size() (n int) {
-	var l int
-	_ = l
-	return n
-}
-
-func (m *TimestampRange) Size() (n int) {
-	var l int
-	_ = l
-	if m.Start != 0 {
-		n += 1 + sovStorage(uint64(m.Start))
-	}
-	if m.End != 0 {
-		n += 1 + sovStorage(uint64(m.End))
-	}
-	return n
-}
+	return n
+}
+
+func (m *HintsResponse) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *TimestampRange) Size() (n int) {
+	var l int
+	_ = l
+	if m.Start != 0 {
+		n += 1 + sovStorage(uint64(m.Start))
+	}
+	if m.End != 0 {
+		n += 1 + sovStorage(uint64(m.End))
+	}
+	return n
+}
+
+func (m *ExplainRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = m.ReadRequest.Size()
+	n += 1 + l + sovStorage(uint64(l))
+	return n
+}
+
+func (m *ExplainResponse) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.ShardIDs) > 0 {
+		l = 0
+		for _, e := range m.ShardIDs {
+			l += sovStorage(uint64(e))
+		}
+		n += 1 + sovStorage(uint64(l)) + l
+	}
+	if m.SeriesN != 0 {
+		n += 1 + sovStorage(uint64(m.SeriesN))
+	}
+	if m.CursorN != 0 {
+		n += 1 + sovStorage(uint64(m.CursorN))
+	}
+	l = len(m.Predicate)
+	if l > 0 {
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	l = len(m.SeriesPredicate)
+	if l > 0 {
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	l = m.Cost.Size()
+	n += 1 + l + sovStorage(uint64(l))
+	return n
+}
+
+func (m *Cost) Size() (n int) {
+	var l int
+	_ = l
+	if m.NumShards != 0 {
+		n += 1 + sovStorage(uint64(m.NumShards))
+	}
+	if m.NumSeries != 0 {
+		n += 1 + sovStorage(uint64(m.NumSeries))
+	}
+	if m.CachedValues != 0 {
+		n += 1 + sovStorage(uint64(m.CachedValues))
+	}
+	if m.NumFiles != 0 {
+		n += 1 + sovStorage(uint64(m.NumFiles))
+	}
+	if m.BlocksRead != 0 {
+		n += 1 + sovStorage(uint64(m.BlocksRead))
+	}
+	if m.BlockSize != 0 {
+		n += 1 + sovStorage(uint64(m.BlockSize))
+	}
+	return n
+}
+
+func sovStorage(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozStorage(x uint64) (n int) {
+	return sovStorage(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ReadRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStorage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReadRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReadRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Database = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimestampRange", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TimestampRange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Descending", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Descending = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grouping", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grouping = append(m.Grouping, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Predicate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Predicate == nil {
+				m.Predicate = &Predicate{}
+			}
+			if err := m.Predicate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeriesLimit", wireType)
+			}
+			m.SeriesLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SeriesLimit |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeriesOffset", wireType)
+			}
+			m.SeriesOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SeriesOffset |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PointsLimit", wireType)
+			}
+			m.PointsLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PointsLimit |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aggregate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Aggregate == nil {
+				m.Aggregate = &Aggregate{}
+			}
+			if err := m.Aggregate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trace", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Trace == nil {
+				m.Trace = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStorage
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowStorage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthStorage
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowStorage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthStorage
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipStorage(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthStorage
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Trace[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestType", wireType)
+			}
+			m.RequestType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestType |= (ReadRequest_Type(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrgID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrgID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aggregates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Aggregates = append(m.Aggregates, Aggregate{})
+			if err := m.Aggregates[len(m.Aggregates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Window.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStorage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Aggregate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStorage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Aggregate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Aggregate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= (Aggregate_AggregateType(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStorage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Window) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStorage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Window: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Window: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Every", wireType)
+			}
+			m.Every = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Every |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStorage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovStorage(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-func sozStorage(x uint64) (n int) {
-	return sovStorage(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *ReadRequest) Unmarshal(dAtA []byte) error {
+func (m *Tag) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1626,17 +3006,17 @@ func (m *ReadRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReadRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: Tag: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReadRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Tag: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStorage
@@ -1646,26 +3026,28 @@ func (m *ReadRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthStorage
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Database = string(dAtA[iNdEx:postIndex])
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimestampRange", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStorage
@@ -1675,47 +3057,78 @@ func (m *ReadRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthStorage
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TimestampRange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Descending", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStorage(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStorage
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if skippy < 0 {
+				return ErrInvalidLengthStorage
 			}
-			m.Descending = bool(v != 0)
-		case 4:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReadResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStorage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReadResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReadResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grouping", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Frames", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStorage
@@ -1725,24 +3138,76 @@ func (m *ReadRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStorage
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Grouping = append(m.Grouping, string(dAtA[iNdEx:postIndex]))
+			m.Frames = append(m.Frames, ReadResponse_Frame{})
+			if err := m.Frames[len(m.Frames)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStorage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReadResponse_Frame) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStorage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Frame: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Frame: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Predicate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Series", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1766,18 +3231,17 @@ func (m *ReadRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Predicate == nil {
-				m.Predicate = &Predicate{}
-			}
-			if err := m.Predicate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &ReadResponse_SeriesFrame{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Data = &ReadResponse_Frame_Series{v}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SeriesLimit", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FloatPoints", wireType)
 			}
-			m.SeriesLimit = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStorage
@@ -1787,16 +3251,29 @@ func (m *ReadRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SeriesLimit |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SeriesOffset", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ReadResponse_FloatPointsFrame{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Data = &ReadResponse_Frame_FloatPoints{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntegerPoints", wireType)
 			}
-			m.SeriesOffset = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStorage
@@ -1806,16 +3283,29 @@ func (m *ReadRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SeriesOffset |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PointsLimit", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthStorage
 			}
-			m.PointsLimit = 0
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ReadResponse_IntegerPointsFrame{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Data = &ReadResponse_Frame_IntegerPoints{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnsignedPoints", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStorage
@@ -1825,14 +3315,27 @@ func (m *ReadRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PointsLimit |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
+			if msglen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ReadResponse_UnsignedPointsFrame{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Data = &ReadResponse_Frame_UnsignedPoints{v}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Aggregate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BooleanPoints", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1856,16 +3359,15 @@ func (m *ReadRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Aggregate == nil {
-				m.Aggregate = &Aggregate{}
-			}
-			if err := m.Aggregate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &ReadResponse_BooleanPointsFrame{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Data = &ReadResponse_Frame_BooleanPoints{v}
 			iNdEx = postIndex
-		case 10:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Trace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StringPoints", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1889,103 +3391,67 @@ func (m *ReadRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Trace == nil {
-				m.Trace = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowStorage
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowStorage
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthStorage
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowStorage
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthStorage
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipStorage(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthStorage
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			v := &ReadResponse_StringPointsFrame{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Trace[mapkey] = mapvalue
+			m.Data = &ReadResponse_Frame_StringPoints{v}
 			iNdEx = postIndex
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestType", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStorage(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.RequestType = 0
+			if skippy < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReadResponse_SeriesFrame) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStorage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SeriesFrame: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SeriesFrame: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStorage
@@ -1995,16 +3461,28 @@ func (m *ReadRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RequestType |= (ReadRequest_Type(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrgID", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthStorage
 			}
-			var stringLen uint64
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tags = append(m.Tags, Tag{})
+			if err := m.Tags[len(m.Tags)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataType", wireType)
+			}
+			m.DataType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStorage
@@ -2014,21 +3492,11 @@ func (m *ReadRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.DataType |= (ReadResponse_DataType(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStorage
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OrgID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStorage(dAtA[iNdEx:])
@@ -2050,7 +3518,7 @@ func (m *ReadRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Aggregate) Unmarshal(dAtA []byte) error {
+func (m *ReadResponse_FloatPointsFrame) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2073,30 +3541,101 @@ func (m *Aggregate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Aggregate: wiretype end group for non-group")
+			return fmt.Errorf("proto: FloatPointsFrame: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Aggregate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FloatPointsFrame: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			if wireType == 1 {
+				var v int64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = int64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				m.Timestamps = append(m.Timestamps, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStorage
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthStorage
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v int64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = int64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					m.Timestamps = append(m.Timestamps, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamps", wireType)
 			}
-			m.Type = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStorage
+		case 2:
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
 				}
-				if iNdEx >= l {
+				v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				v2 := float64(math.Float64frombits(v))
+				m.Values = append(m.Values, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStorage
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthStorage
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Type |= (Aggregate_AggregateType(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					v2 := float64(math.Float64frombits(v))
+					m.Values = append(m.Values, v2)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
 			}
 		default:
 			iNdEx = preIndex
@@ -2119,7 +3658,7 @@ func (m *Aggregate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Tag) Unmarshal(dAtA []byte) error {
+func (m *ReadResponse_IntegerPointsFrame) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2142,74 +3681,118 @@ func (m *Tag) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Tag: wiretype end group for non-group")
+			return fmt.Errorf("proto: IntegerPointsFrame: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Tag: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IntegerPointsFrame: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStorage
+			if wireType == 1 {
+				var v int64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
 				}
-				if iNdEx >= l {
+				v = int64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				m.Timestamps = append(m.Timestamps, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStorage
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthStorage
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
+				for iNdEx < postIndex {
+					var v int64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = int64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					m.Timestamps = append(m.Timestamps, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamps", wireType)
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthStorage
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
-			if m.Key == nil {
-				m.Key = []byte{}
-			}
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStorage
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStorage
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (int64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.Values = append(m.Values, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStorage
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthStorage
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowStorage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (int64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Values = append(m.Values, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthStorage
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStorage(dAtA[iNdEx:])
@@ -2231,7 +3814,7 @@ func (m *Tag) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReadResponse) Unmarshal(dAtA []byte) error {
+func (m *ReadResponse_UnsignedPointsFrame) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2254,43 +3837,118 @@ func (m *ReadResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReadResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnsignedPointsFrame: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReadResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnsignedPointsFrame: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Frames", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStorage
+			if wireType == 1 {
+				var v int64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = int64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				m.Timestamps = append(m.Timestamps, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStorage
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				if packedLen < 0 {
+					return ErrInvalidLengthStorage
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
+				for iNdEx < postIndex {
+					var v int64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = int64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					m.Timestamps = append(m.Timestamps, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamps", wireType)
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthStorage
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Frames = append(m.Frames, ReadResponse_Frame{})
-			if err := m.Frames[len(m.Frames)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStorage
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Values = append(m.Values, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStorage
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthStorage
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowStorage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Values = append(m.Values, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStorage(dAtA[iNdEx:])
@@ -2312,7 +3970,7 @@ func (m *ReadResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReadResponse_Frame) Unmarshal(dAtA []byte) error {
+func (m *ReadResponse_BooleanPointsFrame) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2335,177 +3993,217 @@ func (m *ReadResponse_Frame) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Frame: wiretype end group for non-group")
+			return fmt.Errorf("proto: BooleanPointsFrame: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Frame: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BooleanPointsFrame: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Series", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStorage
-				}
-				if iNdEx >= l {
+			if wireType == 1 {
+				var v int64
+				if (iNdEx + 8) > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
+				v = int64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				m.Timestamps = append(m.Timestamps, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStorage
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthStorage
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &ReadResponse_SeriesFrame{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Data = &ReadResponse_Frame_Series{v}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FloatPoints", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStorage
+				if packedLen < 0 {
+					return ErrInvalidLengthStorage
 				}
-				if iNdEx >= l {
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
+				for iNdEx < postIndex {
+					var v int64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = int64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					m.Timestamps = append(m.Timestamps, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamps", wireType)
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthStorage
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &ReadResponse_FloatPointsFrame{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Data = &ReadResponse_Frame_FloatPoints{v}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IntegerPoints", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStorage
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
+		case 2:
+			if wireType == 0 {
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStorage
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthStorage
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &ReadResponse_IntegerPointsFrame{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Data = &ReadResponse_Frame_IntegerPoints{v}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnsignedPoints", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStorage
+				m.Values = append(m.Values, bool(v != 0))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStorage
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				if packedLen < 0 {
+					return ErrInvalidLengthStorage
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
+				for iNdEx < postIndex {
+					var v int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowStorage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (int(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Values = append(m.Values, bool(v != 0))
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStorage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthStorage
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ReadResponse_UnsignedPointsFrame{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReadResponse_StringPointsFrame) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStorage
 			}
-			m.Data = &ReadResponse_Frame_UnsignedPoints{v}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BooleanPoints", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStorage
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StringPointsFrame: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StringPointsFrame: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 1 {
+				var v int64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
 				}
-				if iNdEx >= l {
+				v = int64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				m.Timestamps = append(m.Timestamps, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStorage
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthStorage
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
+				for iNdEx < postIndex {
+					var v int64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = int64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					m.Timestamps = append(m.Timestamps, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamps", wireType)
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthStorage
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &ReadResponse_BooleanPointsFrame{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Data = &ReadResponse_Frame_BooleanPoints{v}
-			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StringPoints", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStorage
@@ -2515,23 +4213,20 @@ func (m *ReadResponse_Frame) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStorage
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ReadResponse_StringPointsFrame{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Data = &ReadResponse_Frame_StringPoints{v}
+			m.Values = append(m.Values, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2554,7 +4249,7 @@ func (m *ReadResponse_Frame) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReadResponse_SeriesFrame) Unmarshal(dAtA []byte) error {
+func (m *WriteRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2577,17 +4272,17 @@ func (m *ReadResponse_SeriesFrame) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SeriesFrame: wiretype end group for non-group")
+			return fmt.Errorf("proto: WriteRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SeriesFrame: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WriteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStorage
@@ -2597,28 +4292,26 @@ func (m *ReadResponse_SeriesFrame) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStorage
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tags = append(m.Tags, Tag{})
-			if err := m.Tags[len(m.Tags)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Database = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataType", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frames", wireType)
 			}
-			m.DataType = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStorage
@@ -2628,11 +4321,23 @@ func (m *ReadResponse_SeriesFrame) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DataType |= (ReadResponse_DataType(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Frames = append(m.Frames, ReadResponse_Frame{})
+			if err := m.Frames[len(m.Frames)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStorage(dAtA[iNdEx:])
@@ -2654,7 +4359,7 @@ func (m *ReadResponse_SeriesFrame) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReadResponse_FloatPointsFrame) Unmarshal(dAtA []byte) error {
+func (m *WriteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2668,139 +4373,88 @@ func (m *ReadResponse_FloatPointsFrame) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: FloatPointsFrame: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FloatPointsFrame: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType == 1 {
-				var v int64
-				if (iNdEx + 8) > l {
-					return io.ErrUnexpectedEOF
-				}
-				iNdEx += 8
-				v = int64(dAtA[iNdEx-8])
-				v |= int64(dAtA[iNdEx-7]) << 8
-				v |= int64(dAtA[iNdEx-6]) << 16
-				v |= int64(dAtA[iNdEx-5]) << 24
-				v |= int64(dAtA[iNdEx-4]) << 32
-				v |= int64(dAtA[iNdEx-3]) << 40
-				v |= int64(dAtA[iNdEx-2]) << 48
-				v |= int64(dAtA[iNdEx-1]) << 56
-				m.Timestamps = append(m.Timestamps, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowStorage
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthStorage
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WriteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WriteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PointsN", wireType)
+			}
+			m.PointsN = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				for iNdEx < postIndex {
-					var v int64
-					if (iNdEx + 8) > l {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += 8
-					v = int64(dAtA[iNdEx-8])
-					v |= int64(dAtA[iNdEx-7]) << 8
-					v |= int64(dAtA[iNdEx-6]) << 16
-					v |= int64(dAtA[iNdEx-5]) << 24
-					v |= int64(dAtA[iNdEx-4]) << 32
-					v |= int64(dAtA[iNdEx-3]) << 40
-					v |= int64(dAtA[iNdEx-2]) << 48
-					v |= int64(dAtA[iNdEx-1]) << 56
-					m.Timestamps = append(m.Timestamps, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PointsN |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamps", wireType)
 			}
 		case 2:
-			if wireType == 1 {
-				var v uint64
-				if (iNdEx + 8) > l {
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DroppedN", wireType)
+			}
+			m.DroppedN = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				iNdEx += 8
-				v = uint64(dAtA[iNdEx-8])
-				v |= uint64(dAtA[iNdEx-7]) << 8
-				v |= uint64(dAtA[iNdEx-6]) << 16
-				v |= uint64(dAtA[iNdEx-5]) << 24
-				v |= uint64(dAtA[iNdEx-4]) << 32
-				v |= uint64(dAtA[iNdEx-3]) << 40
-				v |= uint64(dAtA[iNdEx-2]) << 48
-				v |= uint64(dAtA[iNdEx-1]) << 56
-				v2 := float64(math.Float64frombits(v))
-				m.Values = append(m.Values, v2)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowStorage
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DroppedN |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthStorage
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					if (iNdEx + 8) > l {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += 8
-					v = uint64(dAtA[iNdEx-8])
-					v |= uint64(dAtA[iNdEx-7]) << 8
-					v |= uint64(dAtA[iNdEx-6]) << 16
-					v |= uint64(dAtA[iNdEx-5]) << 24
-					v |= uint64(dAtA[iNdEx-4]) << 32
-					v |= uint64(dAtA[iNdEx-3]) << 40
-					v |= uint64(dAtA[iNdEx-2]) << 48
-					v |= uint64(dAtA[iNdEx-1]) << 56
-					v2 := float64(math.Float64frombits(v))
-					m.Values = append(m.Values, v2)
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStorage(dAtA[iNdEx:])
@@ -2822,7 +4476,7 @@ func (m *ReadResponse_FloatPointsFrame) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReadResponse_IntegerPointsFrame) Unmarshal(dAtA []byte) error {
+func (m *TagKeysRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2845,131 +4499,122 @@ func (m *ReadResponse_IntegerPointsFrame) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IntegerPointsFrame: wiretype end group for non-group")
+			return fmt.Errorf("proto: TagKeysRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IntegerPointsFrame: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TagKeysRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType == 1 {
-				var v int64
-				if (iNdEx + 8) > l {
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				iNdEx += 8
-				v = int64(dAtA[iNdEx-8])
-				v |= int64(dAtA[iNdEx-7]) << 8
-				v |= int64(dAtA[iNdEx-6]) << 16
-				v |= int64(dAtA[iNdEx-5]) << 24
-				v |= int64(dAtA[iNdEx-4]) << 32
-				v |= int64(dAtA[iNdEx-3]) << 40
-				v |= int64(dAtA[iNdEx-2]) << 48
-				v |= int64(dAtA[iNdEx-1]) << 56
-				m.Timestamps = append(m.Timestamps, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowStorage
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthStorage
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Database = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimestampRange", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				for iNdEx < postIndex {
-					var v int64
-					if (iNdEx + 8) > l {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += 8
-					v = int64(dAtA[iNdEx-8])
-					v |= int64(dAtA[iNdEx-7]) << 8
-					v |= int64(dAtA[iNdEx-6]) << 16
-					v |= int64(dAtA[iNdEx-5]) << 24
-					v |= int64(dAtA[iNdEx-4]) << 32
-					v |= int64(dAtA[iNdEx-3]) << 40
-					v |= int64(dAtA[iNdEx-2]) << 48
-					v |= int64(dAtA[iNdEx-1]) << 56
-					m.Timestamps = append(m.Timestamps, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamps", wireType)
 			}
-		case 2:
-			if wireType == 0 {
-				var v int64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowStorage
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= (int64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if msglen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TimestampRange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Predicate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
 				}
-				m.Values = append(m.Values, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowStorage
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthStorage
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Predicate == nil {
+				m.Predicate = &Predicate{}
+			}
+			if err := m.Predicate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowStorage
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= (int64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Values = append(m.Values, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
 			}
 		default:
 			iNdEx = preIndex
@@ -2992,7 +4637,7 @@ func (m *ReadResponse_IntegerPointsFrame) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReadResponse_UnsignedPointsFrame) Unmarshal(dAtA []byte) error {
+func (m *TagValuesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3015,301 +4660,151 @@ func (m *ReadResponse_UnsignedPointsFrame) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnsignedPointsFrame: wiretype end group for non-group")
+			return fmt.Errorf("proto: TagValuesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnsignedPointsFrame: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TagValuesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType == 1 {
-				var v int64
-				if (iNdEx + 8) > l {
-					return io.ErrUnexpectedEOF
-				}
-				iNdEx += 8
-				v = int64(dAtA[iNdEx-8])
-				v |= int64(dAtA[iNdEx-7]) << 8
-				v |= int64(dAtA[iNdEx-6]) << 16
-				v |= int64(dAtA[iNdEx-5]) << 24
-				v |= int64(dAtA[iNdEx-4]) << 32
-				v |= int64(dAtA[iNdEx-3]) << 40
-				v |= int64(dAtA[iNdEx-2]) << 48
-				v |= int64(dAtA[iNdEx-1]) << 56
-				m.Timestamps = append(m.Timestamps, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowStorage
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthStorage
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				for iNdEx < postIndex {
-					var v int64
-					if (iNdEx + 8) > l {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += 8
-					v = int64(dAtA[iNdEx-8])
-					v |= int64(dAtA[iNdEx-7]) << 8
-					v |= int64(dAtA[iNdEx-6]) << 16
-					v |= int64(dAtA[iNdEx-5]) << 24
-					v |= int64(dAtA[iNdEx-4]) << 32
-					v |= int64(dAtA[iNdEx-3]) << 40
-					v |= int64(dAtA[iNdEx-2]) << 48
-					v |= int64(dAtA[iNdEx-1]) << 56
-					m.Timestamps = append(m.Timestamps, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamps", wireType)
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Database = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowStorage
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Values = append(m.Values, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowStorage
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthStorage
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimestampRange", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowStorage
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Values = append(m.Values, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipStorage(dAtA[iNdEx:])
-			if err != nil {
-				return err
 			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStorage
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ReadResponse_BooleanPointsFrame) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowStorage
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if err := m.TimestampRange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Predicate", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BooleanPointsFrame: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BooleanPointsFrame: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType == 1 {
-				var v int64
-				if (iNdEx + 8) > l {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				iNdEx += 8
-				v = int64(dAtA[iNdEx-8])
-				v |= int64(dAtA[iNdEx-7]) << 8
-				v |= int64(dAtA[iNdEx-6]) << 16
-				v |= int64(dAtA[iNdEx-5]) << 24
-				v |= int64(dAtA[iNdEx-4]) << 32
-				v |= int64(dAtA[iNdEx-3]) << 40
-				v |= int64(dAtA[iNdEx-2]) << 48
-				v |= int64(dAtA[iNdEx-1]) << 56
-				m.Timestamps = append(m.Timestamps, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowStorage
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthStorage
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Predicate == nil {
+				m.Predicate = &Predicate{}
+			}
+			if err := m.Predicate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TagKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				for iNdEx < postIndex {
-					var v int64
-					if (iNdEx + 8) > l {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += 8
-					v = int64(dAtA[iNdEx-8])
-					v |= int64(dAtA[iNdEx-7]) << 8
-					v |= int64(dAtA[iNdEx-6]) << 16
-					v |= int64(dAtA[iNdEx-5]) << 24
-					v |= int64(dAtA[iNdEx-4]) << 32
-					v |= int64(dAtA[iNdEx-3]) << 40
-					v |= int64(dAtA[iNdEx-2]) << 48
-					v |= int64(dAtA[iNdEx-1]) << 56
-					m.Timestamps = append(m.Timestamps, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamps", wireType)
 			}
-		case 2:
-			if wireType == 0 {
-				var v int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowStorage
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Values = append(m.Values, bool(v != 0))
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowStorage
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthStorage
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TagKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				for iNdEx < postIndex {
-					var v int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowStorage
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= (int(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Values = append(m.Values, bool(v != 0))
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
 			}
 		default:
 			iNdEx = preIndex
@@ -3332,7 +4827,7 @@ func (m *ReadResponse_BooleanPointsFrame) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReadResponse_StringPointsFrame) Unmarshal(dAtA []byte) error {
+func (m *MeasurementNamesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3355,75 +4850,178 @@ func (m *ReadResponse_StringPointsFrame) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StringPointsFrame: wiretype end group for non-group")
+			return fmt.Errorf("proto: MeasurementNamesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StringPointsFrame: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MeasurementNamesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType == 1 {
-				var v int64
-				if (iNdEx + 8) > l {
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				iNdEx += 8
-				v = int64(dAtA[iNdEx-8])
-				v |= int64(dAtA[iNdEx-7]) << 8
-				v |= int64(dAtA[iNdEx-6]) << 16
-				v |= int64(dAtA[iNdEx-5]) << 24
-				v |= int64(dAtA[iNdEx-4]) << 32
-				v |= int64(dAtA[iNdEx-3]) << 40
-				v |= int64(dAtA[iNdEx-2]) << 48
-				v |= int64(dAtA[iNdEx-1]) << 56
-				m.Timestamps = append(m.Timestamps, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowStorage
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthStorage
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Database = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimestampRange", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				for iNdEx < postIndex {
-					var v int64
-					if (iNdEx + 8) > l {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += 8
-					v = int64(dAtA[iNdEx-8])
-					v |= int64(dAtA[iNdEx-7]) << 8
-					v |= int64(dAtA[iNdEx-6]) << 16
-					v |= int64(dAtA[iNdEx-5]) << 24
-					v |= int64(dAtA[iNdEx-4]) << 32
-					v |= int64(dAtA[iNdEx-3]) << 40
-					v |= int64(dAtA[iNdEx-2]) << 48
-					v |= int64(dAtA[iNdEx-1]) << 56
-					m.Timestamps = append(m.Timestamps, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamps", wireType)
 			}
-		case 2:
+			if msglen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TimestampRange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Predicate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Predicate == nil {
+				m.Predicate = &Predicate{}
+			}
+			if err := m.Predicate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStorage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StringValuesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStorage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StringValuesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StringValuesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStorage
@@ -3433,20 +5031,20 @@ func (m *ReadResponse_StringPointsFrame) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthStorage
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Values = append(m.Values, string(dAtA[iNdEx:postIndex]))
+			m.Values = append(m.Values, make([]byte, postIndex-iNdEx))
+			copy(m.Values[len(m.Values)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3614,7 +5212,225 @@ func (m *CapabilitiesResponse) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Caps[mapkey] = mapvalue
+			m.Caps[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStorage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HintsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStorage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HintsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HintsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStorage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TimestampRange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStorage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TimestampRange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TimestampRange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			m.Start = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Start |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			m.End = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.End |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStorage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExplainRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStorage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExplainRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExplainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReadRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3637,7 +5453,7 @@ func (m *CapabilitiesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HintsResponse) Unmarshal(dAtA []byte) error {
+func (m *ExplainResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3660,12 +5476,200 @@ func (m *HintsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HintsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExplainResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HintsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExplainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStorage
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ShardIDs = append(m.ShardIDs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStorage
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthStorage
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowStorage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ShardIDs = append(m.ShardIDs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardIDs", wireType)
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeriesN", wireType)
+			}
+			m.SeriesN = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SeriesN |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CursorN", wireType)
+			}
+			m.CursorN = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CursorN |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Predicate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Predicate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeriesPredicate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SeriesPredicate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cost", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Cost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStorage(dAtA[iNdEx:])
@@ -3687,7 +5691,7 @@ func (m *HintsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TimestampRange) Unmarshal(dAtA []byte) error {
+func (m *Cost) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3710,17 +5714,17 @@ func (m *TimestampRange) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TimestampRange: wiretype end group for non-group")
+			return fmt.Errorf("proto: Cost: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TimestampRange: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Cost: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NumShards", wireType)
 			}
-			m.Start = 0
+			m.NumShards = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStorage
@@ -3730,16 +5734,16 @@ func (m *TimestampRange) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Start |= (int64(b) & 0x7F) << shift
+				m.NumShards |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NumSeries", wireType)
 			}
-			m.End = 0
+			m.NumSeries = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStorage
@@ -3749,7 +5753,83 @@ func (m *TimestampRange) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.End |= (int64(b) & 0x7F) << shift
+				m.NumSeries |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CachedValues", wireType)
+			}
+			m.CachedValues = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CachedValues |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumFiles", wireType)
+			}
+			m.NumFiles = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumFiles |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksRead", wireType)
+			}
+			m.BlocksRead = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlocksRead |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockSize", wireType)
+			}
+			m.BlockSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockSize |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3883,88 +5963,130 @@ var (
 func init() { proto.RegisterFile("storage.proto", fileDescriptorStorage) }
 
 var fileDescriptorStorage = []byte{
-	// 1316 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xbf, 0x6f, 0xdb, 0xc6,
-	0x17, 0x27, 0x2d, 0x4a, 0xb6, 0x9e, 0x24, 0x9b, 0xbe, 0x38, 0xfe, 0xea, 0xcb, 0x34, 0x12, 0xa3,
-	0x21, 0x55, 0x87, 0x28, 0x81, 0xda, 0xa2, 0x69, 0x83, 0x02, 0xb5, 0x12, 0xc5, 0x56, 0x63, 0x53,
-	0xc1, 0x49, 0x06, 0x3a, 0x14, 0x70, 0x4f, 0xd6, 0x89, 0x21, 0x2a, 0x91, 0x2c, 0x49, 0x15, 0xf1,
-	0xd6, 0xb1, 0x10, 0x3a, 0x74, 0xe8, 0xaa, 0x29, 0x7f, 0x43, 0xbb, 0x74, 0xeb, 0x94, 0xb1, 0x63,
-	0x27, 0xa3, 0x55, 0xff, 0x91, 0xe2, 0xee, 0x48, 0x8a, 0x94, 0xe5, 0x00, 0x5e, 0x04, 0xbe, 0xf7,
-	0x3e, 0xef, 0xf3, 0x7e, 0xdc, 0x7b, 0x77, 0x82, 0x92, 0x1f, 0x38, 0x1e, 0x31, 0x69, 0xc3, 0xf5,
-	0x9c, 0xc0, 0x41, 0x9b, 0xa1, 0xa8, 0x3d, 0x30, 0xad, 0xe0, 0xd5, 0x74, 0xd0, 0x38, 0x77, 0x26,
-	0x0f, 0x4d, 0xc7, 0x74, 0x1e, 0x72, 0xfb, 0x60, 0x3a, 0xe2, 0x12, 0x17, 0xf8, 0x97, 0xf0, 0xd3,
-	0xee, 0x98, 0x8e, 0x63, 0x8e, 0xe9, 0x12, 0x45, 0x27, 0x6e, 0x70, 0x11, 0x1a, 0x9b, 0x09, 0x2e,
-	0xcb, 0x1e, 0x8d, 0xa7, 0xaf, 0x87, 0x24, 0x20, 0x0f, 0x2f, 0x88, 0xe7, 0x9e, 0x8b, 0x5f, 0xc1,
-	0xc7, 0x3f, 0x43, 0x9f, 0x1d, 0xd7, 0xa3, 0x43, 0xeb, 0x9c, 0x04, 0x61, 0x66, 0xb5, 0x37, 0x39,
-	0x28, 0x60, 0x4a, 0x86, 0x98, 0x7e, 0x37, 0xa5, 0x7e, 0x80, 0x34, 0xd8, 0x62, 0x2c, 0x03, 0xe2,
-	0xd3, 0xb2, 0xac, 0xcb, 0xf5, 0x3c, 0x8e, 0x65, 0xf4, 0x15, 0xec, 0x04, 0xd6, 0x84, 0xfa, 0x01,
-	0x99, 0xb8, 0x67, 0x1e, 0xb1, 0x4d, 0x5a, 0xde, 0xd0, 0xe5, 0x7a, 0xa1, 0xf9, 0xbf, 0x46, 0x54,
-	0x6e, 0x3f, 0xb2, 0x63, 0x66, 0x6e, 0xed, 0xbf, 0xbd, 0xac, 0x4a, 0x8b, 0xcb, 0xea, 0x76, 0x5a,
-	0x8f, 0xb7, 0x83, 0x94, 0x8c, 0x2a, 0x00, 0x43, 0xea, 0x9f, 0x53, 0x7b, 0x68, 0xd9, 0x66, 0x39,
-	0xa3, 0xcb, 0xf5, 0x2d, 0x9c, 0xd0, 0xb0, 0xac, 0x4c, 0xcf, 0x99, 0xba, 0xcc, 0xaa, 0xe8, 0x19,
-	0x96, 0x55, 0x24, 0xa3, 0x47, 0x90, 0x8f, 0x8b, 0x2a, 0x67, 0x79, 0x3e, 0x28, 0xce, 0xe7, 0x65,
-	0x64, 0xc1, 0x4b, 0x10, 0x6a, 0x42, 0xd1, 0xa7, 0x9e, 0x45, 0xfd, 0xb3, 0xb1, 0x35, 0xb1, 0x82,
-	0x72, 0x4e, 0x97, 0xeb, 0x4a, 0x6b, 0x67, 0x71, 0x59, 0x2d, 0xf4, 0xb8, 0xfe, 0x98, 0xa9, 0x71,
-	0xc1, 0x5f, 0x0a, 0xe8, 0x63, 0x28, 0x85, 0x3e, 0xce, 0x68, 0xe4, 0xd3, 0xa0, 0xbc, 0xc9, 0x9d,
-	0xd4, 0xc5, 0x65, 0xb5, 0x28, 0x9c, 0xba, 0x5c, 0x8f, 0x43, 0x6a, 0x21, 0xb1, 0x50, 0xae, 0x63,
-	0xd9, 0x41, 0x14, 0x6a, 0x6b, 0x19, 0xea, 0x25, 0xd7, 0x87, 0xa1, 0xdc, 0xa5, 0xc0, 0x0a, 0x22,
-	0xa6, 0xe9, 0x51, 0x93, 0x15, 0x94, 0x5f, 0x29, 0xe8, 0x20, 0xb2, 0xe0, 0x25, 0x08, 0x7d, 0x01,
-	0xd9, 0xc0, 0x23, 0xe7, 0xb4, 0x0c, 0x7a, 0xa6, 0x5e, 0x68, 0x56, 0x63, 0x74, 0xe2, 0x64, 0x1b,
-	0x7d, 0x86, 0x68, 0xdb, 0x81, 0x77, 0xd1, 0xca, 0x2f, 0x2e, 0xab, 0x59, 0x2e, 0x63, 0xe1, 0x88,
-	0x4e, 0xa0, 0xe8, 0x09, 0xdc, 0x59, 0x70, 0xe1, 0xd2, 0x72, 0x41, 0x97, 0xeb, 0xdb, 0xcd, 0xff,
-	0xaf, 0x27, 0xba, 0x70, 0xa9, 0x28, 0x21, 0xd4, 0x30, 0x05, 0x2e, 0x78, 0x4b, 0x01, 0xe9, 0x90,
-	0x73, 0x3c, 0xf3, 0xcc, 0x1a, 0x96, 0x8b, 0x6c, 0x86, 0x44, 0xc0, 0xae, 0x67, 0x76, 0x9e, 0xe1,
-	0xac, 0xe3, 0x99, 0x9d, 0xa1, 0xf6, 0x18, 0x60, 0x99, 0x10, 0x52, 0x21, 0xf3, 0x2d, 0xbd, 0x08,
-	0x07, 0x8e, 0x7d, 0xa2, 0x3d, 0xc8, 0x7e, 0x4f, 0xc6, 0x53, 0x31, 0x61, 0x79, 0x2c, 0x84, 0xcf,
-	0x36, 0x1e, 0xcb, 0x35, 0x0f, 0x14, 0x1e, 0xa3, 0x09, 0xa5, 0x5e, 0xc7, 0x38, 0x3c, 0x6e, 0x9f,
-	0xf5, 0xdb, 0xc6, 0x81, 0xd1, 0x57, 0x25, 0xad, 0x3a, 0x9b, 0xeb, 0x77, 0x12, 0xa9, 0x32, 0x5c,
-	0xcf, 0xb2, 0xcd, 0x31, 0xed, 0x53, 0x9b, 0xd8, 0xac, 0xb5, 0xc5, 0x93, 0xd3, 0xe3, 0x7e, 0x27,
-	0x72, 0x91, 0xb5, 0xca, 0x6c, 0xae, 0x6b, 0x2b, 0x2e, 0x27, 0xd3, 0x71, 0x60, 0x09, 0x0f, 0x4d,
-	0xf9, 0xf1, 0x4d, 0x45, 0xaa, 0xfd, 0x2e, 0x43, 0x3e, 0xee, 0x3c, 0xfa, 0x08, 0x14, 0xde, 0x24,
-	0x99, 0x37, 0x49, 0xbf, 0x7a, 0x36, 0xcb, 0x2f, 0xde, 0x1a, 0x8e, 0xae, 0xbd, 0x86, 0x52, 0x4a,
-	0x8d, 0xaa, 0xa0, 0x18, 0x5d, 0xa3, 0xad, 0x4a, 0xda, 0xed, 0xd9, 0x5c, 0xdf, 0x4d, 0x19, 0x0d,
-	0xc7, 0xa6, 0xe8, 0x2e, 0x64, 0x7a, 0xa7, 0x27, 0xaa, 0xac, 0xed, 0xcd, 0xe6, 0xba, 0x9a, 0xb2,
-	0xf7, 0xa6, 0x13, 0x74, 0x0f, 0xb2, 0x4f, 0xbb, 0xa7, 0x46, 0x5f, 0xdd, 0xd0, 0xf6, 0x67, 0x73,
-	0x1d, 0xa5, 0x00, 0x4f, 0x9d, 0x69, 0x9c, 0xfd, 0x03, 0xc8, 0xf4, 0x89, 0x99, 0x6c, 0x72, 0x71,
-	0x4d, 0x93, 0x8b, 0x61, 0x93, 0x6b, 0xbf, 0x14, 0xa0, 0x28, 0x3a, 0xe2, 0xbb, 0x8e, 0xed, 0x53,
-	0xf4, 0x29, 0xe4, 0x46, 0x1e, 0x99, 0x50, 0xbf, 0x2c, 0xf3, 0xf9, 0xba, 0xb3, 0x32, 0x16, 0x02,
-	0xd6, 0x78, 0xce, 0x30, 0x2d, 0x85, 0xad, 0x3c, 0x0e, 0x1d, 0xb4, 0x3f, 0x14, 0xc8, 0x72, 0x3d,
-	0x7a, 0x02, 0x39, 0xb1, 0x19, 0x3c, 0x81, 0x42, 0xf3, 0xde, 0x7a, 0x12, 0xb1, 0x4b, 0xdc, 0xe5,
-	0x48, 0xc2, 0xa1, 0x0b, 0xfa, 0x1a, 0x8a, 0xa3, 0xb1, 0x43, 0x82, 0x33, 0xb1, 0x27, 0xe1, 0xb5,
-	0x73, 0xff, 0x9a, 0x3c, 0x18, 0x52, 0x6c, 0x97, 0x48, 0x89, 0xcf, 0x6a, 0x42, 0x7b, 0x24, 0xe1,
-	0xc2, 0x68, 0x29, 0xa2, 0x21, 0x6c, 0x5b, 0x76, 0x40, 0x4d, 0xea, 0x45, 0xfc, 0x19, 0xce, 0x5f,
-	0x5f, 0xcf, 0xdf, 0x11, 0xd8, 0x64, 0x84, 0xdd, 0xc5, 0x65, 0xb5, 0x94, 0xd2, 0x1f, 0x49, 0xb8,
-	0x64, 0x25, 0x15, 0xe8, 0x15, 0xec, 0x4c, 0x6d, 0xdf, 0x32, 0x6d, 0x3a, 0x8c, 0xc2, 0x28, 0x3c,
-	0xcc, 0x07, 0xeb, 0xc3, 0x9c, 0x86, 0xe0, 0x64, 0x1c, 0xc4, 0xee, 0xd2, 0xb4, 0xe1, 0x48, 0xc2,
-	0xdb, 0xd3, 0x94, 0x86, 0xd5, 0x33, 0x70, 0x9c, 0x31, 0x25, 0x76, 0x14, 0x28, 0xfb, 0xae, 0x7a,
-	0x5a, 0x02, 0x7b, 0xa5, 0x9e, 0x94, 0x9e, 0xd5, 0x33, 0x48, 0x2a, 0xd0, 0x37, 0xec, 0x91, 0xf3,
-	0x2c, 0xdb, 0x8c, 0x82, 0xe4, 0x78, 0x90, 0xf7, 0xaf, 0x39, 0x57, 0x0e, 0x4d, 0xc6, 0x10, 0x57,
-	0x67, 0x42, 0x7d, 0x24, 0xe1, 0xa2, 0x9f, 0x90, 0x5b, 0x39, 0x50, 0xd8, 0xdb, 0xa3, 0x79, 0x50,
-	0x48, 0x8c, 0x05, 0xba, 0x0f, 0x4a, 0x40, 0xcc, 0x68, 0x18, 0x8b, 0xcb, 0xb7, 0x87, 0x98, 0xe1,
-	0xf4, 0x71, 0x3b, 0x7a, 0x02, 0x79, 0xe6, 0x2e, 0x2e, 0xb4, 0x0d, 0xbe, 0xab, 0x95, 0xf5, 0xc9,
-	0x3d, 0x23, 0x01, 0xe1, 0x9b, 0xca, 0xdf, 0x3a, 0xf6, 0xa5, 0x7d, 0x09, 0xea, 0xea, 0x1c, 0xb1,
-	0x57, 0x2a, 0x7e, 0xb7, 0x44, 0x78, 0x15, 0x27, 0x34, 0x68, 0x1f, 0x72, 0x7c, 0x83, 0xd8, 0x7c,
-	0x66, 0xea, 0x32, 0x0e, 0x25, 0xed, 0x18, 0xd0, 0xd5, 0x99, 0xb9, 0x21, 0x5b, 0x26, 0x66, 0x3b,
-	0x81, 0x5b, 0x6b, 0x46, 0xe3, 0x86, 0x74, 0x4a, 0x32, 0xb9, 0xab, 0x03, 0x70, 0x43, 0xb6, 0xad,
-	0x98, 0xed, 0x05, 0xec, 0x5e, 0x39, 0xe9, 0x1b, 0x92, 0xe5, 0x23, 0xb2, 0x5a, 0x0f, 0xf2, 0x9c,
-	0x20, 0xbc, 0x2d, 0x73, 0xbd, 0x36, 0xee, 0xb4, 0x7b, 0xaa, 0xa4, 0xdd, 0x9a, 0xcd, 0xf5, 0x9d,
-	0xd8, 0x24, 0x66, 0x83, 0x01, 0x5e, 0x76, 0x3b, 0x46, 0xbf, 0xa7, 0xca, 0x2b, 0x00, 0x91, 0x4b,
-	0x78, 0x19, 0xfe, 0x26, 0xc3, 0x56, 0x74, 0xde, 0xe8, 0x3d, 0xc8, 0x3e, 0x3f, 0xee, 0x1e, 0xb0,
-	0xb7, 0x63, 0x77, 0x36, 0xd7, 0x4b, 0x91, 0x81, 0x1f, 0x3d, 0xd2, 0x61, 0xb3, 0x63, 0xf4, 0xdb,
-	0x87, 0x6d, 0x1c, 0x51, 0x46, 0xf6, 0xf0, 0x38, 0x51, 0x0d, 0xb6, 0x4e, 0x8d, 0x5e, 0xe7, 0xd0,
-	0x68, 0x3f, 0x53, 0x37, 0xc4, 0x35, 0x1d, 0x41, 0xa2, 0x33, 0x62, 0x2c, 0xad, 0x6e, 0xf7, 0xb8,
-	0x7d, 0x60, 0xa8, 0x99, 0x34, 0x4b, 0xd8, 0x77, 0x54, 0x81, 0x5c, 0xaf, 0x8f, 0x3b, 0xc6, 0xa1,
-	0xaa, 0x68, 0x68, 0x36, 0xd7, 0xb7, 0x23, 0x80, 0x68, 0x65, 0x98, 0xf8, 0x4f, 0x32, 0xec, 0x3d,
-	0x25, 0x2e, 0x19, 0x58, 0x63, 0x2b, 0xb0, 0xa8, 0x1f, 0x5f, 0xcf, 0x4f, 0x40, 0x39, 0x27, 0x6e,
-	0xb4, 0x0f, 0xcb, 0xfd, 0x5b, 0x07, 0x66, 0x4a, 0x9f, 0xbf, 0xb9, 0x98, 0x3b, 0x69, 0x9f, 0x40,
-	0x3e, 0x56, 0xdd, 0xe8, 0x19, 0xde, 0x81, 0xd2, 0x11, 0x6b, 0x6b, 0xc4, 0x5c, 0x7b, 0x0c, 0x2b,
-	0xff, 0xf2, 0x98, 0xb3, 0x1f, 0x10, 0x2f, 0xe0, 0x84, 0x19, 0x2c, 0x04, 0x16, 0x84, 0xda, 0x43,
-	0x4e, 0x98, 0xc1, 0xec, 0xb3, 0xf9, 0x97, 0x0c, 0x9b, 0x3d, 0x91, 0x34, 0x2b, 0x86, 0xad, 0x26,
-	0xda, 0x5b, 0xf7, 0xd7, 0x43, 0xbb, 0xbd, 0x76, 0x7f, 0x6b, 0xca, 0x0f, 0xbf, 0x96, 0xa5, 0x47,
-	0x32, 0x7a, 0x01, 0xc5, 0x64, 0xd1, 0x68, 0xbf, 0x21, 0xfe, 0x3f, 0x37, 0xa2, 0xff, 0xcf, 0x8d,
-	0x36, 0xfb, 0xff, 0xac, 0xdd, 0x7d, 0x67, 0x8f, 0x38, 0x9d, 0x8c, 0x3e, 0x87, 0x2c, 0x2f, 0xf0,
-	0x5a, 0x96, 0xfd, 0x98, 0x25, 0xdd, 0x08, 0xe6, 0xbe, 0xa1, 0xf1, 0x9c, 0x5a, 0x7b, 0x6f, 0xff,
-	0xa9, 0x48, 0x6f, 0x17, 0x15, 0xf9, 0xcf, 0x45, 0x45, 0xfe, 0x7b, 0x51, 0x91, 0x7f, 0xfe, 0xb7,
-	0x22, 0x0d, 0x72, 0x9c, 0xe9, 0xc3, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0xc3, 0xd6, 0xa7, 0xe4,
-	0x26, 0x0c, 0x00, 0x00,
+	// 1994 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x18, 0x4d, 0x6f, 0xdb, 0xd8,
+	0x51, 0x94, 0xa8, 0xaf, 0xd1, 0x87, 0x99, 0x17, 0xaf, 0xa3, 0x65, 0x76, 0x25, 0xae, 0x0e, 0x59,
+	0x07, 0x68, 0x1c, 0xc3, 0xfd, 0x4a, 0x1b, 0x14, 0xa8, 0x65, 0xcb, 0xb1, 0x1a, 0x8b, 0x0e, 0x9e,
+	0xe4, 0xee, 0x02, 0x2d, 0xaa, 0x52, 0xe2, 0x33, 0x43, 0xac, 0x44, 0xaa, 0x24, 0xb5, 0x1b, 0xef,
+	0xa9, 0xc7, 0xc2, 0xd8, 0x43, 0x0f, 0xbd, 0x15, 0x3e, 0xed, 0x6f, 0x68, 0x7f, 0x40, 0x4f, 0x39,
+	0x15, 0xbd, 0x14, 0xbd, 0xa9, 0xad, 0x7a, 0xea, 0x9f, 0x28, 0x8a, 0xf7, 0xc1, 0x0f, 0xd9, 0xcc,
+	0x22, 0x3e, 0x2d, 0x72, 0x11, 0xde, 0x7c, 0xcf, 0xbc, 0x99, 0x37, 0x33, 0x14, 0xd4, 0xfc, 0xc0,
+	0xf5, 0x0c, 0x8b, 0xec, 0xcc, 0x3d, 0x37, 0x70, 0x51, 0x51, 0x80, 0xea, 0x23, 0xcb, 0x0e, 0x5e,
+	0x2e, 0xc6, 0x3b, 0x13, 0x77, 0xf6, 0xd8, 0x72, 0x2d, 0xf7, 0x31, 0xa3, 0x8f, 0x17, 0xe7, 0x0c,
+	0x62, 0x00, 0x3b, 0x71, 0x39, 0xf5, 0xbe, 0xe5, 0xba, 0xd6, 0x94, 0xc4, 0x5c, 0x64, 0x36, 0x0f,
+	0x2e, 0x04, 0x71, 0x2f, 0xa1, 0xcb, 0x76, 0xce, 0xa7, 0x8b, 0x57, 0xa6, 0x11, 0x18, 0x8f, 0x2f,
+	0x0c, 0x6f, 0x3e, 0xe1, 0xbf, 0x5c, 0x1f, 0x3b, 0x0a, 0x99, 0x8d, 0xb9, 0x47, 0x4c, 0x7b, 0x62,
+	0x04, 0xc2, 0xb3, 0xf6, 0x57, 0x45, 0xa8, 0x60, 0x62, 0x98, 0x98, 0xfc, 0x66, 0x41, 0xfc, 0x00,
+	0xa9, 0x50, 0xa2, 0x5a, 0xc6, 0x86, 0x4f, 0x1a, 0x92, 0x26, 0x6d, 0x97, 0x71, 0x04, 0xa3, 0x4f,
+	0x61, 0x23, 0xb0, 0x67, 0xc4, 0x0f, 0x8c, 0xd9, 0x7c, 0xe4, 0x19, 0x8e, 0x45, 0x1a, 0x59, 0x4d,
+	0xda, 0xae, 0xec, 0xdd, 0xdb, 0x09, 0xc3, 0x1d, 0x86, 0x74, 0x4c, 0xc9, 0x9d, 0xad, 0xd7, 0xcb,
+	0x56, 0x66, 0xb5, 0x6c, 0xd5, 0xd7, 0xf1, 0xb8, 0x1e, 0xac, 0xc1, 0xa8, 0x09, 0x60, 0x12, 0x7f,
+	0x42, 0x1c, 0xd3, 0x76, 0xac, 0x46, 0x4e, 0x93, 0xb6, 0x4b, 0x38, 0x81, 0xa1, 0x5e, 0x59, 0x9e,
+	0xbb, 0x98, 0x53, 0xaa, 0xac, 0xe5, 0xa8, 0x57, 0x21, 0x8c, 0x76, 0xa1, 0x1c, 0x05, 0xd5, 0xc8,
+	0x33, 0x7f, 0x50, 0xe4, 0xcf, 0x8b, 0x90, 0x82, 0x63, 0x26, 0xb4, 0x07, 0x55, 0x9f, 0x78, 0x36,
+	0xf1, 0x47, 0x53, 0x7b, 0x66, 0x07, 0x8d, 0x82, 0x26, 0x6d, 0xcb, 0x9d, 0x8d, 0xd5, 0xb2, 0x55,
+	0x19, 0x30, 0xfc, 0x09, 0x45, 0xe3, 0x8a, 0x1f, 0x03, 0xe8, 0xfb, 0x50, 0x13, 0x32, 0xee, 0xf9,
+	0xb9, 0x4f, 0x82, 0x46, 0x91, 0x09, 0x29, 0xab, 0x65, 0xab, 0xca, 0x85, 0x4e, 0x19, 0x1e, 0x57,
+	0xfd, 0x04, 0x44, 0x4d, 0xcd, 0x5d, 0xdb, 0x09, 0x42, 0x53, 0xa5, 0xd8, 0xd4, 0x0b, 0x86, 0x17,
+	0xa6, 0xe6, 0x31, 0x40, 0x03, 0x32, 0x2c, 0xcb, 0x23, 0x16, 0x0d, 0xa8, 0x7c, 0x2d, 0xa0, 0xfd,
+	0x90, 0x82, 0x63, 0x26, 0xf4, 0x53, 0xc8, 0x07, 0x9e, 0x31, 0x21, 0x0d, 0xd0, 0x72, 0xdb, 0x95,
+	0xbd, 0x56, 0xc4, 0x9d, 0xc8, 0xec, 0xce, 0x90, 0x72, 0x74, 0x9d, 0xc0, 0xbb, 0xe8, 0x94, 0x57,
+	0xcb, 0x56, 0x9e, 0xc1, 0x98, 0x0b, 0xa2, 0x3e, 0x54, 0x3d, 0xce, 0x37, 0x0a, 0x2e, 0xe6, 0xa4,
+	0x51, 0xd1, 0xa4, 0xed, 0xfa, 0xde, 0xfb, 0xe9, 0x8a, 0x2e, 0xe6, 0x84, 0x87, 0x20, 0x30, 0x14,
+	0x81, 0x2b, 0x5e, 0x0c, 0x20, 0x0d, 0x0a, 0xae, 0x67, 0x8d, 0x6c, 0xb3, 0x51, 0xa5, 0x35, 0xc4,
+	0x0d, 0x9e, 0x7a, 0x56, 0xef, 0x10, 0xe7, 0x5d, 0xcf, 0xea, 0x99, 0xe8, 0x09, 0x40, 0xe4, 0xbf,
+	0xdf, 0xa8, 0x69, 0xb9, 0xf4, 0x28, 0x3b, 0x32, 0xad, 0x20, 0x9c, 0xe0, 0x45, 0x8f, 0xa0, 0xf0,
+	0x85, 0xed, 0x98, 0xee, 0x17, 0x8d, 0x3a, 0xbb, 0x9b, 0x8d, 0x48, 0xea, 0x13, 0x86, 0x16, 0x22,
+	0x82, 0x49, 0x7d, 0x02, 0x10, 0x47, 0x8e, 0x14, 0xc8, 0x7d, 0x46, 0x2e, 0x44, 0x65, 0xd3, 0x23,
+	0xda, 0x84, 0xfc, 0xe7, 0xc6, 0x74, 0xc1, 0x4b, 0xb9, 0x8c, 0x39, 0xf0, 0xe3, 0xec, 0x13, 0xa9,
+	0xed, 0x81, 0xcc, 0x82, 0xd9, 0x83, 0xda, 0xa0, 0xa7, 0x3f, 0x3b, 0xe9, 0x8e, 0x86, 0x5d, 0x7d,
+	0x5f, 0x1f, 0x2a, 0x19, 0xb5, 0x75, 0x79, 0xa5, 0xdd, 0x4f, 0xdc, 0x09, 0xe5, 0x1b, 0xd8, 0x8e,
+	0x35, 0x25, 0x43, 0xe2, 0x18, 0x0e, 0xcd, 0x61, 0xb5, 0x7f, 0x76, 0x32, 0xec, 0x85, 0x22, 0x92,
+	0xda, 0xbc, 0xbc, 0xd2, 0xd4, 0x6b, 0x22, 0xfd, 0xc5, 0x34, 0xb0, 0xb9, 0x84, 0x2a, 0xff, 0xee,
+	0xeb, 0x66, 0xa6, 0xfd, 0xcf, 0x2c, 0x94, 0xa3, 0xe0, 0xd1, 0xf7, 0x40, 0x66, 0xd9, 0x90, 0x58,
+	0x36, 0xb4, 0x9b, 0xd7, 0x13, 0x9f, 0x58, 0x0e, 0x18, 0x77, 0xfb, 0x8f, 0x59, 0xa8, 0xad, 0xe1,
+	0x51, 0x0b, 0x64, 0xfd, 0x54, 0xef, 0x2a, 0x19, 0xf5, 0xbd, 0xcb, 0x2b, 0xed, 0xce, 0x1a, 0x51,
+	0x77, 0x1d, 0x82, 0x3e, 0x84, 0xdc, 0xe0, 0xac, 0xaf, 0x48, 0xea, 0xe6, 0xe5, 0x95, 0xa6, 0xac,
+	0xd1, 0x07, 0x8b, 0x19, 0xfa, 0x08, 0xf2, 0x07, 0xa7, 0x67, 0xfa, 0x50, 0xc9, 0xaa, 0x5b, 0x97,
+	0x57, 0x1a, 0x5a, 0x63, 0x38, 0x70, 0x17, 0x4e, 0x40, 0x35, 0xf4, 0x7b, 0xba, 0x92, 0x4b, 0xd1,
+	0xd0, 0xb7, 0x1d, 0x46, 0xde, 0xff, 0x54, 0x91, 0xd3, 0xc8, 0xc6, 0x2b, 0xea, 0x60, 0xbf, 0xbb,
+	0xaf, 0x2b, 0xf9, 0x14, 0x07, 0xfb, 0xc4, 0x70, 0xa8, 0x07, 0x47, 0x3d, 0x3c, 0x18, 0x2a, 0x85,
+	0x14, 0x0f, 0x8e, 0x6c, 0xcf, 0x0f, 0xa8, 0x8e, 0x93, 0xfd, 0xc1, 0x50, 0x29, 0xa6, 0xe8, 0x38,
+	0x31, 0xfc, 0xf0, 0x86, 0x7f, 0x00, 0x05, 0x5e, 0x27, 0x34, 0xf3, 0xe4, 0x73, 0xe2, 0xf1, 0x6a,
+	0xc8, 0x61, 0x0e, 0xa0, 0x2d, 0x28, 0x88, 0x17, 0x9e, 0x65, 0x68, 0x01, 0xb5, 0x1f, 0x41, 0x6e,
+	0x68, 0x58, 0xc9, 0x02, 0xaa, 0xa6, 0x14, 0x50, 0x55, 0x14, 0x50, 0xfb, 0x0f, 0x15, 0xa8, 0xf2,
+	0x6c, 0xfb, 0x73, 0xd7, 0xf1, 0x09, 0xfa, 0x11, 0x14, 0xce, 0x3d, 0x63, 0x46, 0xfc, 0x86, 0xc4,
+	0x8a, 0xfd, 0xfe, 0xb5, 0xb7, 0xc5, 0xd9, 0x76, 0x8e, 0x28, 0x4f, 0x58, 0xc2, 0x5c, 0x40, 0xfd,
+	0x8b, 0x0c, 0x79, 0x86, 0x47, 0x4f, 0xa1, 0xc0, 0xdb, 0x0b, 0x73, 0xa0, 0xb2, 0xf7, 0x51, 0xba,
+	0x12, 0xde, 0x90, 0x98, 0xc8, 0x71, 0x06, 0x0b, 0x11, 0xf4, 0x4b, 0xa8, 0x9e, 0x4f, 0x5d, 0x23,
+	0x18, 0xf1, 0x66, 0x23, 0x7a, 0xf7, 0x83, 0x37, 0xf8, 0x41, 0x39, 0x79, 0x8b, 0xe2, 0x2e, 0xb1,
+	0x07, 0x9f, 0xc0, 0x1e, 0x67, 0x70, 0xe5, 0x3c, 0x06, 0x91, 0x09, 0x75, 0xdb, 0x09, 0x88, 0x45,
+	0xbc, 0x50, 0x7f, 0x8e, 0xe9, 0xdf, 0x4e, 0xd7, 0xdf, 0xe3, 0xbc, 0x49, 0x0b, 0x77, 0x56, 0xcb,
+	0x56, 0x6d, 0x0d, 0x7f, 0x9c, 0xc1, 0x35, 0x3b, 0x89, 0x40, 0x2f, 0x61, 0x63, 0xe1, 0xf8, 0xb6,
+	0xe5, 0x10, 0x33, 0x34, 0x23, 0x33, 0x33, 0x0f, 0xd3, 0xcd, 0x9c, 0x09, 0xe6, 0xa4, 0x1d, 0x44,
+	0x07, 0xd2, 0x3a, 0xe1, 0x38, 0x83, 0xeb, 0x8b, 0x35, 0x0c, 0x8d, 0x67, 0xec, 0xba, 0x53, 0x62,
+	0x38, 0xa1, 0xa1, 0xfc, 0x37, 0xc5, 0xd3, 0xe1, 0xbc, 0x37, 0xe2, 0x59, 0xc3, 0xd3, 0x78, 0xc6,
+	0x49, 0x04, 0xfa, 0x35, 0xdd, 0x14, 0x3c, 0xdb, 0xb1, 0x42, 0x23, 0x05, 0x66, 0xe4, 0xe3, 0x37,
+	0xe4, 0x95, 0xb1, 0x26, 0x6d, 0xf0, 0xf9, 0x93, 0x40, 0x1f, 0x67, 0x70, 0xd5, 0x4f, 0xc0, 0x9d,
+	0x02, 0xc8, 0x74, 0x80, 0xab, 0x1e, 0x54, 0x12, 0x65, 0x81, 0x1e, 0x80, 0x1c, 0x18, 0x56, 0x58,
+	0x8c, 0xd5, 0x78, 0x80, 0x1b, 0x96, 0xa8, 0x3e, 0x46, 0x47, 0x4f, 0xa1, 0x4c, 0xc5, 0xf9, 0x54,
+	0xc8, 0xb2, 0x3e, 0xd4, 0x4c, 0x77, 0xee, 0xd0, 0x08, 0x0c, 0xd6, 0x85, 0x4a, 0xa6, 0x38, 0xa9,
+	0x3f, 0x03, 0xe5, 0x7a, 0x1d, 0xd1, 0x51, 0x1f, 0x0d, 0x7f, 0x6e, 0x5e, 0xc1, 0x09, 0x0c, 0x7d,
+	0x7f, 0xec, 0x05, 0xd1, 0xfa, 0xcc, 0x6d, 0x4b, 0x58, 0x40, 0xea, 0x09, 0xa0, 0x9b, 0x35, 0x73,
+	0x4b, 0x6d, 0xb9, 0x48, 0x5b, 0x1f, 0xee, 0xa6, 0x94, 0xc6, 0x2d, 0xd5, 0xc9, 0x49, 0xe7, 0x6e,
+	0x16, 0xc0, 0x2d, 0xb5, 0x95, 0x22, 0x6d, 0xcf, 0xe1, 0xce, 0x8d, 0x4c, 0xdf, 0x52, 0x59, 0x39,
+	0x54, 0xd6, 0x1e, 0x40, 0x99, 0x29, 0x10, 0x83, 0xa0, 0x30, 0xe8, 0xe2, 0x5e, 0x77, 0xa0, 0x64,
+	0xd4, 0xbb, 0x97, 0x57, 0xda, 0x46, 0x44, 0xe2, 0xb5, 0x41, 0x19, 0x5e, 0x9c, 0xf6, 0xf4, 0xe1,
+	0x40, 0x91, 0xae, 0x31, 0x70, 0x5f, 0x44, 0x13, 0xfd, 0xb3, 0x04, 0xa5, 0x30, 0xdf, 0xe8, 0x03,
+	0xc8, 0x1f, 0x9d, 0x9c, 0xee, 0xd3, 0xb9, 0x78, 0xe7, 0xf2, 0x4a, 0xab, 0x85, 0x04, 0x96, 0x7a,
+	0xa4, 0x41, 0xb1, 0xa7, 0x0f, 0xbb, 0xcf, 0xba, 0x38, 0x54, 0x19, 0xd2, 0x45, 0x3a, 0x51, 0x1b,
+	0x4a, 0x67, 0xfa, 0xa0, 0xf7, 0x4c, 0xef, 0x1e, 0x2a, 0x59, 0x3e, 0x20, 0x42, 0x96, 0x30, 0x47,
+	0x54, 0x4b, 0xe7, 0xf4, 0xf4, 0x84, 0xce, 0x88, 0xdc, 0xba, 0x16, 0x71, 0xef, 0xa8, 0x09, 0x85,
+	0xc1, 0x10, 0xf7, 0xf4, 0x67, 0x8a, 0xac, 0xa2, 0xcb, 0x2b, 0xad, 0x1e, 0x32, 0xf0, 0xab, 0x14,
+	0x8e, 0x13, 0xa8, 0x7e, 0xe2, 0xd9, 0x01, 0x79, 0x9b, 0x75, 0x37, 0xee, 0xd8, 0xd9, 0x5b, 0x76,
+	0xec, 0xf6, 0x2b, 0xa8, 0x09, 0x33, 0xa2, 0xfb, 0x3f, 0x80, 0x92, 0xd8, 0x03, 0x1d, 0x3e, 0x6e,
+	0x3a, 0x95, 0xd5, 0xb2, 0x55, 0xe4, 0x97, 0xaa, 0xe3, 0x22, 0x27, 0xea, 0xe8, 0x21, 0x94, 0x4d,
+	0xcf, 0x9d, 0xcf, 0x89, 0x39, 0x72, 0xf8, 0x00, 0xea, 0x54, 0x57, 0xcb, 0x56, 0xe9, 0x90, 0x23,
+	0x75, 0x5c, 0x12, 0x64, 0x9d, 0x8d, 0x2f, 0xcf, 0x73, 0x3d, 0xd6, 0x67, 0xcb, 0x98, 0x03, 0xed,
+	0xbf, 0x4a, 0x50, 0x1f, 0x1a, 0xd6, 0x73, 0x72, 0xe1, 0x7f, 0xbb, 0x2b, 0xfd, 0xda, 0x5a, 0x9e,
+	0x7b, 0x9b, 0xb5, 0x7c, 0x13, 0xf2, 0x7c, 0x49, 0xa6, 0x1d, 0x5d, 0xc6, 0x1c, 0x68, 0xff, 0x57,
+	0x02, 0x65, 0x68, 0x58, 0x3f, 0x67, 0xd5, 0xfc, 0xae, 0x85, 0x74, 0x0f, 0x8a, 0x81, 0x61, 0x8d,
+	0xe8, 0xc6, 0x20, 0x33, 0x37, 0x0b, 0x01, 0xcb, 0x4d, 0x1c, 0x6b, 0x3e, 0x19, 0xeb, 0x3f, 0x24,
+	0xb8, 0xd7, 0x27, 0x86, 0xbf, 0xf0, 0xc8, 0x8c, 0x38, 0x81, 0x6e, 0xcc, 0xde, 0xbd, 0x90, 0xd3,
+	0xb3, 0xb8, 0x03, 0x9b, 0xfc, 0x1d, 0x86, 0x79, 0x14, 0xef, 0x22, 0xee, 0x5a, 0xb4, 0xa3, 0x55,
+	0xa3, 0xae, 0xf5, 0x95, 0x04, 0x9b, 0x07, 0xc6, 0xdc, 0x18, 0xdb, 0x53, 0x3b, 0xb0, 0x13, 0x02,
+	0x4f, 0x41, 0x9e, 0x18, 0xf3, 0x70, 0x6e, 0xc5, 0x73, 0x32, 0x8d, 0x99, 0x22, 0x7d, 0xb6, 0xf7,
+	0x63, 0x26, 0xa4, 0xfe, 0x10, 0xca, 0x11, 0xea, 0x56, 0x9f, 0x02, 0x1b, 0x50, 0x3b, 0xa6, 0x0f,
+	0x34, 0xd4, 0xdc, 0x7e, 0x02, 0xd7, 0x6e, 0x8e, 0x0a, 0xfb, 0x81, 0xe1, 0x05, 0xe1, 0x36, 0xc9,
+	0x00, 0x6a, 0x84, 0x38, 0xa6, 0x58, 0x25, 0xe9, 0xb1, 0xfd, 0x2b, 0xa8, 0x77, 0x5f, 0xcd, 0xa7,
+	0x86, 0xed, 0x84, 0x99, 0x3d, 0xa1, 0xdf, 0x5e, 0x86, 0x39, 0x12, 0x1f, 0x50, 0x62, 0xb5, 0xdb,
+	0x4c, 0xfb, 0xf6, 0xea, 0xdc, 0x15, 0x79, 0x4b, 0x7e, 0xb3, 0xd3, 0x4f, 0xaf, 0x08, 0x68, 0xff,
+	0x4f, 0x82, 0x8d, 0xc8, 0x80, 0xb8, 0xb4, 0x87, 0x50, 0xf6, 0x5f, 0x1a, 0x9e, 0x39, 0xb2, 0x4d,
+	0x7e, 0x73, 0x32, 0xef, 0x2a, 0x03, 0x8a, 0xec, 0x1d, 0xfa, 0xb8, 0xc4, 0xc8, 0x3d, 0xd3, 0xa7,
+	0x8d, 0x4a, 0x7c, 0xe7, 0x86, 0xfd, 0x87, 0x35, 0x2a, 0x3e, 0x1e, 0x74, 0x5c, 0xe4, 0x44, 0x9d,
+	0xf2, 0x4d, 0x16, 0x9e, 0xef, 0x7a, 0x23, 0xa7, 0x91, 0x8b, 0xf9, 0x0e, 0x18, 0x4e, 0xc7, 0x45,
+	0x4e, 0xd4, 0xd1, 0x07, 0xc9, 0x02, 0xe2, 0x6f, 0x20, 0x46, 0xa0, 0x87, 0xa0, 0x08, 0x6b, 0xeb,
+	0x9f, 0xf0, 0x65, 0xbc, 0xc1, 0xf1, 0x51, 0x89, 0xa1, 0x8f, 0x41, 0x9e, 0xb8, 0x7e, 0x20, 0x16,
+	0xa4, 0x5a, 0x9c, 0x78, 0xd7, 0x0f, 0xc2, 0x8d, 0x85, 0x32, 0xb4, 0xbf, 0xce, 0x82, 0x4c, 0x91,
+	0xe8, 0x3b, 0x00, 0xce, 0x62, 0x36, 0x62, 0xa1, 0xf9, 0xa2, 0xeb, 0xd6, 0x56, 0xcb, 0x56, 0x59,
+	0x5f, 0xcc, 0x58, 0xe4, 0x3e, 0x2e, 0x3b, 0xe1, 0x31, 0xe2, 0xe6, 0xeb, 0x75, 0x76, 0x9d, 0x9b,
+	0x21, 0x39, 0x37, 0x3b, 0xd2, 0xbf, 0x03, 0x26, 0xc6, 0xe4, 0x25, 0x31, 0x47, 0xa2, 0x7c, 0xf9,
+	0x1d, 0xb0, 0x75, 0xec, 0x80, 0x11, 0x44, 0xa1, 0x57, 0x27, 0x09, 0x88, 0x26, 0x82, 0x1a, 0x39,
+	0xb7, 0xa7, 0x84, 0x2f, 0xae, 0xa2, 0xbd, 0xeb, 0x8b, 0xd9, 0x11, 0xc5, 0xe1, 0x92, 0x23, 0x4e,
+	0xe8, 0x31, 0x54, 0xc6, 0x53, 0x77, 0xf2, 0x99, 0x3f, 0xa2, 0xd9, 0x65, 0xb7, 0x92, 0xeb, 0xd4,
+	0x57, 0xcb, 0x16, 0x74, 0x18, 0x9a, 0x15, 0x00, 0x8c, 0xa3, 0x33, 0x0d, 0x80, 0x41, 0x23, 0xdf,
+	0xfe, 0x92, 0x34, 0x0a, 0x71, 0x00, 0x8c, 0x7f, 0x60, 0x7f, 0x49, 0x70, 0x79, 0x1c, 0x1e, 0xf7,
+	0xfe, 0x2e, 0x43, 0x71, 0xc0, 0xaf, 0x90, 0xbe, 0x29, 0xa6, 0x21, 0xb5, 0xe4, 0xd4, 0xf7, 0x52,
+	0xc7, 0x5e, 0x5b, 0xfe, 0xed, 0x9f, 0x1a, 0x99, 0x5d, 0x09, 0x3d, 0x87, 0x6a, 0xf2, 0xed, 0xa1,
+	0xad, 0x1d, 0xfe, 0x9f, 0xd5, 0x4e, 0xf8, 0x9f, 0xd5, 0x4e, 0x97, 0xfe, 0x67, 0xa5, 0x7e, 0xf8,
+	0x8d, 0x4f, 0x95, 0xa9, 0x93, 0xd0, 0x4f, 0x20, 0xcf, 0xde, 0xd9, 0x1b, 0xb5, 0x6c, 0x45, 0x5a,
+	0xd6, 0xdf, 0x23, 0x15, 0xcf, 0xa2, 0x0e, 0x14, 0x45, 0xe9, 0xa3, 0xb8, 0xf3, 0xad, 0xbf, 0x36,
+	0xb5, 0x71, 0x93, 0x90, 0xd0, 0x91, 0xa3, 0xff, 0xa5, 0xb0, 0xd1, 0x8d, 0xe2, 0xb8, 0x93, 0x1b,
+	0x83, 0xba, 0x75, 0x1d, 0x9d, 0x90, 0x96, 0xb7, 0xa5, 0x5d, 0x09, 0xf5, 0xa0, 0x28, 0x26, 0x70,
+	0xc2, 0x8b, 0xf5, 0x99, 0x9c, 0xb8, 0x8d, 0xb4, 0xb6, 0xc8, 0x94, 0xe5, 0x77, 0x25, 0xd4, 0x87,
+	0x72, 0x34, 0xfb, 0xd0, 0xfb, 0x49, 0x65, 0x6b, 0xf3, 0xf0, 0x6d, 0xd4, 0x15, 0x76, 0x25, 0xf4,
+	0x0b, 0x50, 0xae, 0x8f, 0x17, 0x14, 0xff, 0xab, 0xf0, 0x86, 0xc9, 0xf3, 0x36, 0xca, 0x8b, 0xbb,
+	0x92, 0xca, 0x0a, 0xa2, 0xb3, 0xf9, 0xfa, 0xdf, 0xcd, 0xcc, 0xeb, 0x55, 0x53, 0xfa, 0xdb, 0xaa,
+	0x29, 0xfd, 0x6b, 0xd5, 0x94, 0x7e, 0xff, 0x9f, 0x66, 0x66, 0x5c, 0x60, 0x69, 0xfc, 0xee, 0xff,
+	0x07, 0x00, 0xce, 0x9d, 0x09, 0xf0, 0x17, 0x15, 0x00, 0x00,
 }
@@ -11,11 +11,21 @@ It is generated from these files:
 It has these top-level messages:
 	ReadRequest
 	Aggregate
+	Window
 	Tag
 	ReadResponse
+	WriteRequest
+	WriteResponse
+	TagKeysRequest
+	TagValuesRequest
+	MeasurementNamesRequest
+	StringValuesResponse
 	CapabilitiesResponse
 	HintsResponse
 	TimestampRange
+	ExplainRequest
+	ExplainResponse
+	Cost
 	Node
 	Predicate
 */
@@ -54,6 +64,16 @@ type StorageClient interface {
 	// Capabilities returns a map of keys and values identifying the capabilities supported by the storage engine
 	Capabilities(ctx context.Context, in *google_protobuf1.Empty) (*CapabilitiesResponse, error)
 	Hints(ctx context.Context, in *google_protobuf1.Empty) (*HintsResponse, error)
+	// Explain describes the costs associated with executing a given Read request
+	Explain(ctx context.Context, in *ExplainRequest) (*ExplainResponse, error)
+	// Write writes a stream of point batches, replying with a WriteResponse for each batch.
+	Write(ctx context.Context) (Storage_WriteClient, error)
+	// TagKeys returns the sorted tag keys of the series matching the request.
+	TagKeys(ctx context.Context, in *TagKeysRequest) (Storage_TagKeysClient, error)
+	// TagValues returns the sorted values of a tag key for the series matching the request.
+	TagValues(ctx context.Context, in *TagValuesRequest) (Storage_TagValuesClient, error)
+	// MeasurementNames returns the sorted measurement names of the series matching the request.
+	MeasurementNames(ctx context.Context, in *MeasurementNamesRequest) (Storage_MeasurementNamesClient, error)
 }
 
 type storageClient struct {
@@ -111,6 +131,133 @@ func (c *storageClient) Hints(ctx context.Context, in *google_protobuf1.Empty) (
 	return out, nil
 }
 
+func (c *storageClient) Explain(ctx context.Context, in *ExplainRequest) (*ExplainResponse, error) {
+	out := new(ExplainResponse)
+	err := yarpc.Invoke(ctx, 0x0003, in, out, c.cc)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageClient) Write(ctx context.Context) (Storage_WriteClient, error) {
+	stream, err := yarpc.NewClientStream(ctx, &_Storage_serviceDesc.Streams[1], c.cc, 0x0004)
+	if err != nil {
+		return nil, err
+	}
+	x := &storageWriteClient{stream}
+	return x, nil
+}
+
+type Storage_WriteClient interface {
+	Send(*WriteRequest) error
+	Recv() (*WriteResponse, error)
+	yarpc.ClientStream
+}
+
+type storageWriteClient struct {
+	yarpc.ClientStream
+}
+
+func (x *storageWriteClient) Send(m *WriteRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *storageWriteClient) Recv() (*WriteResponse, error) {
+	m := new(WriteResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *storageClient) TagKeys(ctx context.Context, in *TagKeysRequest) (Storage_TagKeysClient, error) {
+	stream, err := yarpc.NewClientStream(ctx, &_Storage_serviceDesc.Streams[2], c.cc, 0x0005)
+	if err != nil {
+		return nil, err
+	}
+	x := &storageTagKeysClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Storage_TagKeysClient interface {
+	Recv() (*StringValuesResponse, error)
+	yarpc.ClientStream
+}
+
+type storageTagKeysClient struct {
+	yarpc.ClientStream
+}
+
+func (x *storageTagKeysClient) Recv() (*StringValuesResponse, error) {
+	m := new(StringValuesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *storageClient) TagValues(ctx context.Context, in *TagValuesRequest) (Storage_TagValuesClient, error) {
+	stream, err := yarpc.NewClientStream(ctx, &_Storage_serviceDesc.Streams[3], c.cc, 0x0006)
+	if err != nil {
+		return nil, err
+	}
+	x := &storageTagValuesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Storage_TagValuesClient interface {
+	Recv() (*StringValuesResponse, error)
+	yarpc.ClientStream
+}
+
+type storageTagValuesClient struct {
+	yarpc.ClientStream
+}
+
+func (x *storageTagValuesClient) Recv() (*StringValuesResponse, error) {
+	m := new(StringValuesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *storageClient) MeasurementNames(ctx context.Context, in *MeasurementNamesRequest) (Storage_MeasurementNamesClient, error) {
+	stream, err := yarpc.NewClientStream(ctx, &_Storage_serviceDesc.Streams[4], c.cc, 0x0007)
+	if err != nil {
+		return nil, err
+	}
+	x := &storageMeasurementNamesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Storage_MeasurementNamesClient interface {
+	Recv() (*StringValuesResponse, error)
+	yarpc.ClientStream
+}
+
+type storageMeasurementNamesClient struct {
+	yarpc.ClientStream
+}
+
+func (x *storageMeasurementNamesClient) Recv() (*StringValuesResponse, error) {
+	m := new(StringValuesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // Server API for Storage service
 
 type StorageServer interface {
@@ -119,6 +266,16 @@ type StorageServer interface {
 	// Capabilities returns a map of keys and values identifying the capabilities supported by the storage engine
 	Capabilities(context.Context, *google_protobuf1.Empty) (*CapabilitiesResponse, error)
 	Hints(context.Context, *google_protobuf1.Empty) (*HintsResponse, error)
+	// Explain describes the costs associated with executing a given Read request
+	Explain(context.Context, *ExplainRequest) (*ExplainResponse, error)
+	// Write writes a stream of point batches, replying with a WriteResponse for each batch.
+	Write(Storage_WriteServer) error
+	// TagKeys returns the sorted tag keys of the series matching the request.
+	TagKeys(*TagKeysRequest, Storage_TagKeysServer) error
+	// TagValues returns the sorted values of a tag key for the series matching the request.
+	TagValues(*TagValuesRequest, Storage_TagValuesServer) error
+	// MeasurementNames returns the sorted measurement names of the series matching the request.
+	MeasurementNames(*MeasurementNamesRequest, Storage_MeasurementNamesServer) error
 }
 
 func RegisterStorageServer(s *yarpc.Server, srv StorageServer) {
@@ -162,6 +319,103 @@ func _Storage_Hints_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return srv.(StorageServer).Hints(ctx, in)
 }
 
+func _Storage_Explain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(ExplainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	return srv.(StorageServer).Explain(ctx, in)
+}
+
+func _Storage_Write_Handler(srv interface{}, stream yarpc.ServerStream) error {
+	return srv.(StorageServer).Write(&storageWriteServer{stream})
+}
+
+type Storage_WriteServer interface {
+	Send(*WriteResponse) error
+	Recv() (*WriteRequest, error)
+	yarpc.ServerStream
+}
+
+type storageWriteServer struct {
+	yarpc.ServerStream
+}
+
+func (x *storageWriteServer) Send(m *WriteResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *storageWriteServer) Recv() (*WriteRequest, error) {
+	m := new(WriteRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Storage_TagKeys_Handler(srv interface{}, stream yarpc.ServerStream) error {
+	m := new(TagKeysRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StorageServer).TagKeys(m, &storageTagKeysServer{stream})
+}
+
+type Storage_TagKeysServer interface {
+	Send(*StringValuesResponse) error
+	yarpc.ServerStream
+}
+
+type storageTagKeysServer struct {
+	yarpc.ServerStream
+}
+
+func (x *storageTagKeysServer) Send(m *StringValuesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Storage_TagValues_Handler(srv interface{}, stream yarpc.ServerStream) error {
+	m := new(TagValuesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StorageServer).TagValues(m, &storageTagValuesServer{stream})
+}
+
+type Storage_TagValuesServer interface {
+	Send(*StringValuesResponse) error
+	yarpc.ServerStream
+}
+
+type storageTagValuesServer struct {
+	yarpc.ServerStream
+}
+
+func (x *storageTagValuesServer) Send(m *StringValuesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Storage_MeasurementNames_Handler(srv interface{}, stream yarpc.ServerStream) error {
+	m := new(MeasurementNamesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StorageServer).MeasurementNames(m, &storageMeasurementNamesServer{stream})
+}
+
+type Storage_MeasurementNamesServer interface {
+	Send(*StringValuesResponse) error
+	yarpc.ServerStream
+}
+
+type storageMeasurementNamesServer struct {
+	yarpc.ServerStream
+}
+
+func (x *storageMeasurementNamesServer) Send(m *StringValuesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _Storage_serviceDesc = yarpc.ServiceDesc{
 	ServiceName: "storage.Storage",
 	Index:       0,
@@ -177,6 +431,11 @@ var _Storage_serviceDesc = yarpc.ServiceDesc{
 			Index:      2,
 			Handler:    _Storage_Hints_Handler,
 		},
+		{
+			MethodName: "Explain",
+			Index:      3,
+			Handler:    _Storage_Explain_Handler,
+		},
 	},
 	Streams: []yarpc.StreamDesc{
 		{
@@ -185,6 +444,31 @@ var _Storage_serviceDesc = yarpc.ServiceDesc{
 			Handler:       _Storage_Read_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "Write",
+			Index:         4,
+			Handler:       _Storage_Write_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "TagKeys",
+			Index:         5,
+			Handler:       _Storage_TagKeys_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "TagValues",
+			Index:         6,
+			Handler:       _Storage_TagValues_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "MeasurementNames",
+			Index:         7,
+			Handler:       _Storage_MeasurementNames_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "storage.proto",
 }
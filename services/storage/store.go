@@ -1,15 +1,18 @@
 package storage
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"fmt"
 	"sort"
-	"strings"
 	"time"
 
 	"github.com/influxdata/influxdb/models"
+	"github.com/influxdata/influxdb/query"
 	"github.com/influxdata/influxdb/services/meta"
 	"github.com/influxdata/influxdb/tsdb"
+	"github.com/influxdata/influxql"
 	"go.uber.org/zap"
 )
 
@@ -17,6 +20,10 @@ type Store struct {
 	TSDBStore  *tsdb.Store
 	MetaClient StorageMetaClient
 	Logger     *zap.Logger
+
+	PointsWriter interface {
+		WritePointsPrivileged(database, retentionPolicy string, consistencyLevel models.ConsistencyLevel, points []models.Point) error
+	}
 }
 
 func NewStore() *Store {
@@ -29,20 +36,215 @@ func (s *Store) WithLogger(log *zap.Logger) {
 }
 
 func (s *Store) Read(ctx context.Context, req *ReadRequest) (*ResultSet, error) {
-	database, rp := req.Database, ""
+	aggs, tagAggregates := req.Aggregates, true
+	if len(aggs) == 0 && req.Aggregate != nil {
+		aggs, tagAggregates = []Aggregate{*req.Aggregate}, false
+	}
+
+	for _, agg := range aggs {
+		if _, ok := Aggregate_AggregateType_name[int32(agg.Type)]; !ok || agg.Type == AggregateTypeNone {
+			return nil, fmt.Errorf("invalid aggregate: %s", agg.Type)
+		}
+	}
+
+	if req.Window.Every < 0 {
+		return nil, errors.New("invalid window")
+	} else if req.Window.Every > 0 && len(aggs) == 0 {
+		return nil, errors.New("window requires an aggregate")
+	}
+
+	start, end, shardIDs, err := s.shardIDs(req)
+	if err != nil {
+		return nil, err
+	} else if len(shardIDs) == 0 {
+		return nil, nil
+	}
+
+	var cur seriesCursor
+	if ic, err := newIndexSeriesCursor(ctx, req, s.TSDBStore.Shards(shardIDs)); err != nil {
+		return nil, err
+	} else if ic == nil {
+		return nil, nil
+	} else {
+		cur = ic
+	}
+
+	if len(req.Grouping) > 0 {
+		cur = newGroupSeriesCursor(ctx, cur, req.Grouping)
+	}
+
+	if req.SeriesLimit > 0 || req.SeriesOffset > 0 {
+		cur = newLimitSeriesCursor(ctx, cur, req.SeriesLimit, req.SeriesOffset)
+	}
+
+	return &ResultSet{
+		req: readRequest{
+			ctx:           ctx,
+			start:         start,
+			end:           end,
+			asc:           !req.Descending,
+			limit:         req.PointsLimit,
+			aggregates:    aggs,
+			window:        window{every: req.Window.Every, offset: req.Window.Offset},
+			tagAggregates: tagAggregates,
+		},
+		cur: cur,
+	}, nil
+}
+
+// Write writes the points described by frames to the database, which may
+// optionally specify a retention policy using the form db/rp. Points which
+// cannot be converted or written are counted as dropped in the response.
+func (s *Store) Write(ctx context.Context, database string, frames []ReadResponse_Frame) (*WriteResponse, error) {
+	if s.PointsWriter == nil {
+		return nil, errors.New("writes are not enabled")
+	}
+
+	points, dropped, err := framesToPoints(frames)
+	res := &WriteResponse{PointsN: int64(len(points)), DroppedN: int64(dropped)}
+	if err != nil {
+		res.Error = tsdb.PartialWriteError{Reason: err.Error(), Dropped: dropped}.Error()
+	}
+
+	if len(points) == 0 {
+		return res, nil
+	}
+
+	db, rp := splitDatabase(database)
+	if err := s.PointsWriter.WritePointsPrivileged(db, rp, models.ConsistencyLevelAny, points); err != nil {
+		if werr, ok := err.(tsdb.PartialWriteError); ok {
+			res.PointsN -= int64(werr.Dropped)
+			res.DroppedN += int64(werr.Dropped)
+			werr.Dropped = int(res.DroppedN)
+			res.Error = werr.Error()
+		} else {
+			res.PointsN = 0
+			res.DroppedN += int64(len(points))
+			res.Error = err.Error()
+		}
+	}
+
+	return res, nil
+}
+
+// Explain describes the shards, series and estimated costs associated with
+// executing the given read request.
+func (s *Store) Explain(ctx context.Context, req *ReadRequest) (*ExplainResponse, error) {
+	start, end, shardIDs, err := s.shardIDs(req)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &ExplainResponse{ShardIDs: shardIDs}
+	if len(shardIDs) == 0 {
+		return res, nil
+	}
+
+	shards := s.TSDBStore.Shards(shardIDs)
+	ic, err := newIndexSeriesCursor(ctx, req, shards)
+	if err != nil {
+		return nil, err
+	} else if ic == nil {
+		return res, nil
+	}
+
+	if ic.cond != nil {
+		res.Predicate = ic.cond.String()
+	}
+	if ic.seriesCond != nil {
+		res.SeriesPredicate = ic.seriesCond.String()
+	}
+
+	var cur seriesCursor = ic
+	if req.SeriesLimit > 0 || req.SeriesOffset > 0 {
+		cur = newLimitSeriesCursor(ctx, cur, req.SeriesLimit, req.SeriesOffset)
+	}
+	defer cur.Close()
+
+	// rows for the same series key are produced consecutively, one per field
+	var (
+		names  []string
+		fields = make(map[string][]influxql.VarRef)
+		name   []byte
+		tags   models.Tags
+	)
+	for row := cur.Next(); row != nil; row = cur.Next() {
+		res.CursorN++
+		if res.SeriesN == 0 || !bytes.Equal(name, row.name) || !tags.Equal(row.stags) {
+			res.SeriesN++
+			name, tags = row.name, row.stags
+		}
+
+		m := string(row.name)
+		refs, ok := fields[m]
+		if !ok {
+			names = append(names, m)
+		}
+		if !containsVarRef(refs, row.field) {
+			fields[m] = append(refs, influxql.VarRef{Val: row.field})
+		}
+	}
+	if err := cur.Err(); err != nil {
+		return nil, err
+	}
+
+	sg := tsdb.Shards(shards)
+	var cost query.IteratorCost
+	for _, m := range names {
+		opt := query.IteratorOptions{
+			Aux:       fields[m],
+			StartTime: start,
+			EndTime:   end,
+		}
+		if ic.seriesCond != nil {
+			opt.Condition = influxql.Reduce(ic.seriesCond, mapValuer{"_name": m})
+			if b, ok := opt.Condition.(*influxql.BooleanLiteral); ok {
+				if !b.Val {
+					continue
+				}
+				opt.Condition = nil
+			}
+		}
+
+		c, err := sg.IteratorCost(m, opt)
+		if err != nil {
+			return nil, err
+		}
+		cost = cost.Combine(c)
+	}
+
+	res.Cost = Cost{
+		NumShards:    cost.NumShards,
+		NumSeries:    cost.NumSeries,
+		CachedValues: cost.CachedValues,
+		NumFiles:     cost.NumFiles,
+		BlocksRead:   cost.BlocksRead,
+		BlockSize:    cost.BlockSize,
+	}
+
+	return res, nil
+}
 
+// shardIDs returns the time range and the identifiers of the shards
+// which must be read to satisfy req.
+func (s *Store) shardIDs(req *ReadRequest) (start, end int64, shardIDs []uint64, err error) {
+	var database, rp string
 	if req.RequestType == ReadRequestTypeMultiTenant {
 		// TODO(sgc): this should be moved to configuration
 		database, rp = "db", "rp"
 	} else {
-		if p := strings.IndexByte(database, '/'); p > -1 {
-			database, rp = database[:p], database[p+1:]
-		}
+		database, rp = splitDatabase(req.Database)
 	}
 
+	return s.findShardIDs(database, rp, req.TimestampRange, req.Descending)
+}
+
+// findShardIDs returns the time range and the identifiers of the shards in
+// the database and retention policy that overlap tr.
+func (s *Store) findShardIDs(database, rp string, tr TimestampRange, desc bool) (start, end int64, shardIDs []uint64, err error) {
 	di := s.MetaClient.Database(database)
 	if di == nil {
-		return nil, errors.New("no database")
+		return 0, 0, nil, errors.New("no database")
 	}
 
 	if rp == "" {
@@ -51,66 +253,48 @@ func (s *Store) Read(ctx context.Context, req *ReadRequest) (*ResultSet, error)
 
 	rpi := di.RetentionPolicy(rp)
 	if rpi == nil {
-		return nil, errors.New("invalid retention policy")
+		return 0, 0, nil, errors.New("invalid retention policy")
 	}
 
-	var start, end = models.MinNanoTime, models.MaxNanoTime
-	if req.TimestampRange.Start > 0 {
-		start = req.TimestampRange.Start
+	start, end = models.MinNanoTime, models.MaxNanoTime
+	if tr.Start > 0 {
+		start = tr.Start
 	}
 
-	if req.TimestampRange.End > 0 {
-		end = req.TimestampRange.End
+	if tr.End > 0 {
+		end = tr.End
 	}
 
 	groups, err := s.MetaClient.ShardGroupsByTimeRange(database, rp, time.Unix(0, start), time.Unix(0, end))
 	if err != nil {
-		return nil, err
+		return 0, 0, nil, err
 	}
 
 	if len(groups) == 0 {
-		return nil, nil
+		return start, end, nil, nil
 	}
 
-	if req.Descending {
+	if desc {
 		sort.Sort(sort.Reverse(meta.ShardGroupInfos(groups)))
 	} else {
 		sort.Sort(meta.ShardGroupInfos(groups))
 	}
 
-	shardIDs := make([]uint64, 0, len(groups[0].Shards)*len(groups))
+	shardIDs = make([]uint64, 0, len(groups[0].Shards)*len(groups))
 	for _, g := range groups {
 		for _, si := range g.Shards {
 			shardIDs = append(shardIDs, si.ID)
 		}
 	}
 
-	var cur seriesCursor
-	if ic, err := newIndexSeriesCursor(ctx, req, s.TSDBStore.Shards(shardIDs)); err != nil {
-		return nil, err
-	} else if ic == nil {
-		return nil, nil
-	} else {
-		cur = ic
-	}
-
-	if len(req.Grouping) > 0 {
-		cur = newGroupSeriesCursor(ctx, cur, req.Grouping)
-	}
+	return start, end, shardIDs, nil
+}
 
-	if req.SeriesLimit > 0 || req.SeriesOffset > 0 {
-		cur = newLimitSeriesCursor(ctx, cur, req.SeriesLimit, req.SeriesOffset)
+func containsVarRef(refs []influxql.VarRef, name string) bool {
+	for i := range refs {
+		if refs[i].Val == name {
+			return true
+		}
 	}
-
-	return &ResultSet{
-		req: readRequest{
-			ctx:       ctx,
-			start:     start,
-			end:       end,
-			asc:       !req.Descending,
-			limit:     req.PointsLimit,
-			aggregate: req.Aggregate,
-		},
-		cur: cur,
-	}, nil
+	return false
 }
@@ -0,0 +1,157 @@
+package storage
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/influxdata/influxdb/models"
+	"github.com/influxdata/influxdb/services/meta"
+	"github.com/influxdata/influxdb/tsdb"
+	_ "github.com/influxdata/influxdb/tsdb/engine"
+	_ "github.com/influxdata/influxdb/tsdb/index"
+	"go.uber.org/zap"
+)
+
+func TestStore_Explain(t *testing.T) {
+	s := MustOpenStore(t)
+	defer s.Close()
+
+	s.MustWritePoints(t,
+		`cpu,host=a value=1,idle=2 10`,
+		`cpu,host=b value=1 10`,
+		`mem,host=a free=3 10`,
+	)
+
+	res, err := s.Explain(context.Background(), &ReadRequest{Database: "db0"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.ShardIDs) != 1 || res.ShardIDs[0] != 1 {
+		t.Errorf("unexpected shard IDs: %v", res.ShardIDs)
+	}
+	if res.SeriesN != 3 {
+		t.Errorf("unexpected series count: %d", res.SeriesN)
+	}
+	// Each series has a cursor for every field key of the shards.
+	if res.CursorN != 9 {
+		t.Errorf("unexpected cursor count: %d", res.CursorN)
+	}
+
+	// The series limit applies to the cursors.
+	res, err = s.Explain(context.Background(), &ReadRequest{Database: "db0", SeriesLimit: 4})
+	if err != nil {
+		t.Fatal(err)
+	} else if res.SeriesN != 2 || res.CursorN != 4 {
+		t.Errorf("unexpected counts: series %d, cursors %d", res.SeriesN, res.CursorN)
+	}
+}
+
+func TestStore_Explain_Error(t *testing.T) {
+	s := MustOpenStore(t)
+	defer s.Close()
+
+	if _, err := s.Explain(context.Background(), &ReadRequest{Database: "db1"}); err == nil || err.Error() != "no database" {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := s.Explain(context.Background(), &ReadRequest{Database: "db0/rp1"}); err == nil || err.Error() != "invalid retention policy" {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestRPCService_Explain(t *testing.T) {
+	s := MustOpenStore(t)
+	defer s.Close()
+
+	s.MustWritePoints(t, `cpu,host=a value=1 10`)
+
+	r := &rpcService{Store: s.Store, Logger: zap.NewNop()}
+	res, err := r.Explain(context.Background(), &ExplainRequest{ReadRequest: ReadRequest{Database: "db0"}})
+	if err != nil {
+		t.Fatal(err)
+	} else if res.SeriesN != 1 || res.CursorN != 1 {
+		t.Errorf("unexpected counts: series %d, cursors %d", res.SeriesN, res.CursorN)
+	}
+
+	if _, err := r.Explain(context.Background(), &ExplainRequest{ReadRequest: ReadRequest{Database: "db1"}}); err == nil {
+		t.Fatal("expected error, got nil")
+	}
+}
+
+// TestStore is a Store backed by a TSDB store holding a single shard of db0.rp0.
+type TestStore struct {
+	*Store
+	dir string
+}
+
+// MustOpenStore returns a new, open TestStore.
+func MustOpenStore(t *testing.T) *TestStore {
+	dir, err := ioutil.TempDir("", "storage-")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ts := tsdb.NewStore(filepath.Join(dir, "data"))
+	ts.EngineOptions.Config.WALDir = filepath.Join(dir, "wal")
+	if err := ts.Open(); err != nil {
+		os.RemoveAll(dir)
+		t.Fatal(err)
+	}
+	if err := ts.CreateShard("db0", "rp0", 1, true); err != nil {
+		ts.Close()
+		os.RemoveAll(dir)
+		t.Fatal(err)
+	}
+
+	s := NewStore()
+	s.TSDBStore = ts
+	s.MetaClient = &testMetaClient{}
+	return &TestStore{Store: s, dir: dir}
+}
+
+// Close closes the TSDB store and removes its data.
+func (s *TestStore) Close() error {
+	defer os.RemoveAll(s.dir)
+	return s.TSDBStore.Close()
+}
+
+// MustWritePoints writes points in line protocol to the shard of the store.
+func (s *TestStore) MustWritePoints(t *testing.T, lines ...string) {
+	var points []models.Point
+	for _, line := range lines {
+		p, err := models.ParsePointsString(line)
+		if err != nil {
+			t.Fatal(err)
+		}
+		points = append(points, p...)
+	}
+	if err := s.TSDBStore.WriteToShard(1, points); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// testMetaClient reports db0 with the retention policy rp0, whose only shard is 1.
+type testMetaClient struct{}
+
+func (*testMetaClient) Database(name string) *meta.DatabaseInfo {
+	if name != "db0" {
+		return nil
+	}
+	return &meta.DatabaseInfo{
+		Name:                   "db0",
+		DefaultRetentionPolicy: "rp0",
+		RetentionPolicies:      []meta.RetentionPolicyInfo{{Name: "rp0"}},
+	}
+}
+
+func (*testMetaClient) ShardGroupsByTimeRange(database, policy string, min, max time.Time) ([]meta.ShardGroupInfo, error) {
+	return []meta.ShardGroupInfo{{
+		ID:        1,
+		StartTime: time.Unix(0, models.MinNanoTime),
+		EndTime:   time.Unix(0, models.MaxNanoTime),
+		Shards:    []meta.ShardInfo{{ID: 1}},
+	}}, nil
+}
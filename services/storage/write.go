@@ -0,0 +1,137 @@
+package storage
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/influxdata/influxdb/models"
+)
+
+var errNoSeriesFrame = errors.New("points frame without preceding series frame")
+
+// framesToPoints converts a series of frames, laid out as in ReadResponse, to points.
+// Points which cannot be converted are counted by dropped and the first
+// conversion error is returned.
+func framesToPoints(frames []ReadResponse_Frame) (points []models.Point, dropped int, err error) {
+	var (
+		name  []byte
+		tags  models.Tags
+		field string
+		typ   ReadResponse_DataType
+		seen  bool
+		valid bool
+	)
+
+	setErr := func(e error) {
+		if err == nil {
+			err = e
+		}
+	}
+
+	addPoint := func(ts int64, v interface{}) {
+		pt, e := models.NewPoint(string(name), tags, models.Fields{field: v}, time.Unix(0, ts))
+		if e != nil {
+			dropped++
+			setErr(e)
+			return
+		}
+		points = append(points, pt)
+	}
+
+	checkPoints := func(dt ReadResponse_DataType, n, values int) bool {
+		switch {
+		case !seen:
+			setErr(errNoSeriesFrame)
+		case !valid:
+			// error was recorded when the series frame was read
+		case dt != typ:
+			setErr(fmt.Errorf("points frame of type %s does not match series of type %s", dt, typ))
+		case values != n:
+			setErr(fmt.Errorf("points frame has %d timestamps and %d values", n, values))
+		default:
+			return true
+		}
+		dropped += n
+		return false
+	}
+
+	for i := range frames {
+		switch f := frames[i].Data.(type) {
+		case *ReadResponse_Frame_Series:
+			name, tags, field, seen, valid = nil, nil, "", true, true
+			typ = f.Series.DataType
+			for _, t := range f.Series.Tags {
+				switch {
+				case bytes.Equal(t.Key, measurementKey):
+					name = t.Value
+				case bytes.Equal(t.Key, fieldKey):
+					field = string(t.Value)
+				default:
+					tags = append(tags, models.Tag(t))
+				}
+			}
+
+			sort.Sort(tags)
+
+			if len(name) == 0 || field == "" {
+				valid = false
+				setErr(fmt.Errorf("series frame must specify %s and %s tags", measurementKey, fieldKey))
+			}
+
+		case *ReadResponse_Frame_FloatPoints:
+			p := f.FloatPoints
+			if checkPoints(DataTypeFloat, len(p.Timestamps), len(p.Values)) {
+				for i, ts := range p.Timestamps {
+					addPoint(ts, p.Values[i])
+				}
+			}
+
+		case *ReadResponse_Frame_IntegerPoints:
+			p := f.IntegerPoints
+			if checkPoints(DataTypeInteger, len(p.Timestamps), len(p.Values)) {
+				for i, ts := range p.Timestamps {
+					addPoint(ts, p.Values[i])
+				}
+			}
+
+		case *ReadResponse_Frame_UnsignedPoints:
+			p := f.UnsignedPoints
+			if checkPoints(DataTypeUnsigned, len(p.Timestamps), len(p.Values)) {
+				for i, ts := range p.Timestamps {
+					addPoint(ts, p.Values[i])
+				}
+			}
+
+		case *ReadResponse_Frame_BooleanPoints:
+			p := f.BooleanPoints
+			if checkPoints(DataTypeBoolean, len(p.Timestamps), len(p.Values)) {
+				for i, ts := range p.Timestamps {
+					addPoint(ts, p.Values[i])
+				}
+			}
+
+		case *ReadResponse_Frame_StringPoints:
+			p := f.StringPoints
+			if checkPoints(DataTypeString, len(p.Timestamps), len(p.Values)) {
+				for i, ts := range p.Timestamps {
+					addPoint(ts, p.Values[i])
+				}
+			}
+		}
+	}
+
+	return points, dropped, err
+}
+
+// splitDatabase splits a database name of the form db/rp into the database
+// and retention policy.
+func splitDatabase(s string) (database, rp string) {
+	if p := strings.IndexByte(s, '/'); p > -1 {
+		return s[:p], s[p+1:]
+	}
+	return s, ""
+}
@@ -0,0 +1,89 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestFramesToPoints(t *testing.T) {
+	series := func(dt ReadResponse_DataType, tags ...string) ReadResponse_Frame {
+		sf := &ReadResponse_SeriesFrame{DataType: dt}
+		for i := 0; i < len(tags); i += 2 {
+			sf.Tags = append(sf.Tags, Tag{Key: []byte(tags[i]), Value: []byte(tags[i+1])})
+		}
+		return ReadResponse_Frame{&ReadResponse_Frame_Series{sf}}
+	}
+
+	frames := []ReadResponse_Frame{
+		series(DataTypeFloat, "_field", "value", "_measurement", "cpu", "host", "a"),
+		{&ReadResponse_Frame_FloatPoints{&ReadResponse_FloatPointsFrame{Timestamps: []int64{1, 2}, Values: []float64{1.5, 2.5}}}},
+		series(DataTypeInteger, "_field", "count", "_measurement", "mem"),
+		{&ReadResponse_Frame_IntegerPoints{&ReadResponse_IntegerPointsFrame{Timestamps: []int64{3}, Values: []int64{10}}}},
+		// data type of points does not match series
+		{&ReadResponse_Frame_StringPoints{&ReadResponse_StringPointsFrame{Timestamps: []int64{4}, Values: []string{"x"}}}},
+		// series without field
+		series(DataTypeBoolean, "_measurement", "disk"),
+		{&ReadResponse_Frame_BooleanPoints{&ReadResponse_BooleanPointsFrame{Timestamps: []int64{5, 6}, Values: []bool{true, false}}}},
+	}
+
+	points, dropped, err := framesToPoints(frames)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	var got []string
+	for _, p := range points {
+		got = append(got, p.String())
+	}
+
+	exp := []string{
+		"cpu,host=a value=1.5 1",
+		"cpu,host=a value=2.5 2",
+		"mem count=10i 3",
+	}
+	if !cmp.Equal(got, exp) {
+		t.Errorf("unexpected points; -got/+exp\n%s", cmp.Diff(got, exp))
+	}
+
+	if exp := 3; dropped != exp {
+		t.Errorf("unexpected dropped count, got %d, exp %d", dropped, exp)
+	}
+}
+
+func TestFramesToPoints_NoSeries(t *testing.T) {
+	frames := []ReadResponse_Frame{
+		{&ReadResponse_Frame_FloatPoints{&ReadResponse_FloatPointsFrame{Timestamps: []int64{1}, Values: []float64{1}}}},
+	}
+
+	points, dropped, err := framesToPoints(frames)
+	if err != errNoSeriesFrame {
+		t.Fatalf("unexpected error, got %v, exp %v", err, errNoSeriesFrame)
+	}
+	if len(points) != 0 || dropped != 1 {
+		t.Errorf("unexpected result, got %d points, %d dropped", len(points), dropped)
+	}
+}
+
+func TestFramesToPoints_ValuesLength(t *testing.T) {
+	frames := []ReadResponse_Frame{
+		{&ReadResponse_Frame_Series{&ReadResponse_SeriesFrame{
+			DataType: DataTypeFloat,
+			Tags:     []Tag{{Key: []byte("_measurement"), Value: []byte("cpu")}, {Key: []byte("_field"), Value: []byte("value")}},
+		}}},
+		// fewer values than timestamps
+		{&ReadResponse_Frame_FloatPoints{&ReadResponse_FloatPointsFrame{Timestamps: []int64{1, 2, 3}, Values: []float64{1}}}},
+		{&ReadResponse_Frame_FloatPoints{&ReadResponse_FloatPointsFrame{Timestamps: []int64{4}, Values: []float64{4}}}},
+	}
+
+	points, dropped, err := framesToPoints(frames)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if len(points) != 1 || points[0].String() != "cpu value=4 4" {
+		t.Errorf("unexpected points: %v", points)
+	}
+	if exp := 3; dropped != exp {
+		t.Errorf("unexpected dropped count, got %d, exp %d", dropped, exp)
+	}
+}
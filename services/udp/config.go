@@ -5,6 +5,7 @@ import (
 
 	"github.com/influxdata/influxdb/monitor/diagnostics"
 	"github.com/influxdata/influxdb/toml"
+	"github.com/influxdata/influxdb/tsdb"
 )
 
 const (
@@ -55,6 +56,12 @@ type Config struct {
 	ReadBuffer      int           `toml:"read-buffer"`
 	BatchTimeout    toml.Duration `toml:"batch-timeout"`
 	Precision       string        `toml:"precision"`
+
+	// SpoolDir is the directory of an on-disk spool which holds batches until
+	// they are written.  If empty, batches are dropped if they cannot be written.
+	SpoolDir     string    `toml:"spool-dir"`
+	SpoolMaxSize toml.Size `toml:"spool-max-size"`
+	SpoolPolicy  string    `toml:"spool-policy"`
 }
 
 // NewConfig returns a new instance of Config with defaults.
@@ -91,6 +98,12 @@ func (c *Config) WithDefaults() *Config {
 	if d.ReadBuffer == 0 {
 		d.ReadBuffer = DefaultReadBuffer
 	}
+	if d.SpoolMaxSize == 0 {
+		d.SpoolMaxSize = tsdb.DefaultSpoolMaxSize
+	}
+	if d.SpoolPolicy == "" {
+		d.SpoolPolicy = tsdb.SpoolPolicyDropNewest
+	}
 	return &d
 }
 
@@ -100,7 +113,7 @@ type Configs []Config
 // Diagnostics returns one set of diagnostics for all of the Configs.
 func (c Configs) Diagnostics() (*diagnostics.Diagnostics, error) {
 	d := &diagnostics.Diagnostics{
-		Columns: []string{"enabled", "bind-address", "database", "retention-policy", "batch-size", "batch-pending", "batch-timeout"},
+		Columns: []string{"enabled", "bind-address", "database", "retention-policy", "batch-size", "batch-pending", "batch-timeout", "spool-dir"},
 	}
 
 	for _, cc := range c {
@@ -109,7 +122,7 @@ func (c Configs) Diagnostics() (*diagnostics.Diagnostics, error) {
 			continue
 		}
 
-		r := []interface{}{true, cc.BindAddress, cc.Database, cc.RetentionPolicy, cc.BatchSize, cc.BatchPending, cc.BatchTimeout}
+		r := []interface{}{true, cc.BindAddress, cc.Database, cc.RetentionPolicy, cc.BatchSize, cc.BatchPending, cc.BatchTimeout, cc.SpoolDir}
 		d.AddRow(r)
 	}
 
@@ -46,6 +46,7 @@ type Service struct {
 
 	parserChan chan []byte
 	batcher    *tsdb.PointBatcher
+	spool      *tsdb.PointSpool
 	config     Config
 
 	PointsWriter interface {
@@ -112,6 +113,14 @@ func (s *Service) Open() (err error) {
 			return err
 		}
 	}
+	if s.config.SpoolDir != "" {
+		s.spool = tsdb.NewPointSpool(s.config.SpoolDir, int64(s.config.SpoolMaxSize), s.config.SpoolPolicy)
+		if err := s.spool.Open(); err != nil {
+			s.conn.Close()
+			return err
+		}
+	}
+
 	s.batcher = tsdb.NewPointBatcher(s.config.BatchSize, s.config.BatchPending, time.Duration(s.config.BatchTimeout))
 	s.batcher.Start()
 
@@ -138,7 +147,7 @@ type Statistics struct {
 
 // Statistics returns statistics for periodic monitoring.
 func (s *Service) Statistics(tags map[string]string) []models.Statistic {
-	return []models.Statistic{{
+	statistics := []models.Statistic{{
 		Name: "udp",
 		Tags: s.defaultTags.Merge(tags),
 		Values: map[string]interface{}{
@@ -151,14 +160,39 @@ func (s *Service) Statistics(tags map[string]string) []models.Statistic {
 			statBatchesTransmitFail: atomic.LoadInt64(&s.stats.BatchesTransmitFail),
 		},
 	}}
+
+	s.mu.RLock()
+	spool := s.spool
+	s.mu.RUnlock()
+	if spool != nil {
+		spoolTags := s.defaultTags.Merge(tags)
+		spoolTags["service"] = "udp"
+		statistics = append(statistics, spool.Statistics(spoolTags)...)
+	}
+	return statistics
 }
 
 func (s *Service) writer() {
 	defer s.wg.Done()
 
+	var retry <-chan time.Time
+	if s.spool != nil {
+		ticker := time.NewTicker(tsdb.SpoolRetryInterval)
+		defer ticker.Stop()
+		retry = ticker.C
+	}
+
 	for {
 		select {
 		case batch := <-s.batcher.Out():
+			if s.spool != nil {
+				if err := s.spool.Append(batch); err != nil {
+					s.Logger.Info("Failed to spool point batch", zap.Error(err))
+				}
+				s.writeSpooled()
+				continue
+			}
+
 			// Will attempt to create database if not yet created.
 			if err := s.createInternalStorage(); err != nil {
 				s.Logger.Info("Required database does not yet exist",
@@ -175,12 +209,30 @@ func (s *Service) writer() {
 				atomic.AddInt64(&s.stats.BatchesTransmitFail, 1)
 			}
 
+		case <-retry:
+			s.writeSpooled()
+
 		case <-s.done:
 			return
 		}
 	}
 }
 
+// writeSpooled writes the batches held by the spool.  Batches which cannot be
+// written remain in the spool and are retried later, unless the database
+// rejects them.
+func (s *Service) writeSpooled() {
+	batchN, pointN, failN := s.spool.WriteSpooled(func(batch []models.Point) error {
+		if err := s.createInternalStorage(); err != nil {
+			return err
+		}
+		return s.PointsWriter.WritePointsPrivileged(s.config.Database, s.config.RetentionPolicy, models.ConsistencyLevelAny, batch)
+	}, s.Logger, s.config.Database)
+	atomic.AddInt64(&s.stats.BatchesTransmitted, batchN)
+	atomic.AddInt64(&s.stats.PointsTransmitted, pointN)
+	atomic.AddInt64(&s.stats.BatchesTransmitFail, failN)
+}
+
 func (s *Service) serve() {
 	defer s.wg.Done()
 
@@ -256,9 +308,13 @@ func (s *Service) Close() error {
 
 	// Release all remaining resources.
 	s.mu.Lock()
+	if s.spool != nil {
+		s.spool.Close()
+	}
 	s.done = nil
 	s.conn = nil
 	s.batcher = nil
+	s.spool = nil
 	s.mu.Unlock()
 
 	s.Logger.Info("Service closed")
@@ -2,7 +2,9 @@ package udp
 
 import (
 	"errors"
+	"io/ioutil"
 	"os"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -124,6 +126,63 @@ func TestService_CreatesDatabase(t *testing.T) {
 	s.Service.Close()
 }
 
+// Ensure batches which fail to be written are held in the spool and retried.
+func TestService_Spool(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "udp-spool-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	c := NewConfig()
+	c.BindAddress = "127.0.0.1:0"
+	c.SpoolDir = dir
+	s := NewTestService(&c)
+	s.MetaClient.CreateDatabaseFn = func(name string) (*meta.DatabaseInfo, error) {
+		return nil, nil
+	}
+
+	var attempts int32
+	written := make(chan []models.Point, 1)
+	s.WritePointsFn = func(_, _ string, _ models.ConsistencyLevel, points []models.Point) error {
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			return errors.New("write failed")
+		}
+		written <- points
+		return nil
+	}
+
+	if err := s.Service.Open(); err != nil {
+		t.Fatal(err)
+	}
+	defer s.Service.Close()
+
+	points, err := models.ParsePointsString(`cpu value=1 10`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s.Service.batcher.In() <- points[0]
+	s.Service.batcher.Flush()
+	select {
+	case got := <-written:
+		if len(got) != 1 || got[0].String() != points[0].String() {
+			t.Fatalf("unexpected points: %v", got)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("spooled batch was not retried")
+	}
+
+	stats := s.Service.Statistics(nil)
+	if len(stats) != 2 || stats[1].Name != "spool" {
+		t.Fatalf("unexpected statistics: %v", stats)
+	} else if n := stats[1].Values["batchesReplayed"]; n != int64(1) {
+		t.Fatalf("unexpected replayed batches: %v", n)
+	}
+}
+
 type TestService struct {
 	Service       *Service
 	Config        Config
@@ -3,6 +3,7 @@ package tsdb
 import (
 	"errors"
 	"fmt"
+	"path/filepath"
 	"time"
 
 	"github.com/influxdata/influxdb/monitor/diagnostics"
@@ -37,6 +38,14 @@ const (
 	// will compact all TSM files in a shard if it hasn't received a write or delete
 	DefaultCompactFullWriteColdDuration = time.Duration(4 * time.Hour)
 
+	// DefaultScrubThroughput is the default rate, in bytes per second, at
+	// which TSM files are read when they are scrubbed.
+	DefaultScrubThroughput = 16 * 1024 * 1024 // 16MB
+
+	// DefaultColdAge is the duration a shard must not receive writes or deletes
+	// before its TSM files are moved to the cold directory.
+	DefaultColdAge = time.Duration(7 * 24 * time.Hour)
+
 	// DefaultMaxPointsPerBlock is the maximum number of points in an encoded
 	// block in a TSM file
 	DefaultMaxPointsPerBlock = 1000
@@ -55,6 +64,34 @@ const (
 	// DefaultMaxIndexLogFileSize is the default threshold, in bytes, when an index
 	// write-ahead log file will compact into an index file.
 	DefaultMaxIndexLogFileSize = 1 * 1024 * 1024 // 1MB
+
+	// DefaultFloatBlockCodec is the default codec for the values of float blocks.
+	DefaultFloatBlockCodec = FloatBlockCodecGorilla
+
+	// DefaultStringBlockCodec is the default codec for the values of string blocks.
+	DefaultStringBlockCodec = StringBlockCodecSnappy
+)
+
+// Codecs which may be used to compress the values of float and string blocks in TSM files.
+const (
+	// FloatBlockCodecGorilla compresses floats using the XOR encoding from
+	// Facebook's Gorilla paper.
+	FloatBlockCodecGorilla = "gorilla"
+
+	// FloatBlockCodecDeflate byte-shuffles floats and compresses them with DEFLATE.
+	FloatBlockCodecDeflate = "deflate"
+
+	// StringBlockCodecSnappy compresses strings with Snappy.
+	StringBlockCodecSnappy = "snappy"
+
+	// StringBlockCodecDeflate compresses strings with DEFLATE, which is slower
+	// than Snappy but achieves better compression of long strings.
+	StringBlockCodecDeflate = "deflate"
+
+	// StringBlockCodecDictionary replaces strings with references to a dictionary
+	// of the distinct strings in the block. Blocks with many distinct strings
+	// are compressed with Snappy instead.
+	StringBlockCodecDictionary = "dictionary"
 )
 
 // Config holds the configuration for the tsbd package.
@@ -71,6 +108,40 @@ type Config struct {
 	// disks or when WAL write contention is seen.  A value of 0 fsyncs every write to the WAL.
 	WALFsyncDelay toml.Duration `toml:"wal-fsync-delay"`
 
+	// ColdDir is the directory which holds the TSM files of shards which have not been
+	// written to recently, typically on cheaper storage than Dir.  Shards use the same
+	// layout under ColdDir as under Dir.  If empty, TSM files are never moved.
+	ColdDir string `toml:"cold-dir"`
+
+	// ColdAge is the duration a shard must not receive writes or deletes before its fully
+	// compacted TSM files are moved to ColdDir.
+	ColdAge toml.Duration `toml:"cold-age"`
+
+	// ColdMinFileSize is the size of the smallest TSM file which will be moved to ColdDir.
+	ColdMinFileSize toml.Size `toml:"cold-min-file-size"`
+
+	// ScrubInterval is the interval at which the checksums of every block in every TSM file
+	// are verified.  Corrupt files are quarantined so they are no longer read.  A value of
+	// 0 disables scrubbing.
+	ScrubInterval toml.Duration `toml:"scrub-interval"`
+
+	// ScrubThroughput is the maximum rate, in bytes per second, at which TSM files are read
+	// when they are scrubbed.  A value of 0 disables the limit.
+	ScrubThroughput toml.Size `toml:"scrub-throughput"`
+
+	// EncryptionKeyring is the path of a keyring file holding the master keys used to
+	// encrypt WAL segments and TSM files.  If empty, new files are not encrypted.
+	EncryptionKeyring string `toml:"encryption-keyring"`
+
+	// CacheSegregateLateWrites causes cache snapshots to write the values of each series
+	// that are not newer than the data already stored for the series to separate TSM files,
+	// which are merged together before being compacted with other files.
+	CacheSegregateLateWrites bool `toml:"cache-segregate-late-writes"`
+
+	// BloomFiltersEnabled causes compactions to write a bloom filter of the series keys
+	// alongside each new TSM file, allowing lookups to skip files that cannot contain a key.
+	BloomFiltersEnabled bool `toml:"bloom-filters-enabled"`
+
 	// Query logging
 	QueryLogEnabled bool `toml:"query-log-enabled"`
 
@@ -105,6 +176,103 @@ type Config struct {
 	MaxIndexLogFileSize toml.Size `toml:"max-index-log-file-size"`
 
 	TraceLoggingEnabled bool `toml:"trace-logging-enabled"`
+
+	// FloatBlockCodec and StringBlockCodec select the codecs used to compress the
+	// values of new float and string blocks in TSM files. Compactions copy full
+	// blocks as they are, so only blocks which are written or merged with other
+	// blocks use the configured codecs.
+	FloatBlockCodec  string `toml:"float-block-codec"`
+	StringBlockCodec string `toml:"string-block-codec"`
+
+	// BlockCodecs overrides the block codecs for individual databases.
+	BlockCodecs []BlockCodecConfig `toml:"block-codec"`
+
+	// Rollups downsample the data of retention policies into other retention
+	// policies as their shards become cold.
+	Rollups []RollupConfig `toml:"rollup"`
+}
+
+// BlockCodecConfig selects the block codecs for a single database. An empty
+// codec uses the default for all databases.
+type BlockCodecConfig struct {
+	Database string `toml:"database"`
+	Float    string `toml:"float"`
+	String   string `toml:"string"`
+}
+
+// BlockCodecsFor returns the names of the float and string block codecs for database.
+func (c Config) BlockCodecsFor(database string) (float, str string) {
+	float, str = c.FloatBlockCodec, c.StringBlockCodec
+	for _, bc := range c.BlockCodecs {
+		if bc.Database != database {
+			continue
+		}
+		if bc.Float != "" {
+			float = bc.Float
+		}
+		if bc.String != "" {
+			str = bc.String
+		}
+	}
+	return float, str
+}
+
+// Rollup functions.
+const (
+	RollupMin   = "min"
+	RollupMax   = "max"
+	RollupMean  = "mean"
+	RollupCount = "count"
+	RollupSum   = "sum"
+)
+
+// RollupConfig computes aggregates of every series of a retention policy over
+// windows of Interval and writes them to TargetRetentionPolicy of the same
+// database.  Aggregates are computed when a shard has not been written to for
+// compact-full-write-cold-duration, during its full compaction.  Each
+// aggregated field is named after the function and the field, such as
+// mean_value.  Only count is computed for boolean and string fields.
+type RollupConfig struct {
+	Database              string        `toml:"database"`
+	RetentionPolicy       string        `toml:"retention-policy"`
+	TargetRetentionPolicy string        `toml:"target-retention-policy"`
+	Interval              toml.Duration `toml:"interval"`
+	Functions             []string      `toml:"functions"`
+}
+
+// Validate returns an error if the rollup is invalid.
+func (c RollupConfig) Validate() error {
+	if c.Database == "" || c.RetentionPolicy == "" {
+		return errors.New("rollup database and retention-policy must be specified")
+	} else if c.TargetRetentionPolicy == "" {
+		return errors.New("rollup target-retention-policy must be specified")
+	} else if c.TargetRetentionPolicy == c.RetentionPolicy {
+		return errors.New("rollup target-retention-policy must differ from retention-policy")
+	} else if c.Interval <= 0 {
+		return errors.New("rollup interval must be greater than 0")
+	} else if len(c.Functions) == 0 {
+		return errors.New("rollup functions must be specified")
+	}
+
+	for _, fn := range c.Functions {
+		switch fn {
+		case RollupMin, RollupMax, RollupMean, RollupCount, RollupSum:
+		default:
+			return fmt.Errorf("unrecognized rollup function %s", fn)
+		}
+	}
+	return nil
+}
+
+// RollupsFor returns the rollups of the retention policy rp of database.
+func (c Config) RollupsFor(database, rp string) []RollupConfig {
+	var rollups []RollupConfig
+	for _, r := range c.Rollups {
+		if r.Database == database && r.RetentionPolicy == rp {
+			rollups = append(rollups, r)
+		}
+	}
+	return rollups
 }
 
 // NewConfig returns the default configuration for tsdb.
@@ -119,6 +287,8 @@ func NewConfig() Config {
 		CacheSnapshotMemorySize:        toml.Size(DefaultCacheSnapshotMemorySize),
 		CacheSnapshotWriteColdDuration: toml.Duration(DefaultCacheSnapshotWriteColdDuration),
 		CompactFullWriteColdDuration:   toml.Duration(DefaultCompactFullWriteColdDuration),
+		ColdAge:                        toml.Duration(DefaultColdAge),
+		ScrubThroughput:                toml.Size(DefaultScrubThroughput),
 
 		MaxSeriesPerDatabase:     DefaultMaxSeriesPerDatabase,
 		MaxValuesPerTag:          DefaultMaxValuesPerTag,
@@ -127,6 +297,9 @@ func NewConfig() Config {
 		MaxIndexLogFileSize: toml.Size(DefaultMaxIndexLogFileSize),
 
 		TraceLoggingEnabled: false,
+
+		FloatBlockCodec:  DefaultFloatBlockCodec,
+		StringBlockCodec: DefaultStringBlockCodec,
 	}
 }
 
@@ -142,6 +315,16 @@ func (c *Config) Validate() error {
 		return errors.New("max-concurrent-compactions must be greater than 0")
 	}
 
+	if c.ColdDir != "" && filepath.Clean(c.ColdDir) == filepath.Clean(c.Dir) {
+		return errors.New("Data.ColdDir must differ from Data.Dir")
+	} else if c.ColdAge < 0 {
+		return errors.New("cold-age must not be negative")
+	}
+
+	if c.ScrubInterval < 0 {
+		return errors.New("scrub-interval must not be negative")
+	}
+
 	valid := false
 	for _, e := range RegisteredEngines() {
 		if e == c.Engine {
@@ -164,6 +347,40 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("unrecognized index %s", c.Index)
 	}
 
+	if err := validateBlockCodecs(c.FloatBlockCodec, c.StringBlockCodec); err != nil {
+		return err
+	}
+	for _, bc := range c.BlockCodecs {
+		if bc.Database == "" {
+			return errors.New("block-codec database must be specified")
+		} else if err := validateBlockCodecs(bc.Float, bc.String); err != nil {
+			return fmt.Errorf("invalid block-codec for database %s: %s", bc.Database, err)
+		}
+	}
+
+	for _, r := range c.Rollups {
+		if err := r.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateBlockCodecs returns an error if either codec is not recognized.
+// Empty codecs are valid.
+func validateBlockCodecs(float, str string) error {
+	switch float {
+	case "", FloatBlockCodecGorilla, FloatBlockCodecDeflate:
+	default:
+		return fmt.Errorf("unrecognized float block codec %s", float)
+	}
+
+	switch str {
+	case "", StringBlockCodecSnappy, StringBlockCodecDeflate, StringBlockCodecDictionary:
+	default:
+		return fmt.Errorf("unrecognized string block codec %s", str)
+	}
 	return nil
 }
 
@@ -172,6 +389,12 @@ func (c Config) Diagnostics() (*diagnostics.Diagnostics, error) {
 	return diagnostics.RowFromMap(map[string]interface{}{
 		"dir":                                c.Dir,
 		"wal-dir":                            c.WALDir,
+		"cold-dir":                           c.ColdDir,
+		"cold-age":                           c.ColdAge,
+		"scrub-interval":                     c.ScrubInterval,
+		"encryption-keyring":                 c.EncryptionKeyring,
+		"cache-segregate-late-writes":        c.CacheSegregateLateWrites,
+		"bloom-filters-enabled":              c.BloomFiltersEnabled,
 		"wal-fsync-delay":                    c.WALFsyncDelay,
 		"cache-max-memory-size":              c.CacheMaxMemorySize,
 		"cache-snapshot-memory-size":         c.CacheSnapshotMemorySize,
@@ -180,5 +403,7 @@ func (c Config) Diagnostics() (*diagnostics.Diagnostics, error) {
 		"max-series-per-database":            c.MaxSeriesPerDatabase,
 		"max-values-per-tag":                 c.MaxValuesPerTag,
 		"max-concurrent-compactions":         c.MaxConcurrentCompactions,
+		"float-block-codec":                  c.FloatBlockCodec,
+		"string-block-codec":                 c.StringBlockCodec,
 	}), nil
 }
@@ -68,6 +68,99 @@ func TestConfig_Validate_Error(t *testing.T) {
 	}
 }
 
+func TestConfig_BlockCodecs(t *testing.T) {
+	c := tsdb.NewConfig()
+	if _, err := toml.Decode(`
+dir = "/var/lib/influxdb/data"
+wal-dir = "/var/lib/influxdb/wal"
+string-block-codec = "deflate"
+
+[[block-codec]]
+database = "logs"
+string = "dictionary"
+
+[[block-codec]]
+database = "metrics"
+float = "deflate"
+`, &c); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := c.Validate(); err != nil {
+		t.Fatalf("unexpected validate error: %s", err)
+	}
+
+	for _, tt := range []struct {
+		database   string
+		float, str string
+	}{
+		{database: "db0", float: "gorilla", str: "deflate"},
+		{database: "logs", float: "gorilla", str: "dictionary"},
+		{database: "metrics", float: "deflate", str: "deflate"},
+	} {
+		if float, str := c.BlockCodecsFor(tt.database); float != tt.float || str != tt.str {
+			t.Errorf("unexpected codecs for %s: got=%s/%s exp=%s/%s", tt.database, float, str, tt.float, tt.str)
+		}
+	}
+
+	c.BlockCodecs[1].Float = "zstd"
+	if err := c.Validate(); err == nil || err.Error() != "invalid block-codec for database metrics: unrecognized float block codec zstd" {
+		t.Errorf("unexpected error: %s", err)
+	}
+
+	c.BlockCodecs = nil
+	c.StringBlockCodec = "lz4"
+	if err := c.Validate(); err == nil || err.Error() != "unrecognized string block codec lz4" {
+		t.Errorf("unexpected error: %s", err)
+	}
+}
+
+func TestConfig_Rollups(t *testing.T) {
+	c := tsdb.NewConfig()
+	if _, err := toml.Decode(`
+dir = "/var/lib/influxdb/data"
+wal-dir = "/var/lib/influxdb/wal"
+
+[[rollup]]
+database = "db0"
+retention-policy = "autogen"
+target-retention-policy = "5m"
+interval = "5m"
+functions = ["mean", "max"]
+
+[[rollup]]
+database = "db0"
+retention-policy = "autogen"
+target-retention-policy = "1h"
+interval = "1h"
+functions = ["count"]
+`, &c); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := c.Validate(); err != nil {
+		t.Fatalf("unexpected validate error: %s", err)
+	}
+
+	if got := c.RollupsFor("db0", "autogen"); len(got) != 2 {
+		t.Fatalf("unexpected rollups: %v", got)
+	} else if got[0].TargetRetentionPolicy != "5m" || time.Duration(got[0].Interval) != 5*time.Minute {
+		t.Fatalf("unexpected rollup: %v", got[0])
+	} else if got := c.RollupsFor("db0", "5m"); len(got) != 0 {
+		t.Fatalf("unexpected rollups: %v", got)
+	}
+
+	c.Rollups[1].Functions = []string{"median"}
+	if err := c.Validate(); err == nil || err.Error() != "unrecognized rollup function median" {
+		t.Errorf("unexpected error: %s", err)
+	}
+
+	c.Rollups[1].TargetRetentionPolicy = "autogen"
+	if err := c.Validate(); err == nil || err.Error() != "rollup target-retention-policy must differ from retention-policy" {
+		t.Errorf("unexpected error: %s", err)
+	}
+}
+
 func TestConfig_ByteSizes(t *testing.T) {
 	// Parse configuration.
 	c := tsdb.NewConfig()
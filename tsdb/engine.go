@@ -12,6 +12,7 @@ import (
 
 	"github.com/influxdata/influxdb/models"
 	"github.com/influxdata/influxdb/pkg/estimator"
+	"github.com/influxdata/influxdb/pkg/keyring"
 	"github.com/influxdata/influxdb/pkg/limiter"
 	"github.com/influxdata/influxdb/query"
 	"github.com/influxdata/influxql"
@@ -34,7 +35,11 @@ type Engine interface {
 	Close() error
 	SetEnabled(enabled bool)
 	SetCompactionsEnabled(enabled bool)
+	SetCompactionsPaused(paused bool)
+	CompactionsPaused() bool
+	CancelCompactions()
 	ScheduleFullCompaction() error
+	Compactions() []CompactionInfo
 
 	WithLogger(*zap.Logger)
 
@@ -77,12 +82,37 @@ type Engine interface {
 	Statistics(tags map[string]string) []models.Statistic
 	LastModified() time.Time
 	DiskSize() int64
+	ColdDiskSize() int64
+	Scrub(ctx context.Context, lim limiter.Rate) (ScrubReport, error)
 	IsIdle() bool
 	Free() error
 
 	io.WriterTo
 }
 
+// PointsWriter writes points to a retention policy of a database, creating
+// shards as needed.
+type PointsWriter interface {
+	WritePointsPrivileged(database, retentionPolicy string, consistencyLevel models.ConsistencyLevel, points []models.Point) error
+}
+
+// CompactionInfo describes a compaction which is running or planned to run.
+type CompactionInfo struct {
+	// Level is the level of the compaction.  Full and optimize compactions
+	// have a level of 4.
+	Level    int
+	Strategy string
+
+	Files []string
+	Bytes int64
+
+	// Active is true if the compaction is running.  Started and Progress are
+	// only set for running compactions.
+	Active   bool
+	Started  time.Time
+	Progress float64
+}
+
 // SeriesIDSets provides access to the total set of series IDs
 type SeriesIDSets interface {
 	ForEach(f func(ids *SeriesIDSet)) error
@@ -123,6 +153,12 @@ func RegisteredEngines() []string {
 // NewEngine returns an instance of an engine based on its format.
 // If the path does not exist then the DefaultFormat is used.
 func NewEngine(id uint64, i Index, database, path string, walPath string, sfile *SeriesFile, options EngineOptions) (Engine, error) {
+	// Engines cannot report invalid options when they are created, and the
+	// options may not have been validated as part of a configuration.
+	if err := validateBlockCodecs(options.Config.BlockCodecsFor(database)); err != nil {
+		return nil, err
+	}
+
 	// Create a new engine
 	if _, err := os.Stat(path); os.IsNotExist(err) {
 		return newEngineFuncs[options.EngineVersion](id, i, database, path, walPath, sfile, options), nil
@@ -159,6 +195,20 @@ type EngineOptions struct {
 	CompactionThroughputLimiter limiter.Rate
 	WALEnabled                  bool
 
+	// ColdPath is the directory which holds the shard's cold TSM files.
+	ColdPath string
+
+	// RetentionPolicy is the retention policy of the shard.
+	RetentionPolicy string
+
+	// RollupWriter writes the points computed by rollups.  Rollups are not
+	// computed if it is nil.
+	RollupWriter PointsWriter
+
+	// KeyProvider provides the master keys of encrypted WAL segments and TSM
+	// files.  If set, new segments and files are encrypted.
+	KeyProvider keyring.Provider
+
 	Config       Config
 	SeriesIDSets SeriesIDSets
 }
@@ -0,0 +1,161 @@
+package tsm1
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/influxdata/influxdb/pkg/bloom"
+)
+
+const (
+	// BloomFileExtension is the extension of the bloom filter written alongside a TSM file.
+	BloomFileExtension = "bloom"
+
+	// bloomMagicNumber identifies a bloom filter file.
+	bloomMagicNumber uint32 = 0x16D1B100
+
+	// bloomVersion is the version of the bloom filter file format.
+	bloomVersion byte = 1
+
+	// bloomHeaderSize is the size of the magic number, version, hash function
+	// count, TSM file size and TSM index size.
+	bloomHeaderSize = 4 + 1 + 8 + 8 + 4
+
+	// bloomFalsePositiveRate is the false positive rate of new bloom filters.
+	bloomFalsePositiveRate = 0.01
+)
+
+var (
+	// ErrBloomFilterEncrypted is returned when writing the bloom filter of an
+	// encrypted TSM file.
+	ErrBloomFilterEncrypted = errors.New("bloom filters are not written for encrypted TSM files")
+
+	errBloomFilterMismatch = errors.New("bloom filter does not match TSM file")
+)
+
+// BloomFilterPath returns the path of the bloom filter of the TSM file at path.
+// Temporary and final TSM files share the same bloom filter path, so filters
+// written during a compaction do not need to be renamed.
+func BloomFilterPath(path string) string {
+	dir, base := filepath.Split(path)
+	if i := strings.IndexByte(base, '.'); i >= 0 {
+		base = base[:i]
+	}
+	return filepath.Join(dir, fmt.Sprintf("%s.%s", base, BloomFileExtension))
+}
+
+// WriteBloomFilter writes a bloom filter of the keys in r alongside the TSM
+// file.  Filters are not written for encrypted files as they would reveal
+// which keys the file contains.
+func WriteBloomFilter(r *TSMReader) error {
+	if m, ok := r.accessor.(*mmapAccessor); ok && m.aead != nil {
+		return ErrBloomFilterEncrypted
+	}
+
+	n := uint64(r.KeyCount())
+	if n == 0 {
+		n = 1
+	}
+	filter := bloom.NewFilter(bloom.Estimate(n, bloomFalsePositiveRate))
+
+	// Keys are copied as inserting them into the filter temporarily modifies
+	// them, and the index of the file is read-only.
+	var buf []byte
+	for i := 0; i < r.KeyCount(); i++ {
+		key, _ := r.KeyAt(i)
+		buf = append(buf[:0], key...)
+		filter.Insert(buf)
+	}
+
+	b := make([]byte, bloomHeaderSize, bloomHeaderSize+len(filter.Bytes())+4)
+	binary.BigEndian.PutUint32(b[0:4], bloomMagicNumber)
+	b[4] = bloomVersion
+	binary.BigEndian.PutUint64(b[5:13], filter.K())
+	binary.BigEndian.PutUint64(b[13:21], uint64(r.Size()))
+	binary.BigEndian.PutUint32(b[21:25], r.IndexSize())
+	b = append(b, filter.Bytes()...)
+
+	var checksum [4]byte
+	binary.BigEndian.PutUint32(checksum[:], crc32.ChecksumIEEE(b))
+	b = append(b, checksum[:]...)
+
+	// Write to a temporary file so the filter is replaced atomically.
+	path := BloomFilterPath(r.Path())
+	tmpPath := fmt.Sprintf("%s.%s", path, TmpTSMFileExtension)
+	if err := ioutil.WriteFile(tmpPath, b, 0666); err != nil {
+		return err
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+	return nil
+}
+
+// readBloomFilter reads the bloom filter of the TSM file read by r.  If the
+// file has no bloom filter, nil is returned.
+func readBloomFilter(r *TSMReader) (*bloom.Filter, error) {
+	b, err := ioutil.ReadFile(BloomFilterPath(r.Path()))
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	if len(b) < bloomHeaderSize+4 {
+		return nil, fmt.Errorf("bloom filter too short: %d bytes", len(b))
+	} else if magic := binary.BigEndian.Uint32(b[0:4]); magic != bloomMagicNumber {
+		return nil, fmt.Errorf("invalid bloom filter magic number: %x", magic)
+	} else if b[4] != bloomVersion {
+		return nil, fmt.Errorf("unsupported bloom filter version: %d", b[4])
+	}
+
+	data, checksum := b[:len(b)-4], binary.BigEndian.Uint32(b[len(b)-4:])
+	if crc32.ChecksumIEEE(data) != checksum {
+		return nil, errors.New("bloom filter checksum mismatch")
+	}
+
+	if binary.BigEndian.Uint64(data[13:21]) != uint64(r.Size()) || binary.BigEndian.Uint32(data[21:25]) != r.IndexSize() {
+		return nil, errBloomFilterMismatch
+	}
+	return bloom.NewFilterBuffer(data[bloomHeaderSize:], binary.BigEndian.Uint64(data[5:13]))
+}
+
+// BuildBloomFilter writes the bloom filter of the TSM file at path.  Unless
+// force is set, files which already have a valid filter are skipped.  It
+// returns false if no filter was written, including for encrypted files.
+func BuildBloomFilter(path string, force bool) (bool, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return false, err
+	}
+
+	if _, ok, err := envelopeOffset(f); err != nil {
+		f.Close()
+		return false, err
+	} else if ok {
+		f.Close()
+		return false, nil
+	}
+
+	r, err := NewTSMReader(f)
+	if err != nil {
+		f.Close()
+		return false, err
+	}
+	defer r.Close()
+
+	if r.bloom != nil && !force {
+		return false, nil
+	}
+	if err := WriteBloomFilter(r); err != nil {
+		return false, err
+	}
+	return true, nil
+}
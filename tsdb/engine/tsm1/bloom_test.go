@@ -0,0 +1,159 @@
+package tsm1_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/influxdata/influxdb/tsdb/engine/tsm1"
+)
+
+// Ensure a bloom filter can be built for a TSM file and excludes missing keys.
+func TestBuildBloomFilter(t *testing.T) {
+	dir := MustTempDir()
+	defer os.RemoveAll(dir)
+
+	writes := map[string][]tsm1.Value{}
+	for i := 0; i < 100; i++ {
+		writes[fmt.Sprintf("cpu,host=%d#!~#value", i)] = []tsm1.Value{tsm1.NewValue(1, float64(i))}
+	}
+	path := MustWriteTSM(dir, 1, writes)
+
+	if ok, err := tsm1.BuildBloomFilter(path, false); err != nil {
+		t.Fatal(err)
+	} else if !ok {
+		t.Fatal("expected bloom filter to be built")
+	}
+	if ok, err := tsm1.BuildBloomFilter(path, false); err != nil {
+		t.Fatal(err)
+	} else if ok {
+		t.Fatal("expected existing bloom filter to be kept")
+	}
+	if ok, err := tsm1.BuildBloomFilter(path, true); err != nil {
+		t.Fatal(err)
+	} else if !ok {
+		t.Fatal("expected bloom filter to be rebuilt")
+	}
+
+	r := MustOpenTSMReader(path)
+	for k := range writes {
+		if !r.MayContain([]byte(k)) {
+			t.Fatalf("expected key %q to be included", k)
+		}
+	}
+
+	var excluded int
+	for i := 0; i < 100; i++ {
+		if !r.MayContain([]byte(fmt.Sprintf("mem,host=%d#!~#value", i))) {
+			excluded++
+		}
+	}
+	if excluded < 90 {
+		t.Fatalf("expected most missing keys to be excluded, got %d", excluded)
+	}
+
+	// Removing the file removes its bloom filter.
+	if err := r.Close(); err != nil {
+		t.Fatal(err)
+	} else if err := r.Remove(); err != nil {
+		t.Fatal(err)
+	} else if _, err := os.Stat(tsm1.BloomFilterPath(path)); !os.IsNotExist(err) {
+		t.Fatalf("expected bloom filter to be removed: %v", err)
+	}
+}
+
+// Ensure an invalid bloom filter is ignored when reading a TSM file.
+func TestTSMReader_MayContain_InvalidBloomFilter(t *testing.T) {
+	dir := MustTempDir()
+	defer os.RemoveAll(dir)
+
+	path := MustWriteTSM(dir, 1, map[string][]tsm1.Value{
+		"cpu,host=A#!~#value": []tsm1.Value{tsm1.NewValue(1, 1.1)},
+	})
+	if _, err := tsm1.BuildBloomFilter(path, false); err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := ioutil.ReadFile(tsm1.BloomFilterPath(path))
+	if err != nil {
+		t.Fatal(err)
+	}
+	b[len(b)-1] ^= 0xFF
+	if err := ioutil.WriteFile(tsm1.BloomFilterPath(path), b, 0666); err != nil {
+		t.Fatal(err)
+	}
+
+	r := MustOpenTSMReader(path)
+	defer r.Close()
+	if !r.MayContain([]byte("mem,host=A#!~#value")) {
+		t.Fatal("expected invalid bloom filter to be ignored")
+	}
+	if values, err := r.ReadAll([]byte("cpu,host=A#!~#value")); err != nil {
+		t.Fatal(err)
+	} else if len(values) != 1 {
+		t.Fatalf("unexpected values: %v", values)
+	}
+}
+
+// Ensure compactions write the bloom filters of new TSM files.
+func TestCompactor_CompactFull_BloomFilter(t *testing.T) {
+	dir := MustTempDir()
+	defer os.RemoveAll(dir)
+
+	f1 := MustWriteTSM(dir, 1, map[string][]tsm1.Value{
+		"cpu,host=A#!~#value": []tsm1.Value{tsm1.NewValue(1, 1.1)},
+	})
+	f2 := MustWriteTSM(dir, 2, map[string][]tsm1.Value{
+		"cpu,host=B#!~#value": []tsm1.Value{tsm1.NewValue(1, 2.1)},
+	})
+
+	fs := &fakeFileStore{}
+	defer fs.Close()
+	compactor := &tsm1.Compactor{
+		Dir:          dir,
+		FileStore:    fs,
+		BloomFilters: true,
+	}
+	compactor.Open()
+
+	files, err := compactor.CompactFull([]string{f1, f2})
+	if err != nil {
+		t.Fatalf("unexpected error compacting: %v", err)
+	} else if len(files) != 1 {
+		t.Fatalf("unexpected files: %v", files)
+	}
+	if _, err := os.Stat(tsm1.BloomFilterPath(files[0])); err != nil {
+		t.Fatalf("expected bloom filter: %v", err)
+	}
+
+	r := MustOpenTSMReader(files[0])
+	defer r.Close()
+	for _, k := range []string{"cpu,host=A#!~#value", "cpu,host=B#!~#value"} {
+		if !r.MayContain([]byte(k)) {
+			t.Fatalf("expected key %q to be included", k)
+		}
+	}
+}
+
+func BenchmarkTSMReader_MayContain(b *testing.B) {
+	dir := MustTempDir()
+	defer os.RemoveAll(dir)
+
+	path := MustWriteTSM(dir, 1, map[string][]tsm1.Value{
+		"cpu,host=A#!~#value": []tsm1.Value{tsm1.NewValue(1, 1.1)},
+	})
+	if _, err := tsm1.BuildBloomFilter(path, false); err != nil {
+		b.Fatal(err)
+	}
+
+	r := MustOpenTSMReader(path)
+	defer r.Close()
+
+	key := []byte("mem,host=A#!~#value")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		r.MayContain(key)
+	}
+}
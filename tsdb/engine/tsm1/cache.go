@@ -4,11 +4,13 @@ import (
 	"fmt"
 	"math"
 	"os"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/influxdata/influxdb/models"
+	"github.com/influxdata/influxdb/pkg/keyring"
 	"github.com/influxdata/influxdb/tsdb"
 	"github.com/influxdata/influxql"
 	"go.uber.org/zap"
@@ -156,6 +158,8 @@ const (
 
 	statCachedBytes         = "cachedBytes"         // counter: Total number of bytes written into snapshots.
 	statWALCompactionTimeMs = "WALCompactionTimeMs" // counter: Total number of milliseconds spent compacting snapshots
+	statCacheLateBytes      = "lateBytes"           // counter: Total number of bytes of late values written into separate snapshots
+	statCacheLateValues     = "lateValues"          // counter: Total number of late values written into separate snapshots
 
 	statCacheWriteOK      = "writeOk"
 	statCacheWriteErr     = "writeErr"
@@ -232,6 +236,8 @@ type CacheStatistics struct {
 	CacheAgeMs          int64
 	CachedBytes         int64
 	WALCompactionTimeMs int64
+	LateBytes           int64
+	LateValues          int64
 	WriteOK             int64
 	WriteErr            int64
 	WriteDropped        int64
@@ -249,6 +255,8 @@ func (c *Cache) Statistics(tags map[string]string) []models.Statistic {
 			statCacheAgeMs:          atomic.LoadInt64(&c.stats.CacheAgeMs),
 			statCachedBytes:         atomic.LoadInt64(&c.stats.CachedBytes),
 			statWALCompactionTimeMs: atomic.LoadInt64(&c.stats.WALCompactionTimeMs),
+			statCacheLateBytes:      atomic.LoadInt64(&c.stats.LateBytes),
+			statCacheLateValues:     atomic.LoadInt64(&c.stats.LateValues),
 			statCacheWriteOK:        atomic.LoadInt64(&c.stats.WriteOK),
 			statCacheWriteErr:       atomic.LoadInt64(&c.stats.WriteErr),
 			statCacheWriteDropped:   atomic.LoadInt64(&c.stats.WriteDropped),
@@ -514,6 +522,63 @@ func (c *Cache) Split(n int) []*Cache {
 	return caches
 }
 
+// SplitLate splits a deduplicated snapshot into a cache of the values of each
+// key written after the data already stored for the key, and a cache of the
+// remaining late values.  maxTime returns the maximum time stored for a key
+// given the minimum time of its values in the snapshot, or false if the values
+// are all newer.  Entries are shared with c, so c must not be modified.
+func (c *Cache) SplitLate(maxTime func(key []byte, min int64) (int64, bool)) (*Cache, *Cache, error) {
+	inOrder, err := newring(ringShards)
+	if err != nil {
+		return nil, nil, err
+	}
+	late, err := newring(ringShards)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var inOrderSize, lateSize uint64
+	if err := c.store.applySerial(func(key []byte, e *entry) error {
+		e.mu.RLock()
+		values := e.values
+		e.mu.RUnlock()
+		if len(values) == 0 {
+			return nil
+		}
+
+		max, ok := maxTime(key, values[0].UnixNano())
+		if !ok || values[0].UnixNano() > max {
+			inOrder.add(key, e)
+			inOrderSize += uint64(values.Size() + len(key))
+			return nil
+		} else if values[len(values)-1].UnixNano() <= max {
+			late.add(key, e)
+			lateSize += uint64(values.Size() + len(key))
+			return nil
+		}
+
+		// The values are sorted, so only the values up to max are late.
+		i := sort.Search(len(values), func(i int) bool { return values[i].UnixNano() > max })
+		le, err := newEntryValues(values[:i])
+		if err != nil {
+			return err
+		}
+		ie, err := newEntryValues(values[i:])
+		if err != nil {
+			return err
+		}
+		late.add(key, le)
+		inOrder.add(key, ie)
+		lateSize += uint64(values[:i].Size() + len(key))
+		inOrderSize += uint64(values[i:].Size() + len(key))
+		return nil
+	}); err != nil {
+		return nil, nil, err
+	}
+
+	return &Cache{store: inOrder, size: inOrderSize}, &Cache{store: late, size: lateSize}, nil
+}
+
 // Values returns a copy of all values, deduped and sorted, for the given key.
 func (c *Cache) Values(key []byte) Values {
 	var snapshotEntries *entry
@@ -649,6 +714,9 @@ func (c *Cache) ApplyEntryFn(f func(key []byte, entry *entry) error) error {
 type CacheLoader struct {
 	files []string
 
+	// Keys provides the master keys of encrypted segments.
+	Keys keyring.Provider
+
 	Logger *zap.Logger
 }
 
@@ -688,7 +756,7 @@ func (cl *CacheLoader) Load(cache *Cache) error {
 			}
 
 			if r == nil {
-				r = NewWALSegmentReader(f)
+				r = NewWALSegmentReaderWithKeys(f, cl.Keys)
 				defer r.Close()
 			} else {
 				r.Reset(f)
@@ -696,7 +764,11 @@ func (cl *CacheLoader) Load(cache *Cache) error {
 
 			for r.Next() {
 				entry, err := r.Read()
-				if err != nil {
+				if _, ok := err.(errDecrypt); ok {
+					// Truncating the segment would discard data which can
+					// be read once the right key is available.
+					return fmt.Errorf("%s: %s", f.Name(), err)
+				} else if err != nil {
 					n := r.Count()
 					cl.Logger.Info("File corrupt", zap.Error(err), zap.String("path", f.Name()), zap.Int64("pos", n))
 					if err := f.Truncate(n); err != nil {
@@ -748,6 +820,15 @@ func (c *Cache) updateCachedBytes(b uint64) {
 	atomic.AddInt64(&c.stats.CachedBytes, int64(b))
 }
 
+// updateLateStats increments the late write statistics by the size and number
+// of values of the late snapshot.
+func (c *Cache) updateLateStats(late *Cache) {
+	var n int
+	_ = late.store.applySerial(func(_ []byte, e *entry) error { n += e.count(); return nil })
+	atomic.AddInt64(&c.stats.LateBytes, int64(late.Size()))
+	atomic.AddInt64(&c.stats.LateValues, int64(n))
+}
+
 // updateMemSize updates the memSize level by b.
 func (c *Cache) updateMemSize(b int64) {
 	atomic.AddInt64(&c.stats.MemSizeBytes, b)
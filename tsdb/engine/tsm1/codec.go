@@ -0,0 +1,209 @@
+package tsm1
+
+// Block codecs select how the values of float and string blocks are compressed.
+// The codec is recorded in the high 4 bits of the first byte of the encoded
+// values, so blocks written with any codec can be read regardless of the codecs
+// currently configured and existing files do not need to be rewritten.
+//
+// The general purpose codecs use DEFLATE from the standard library rather than
+// zstd or lz4, so that no compression library has to be added as a dependency.
+// New codecs, such as zstd, only need an unused value of the codec bits.
+
+import (
+	"bytes"
+	"compress/flate"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/influxdata/influxdb/tsdb"
+)
+
+// BlockCodecs holds the encodings used to compress the values of new float
+// and string blocks. The zero value uses the default encodings.
+type BlockCodecs struct {
+	float  byte
+	string byte
+}
+
+// NewBlockCodecs returns the BlockCodecs for the named float and string codecs.
+// An empty name selects the default codec.
+func NewBlockCodecs(float, str string) (BlockCodecs, error) {
+	var c BlockCodecs
+	switch float {
+	case "", tsdb.FloatBlockCodecGorilla:
+		c.float = floatCompressedGorilla
+	case tsdb.FloatBlockCodecDeflate:
+		c.float = floatCompressedDeflate
+	default:
+		return BlockCodecs{}, fmt.Errorf("unknown float block codec: %q", float)
+	}
+
+	switch str {
+	case "", tsdb.StringBlockCodecSnappy:
+		c.string = stringCompressedSnappy
+	case tsdb.StringBlockCodecDeflate:
+		c.string = stringCompressedDeflate
+	case tsdb.StringBlockCodecDictionary:
+		c.string = stringCompressedDictionary
+	default:
+		return BlockCodecs{}, fmt.Errorf("unknown string block codec: %q", str)
+	}
+	return c, nil
+}
+
+var (
+	flateWriterPool sync.Pool
+	flateReaderPool sync.Pool
+)
+
+// appendDeflate appends the DEFLATE compressed form of src to dst.
+func appendDeflate(dst, src []byte) ([]byte, error) {
+	buf := bytes.NewBuffer(dst)
+
+	w, _ := flateWriterPool.Get().(*flate.Writer)
+	if w == nil {
+		var err error
+		if w, err = flate.NewWriter(buf, flate.DefaultCompression); err != nil {
+			return nil, err
+		}
+	} else {
+		w.Reset(buf)
+	}
+	defer flateWriterPool.Put(w)
+
+	if _, err := w.Write(src); err != nil {
+		return nil, err
+	} else if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// inflate decompresses the DEFLATE compressed src, reusing dst if it is
+// large enough.
+func inflate(dst, src []byte) ([]byte, error) {
+	r, _ := flateReaderPool.Get().(io.ReadCloser)
+	if r == nil {
+		r = flate.NewReader(bytes.NewReader(src))
+	} else if err := r.(flate.Resetter).Reset(bytes.NewReader(src), nil); err != nil {
+		return nil, err
+	}
+	defer flateReaderPool.Put(r)
+
+	buf := bytes.NewBuffer(dst[:0])
+	if _, err := buf.ReadFrom(r); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// shuffleFloats writes the big endian representation of each value in vals
+// to dst, grouping the nth byte of every value together. Neighbouring floats
+// commonly share their sign, exponent and high mantissa bytes, so grouping
+// them produces long runs which compress well.
+func shuffleFloats(dst []byte, vals []uint64) []byte {
+	n := len(vals)
+	if cap(dst) < n*8 {
+		dst = make([]byte, n*8)
+	}
+	dst = dst[:n*8]
+
+	for i, v := range vals {
+		for j := 0; j < 8; j++ {
+			dst[j*n+i] = byte(v >> uint(56-8*j))
+		}
+	}
+	return dst
+}
+
+// unshuffleFloats reverses shuffleFloats, appending the values in src to dst.
+func unshuffleFloats(dst []uint64, src []byte) ([]uint64, error) {
+	if len(src)%8 != 0 {
+		return nil, fmt.Errorf("invalid shuffled float length: %d", len(src))
+	}
+
+	n := len(src) / 8
+	for i := 0; i < n; i++ {
+		var v uint64
+		for j := 0; j < 8; j++ {
+			v = v<<8 | uint64(src[j*n+i])
+		}
+		dst = append(dst, v)
+	}
+	return dst, nil
+}
+
+// encodeStringDictionary encodes the length prefixed strings in src as a
+// dictionary of the distinct strings followed by the index of each string in
+// the dictionary. ok is false if the strings are too varied for a dictionary
+// to be smaller than the original encoding.
+func encodeStringDictionary(src []byte) (dst []byte, ok bool, err error) {
+	var (
+		entries [][]byte
+		indexes []int
+		lookup  = make(map[string]int)
+	)
+	for i := 0; i < len(src); {
+		length, n := binary.Uvarint(src[i:])
+		if n <= 0 || i+n+int(length) > len(src) {
+			return nil, false, fmt.Errorf("StringEncoder: invalid encoded string length")
+		}
+		s := src[i+n : i+n+int(length)]
+		i += n + int(length)
+
+		idx, ok := lookup[string(s)]
+		if !ok {
+			idx = len(entries)
+			lookup[string(s)] = idx
+			entries = append(entries, s)
+		}
+		indexes = append(indexes, idx)
+	}
+
+	if len(entries) > len(indexes)/2 {
+		return nil, false, nil
+	}
+
+	var tmp [binary.MaxVarintLen64]byte
+	dst = append(dst, tmp[:binary.PutUvarint(tmp[:], uint64(len(entries)))]...)
+	for _, s := range entries {
+		dst = append(dst, tmp[:binary.PutUvarint(tmp[:], uint64(len(s)))]...)
+		dst = append(dst, s...)
+	}
+	for _, idx := range indexes {
+		dst = append(dst, tmp[:binary.PutUvarint(tmp[:], uint64(idx))]...)
+	}
+	return dst, true, nil
+}
+
+// decodeStringDictionary reverses encodeStringDictionary, appending the
+// length prefixed strings to dst.
+func decodeStringDictionary(dst, src []byte) ([]byte, error) {
+	entryN, n := binary.Uvarint(src)
+	if n <= 0 || entryN > uint64(len(src)) {
+		return nil, fmt.Errorf("StringDecoder: invalid dictionary size")
+	}
+	src = src[n:]
+
+	// Each entry retains its length prefix so it can be appended directly.
+	entries := make([][]byte, entryN)
+	for i := range entries {
+		length, n := binary.Uvarint(src)
+		if n <= 0 || uint64(n)+length > uint64(len(src)) {
+			return nil, fmt.Errorf("StringDecoder: invalid dictionary entry length")
+		}
+		entries[i], src = src[:n+int(length)], src[n+int(length):]
+	}
+
+	for len(src) > 0 {
+		idx, n := binary.Uvarint(src)
+		if n <= 0 || idx >= entryN {
+			return nil, fmt.Errorf("StringDecoder: invalid dictionary index")
+		}
+		src = src[n:]
+		dst = append(dst, entries[idx]...)
+	}
+	return dst, nil
+}
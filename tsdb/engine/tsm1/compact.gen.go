@@ -172,7 +172,7 @@ func (k *tsmKeyIterator) combineFloat(dedup bool) blocks {
 func (k *tsmKeyIterator) chunkFloat(dst blocks) blocks {
 	if len(k.mergedFloatValues) > k.size {
 		values := k.mergedFloatValues[:k.size]
-		cb, err := FloatValues(values).Encode(nil)
+		cb, err := FloatValues(values).EncodeWith(nil, k.codecs)
 		if err != nil {
 			k.err = err
 			return nil
@@ -190,7 +190,7 @@ func (k *tsmKeyIterator) chunkFloat(dst blocks) blocks {
 
 	// Re-encode the remaining values into the last block
 	if len(k.mergedFloatValues) > 0 {
-		cb, err := FloatValues(k.mergedFloatValues).Encode(nil)
+		cb, err := FloatValues(k.mergedFloatValues).EncodeWith(nil, k.codecs)
 		if err != nil {
 			k.err = err
 			return nil
@@ -371,7 +371,7 @@ func (k *tsmKeyIterator) combineInteger(dedup bool) blocks {
 func (k *tsmKeyIterator) chunkInteger(dst blocks) blocks {
 	if len(k.mergedIntegerValues) > k.size {
 		values := k.mergedIntegerValues[:k.size]
-		cb, err := IntegerValues(values).Encode(nil)
+		cb, err := IntegerValues(values).EncodeWith(nil, k.codecs)
 		if err != nil {
 			k.err = err
 			return nil
@@ -389,7 +389,7 @@ func (k *tsmKeyIterator) chunkInteger(dst blocks) blocks {
 
 	// Re-encode the remaining values into the last block
 	if len(k.mergedIntegerValues) > 0 {
-		cb, err := IntegerValues(k.mergedIntegerValues).Encode(nil)
+		cb, err := IntegerValues(k.mergedIntegerValues).EncodeWith(nil, k.codecs)
 		if err != nil {
 			k.err = err
 			return nil
@@ -570,7 +570,7 @@ func (k *tsmKeyIterator) combineUnsigned(dedup bool) blocks {
 func (k *tsmKeyIterator) chunkUnsigned(dst blocks) blocks {
 	if len(k.mergedUnsignedValues) > k.size {
 		values := k.mergedUnsignedValues[:k.size]
-		cb, err := UnsignedValues(values).Encode(nil)
+		cb, err := UnsignedValues(values).EncodeWith(nil, k.codecs)
 		if err != nil {
 			k.err = err
 			return nil
@@ -588,7 +588,7 @@ func (k *tsmKeyIterator) chunkUnsigned(dst blocks) blocks {
 
 	// Re-encode the remaining values into the last block
 	if len(k.mergedUnsignedValues) > 0 {
-		cb, err := UnsignedValues(k.mergedUnsignedValues).Encode(nil)
+		cb, err := UnsignedValues(k.mergedUnsignedValues).EncodeWith(nil, k.codecs)
 		if err != nil {
 			k.err = err
 			return nil
@@ -769,7 +769,7 @@ func (k *tsmKeyIterator) combineString(dedup bool) blocks {
 func (k *tsmKeyIterator) chunkString(dst blocks) blocks {
 	if len(k.mergedStringValues) > k.size {
 		values := k.mergedStringValues[:k.size]
-		cb, err := StringValues(values).Encode(nil)
+		cb, err := StringValues(values).EncodeWith(nil, k.codecs)
 		if err != nil {
 			k.err = err
 			return nil
@@ -787,7 +787,7 @@ func (k *tsmKeyIterator) chunkString(dst blocks) blocks {
 
 	// Re-encode the remaining values into the last block
 	if len(k.mergedStringValues) > 0 {
-		cb, err := StringValues(k.mergedStringValues).Encode(nil)
+		cb, err := StringValues(k.mergedStringValues).EncodeWith(nil, k.codecs)
 		if err != nil {
 			k.err = err
 			return nil
@@ -968,7 +968,7 @@ func (k *tsmKeyIterator) combineBoolean(dedup bool) blocks {
 func (k *tsmKeyIterator) chunkBoolean(dst blocks) blocks {
 	if len(k.mergedBooleanValues) > k.size {
 		values := k.mergedBooleanValues[:k.size]
-		cb, err := BooleanValues(values).Encode(nil)
+		cb, err := BooleanValues(values).EncodeWith(nil, k.codecs)
 		if err != nil {
 			k.err = err
 			return nil
@@ -986,7 +986,7 @@ func (k *tsmKeyIterator) chunkBoolean(dst blocks) blocks {
 
 	// Re-encode the remaining values into the last block
 	if len(k.mergedBooleanValues) > 0 {
-		cb, err := BooleanValues(k.mergedBooleanValues).Encode(nil)
+		cb, err := BooleanValues(k.mergedBooleanValues).EncodeWith(nil, k.codecs)
 		if err != nil {
 			k.err = err
 			return nil
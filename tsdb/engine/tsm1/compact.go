@@ -25,12 +25,17 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/influxdata/influxdb/pkg/keyring"
 	"github.com/influxdata/influxdb/pkg/limiter"
 	"github.com/influxdata/influxdb/tsdb"
 )
 
 const maxTSMFileSize = uint32(2048 * 1024 * 1024) // 2GB
 
+// minLateGenerations is the minimum number of adjacent late generations merged
+// together by a level 1 plan.
+const minLateGenerations = 4
+
 const (
 	// CompactionTempExtension is the extension used for temporary files created during compaction.
 	CompactionTempExtension = "tmp"
@@ -153,10 +158,11 @@ func (t *tsmGeneration) size() uint64 {
 
 // compactionLevel returns the level of the files in this generation.
 func (t *tsmGeneration) level() int {
-	// Level 0 is always created from the result of a cache compaction.  It generates
-	// 1 file with a sequence num of 1.  Level 2 is generated by compacting multiple
-	// level 1 files.  Level 3 is generate by compacting multiple level 2 files.  Level
-	// 4 is for anything else.
+	// Level 1 is always created from the result of a cache compaction.  It generates
+	// 1 file with a sequence num of 1.  Level 0 holds the late values of a cache
+	// compaction, which are written with a sequence num of 0.  Level 2 is generated by
+	// compacting multiple level 1 files.  Level 3 is generate by compacting multiple
+	// level 2 files.  Level 4 is for anything else.
 	_, seq, _ := ParseTSMFileName(t.files[0].Path)
 	if seq < 4 {
 		return seq
@@ -225,9 +231,26 @@ func (c *DefaultPlanner) PlanLevel(level int) []CompactionGroup {
 	// level become part of the same group.
 	var currentGen tsmGenerations
 	var groups []tsmGenerations
+	var lateGens []tsmGenerations
 	for i := 0; i < len(generations); i++ {
 		cur := generations[i]
 
+		// Late generations may overwrite values in any older generation, so groups
+		// must not span them until they are merged by a level 1 plan.  Only runs of
+		// adjacent late generations are merged together.
+		if cur.level() == 0 {
+			if i > 0 && generations[i-1].level() == 0 {
+				lateGens[len(lateGens)-1] = append(lateGens[len(lateGens)-1], cur)
+			} else {
+				lateGens = append(lateGens, tsmGenerations{cur})
+			}
+			if len(currentGen) > 0 {
+				groups = append(groups, currentGen)
+				currentGen = tsmGenerations{}
+			}
+			continue
+		}
+
 		// See if this generation is orphan'd which would prevent it from being further
 		// compacted until a final full compactin runs.
 		if i < len(generations)-1 {
@@ -286,6 +309,23 @@ func (c *DefaultPlanner) PlanLevel(level int) []CompactionGroup {
 		}
 	}
 
+	// Adjacent late generations are merged together in bulk, in generation order.
+	// Merging late generations separated by other generations would move the
+	// values of the older ones past the values written between them.
+	for _, run := range lateGens {
+		if level != 1 || (len(run) < minLateGenerations && !run.hasTombstones()) {
+			continue
+		}
+
+		var cGroup CompactionGroup
+		for _, gen := range run {
+			for _, file := range gen.files {
+				cGroup = append(cGroup, file.Path)
+			}
+		}
+		cGroups = append(cGroups, cGroup)
+	}
+
 	if !c.acquire(cGroups) {
 		return nil
 	}
@@ -329,6 +369,15 @@ func (c *DefaultPlanner) PlanOptimize() []CompactionGroup {
 			continue
 		}
 
+		// Groups must not span late generations.
+		if cur.level() == 0 {
+			if len(currentGen) > 0 {
+				groups = append(groups, currentGen)
+				currentGen = tsmGenerations{}
+			}
+			continue
+		}
+
 		// See if this generation is orphan'd which would prevent it from being further
 		// compacted until a final full compactin runs.
 		if i < len(generations)-1 {
@@ -681,6 +730,18 @@ type Compactor struct {
 	// RateLimit is the limit for disk writes for all concurrent compactions.
 	RateLimit limiter.Rate
 
+	// Codecs are the encodings used to compress the values of new float and
+	// string blocks.
+	Codecs BlockCodecs
+
+	// Keys provides the master key used to encrypt new TSM files.  If nil,
+	// new files are not encrypted.
+	Keys keyring.Provider
+
+	// BloomFilters enables writing a bloom filter of the keys of each new
+	// unencrypted TSM file.
+	BloomFilters bool
+
 	mu                 sync.RWMutex
 	snapshotsEnabled   bool
 	compactionsEnabled bool
@@ -696,6 +757,10 @@ type Compactor struct {
 	compactionsInterrupt chan struct{}
 
 	files map[string]struct{}
+
+	// iterators holds the iterator of each running compaction, keyed by the
+	// first file of the compaction, so progress can be reported.
+	iterators map[string]*tsmKeyIterator
 }
 
 // Open initializes the Compactor.
@@ -713,6 +778,7 @@ func (c *Compactor) Open() {
 	c.snapshotLatencies = &latencies{values: make([]time.Duration, 4)}
 
 	c.files = make(map[string]struct{})
+	c.iterators = make(map[string]*tsmKeyIterator)
 }
 
 // Close disables the Compactor.
@@ -776,6 +842,19 @@ func (c *Compactor) EnableCompactions() {
 
 // WriteSnapshot writes a Cache snapshot to one or more new TSM files.
 func (c *Compactor) WriteSnapshot(cache *Cache) ([]string, error) {
+	return c.writeSnapshot(cache, 0)
+}
+
+// WriteLateSnapshot writes a Cache snapshot of late values to one or more new
+// level 0 TSM files.  The planner keeps level 0 files out of the level
+// compactions of other files and merges them together in bulk.
+func (c *Compactor) WriteLateSnapshot(cache *Cache) ([]string, error) {
+	return c.writeSnapshot(cache, -1)
+}
+
+// writeSnapshot writes a Cache snapshot to new TSM files in new generations
+// whose sequence numbers follow sequence.
+func (c *Compactor) writeSnapshot(cache *Cache, sequence int) ([]string, error) {
 	c.mu.RLock()
 	enabled := c.snapshotsEnabled
 	intC := c.snapshotsInterrupt
@@ -813,8 +892,8 @@ func (c *Compactor) WriteSnapshot(cache *Cache) ([]string, error) {
 	resC := make(chan res, concurrency)
 	for i := 0; i < concurrency; i++ {
 		go func(sp *Cache) {
-			iter := NewCacheKeyIterator(sp, tsdb.DefaultMaxPointsPerBlock, intC)
-			files, err := c.writeNewFiles(c.FileStore.NextGeneration(), 0, iter, throttle)
+			iter := newCacheKeyIterator(sp, tsdb.DefaultMaxPointsPerBlock, c.Codecs, intC)
+			files, err := c.writeNewFiles(c.FileStore.NextGeneration(), sequence, iter, throttle)
 			resC <- res{files: files, err: err}
 
 		}(splits[i])
@@ -847,8 +926,9 @@ func (c *Compactor) WriteSnapshot(cache *Cache) ([]string, error) {
 	return files, err
 }
 
-// compact writes multiple smaller TSM files into 1 or more larger files.
-func (c *Compactor) compact(fast bool, tsmFiles []string) ([]string, error) {
+// compact writes multiple smaller TSM files into 1 or more larger files.  Any
+// rollups are computed from the blocks as they are written.
+func (c *Compactor) compact(fast bool, tsmFiles []string, rollups []*rollup) ([]string, error) {
 	size := c.Size
 	if size <= 0 {
 		size = tsdb.DefaultMaxPointsPerBlock
@@ -901,16 +981,49 @@ func (c *Compactor) compact(fast bool, tsmFiles []string) ([]string, error) {
 		return nil, nil
 	}
 
-	tsm, err := NewTSMKeyIterator(size, fast, intC, trs...)
+	tsm, err := newTSMKeyIterator(size, fast, c.Codecs, intC, trs...)
 	if err != nil {
 		return nil, err
 	}
 
+	c.mu.Lock()
+	c.iterators[tsmFiles[0]] = tsm.(*tsmKeyIterator)
+	c.mu.Unlock()
+	defer func() {
+		c.mu.Lock()
+		delete(c.iterators, tsmFiles[0])
+		c.mu.Unlock()
+	}()
+
+	if len(rollups) > 0 {
+		tsm = newRollupKeyIterator(tsm, rollups)
+	}
+
 	return c.writeNewFiles(maxGeneration, maxSequence, tsm, true)
 }
 
+// Progress returns the estimated fraction, from 0 to 1, of the compaction of
+// tsmFiles which has completed.  It returns 0 if the files are not being compacted.
+func (c *Compactor) Progress(tsmFiles []string) float64 {
+	if len(tsmFiles) == 0 {
+		return 0
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if iter := c.iterators[tsmFiles[0]]; iter != nil {
+		return iter.progress()
+	}
+	return 0
+}
+
 // CompactFull writes multiple smaller TSM files into 1 or more larger files.
 func (c *Compactor) CompactFull(tsmFiles []string) ([]string, error) {
+	return c.compactFull(tsmFiles, nil)
+}
+
+// compactFull is like CompactFull, but also computes rollups from the values compacted.
+func (c *Compactor) compactFull(tsmFiles []string, rollups []*rollup) ([]string, error) {
 	c.mu.RLock()
 	enabled := c.compactionsEnabled
 	c.mu.RUnlock()
@@ -924,7 +1037,7 @@ func (c *Compactor) CompactFull(tsmFiles []string) ([]string, error) {
 	}
 	defer c.remove(tsmFiles)
 
-	files, err := c.compact(false, tsmFiles)
+	files, err := c.compact(false, tsmFiles, rollups)
 
 	// See if we were disabled while writing a snapshot
 	c.mu.RLock()
@@ -956,7 +1069,7 @@ func (c *Compactor) CompactFast(tsmFiles []string) ([]string, error) {
 	}
 	defer c.remove(tsmFiles)
 
-	files, err := c.compact(true, tsmFiles)
+	files, err := c.compact(true, tsmFiles, nil)
 
 	// See if we were disabled while writing a snapshot
 	c.mu.RLock()
@@ -980,6 +1093,8 @@ func (c *Compactor) removeTmpFiles(files []string) error {
 	for _, f := range files {
 		if err := os.Remove(f); err != nil {
 			return fmt.Errorf("error removing temp compaction file: %v", err)
+		} else if err := os.Remove(BloomFilterPath(f)); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("error removing temp compaction bloom filter: %v", err)
 		}
 	}
 	return nil
@@ -1003,6 +1118,10 @@ func (c *Compactor) writeNewFiles(generation, sequence int, iter KeyIterator, th
 		// and continue.
 		if err == errMaxFileExceeded || err == ErrMaxBlocksExceeded {
 			files = append(files, fileName)
+			if err := c.writeBloomFilter(fileName); err != nil {
+				c.removeTmpFiles(files)
+				return nil, err
+			}
 			continue
 		} else if err == ErrNoValues {
 			// If the file only contained tombstoned entries, then it would be a 0 length
@@ -1021,6 +1140,7 @@ func (c *Compactor) writeNewFiles(generation, sequence int, iter KeyIterator, th
 				if err := os.RemoveAll(f); err != nil {
 					return nil, err
 				}
+				os.Remove(BloomFilterPath(f))
 			}
 			// We hit an error and didn't finish the compaction.  Remove the temp file and abort.
 			if err := os.RemoveAll(fileName); err != nil {
@@ -1030,12 +1150,38 @@ func (c *Compactor) writeNewFiles(generation, sequence int, iter KeyIterator, th
 		}
 
 		files = append(files, fileName)
+		if err := c.writeBloomFilter(fileName); err != nil {
+			c.removeTmpFiles(files)
+			return nil, err
+		}
 		break
 	}
 
 	return files, nil
 }
 
+// writeBloomFilter writes the bloom filter of the completed TSM file at path
+// if bloom filters are enabled.  Encrypted files do not have bloom filters.
+func (c *Compactor) writeBloomFilter(path string) error {
+	if !c.BloomFilters || c.Keys != nil {
+		return nil
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+
+	r, err := NewTSMReader(f)
+	if err != nil {
+		f.Close()
+		return err
+	}
+	defer r.Close()
+
+	return WriteBloomFilter(r)
+}
+
 func (c *Compactor) write(path string, iter KeyIterator, throttle bool) (err error) {
 	fd, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR|os.O_EXCL, 0666)
 	if err != nil {
@@ -1053,8 +1199,14 @@ func (c *Compactor) write(path string, iter KeyIterator, throttle bool) (err err
 	}
 
 	// Use a disk based TSM buffer if it looks like we might create a big index
-	// in memory.
-	if iter.EstimatedIndexSize() > 64*1024*1024 {
+	// in memory.  The index of encrypted files is sealed as a whole, so it is
+	// always held in memory.
+	if c.Keys != nil {
+		w, err = NewTSMWriterWithKeys(limitWriter, c.Keys)
+		if err != nil {
+			return err
+		}
+	} else if iter.EstimatedIndexSize() > 64*1024*1024 {
 		w, err = NewTSMWriterWithDiskBuffer(limitWriter)
 		if err != nil {
 			return err
@@ -1208,6 +1360,9 @@ type tsmKeyIterator struct {
 	// size is the maximum number of values to encode in a single block
 	size int
 
+	// codecs are the encodings used for blocks which are re-encoded.
+	codecs BlockCodecs
+
 	// key is the current key lowest key across all readers that has not be fully exhausted
 	// of values.
 	key []byte
@@ -1229,6 +1384,11 @@ type tsmKeyIterator struct {
 	// without decode
 	merged    blocks
 	interrupt chan struct{}
+
+	// readN is the number of bytes of blocks read from the readers and totalN
+	// the number of bytes of blocks in all readers.
+	readN  int64
+	totalN int64
 }
 
 type block struct {
@@ -1286,9 +1446,15 @@ func (a blocks) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
 // NewTSMKeyIterator returns a new TSM key iterator from readers.
 // size indicates the maximum number of values to encode in a single block.
 func NewTSMKeyIterator(size int, fast bool, interrupt chan struct{}, readers ...*TSMReader) (KeyIterator, error) {
+	return newTSMKeyIterator(size, fast, BlockCodecs{}, interrupt, readers...)
+}
+
+func newTSMKeyIterator(size int, fast bool, codecs BlockCodecs, interrupt chan struct{}, readers ...*TSMReader) (KeyIterator, error) {
 	var iter []*BlockIterator
+	var totalN int64
 	for _, r := range readers {
 		iter = append(iter, r.BlockIterator())
+		totalN += int64(r.Size()) - int64(r.IndexSize())
 	}
 
 	return &tsmKeyIterator{
@@ -1296,10 +1462,12 @@ func NewTSMKeyIterator(size int, fast bool, interrupt chan struct{}, readers ...
 		values:    map[string][]Value{},
 		pos:       make([]int, len(readers)),
 		size:      size,
+		codecs:    codecs,
 		iterators: iter,
 		fast:      fast,
 		buf:       make([]blocks, len(iter)),
 		interrupt: interrupt,
+		totalN:    totalN,
 	}, nil
 }
 
@@ -1355,6 +1523,7 @@ RETRY:
 				if err != nil {
 					k.err = err
 				}
+				atomic.AddInt64(&k.readN, int64(len(b)))
 
 				// This block may have ranges of time removed from it that would
 				// reduce the block min and max time.
@@ -1388,6 +1557,7 @@ RETRY:
 					if err != nil {
 						k.err = err
 					}
+					atomic.AddInt64(&k.readN, int64(len(b)))
 
 					tombstones := iter.r.TombstoneRange(key)
 
@@ -1516,10 +1686,24 @@ func (k *tsmKeyIterator) Err() error {
 	return k.err
 }
 
+// progress returns the fraction of the blocks of the readers which have been read.
+func (k *tsmKeyIterator) progress() float64 {
+	if k.totalN <= 0 {
+		return 0
+	}
+
+	p := float64(atomic.LoadInt64(&k.readN)) / float64(k.totalN)
+	if p > 1 {
+		p = 1
+	}
+	return p
+}
+
 type cacheKeyIterator struct {
-	cache *Cache
-	size  int
-	order [][]byte
+	cache  *Cache
+	size   int
+	codecs BlockCodecs
+	order  [][]byte
 
 	i         int
 	blocks    [][]cacheBlock
@@ -1537,6 +1721,10 @@ type cacheBlock struct {
 
 // NewCacheKeyIterator returns a new KeyIterator from a Cache.
 func NewCacheKeyIterator(cache *Cache, size int, interrupt chan struct{}) KeyIterator {
+	return newCacheKeyIterator(cache, size, BlockCodecs{}, interrupt)
+}
+
+func newCacheKeyIterator(cache *Cache, size int, codecs BlockCodecs, interrupt chan struct{}) KeyIterator {
 	keys := cache.Keys()
 
 	chans := make([]chan struct{}, len(keys))
@@ -1547,6 +1735,7 @@ func NewCacheKeyIterator(cache *Cache, size int, interrupt chan struct{}) KeyIte
 	cki := &cacheKeyIterator{
 		i:         -1,
 		size:      size,
+		codecs:    codecs,
 		cache:     cache,
 		order:     keys,
 		ready:     chans,
@@ -1583,6 +1772,8 @@ func (c *cacheKeyIterator) encode() {
 			senc := getStringEncoder(tsdb.DefaultMaxPointsPerBlock)
 			ienc := getIntegerEncoder(tsdb.DefaultMaxPointsPerBlock)
 
+			fenc.codec, senc.codec = c.codecs.float, c.codecs.string
+
 			defer putTimeEncoder(tenc)
 			defer putFloatEncoder(fenc)
 			defer putBooleanEncoder(benc)
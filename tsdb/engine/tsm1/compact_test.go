@@ -1879,6 +1879,106 @@ func TestDefaultPlanner_PlanLevel_Tombstone(t *testing.T) {
 	}
 }
 
+// Ensure level 1 groups do not span late generations.
+func TestDefaultPlanner_PlanLevel_Late(t *testing.T) {
+	var data []tsm1.FileStat
+	for i := 1; i <= 13; i++ {
+		seq := 1
+		if i == 5 {
+			seq = 0
+		}
+		data = append(data, tsm1.FileStat{
+			Path: fmt.Sprintf("%02d-%02d.tsm1", i, seq),
+			Size: 1 * 1024 * 1024,
+		})
+	}
+
+	cp := tsm1.NewDefaultPlanner(
+		&fakeFileStore{
+			PathsFn: func() []tsm1.FileStat {
+				return data
+			},
+		}, tsdb.DefaultCompactFullWriteColdDuration,
+	)
+
+	expFiles := data[5:]
+	tsm := cp.PlanLevel(1)
+	if exp, got := 1, len(tsm); got != exp {
+		t.Fatalf("tsm group length mismatch: got %v, exp %v", got, exp)
+	} else if exp, got := len(expFiles), len(tsm[0]); got != exp {
+		t.Fatalf("tsm file length mismatch: got %v, exp %v", got, exp)
+	}
+
+	for i, p := range expFiles {
+		if got, exp := tsm[0][i], p.Path; got != exp {
+			t.Fatalf("tsm file mismatch: got %v, exp %v", got, exp)
+		}
+	}
+}
+
+// Ensure adjacent late generations are merged together once there are enough of them.
+func TestDefaultPlanner_PlanLevel_LateMerge(t *testing.T) {
+	var data, expFiles []tsm1.FileStat
+	for i := 1; i <= 12; i++ {
+		f := tsm1.FileStat{
+			Path: fmt.Sprintf("%02d-01.tsm1", i),
+			Size: 1 * 1024 * 1024,
+		}
+		if i == 2 || i == 4 || i == 12 || (i >= 7 && i <= 10) {
+			f.Path = fmt.Sprintf("%02d-00.tsm1", i)
+		}
+		if i >= 7 && i <= 10 {
+			expFiles = append(expFiles, f)
+		}
+		data = append(data, f)
+	}
+
+	cp := tsm1.NewDefaultPlanner(
+		&fakeFileStore{
+			PathsFn: func() []tsm1.FileStat {
+				return data
+			},
+		}, tsdb.DefaultCompactFullWriteColdDuration,
+	)
+
+	tsm := cp.PlanLevel(1)
+	if exp, got := 1, len(tsm); got != exp {
+		t.Fatalf("tsm group length mismatch: got %v, exp %v", got, exp)
+	} else if exp, got := len(expFiles), len(tsm[0]); got != exp {
+		t.Fatalf("tsm file length mismatch: got %v, exp %v", got, exp)
+	}
+
+	for i, p := range expFiles {
+		if got, exp := tsm[0][i], p.Path; got != exp {
+			t.Fatalf("tsm file mismatch: got %v, exp %v", got, exp)
+		}
+	}
+}
+
+// Ensure a late generation holding a delete is not merged with a later late
+// generation past the values written after the delete.
+func TestDefaultPlanner_PlanLevel_LateDelete(t *testing.T) {
+	data := []tsm1.FileStat{
+		{Path: "01-01.tsm1", Size: 1 * 1024 * 1024},
+		{Path: "02-00.tsm1", Size: 1 * 1024 * 1024, HasTombstone: true},
+		{Path: "03-01.tsm1", Size: 1 * 1024 * 1024},
+		{Path: "04-00.tsm1", Size: 1 * 1024 * 1024},
+	}
+
+	cp := tsm1.NewDefaultPlanner(
+		&fakeFileStore{
+			PathsFn: func() []tsm1.FileStat {
+				return data
+			},
+		}, tsdb.DefaultCompactFullWriteColdDuration,
+	)
+
+	tsm := cp.PlanLevel(1)
+	if len(tsm) != 1 || len(tsm[0]) != 1 || tsm[0][0] != "02-00.tsm1" {
+		t.Fatalf("unexpected plan: %v", tsm)
+	}
+}
+
 func TestDefaultPlanner_PlanLevel_Multiple(t *testing.T) {
 	data := []tsm1.FileStat{
 		tsm1.FileStat{
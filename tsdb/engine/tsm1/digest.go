@@ -8,11 +8,16 @@ import (
 	"os"
 	"path/filepath"
 	"sort"
+
+	"github.com/influxdata/influxdb/pkg/keyring"
 )
 
 type DigestOptions struct {
 	MinTime, MaxTime int64
 	MinKey, MaxKey   []byte
+
+	// Keys provides the master keys of encrypted files.
+	Keys keyring.Provider
 }
 
 // DigestWithOptions writes a digest of dir to w using options to filter by
@@ -35,7 +40,7 @@ func DigestWithOptions(dir string, opts DigestOptions, w io.WriteCloser) error {
 			return err
 		}
 
-		r, err := NewTSMReader(f)
+		r, err := NewTSMReaderWithKeys(f, opts.Keys)
 		if err != nil {
 			return err
 		}
@@ -49,7 +54,7 @@ func DigestWithOptions(dir string, opts DigestOptions, w io.WriteCloser) error {
 			return err
 		}
 
-		r, err := NewTSMReader(f)
+		r, err := NewTSMReaderWithKeys(f, opts.Keys)
 		if err != nil {
 			return err
 		}
@@ -441,15 +441,21 @@ func (a FloatValues) Merge(b FloatValues) FloatValues {
 }
 
 func (a FloatValues) Encode(buf []byte) ([]byte, error) {
-	return encodeFloatValuesBlock(buf, a)
+	return encodeFloatValuesBlock(buf, a, BlockCodecs{})
 }
 
-func encodeFloatValuesBlock(buf []byte, values []FloatValue) ([]byte, error) {
+// EncodeWith encodes the values using the given block codecs.
+func (a FloatValues) EncodeWith(buf []byte, codecs BlockCodecs) ([]byte, error) {
+	return encodeFloatValuesBlock(buf, a, codecs)
+}
+
+func encodeFloatValuesBlock(buf []byte, values []FloatValue, codecs BlockCodecs) ([]byte, error) {
 	if len(values) == 0 {
 		return nil, nil
 	}
 
 	venc := getFloatEncoder(len(values))
+	venc.codec = codecs.float
 	tsenc := getTimeEncoder(len(values))
 
 	var b []byte
@@ -702,10 +708,15 @@ func (a IntegerValues) Merge(b IntegerValues) IntegerValues {
 }
 
 func (a IntegerValues) Encode(buf []byte) ([]byte, error) {
-	return encodeIntegerValuesBlock(buf, a)
+	return encodeIntegerValuesBlock(buf, a, BlockCodecs{})
 }
 
-func encodeIntegerValuesBlock(buf []byte, values []IntegerValue) ([]byte, error) {
+// EncodeWith encodes the values using the given block codecs.
+func (a IntegerValues) EncodeWith(buf []byte, codecs BlockCodecs) ([]byte, error) {
+	return encodeIntegerValuesBlock(buf, a, codecs)
+}
+
+func encodeIntegerValuesBlock(buf []byte, values []IntegerValue, codecs BlockCodecs) ([]byte, error) {
 	if len(values) == 0 {
 		return nil, nil
 	}
@@ -963,10 +974,15 @@ func (a UnsignedValues) Merge(b UnsignedValues) UnsignedValues {
 }
 
 func (a UnsignedValues) Encode(buf []byte) ([]byte, error) {
-	return encodeUnsignedValuesBlock(buf, a)
+	return encodeUnsignedValuesBlock(buf, a, BlockCodecs{})
+}
+
+// EncodeWith encodes the values using the given block codecs.
+func (a UnsignedValues) EncodeWith(buf []byte, codecs BlockCodecs) ([]byte, error) {
+	return encodeUnsignedValuesBlock(buf, a, codecs)
 }
 
-func encodeUnsignedValuesBlock(buf []byte, values []UnsignedValue) ([]byte, error) {
+func encodeUnsignedValuesBlock(buf []byte, values []UnsignedValue, codecs BlockCodecs) ([]byte, error) {
 	if len(values) == 0 {
 		return nil, nil
 	}
@@ -1224,15 +1240,21 @@ func (a StringValues) Merge(b StringValues) StringValues {
 }
 
 func (a StringValues) Encode(buf []byte) ([]byte, error) {
-	return encodeStringValuesBlock(buf, a)
+	return encodeStringValuesBlock(buf, a, BlockCodecs{})
+}
+
+// EncodeWith encodes the values using the given block codecs.
+func (a StringValues) EncodeWith(buf []byte, codecs BlockCodecs) ([]byte, error) {
+	return encodeStringValuesBlock(buf, a, codecs)
 }
 
-func encodeStringValuesBlock(buf []byte, values []StringValue) ([]byte, error) {
+func encodeStringValuesBlock(buf []byte, values []StringValue, codecs BlockCodecs) ([]byte, error) {
 	if len(values) == 0 {
 		return nil, nil
 	}
 
 	venc := getStringEncoder(len(values))
+	venc.codec = codecs.string
 	tsenc := getTimeEncoder(len(values))
 
 	var b []byte
@@ -1485,10 +1507,15 @@ func (a BooleanValues) Merge(b BooleanValues) BooleanValues {
 }
 
 func (a BooleanValues) Encode(buf []byte) ([]byte, error) {
-	return encodeBooleanValuesBlock(buf, a)
+	return encodeBooleanValuesBlock(buf, a, BlockCodecs{})
+}
+
+// EncodeWith encodes the values using the given block codecs.
+func (a BooleanValues) EncodeWith(buf []byte, codecs BlockCodecs) ([]byte, error) {
+	return encodeBooleanValuesBlock(buf, a, codecs)
 }
 
-func encodeBooleanValuesBlock(buf []byte, values []BooleanValue) ([]byte, error) {
+func encodeBooleanValuesBlock(buf []byte, values []BooleanValue, codecs BlockCodecs) ([]byte, error) {
 	if len(values) == 0 {
 		return nil, nil
 	}
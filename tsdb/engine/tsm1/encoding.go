@@ -1015,6 +1015,7 @@ func putUnsignedEncoder(enc IntegerEncoder) { integerEncoderPool.Put(enc) }
 func getFloatEncoder(sz int) *FloatEncoder {
 	x := floatEncoderPool.Get(sz).(*FloatEncoder)
 	x.Reset()
+	x.codec = floatCompressedGorilla
 	return x
 }
 func putFloatEncoder(enc *FloatEncoder) { floatEncoderPool.Put(enc) }
@@ -1022,6 +1023,7 @@ func putFloatEncoder(enc *FloatEncoder) { floatEncoderPool.Put(enc) }
 func getStringEncoder(sz int) StringEncoder {
 	x := stringEncoderPool.Get(sz).(StringEncoder)
 	x.Reset()
+	x.codec = stringCompressedSnappy
 	return x
 }
 func putStringEncoder(enc StringEncoder) { stringEncoderPool.Put(enc) }
@@ -254,6 +254,65 @@ func TestEncoding_StringBlock_Basic(t *testing.T) {
 	}
 }
 
+func TestEncoding_BlockCodecs(t *testing.T) {
+	for _, tt := range []struct {
+		float, str string
+	}{
+		{float: "gorilla", str: "snappy"},
+		{float: "deflate", str: "deflate"},
+		{float: "deflate", str: "dictionary"},
+	} {
+		t.Run(tt.float+"/"+tt.str, func(t *testing.T) {
+			codecs, err := tsm1.NewBlockCodecs(tt.float, tt.str)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			times := getTimes(1000, 60, time.Second)
+			floats := make(tsm1.FloatValues, len(times))
+			strs := make(tsm1.StringValues, len(times))
+			for i, t := range times {
+				floats[i] = tsm1.NewFloatValue(t, float64(i%10)*1.5).(tsm1.FloatValue)
+				strs[i] = tsm1.NewStringValue(t, fmt.Sprintf("value %d", i%10)).(tsm1.StringValue)
+			}
+
+			for _, values := range []interface {
+				EncodeWith(buf []byte, codecs tsm1.BlockCodecs) ([]byte, error)
+			}{floats, strs} {
+				b, err := values.EncodeWith(nil, codecs)
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				decoded, err := tsm1.DecodeBlock(b, nil)
+				if err != nil {
+					t.Fatalf("unexpected error decoding block: %v", err)
+				}
+
+				var exp []tsm1.Value
+				switch values := values.(type) {
+				case tsm1.FloatValues:
+					for _, v := range values {
+						exp = append(exp, v)
+					}
+				case tsm1.StringValues:
+					for _, v := range values {
+						exp = append(exp, v)
+					}
+				}
+
+				if !reflect.DeepEqual(decoded, exp) {
+					t.Fatalf("unexpected results:\n\tgot: %s\n\texp: %s\n", spew.Sdump(decoded), spew.Sdump(exp))
+				}
+			}
+		})
+	}
+
+	if _, err := tsm1.NewBlockCodecs("zstd", ""); err == nil {
+		t.Fatal("expected error for unknown codec")
+	}
+}
+
 func TestEncoding_BlockType(t *testing.T) {
 	tests := []struct {
 		value     interface{}
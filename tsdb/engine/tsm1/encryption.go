@@ -0,0 +1,328 @@
+package tsm1
+
+/*
+Encrypted TSM files and WAL segments use envelope encryption.  Each file is
+encrypted with its own randomly generated data key using AES-GCM.  The data key
+is itself encrypted with a master key from a keyring.Provider and stored in an
+envelope at the start of the file, along with the ID of the master key.
+
+┌──────────────────────────────────────────┐
+│                 Envelope                 │
+├─────────┬──────────┬─────────────────────┤
+│ Key Len │  Key ID  │  Wrapped Data Key   │
+│ 1 byte  │ 64 bytes │      60 bytes       │
+└─────────┴──────────┴─────────────────────┘
+
+Encrypted TSM files have a version of 2 and the envelope follows the header.
+The data of each block and the whole index are sealed separately.  The CRC of a
+block covers its sealed data.  Encrypted WAL segments start with a magic number
+followed by the envelope, and the compressed data of each entry is sealed.
+
+Sealed data is a random nonce followed by the ciphertext and GCM tag.
+
+┌─────────────────────────────────┐
+│           Sealed Data           │
+├──────────┬────────────┬─────────┤
+│  Nonce   │ Ciphertext │   Tag   │
+│ 12 bytes │  N bytes   │16 bytes │
+└──────────┴────────────┴─────────┘
+
+Since the data key never changes, rotating the master key of a file only
+replaces its envelope.
+*/
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/influxdata/influxdb/pkg/keyring"
+)
+
+const (
+	// EncryptedVersion is the version of encrypted TSM files.
+	EncryptedVersion byte = 2
+
+	// walMagicNumber is written as the first 4 bytes of encrypted WAL segments.
+	// Plain segments start with an entry type, so the first byte never matches.
+	walMagicNumber uint32 = 0x57414C45
+
+	// Sizes in bytes of an AES-GCM nonce and tag.
+	nonceSize = 12
+	tagSize   = 16
+
+	// sealOverhead is the number of bytes added to data when it is sealed.
+	sealOverhead = nonceSize + tagSize
+
+	// Size in bytes of a data key and of a data key sealed with a master key.
+	dataKeySize    = 32
+	wrappedKeySize = dataKeySize + sealOverhead
+
+	// envelopeSize is the size in bytes of the envelope.  The envelope has a
+	// fixed size so that it can be rewritten in place.
+	envelopeSize = 1 + keyring.MaxKeyIDSize + wrappedKeySize
+
+	// Offsets of the envelope in encrypted TSM files and WAL segments.
+	tsmEnvelopeOffset = 5
+	walEnvelopeOffset = 4
+)
+
+// errDecrypt is returned when the data key of a file cannot be decrypted.
+type errDecrypt struct {
+	err error
+}
+
+func (e errDecrypt) Error() string {
+	return fmt.Sprintf("decrypt data key: %s", e.err)
+}
+
+// envelope holds the data key of a file sealed with a master key.
+type envelope struct {
+	keyID      string
+	wrappedKey []byte
+}
+
+// newEnvelope generates a new data key sealed with the active key of keys. It
+// returns the envelope and a cipher for the data key.
+func newEnvelope(keys keyring.Provider) (*envelope, cipher.AEAD, error) {
+	dataKey := make([]byte, dataKeySize)
+	if _, err := io.ReadFull(rand.Reader, dataKey); err != nil {
+		return nil, nil, err
+	}
+
+	env := &envelope{}
+	if err := env.wrap(keys, dataKey); err != nil {
+		return nil, nil, err
+	}
+
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	return env, aead, nil
+}
+
+// wrap seals dataKey with the active key of keys.
+func (e *envelope) wrap(keys keyring.Provider, dataKey []byte) error {
+	id, key, err := keys.ActiveKey()
+	if err != nil {
+		return err
+	} else if len(id) > keyring.MaxKeyIDSize {
+		return fmt.Errorf("key ID %s longer than %d bytes", id, keyring.MaxKeyIDSize)
+	}
+
+	aead, err := newAEAD(key)
+	if err != nil {
+		return err
+	}
+
+	// The key ID is authenticated so that it cannot be swapped.
+	wrappedKey, err := seal(aead, nil, dataKey, []byte(id))
+	if err != nil {
+		return err
+	}
+
+	e.keyID, e.wrappedKey = id, wrappedKey
+	return nil
+}
+
+// unwrap returns the data key of the envelope.
+func (e *envelope) unwrap(keys keyring.Provider) ([]byte, error) {
+	if keys == nil {
+		return nil, errDecrypt{err: fmt.Errorf("no keyring configured")}
+	}
+
+	key, err := keys.Key(e.keyID)
+	if err != nil {
+		return nil, errDecrypt{err: fmt.Errorf("key %s: %s", e.keyID, err)}
+	}
+
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, errDecrypt{err: err}
+	}
+
+	dataKey, err := open(aead, nil, e.wrappedKey, []byte(e.keyID))
+	if err != nil {
+		return nil, errDecrypt{err: fmt.Errorf("key %s: %s", e.keyID, err)}
+	}
+	return dataKey, nil
+}
+
+// cipher returns a cipher for the data key of the envelope.
+func (e *envelope) cipher(keys keyring.Provider) (cipher.AEAD, error) {
+	dataKey, err := e.unwrap(keys)
+	if err != nil {
+		return nil, err
+	}
+	return newAEAD(dataKey)
+}
+
+// MarshalBinary encodes the envelope.
+func (e *envelope) MarshalBinary() ([]byte, error) {
+	b := make([]byte, envelopeSize)
+	b[0] = byte(len(e.keyID))
+	copy(b[1:], e.keyID)
+	copy(b[1+keyring.MaxKeyIDSize:], e.wrappedKey)
+	return b, nil
+}
+
+// UnmarshalBinary decodes the envelope from b.
+func (e *envelope) UnmarshalBinary(b []byte) error {
+	if len(b) < envelopeSize {
+		return fmt.Errorf("envelope: short buffer: %d bytes", len(b))
+	}
+
+	n := int(b[0])
+	if n > keyring.MaxKeyIDSize {
+		return fmt.Errorf("envelope: invalid key ID length: %d", n)
+	}
+
+	e.keyID = string(b[1 : 1+n])
+	e.wrappedKey = append([]byte(nil), b[1+keyring.MaxKeyIDSize:envelopeSize]...)
+	return nil
+}
+
+// newAEAD returns an AES-GCM cipher for key.
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// seal encrypts and authenticates plaintext with a random nonce and appends
+// the nonce and the result to dst.
+func seal(aead cipher.AEAD, dst, plaintext, additionalData []byte) ([]byte, error) {
+	var nonce [nonceSize]byte
+	if _, err := io.ReadFull(rand.Reader, nonce[:]); err != nil {
+		return nil, err
+	}
+	dst = append(dst, nonce[:]...)
+	return aead.Seal(dst, nonce[:], plaintext, additionalData), nil
+}
+
+// open decrypts and authenticates data sealed by seal and appends the result
+// to dst.
+func open(aead cipher.AEAD, dst, sealed, additionalData []byte) ([]byte, error) {
+	if len(sealed) < sealOverhead {
+		return nil, fmt.Errorf("sealed data too short: %d bytes", len(sealed))
+	}
+	return aead.Open(dst, sealed[:nonceSize], sealed[nonceSize:], additionalData)
+}
+
+// offsetData returns the additional data authenticated with data sealed at
+// offset in a file.
+func offsetData(offset int64) []byte {
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], uint64(offset))
+	return b[:]
+}
+
+// envelopeOffset returns the offset of the envelope in the TSM file or WAL
+// segment read by r.  ok is false if the file is not encrypted.
+func envelopeOffset(r io.ReaderAt) (offset int64, ok bool, err error) {
+	var b [5]byte
+	n, err := r.ReadAt(b[:], 0)
+	if err != nil && err != io.EOF {
+		return 0, false, err
+	} else if n < 4 {
+		return 0, false, nil
+	}
+
+	switch binary.BigEndian.Uint32(b[:4]) {
+	case MagicNumber:
+		if n == len(b) && b[4] == EncryptedVersion {
+			return tsmEnvelopeOffset, true, nil
+		}
+	case walMagicNumber:
+		return walEnvelopeOffset, true, nil
+	}
+	return 0, false, nil
+}
+
+// RotateKey seals the data key of the encrypted TSM file or WAL segment at path
+// with the active key of keys.  The previous master key must still be held by
+// keys.  It returns false if the file is not encrypted or already uses the
+// active key.
+//
+// The envelope holds the only copy of the data key, so it is not overwritten in
+// place.  The new envelope is written to a copy of the file which then replaces
+// it, leaving the file sealed with either key if the rotation is interrupted.
+func RotateKey(path string, keys keyring.Provider) (bool, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+
+	offset, ok, err := envelopeOffset(f)
+	if err != nil || !ok {
+		return false, err
+	}
+
+	b := make([]byte, envelopeSize)
+	if _, err := f.ReadAt(b, offset); err != nil {
+		return false, err
+	}
+
+	var env envelope
+	if err := env.UnmarshalBinary(b); err != nil {
+		return false, err
+	}
+
+	if id, _, err := keys.ActiveKey(); err != nil {
+		return false, err
+	} else if id == env.keyID {
+		return false, nil
+	}
+
+	dataKey, err := env.unwrap(keys)
+	if err != nil {
+		return false, err
+	}
+	if err := env.wrap(keys, dataKey); err != nil {
+		return false, err
+	}
+	if b, err = env.MarshalBinary(); err != nil {
+		return false, err
+	}
+
+	tmpPath := fmt.Sprintf("%s.rotate.%s", path, TmpTSMFileExtension)
+	if err := writeRotatedFile(path, tmpPath, b, offset); err != nil {
+		os.Remove(tmpPath)
+		return false, err
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		os.Remove(tmpPath)
+		return false, err
+	}
+	return true, syncDir(filepath.Dir(path))
+}
+
+// writeRotatedFile copies the file at path to tmpPath, replacing the envelope at
+// offset with env, and syncs the copy to disk.
+func writeRotatedFile(path, tmpPath string, env []byte, offset int64) error {
+	if err := copyFile(path, tmpPath); err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(tmpPath, os.O_RDWR, 0666)
+	if err != nil {
+		return err
+	}
+	if _, err := f.WriteAt(env, offset); err != nil {
+		f.Close()
+		return err
+	} else if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
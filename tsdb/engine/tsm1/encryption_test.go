@@ -0,0 +1,277 @@
+package tsm1_test
+
+import (
+	"bytes"
+	"hash/crc32"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/influxdata/influxdb/pkg/keyring"
+	"github.com/influxdata/influxdb/tsdb/engine/tsm1"
+)
+
+func TestTSMWriter_Encrypted(t *testing.T) {
+	dir := MustTempDir()
+	defer os.RemoveAll(dir)
+
+	keys := testKeyring{"k1"}
+	path := mustWriteEncryptedTSM(t, dir, keys, map[string][]tsm1.Value{
+		"cpu,host=A#!~#value": {tsm1.NewValue(0, 1.0), tsm1.NewValue(1, 2.0)},
+		"mem,host=A#!~#value": {tsm1.NewValue(0, "free")},
+	})
+
+	// Neither keys nor values are stored in the clear.
+	if b, err := ioutil.ReadFile(path); err != nil {
+		t.Fatal(err)
+	} else if bytes.Contains(b, []byte("host=A")) || bytes.Contains(b, []byte("free")) {
+		t.Fatal("expected file to be encrypted")
+	}
+
+	if _, err := tsm1.NewTSMReader(mustOpen(t, path)); err == nil {
+		t.Fatal("expected error reading encrypted file without keys")
+	}
+
+	r, err := tsm1.NewTSMReaderWithKeys(mustOpen(t, path), keys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+
+	if values, err := r.ReadAll([]byte("cpu,host=A#!~#value")); err != nil {
+		t.Fatal(err)
+	} else if exp := []tsm1.Value{tsm1.NewValue(0, 1.0), tsm1.NewValue(1, 2.0)}; !reflect.DeepEqual(values, exp) {
+		t.Fatalf("unexpected values: got %v, exp %v", values, exp)
+	}
+
+	var strings []tsm1.StringValue
+	entry := r.Entries([]byte("mem,host=A#!~#value"))[0]
+	if values, err := r.ReadStringBlockAt(&entry, &strings); err != nil {
+		t.Fatal(err)
+	} else if len(values) != 1 || values[0].Value() != "free" {
+		t.Fatalf("unexpected values: %v", values)
+	}
+
+	// The checksums of decrypted blocks are still valid.
+	itr := r.BlockIterator()
+	for itr.Next() {
+		_, _, _, _, checksum, buf, err := itr.Read()
+		if err != nil {
+			t.Fatal(err)
+		} else if exp := crc32.ChecksumIEEE(buf); checksum != exp {
+			t.Fatalf("unexpected checksum: got %d, exp %d", checksum, exp)
+		}
+	}
+}
+
+func TestWALSegmentWriter_Encrypted(t *testing.T) {
+	dir := MustTempDir()
+	defer os.RemoveAll(dir)
+	f := MustTempFile(dir)
+
+	keys := testKeyring{"k1"}
+	w, err := tsm1.NewWALSegmentWriterWithKeys(f, keys)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entry := &tsm1.WriteWALEntry{
+		Values: map[string][]tsm1.Value{
+			"cpu,host=A#!~#value": {tsm1.NewValue(1, 1.1)},
+		},
+	}
+	if err := w.Write(mustMarshalEntry(entry)); err != nil {
+		t.Fatal(err)
+	} else if err := w.Write(mustMarshalEntry(&tsm1.DeleteWALEntry{Keys: [][]byte{[]byte("cpu")}})); err != nil {
+		t.Fatal(err)
+	} else if err := w.Flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	if b, err := ioutil.ReadFile(f.Name()); err != nil {
+		t.Fatal(err)
+	} else if bytes.Contains(b, []byte("cpu")) {
+		t.Fatal("expected segment to be encrypted")
+	}
+
+	r := tsm1.NewWALSegmentReaderWithKeys(mustOpen(t, f.Name()), keys)
+	defer r.Close()
+
+	if !r.Next() {
+		t.Fatal("expected next")
+	} else if e, err := r.Read(); err != nil {
+		t.Fatal(err)
+	} else if values := e.(*tsm1.WriteWALEntry).Values; !reflect.DeepEqual(values, entry.Values) {
+		t.Fatalf("unexpected values: got %v, exp %v", values, entry.Values)
+	}
+
+	if !r.Next() {
+		t.Fatal("expected next")
+	} else if e, err := r.Read(); err != nil {
+		t.Fatal(err)
+	} else if keys := e.(*tsm1.DeleteWALEntry).Keys; len(keys) != 1 || string(keys[0]) != "cpu" {
+		t.Fatalf("unexpected delete keys: %q", keys)
+	}
+
+	if r.Next() {
+		t.Fatal("expected end of segment")
+	} else if fi, err := os.Stat(f.Name()); err != nil {
+		t.Fatal(err)
+	} else if n := r.Count(); n != fi.Size() {
+		t.Fatalf("unexpected count: got %d, exp %d", n, fi.Size())
+	}
+
+	// Reading the segment without the key fails.
+	r = tsm1.NewWALSegmentReader(mustOpen(t, f.Name()))
+	defer r.Close()
+	if !r.Next() {
+		t.Fatal("expected next")
+	} else if _, err := r.Read(); err == nil {
+		t.Fatal("expected error reading encrypted segment without keys")
+	}
+}
+
+// Ensure an encrypted WAL can be reopened and its segments loaded into the cache.
+func TestWAL_Encrypted_Reopen(t *testing.T) {
+	dir := MustTempDir()
+	defer os.RemoveAll(dir)
+
+	keys := testKeyring{"k1"}
+	for i := int64(0); i < 2; i++ {
+		w := tsm1.NewWAL(dir)
+		w.SetKeyProvider(keys)
+		if err := w.Open(); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.WriteMulti(map[string][]tsm1.Value{"cpu#!~#value": {tsm1.NewValue(i, float64(i))}}); err != nil {
+			t.Fatal(err)
+		} else if err := w.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Each open starts a new segment.
+	files, err := filepath.Glob(filepath.Join(dir, "*."+tsm1.WALFileExtension))
+	if err != nil {
+		t.Fatal(err)
+	} else if len(files) != 2 {
+		t.Fatalf("unexpected segments: %v", files)
+	}
+
+	cache := tsm1.NewCache(1024, "")
+	loader := tsm1.NewCacheLoader(files)
+	if err := loader.Load(cache); err == nil {
+		t.Fatal("expected error loading encrypted segments without keys")
+	}
+
+	loader.Keys = keys
+	if err := loader.Load(cache); err != nil {
+		t.Fatal(err)
+	}
+	if values, exp := cache.Values([]byte("cpu#!~#value")), (tsm1.Values{tsm1.NewValue(0, 0.0), tsm1.NewValue(1, 1.0)}); !reflect.DeepEqual(values, exp) {
+		t.Fatalf("unexpected values: got %v, exp %v", values, exp)
+	}
+}
+
+func TestRotateKey(t *testing.T) {
+	dir := MustTempDir()
+	defer os.RemoveAll(dir)
+
+	path := mustWriteEncryptedTSM(t, dir, testKeyring{"k1"}, map[string][]tsm1.Value{
+		"cpu#!~#value": {tsm1.NewValue(0, 1.0)},
+	})
+
+	// Rotate to k2 while k1 is still available.
+	keys := testKeyring{"k1", "k2"}
+	if ok, err := tsm1.RotateKey(path, keys); err != nil {
+		t.Fatal(err)
+	} else if !ok {
+		t.Fatal("expected key to be rotated")
+	}
+
+	if ok, err := tsm1.RotateKey(path, keys); err != nil {
+		t.Fatal(err)
+	} else if ok {
+		t.Fatal("expected key to already be rotated")
+	}
+
+	// The file is replaced by a rotated copy, which leaves no temporary file.
+	if tmpFiles, err := filepath.Glob(filepath.Join(dir, "*."+tsm1.TmpTSMFileExtension)); err != nil {
+		t.Fatal(err)
+	} else if len(tmpFiles) != 0 {
+		t.Fatalf("unexpected temporary files: %v", tmpFiles)
+	}
+
+	// The file can be read once k1 is removed.
+	r, err := tsm1.NewTSMReaderWithKeys(mustOpen(t, path), testKeyring{"k2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+
+	if values, err := r.ReadAll([]byte("cpu#!~#value")); err != nil {
+		t.Fatal(err)
+	} else if exp := []tsm1.Value{tsm1.NewValue(0, 1.0)}; !reflect.DeepEqual(values, exp) {
+		t.Fatalf("unexpected values: got %v, exp %v", values, exp)
+	}
+}
+
+// testKeyring is a keyring.Provider holding a key for each ID.  The last ID
+// is the active key.
+type testKeyring []string
+
+func (k testKeyring) ActiveKey() (string, []byte, error) {
+	id := k[len(k)-1]
+	key, err := k.Key(id)
+	return id, key, err
+}
+
+func (k testKeyring) Key(id string) ([]byte, error) {
+	for _, v := range k {
+		if v == id {
+			key := make([]byte, 32)
+			copy(key, id)
+			return key, nil
+		}
+	}
+	return nil, keyring.ErrKeyNotFound
+}
+
+// mustWriteEncryptedTSM writes values to a new encrypted TSM file in dir.
+func mustWriteEncryptedTSM(t *testing.T, dir string, provider keyring.Provider, values map[string][]tsm1.Value) string {
+	f := MustTempFile(dir)
+	w, err := tsm1.NewTSMWriterWithKeys(f, provider)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		if err := w.Write([]byte(k), values[k]); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := w.WriteIndex(); err != nil {
+		t.Fatal(err)
+	} else if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return f.Name()
+}
+
+func mustOpen(t *testing.T, path string) *os.File {
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return f
+}
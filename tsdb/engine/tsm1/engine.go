@@ -24,6 +24,7 @@ import (
 	"github.com/influxdata/influxdb/models"
 	"github.com/influxdata/influxdb/pkg/bytesutil"
 	"github.com/influxdata/influxdb/pkg/estimator"
+	"github.com/influxdata/influxdb/pkg/keyring"
 	"github.com/influxdata/influxdb/pkg/limiter"
 	"github.com/influxdata/influxdb/pkg/metrics"
 	intar "github.com/influxdata/influxdb/pkg/tar"
@@ -144,6 +145,18 @@ type Engine struct {
 	snapDone chan struct{}   // channel to signal snapshot compactions to stop
 	snapWG   *sync.WaitGroup // waitgroup for running snapshot compactions
 
+	// enableMu serializes enabling and disabling compactions with cancelling them,
+	// so a cancel never restarts compactions which were disabled while it ran.
+	enableMu sync.Mutex
+
+	// The following fields are used to report and control level and full compactions.
+	// While compactionsPaused is set, the compaction goroutine does not plan or start
+	// new compactions.
+	compactionsMu      sync.Mutex
+	compactionsPaused  bool
+	runningCompactions []runningCompaction
+	plannedCompactions []plannedCompaction
+
 	id           uint64
 	database     string
 	path         string
@@ -175,6 +188,29 @@ type Engine struct {
 	// writes will only exist in the cache and can be lost if a snapshot has not occurred.
 	WALEnabled bool
 
+	// SegregateLateWrites causes snapshots to write the values of each series which
+	// are not newer than the data already stored for the series to separate files.
+	SegregateLateWrites bool
+
+	// ColdAge specifies the length of time after which if no writes or deletes
+	// have occurred, the TSM files of a fully compacted shard are moved to the
+	// FileStore's cold directory.  Files smaller than ColdMinFileSize are not moved.
+	ColdAge         time.Duration
+	ColdMinFileSize int64
+
+	// coldRetryTime is the earliest time to retry moving files after an error.
+	coldRetryTime time.Time
+
+	// The following fields configure the rollups of the shard, which are computed once
+	// the shard has not been written to for rollupColdDuration.  rolledUpFiles holds
+	// the names of the TSM files which the rollups were last computed from.
+	rollupConfigs      []tsdb.RollupConfig
+	rollupWriter       tsdb.PointsWriter
+	rollupColdDuration time.Duration
+	rollupRetryTime    time.Time
+	rollupMu           sync.Mutex
+	rolledUpFiles      string
+
 	// Controls whether to enabled compactions when the engine is open
 	enableCompactionsOnOpen bool
 
@@ -187,20 +223,37 @@ type Engine struct {
 
 	// provides access to the total set of series IDs
 	seriesIDSets tsdb.SeriesIDSets
+
+	// keys provides the master keys of encrypted files.  If set, new WAL
+	// segments and TSM files are encrypted.
+	keys keyring.Provider
+
+	// optionsErr is an error in the options of the engine, returned by Open.
+	optionsErr error
 }
 
 // NewEngine returns a new instance of Engine.
 func NewEngine(id uint64, idx tsdb.Index, database, path string, walPath string, sfile *tsdb.SeriesFile, opt tsdb.EngineOptions) tsdb.Engine {
 	w := NewWAL(walPath)
 	w.syncDelay = time.Duration(opt.Config.WALFsyncDelay)
+	w.SetKeyProvider(opt.KeyProvider)
 
 	fs := NewFileStore(path)
+	if opt.ColdPath != "" {
+		fs.SetColdDir(opt.ColdPath)
+	}
+	fs.SetKeyProvider(opt.KeyProvider)
 	cache := NewCache(uint64(opt.Config.CacheMaxMemorySize), path)
 
+	codecs, codecsErr := NewBlockCodecs(opt.Config.BlockCodecsFor(database))
+
 	c := &Compactor{
-		Dir:       path,
-		FileStore: fs,
-		RateLimit: opt.CompactionThroughputLimiter,
+		Dir:          path,
+		FileStore:    fs,
+		RateLimit:    opt.CompactionThroughputLimiter,
+		Codecs:       codecs,
+		Keys:         opt.KeyProvider,
+		BloomFilters: opt.Config.BloomFiltersEnabled,
 	}
 
 	var planner CompactionPlanner = NewDefaultPlanner(fs, time.Duration(opt.Config.CompactFullWriteColdDuration))
@@ -232,10 +285,18 @@ func NewEngine(id uint64, idx tsdb.Index, database, path string, walPath string,
 		CacheFlushWriteColdDuration:   time.Duration(opt.Config.CacheSnapshotWriteColdDuration),
 		enableCompactionsOnOpen:       true,
 		WALEnabled:                    opt.WALEnabled,
+		SegregateLateWrites:           opt.Config.CacheSegregateLateWrites,
+		ColdAge:                       time.Duration(opt.Config.ColdAge),
+		ColdMinFileSize:               int64(opt.Config.ColdMinFileSize),
+		rollupConfigs:                 opt.Config.RollupsFor(database, opt.RetentionPolicy),
+		rollupWriter:                  opt.RollupWriter,
+		rollupColdDuration:            time.Duration(opt.Config.CompactFullWriteColdDuration),
+		keys:                          opt.KeyProvider,
 		stats:                         stats,
 		compactionLimiter:             opt.CompactionLimiter,
 		scheduler:                     newScheduler(stats, opt.CompactionLimiter.Capacity()),
 		seriesIDSets:                  opt.SeriesIDSets,
+		optionsErr:                    codecsErr,
 	}
 
 	if e.traceLogging {
@@ -285,7 +346,11 @@ func (e *Engine) Digest() (io.ReadCloser, int64, error) {
 	}
 
 	// Write the new digest to the tmp file.
-	if err := Digest(e.path, tf); err != nil {
+	if err := DigestWithOptions(e.path, DigestOptions{
+		MinTime: math.MinInt64,
+		MaxTime: math.MaxInt64,
+		Keys:    e.keys,
+	}, tf); err != nil {
 		tf.Close()
 		os.Remove(tf.Name())
 		return nil, 0, err
@@ -320,6 +385,9 @@ func (e *Engine) SetEnabled(enabled bool) {
 // SetCompactionsEnabled enables compactions on the engine.  When disabled
 // all running compactions are aborted and new compactions stop running.
 func (e *Engine) SetCompactionsEnabled(enabled bool) {
+	e.enableMu.Lock()
+	defer e.enableMu.Unlock()
+
 	if enabled {
 		e.enableSnapshotCompactions()
 		e.enableLevelCompactions(false)
@@ -481,6 +549,135 @@ func (e *Engine) disableSnapshotCompactions() {
 	}
 }
 
+// SetCompactionsPaused pauses or resumes level and full compactions.  Running
+// compactions are aborted when paused.  Snapshots are not affected.
+func (e *Engine) SetCompactionsPaused(paused bool) {
+	e.compactionsMu.Lock()
+	e.compactionsPaused = paused
+	if paused {
+		e.plannedCompactions = nil
+	}
+	e.compactionsMu.Unlock()
+
+	if paused {
+		e.CancelCompactions()
+	}
+}
+
+// CompactionsPaused returns true if level and full compactions are paused.
+func (e *Engine) CompactionsPaused() bool {
+	e.compactionsMu.Lock()
+	defer e.compactionsMu.Unlock()
+	return e.compactionsPaused
+}
+
+// CancelCompactions aborts any running level and full compactions.  Compactions
+// are planned again unless they are paused.
+func (e *Engine) CancelCompactions() {
+	e.enableMu.Lock()
+	defer e.enableMu.Unlock()
+
+	e.mu.RLock()
+	enabled := e.done != nil
+	e.mu.RUnlock()
+
+	// Don't enable compactions if they were disabled, for example because
+	// the shard is idle.
+	if !enabled {
+		return
+	}
+
+	e.disableLevelCompactions(false)
+	e.enableLevelCompactions(false)
+}
+
+// Compactions returns the running level and full compactions, followed by the
+// compactions which were planned but not started when last checked.
+func (e *Engine) Compactions() []tsdb.CompactionInfo {
+	sizes := make(map[string]int64)
+	for _, f := range e.FileStore.Stats() {
+		sizes[f.Path] = int64(f.Size)
+	}
+
+	e.compactionsMu.Lock()
+	defer e.compactionsMu.Unlock()
+
+	infos := make([]tsdb.CompactionInfo, 0, len(e.runningCompactions)+len(e.plannedCompactions))
+	for _, c := range e.runningCompactions {
+		info := newCompactionInfo(c.strategy.level, c.strategy.name(), c.strategy.group, sizes)
+		info.Active = true
+		info.Started = c.started
+		info.Progress = e.Compactor.Progress(c.strategy.group)
+		infos = append(infos, info)
+	}
+	for _, c := range e.plannedCompactions {
+		infos = append(infos, newCompactionInfo(c.level, c.strategy, c.group, sizes))
+	}
+	return infos
+}
+
+// runningCompaction is a compaction started by the compaction goroutine.
+type runningCompaction struct {
+	strategy *compactionStrategy
+	started  time.Time
+}
+
+// plannedCompaction is a compaction planned by the compaction goroutine, but not started.
+type plannedCompaction struct {
+	level    int
+	strategy string
+	group    CompactionGroup
+}
+
+func newCompactionInfo(level int, strategy string, group CompactionGroup, sizes map[string]int64) tsdb.CompactionInfo {
+	info := tsdb.CompactionInfo{
+		Level:    level,
+		Strategy: strategy,
+		Files:    append([]string(nil), group...),
+	}
+	for _, f := range group {
+		info.Bytes += sizes[f]
+	}
+	return info
+}
+
+// setPlannedCompactions records the compactions planned for each level which were not started.
+func (e *Engine) setPlannedCompactions(level4Strategy string, groups ...[]CompactionGroup) {
+	var planned []plannedCompaction
+	for i, grps := range groups {
+		strategy := "level"
+		if i+1 == 4 {
+			strategy = level4Strategy
+		}
+		for _, grp := range grps {
+			planned = append(planned, plannedCompaction{level: i + 1, strategy: strategy, group: grp})
+		}
+	}
+
+	e.compactionsMu.Lock()
+	e.plannedCompactions = planned
+	e.compactionsMu.Unlock()
+}
+
+// addRunningCompaction records the start of the compaction s.
+func (e *Engine) addRunningCompaction(s *compactionStrategy, started time.Time) {
+	e.compactionsMu.Lock()
+	e.runningCompactions = append(e.runningCompactions, runningCompaction{strategy: s, started: started})
+	e.compactionsMu.Unlock()
+}
+
+// removeRunningCompaction records the end of the compaction s.
+func (e *Engine) removeRunningCompaction(s *compactionStrategy) {
+	e.compactionsMu.Lock()
+	defer e.compactionsMu.Unlock()
+	for i, c := range e.runningCompactions {
+		if c.strategy == s {
+			e.runningCompactions = append(e.runningCompactions[:i], e.runningCompactions[i+1:]...)
+			return
+		}
+	}
+}
+
 // ScheduleFullCompaction will force the engine to fully compact all data stored.
 // This will cancel and running compactions and snapshot any data in the cache to
 // TSM files.  This is an expensive operation.
@@ -650,8 +847,17 @@ func (e *Engine) DiskSize() int64 {
 	return e.FileStore.DiskSizeBytes() + e.WAL.DiskSizeBytes()
 }
 
+// ColdDiskSize returns the total size in bytes of the TSM files in the cold directory.
+func (e *Engine) ColdDiskSize() int64 {
+	return e.FileStore.ColdDiskSizeBytes()
+}
+
 // Open opens and initializes the engine.
 func (e *Engine) Open() error {
+	if e.optionsErr != nil {
+		return e.optionsErr
+	}
+
 	if err := os.MkdirAll(e.path, 0777); err != nil {
 		return err
 	}
@@ -679,6 +885,10 @@ func (e *Engine) Open() error {
 		return err
 	}
 
+	if err := e.loadRolledUpFiles(); err != nil {
+		return err
+	}
+
 	if err := e.reloadCache(); err != nil {
 		return err
 	}
@@ -858,7 +1068,7 @@ func (e *Engine) timeStampFilterTarFile(start, end time.Time) func(f os.FileInfo
 		if err != nil {
 			return err
 		}
-		r, err := NewTSMReader(f)
+		r, err := NewTSMReaderWithKeys(f, e.keys)
 		if err != nil {
 			return err
 		}
@@ -921,7 +1131,12 @@ func (e *Engine) filterFileToBackup(r *TSMReader, fi os.FileInfo, shardRelativeP
 	}
 	defer os.Remove(path)
 
-	w, err := NewTSMWriter(out)
+	var w TSMWriter
+	if e.keys != nil {
+		w, err = NewTSMWriterWithKeys(out, e.keys)
+	} else {
+		w, err = NewTSMWriter(out)
+	}
 	if err != nil {
 		return err
 	}
@@ -1043,7 +1258,7 @@ func (e *Engine) overlay(r io.Reader, basePath string, asNew bool) error {
 			return err
 		}
 
-		r, err := NewTSMReader(fd)
+		r, err := NewTSMReaderWithKeys(fd, e.keys)
 		if err != nil {
 			return err
 		}
@@ -1762,7 +1977,7 @@ func (e *Engine) writeSnapshotAndCommit(log *zap.Logger, closedFiles []string, s
 	}()
 
 	// write the new snapshot files
-	newFiles, err := e.Compactor.WriteSnapshot(snapshot)
+	newFiles, err := e.writeSnapshotFiles(snapshot)
 	if err != nil {
 		log.Info("Error writing snapshot from compactor", zap.Error(err))
 		return err
@@ -1787,6 +2002,37 @@ func (e *Engine) writeSnapshotAndCommit(log *zap.Logger, closedFiles []string, s
 	return nil
 }
 
+// writeSnapshotFiles writes the snapshot to new TSM files.  If late writes are
+// segregated, the late values of each series are written to separate files.
+func (e *Engine) writeSnapshotFiles(snapshot *Cache) ([]string, error) {
+	if !e.SegregateLateWrites {
+		return e.Compactor.WriteSnapshot(snapshot)
+	}
+
+	inOrder, late, err := snapshot.SplitLate(e.FileStore.MaxTime)
+	if err != nil {
+		return nil, err
+	}
+
+	newFiles, err := e.Compactor.WriteSnapshot(inOrder)
+	if err != nil {
+		return nil, err
+	} else if late.Count() == 0 {
+		return newFiles, nil
+	}
+
+	lateFiles, err := e.Compactor.WriteLateSnapshot(late)
+	if err != nil {
+		if rerr := e.Compactor.removeTmpFiles(newFiles); rerr != nil {
+			e.logger.Info("Error removing snapshot files", zap.Error(rerr))
+		}
+		return nil, err
+	}
+
+	e.Cache.updateLateStats(late)
+	return append(newFiles, lateFiles...), nil
+}
+
 // compactCache continually checks if the WAL cache should be written to disk.
 func (e *Engine) compactCache() {
 	t := time.NewTicker(time.Second)
@@ -1834,6 +2080,7 @@ func (e *Engine) ShouldCompactCache(lastWriteTime time.Time) bool {
 func (e *Engine) compact(wg *sync.WaitGroup) {
 	t := time.NewTicker(time.Second)
 	defer t.Stop()
+	defer e.setPlannedCompactions("")
 
 	for {
 		e.mu.RLock()
@@ -1845,20 +2092,27 @@ func (e *Engine) compact(wg *sync.WaitGroup) {
 			return
 
 		case <-t.C:
+			if e.CompactionsPaused() {
+				continue
+			}
 
 			// Find our compaction plans
 			level1Groups := e.CompactionPlan.PlanLevel(1)
 			level2Groups := e.CompactionPlan.PlanLevel(2)
 			level3Groups := e.CompactionPlan.PlanLevel(3)
 			level4Groups := e.CompactionPlan.Plan(e.FileStore.LastModified())
+			level4Strategy := "full"
 			atomic.StoreInt64(&e.stats.TSMOptimizeCompactionsQueue, int64(len(level4Groups)))
 
 			// If no full compactions are need, see if an optimize is needed
 			if len(level4Groups) == 0 {
 				level4Groups = e.CompactionPlan.PlanOptimize()
+				level4Strategy = "optimize"
 				atomic.StoreInt64(&e.stats.TSMOptimizeCompactionsQueue, int64(len(level4Groups)))
 			}
 
+			planN := len(level1Groups) + len(level2Groups) + len(level3Groups) + len(level4Groups)
+
 			// Update the level plan queue stats
 			atomic.StoreInt64(&e.stats.TSMCompactionsQueue[0], int64(len(level1Groups)))
 			atomic.StoreInt64(&e.stats.TSMCompactionsQueue[1], int64(len(level2Groups)))
@@ -1892,15 +2146,65 @@ func (e *Engine) compact(wg *sync.WaitGroup) {
 				}
 			}
 
+			e.setPlannedCompactions(level4Strategy, level1Groups, level2Groups, level3Groups, level4Groups)
+
 			// Release all the plans we didn't start.
 			e.CompactionPlan.Release(level1Groups)
 			e.CompactionPlan.Release(level2Groups)
 			e.CompactionPlan.Release(level3Groups)
 			e.CompactionPlan.Release(level4Groups)
+
+			// Files are only rolled up and moved once there is nothing left to compact.
+			if planN == 0 && !e.compactionsActive() {
+				e.rollupShard(quit)
+				e.moveColdFiles()
+			}
 		}
 	}
 }
 
+// compactionsActive returns true if any level, optimize or full compactions are running.
+func (e *Engine) compactionsActive() bool {
+	for i := range e.stats.TSMCompactionsActive {
+		if atomic.LoadInt64(&e.stats.TSMCompactionsActive[i]) > 0 {
+			return true
+		}
+	}
+	return atomic.LoadInt64(&e.stats.TSMOptimizeCompactionsActive) > 0 ||
+		atomic.LoadInt64(&e.stats.TSMFullCompactionsActive) > 0
+}
+
+// moveColdFiles moves the shard's TSM files to the cold directory if the shard
+// is fully compacted and has not been written to for ColdAge.  It must only be
+// called from the compaction goroutine while no compactions are running.
+func (e *Engine) moveColdFiles() {
+	if e.FileStore.coldDir == "" || time.Now().Before(e.coldRetryTime) {
+		return
+	} else if time.Since(e.LastModified()) < e.ColdAge || !e.CompactionPlan.FullyCompacted() {
+		return
+	}
+
+	var paths []string
+	for _, f := range e.FileStore.Stats() {
+		if !e.FileStore.isCold(f.Path) && int64(f.Size) >= e.ColdMinFileSize {
+			paths = append(paths, f.Path)
+		}
+	}
+	if len(paths) == 0 {
+		return
+	}
+
+	start := time.Now()
+	if err := e.FileStore.MoveToCold(paths); err != nil {
+		e.logger.Warn("Error moving files to cold directory", zap.Error(err))
+		e.coldRetryTime = time.Now().Add(time.Minute)
+		return
+	}
+	e.logger.Info("Moved files to cold directory",
+		zap.Int("files", len(paths)),
+		zap.Duration("duration", time.Since(start)))
+}
+
 // compactHiPriorityLevel kicks off compactions using the high priority policy. It returns
 // true if the compaction was started
 func (e *Engine) compactHiPriorityLevel(grp CompactionGroup, level int, fast bool, wg *sync.WaitGroup) bool {
@@ -1963,6 +2267,11 @@ func (e *Engine) compactFull(grp CompactionGroup, wg *sync.WaitGroup) bool {
 		return false
 	}
 
+	// Rollups can only be computed from a compaction of all of the shard's data.
+	if e.rollupPending() && e.coversAllFiles(grp) {
+		s.rollups = e.newRollups()
+	}
+
 	// Try the lo priority limiter, otherwise steal a little from the high priority if we can.
 	if e.compactionLimiter.TryTake() {
 		atomic.AddInt64(&e.stats.TSMFullCompactionsActive, 1)
@@ -1996,16 +2305,34 @@ type compactionStrategy struct {
 	compactor *Compactor
 	fileStore *FileStore
 
+	// rollups are computed from the values compacted, if set.
+	rollups []*rollup
+
 	engine *Engine
 }
 
 // Apply concurrently compacts all the groups in a compaction strategy.
 func (s *compactionStrategy) Apply() {
 	start := time.Now()
+	s.engine.addRunningCompaction(s, start)
+	defer s.engine.removeRunningCompaction(s)
+
 	s.compactGroup()
 	atomic.AddInt64(s.durationStat, time.Since(start).Nanoseconds())
 }
 
+// name returns the name of the strategy reported for the compaction.
+func (s *compactionStrategy) name() string {
+	switch {
+	case s.level < 4:
+		return "level"
+	case s.fast:
+		return "optimize"
+	default:
+		return "full"
+	}
+}
+
 // compactGroup executes the compaction strategy against a single CompactionGroup.
 func (s *compactionStrategy) compactGroup() {
 	group := s.group
@@ -2025,7 +2352,7 @@ func (s *compactionStrategy) compactGroup() {
 	if s.fast {
 		files, err = s.compactor.CompactFast(group)
 	} else {
-		files, err = s.compactor.CompactFull(group)
+		files, err = s.compactor.compactFull(group, s.rollups)
 	}
 
 	if err != nil {
@@ -2055,6 +2382,10 @@ func (s *compactionStrategy) compactGroup() {
 	for i, f := range files {
 		log.Info("Compacted file", zap.Int("tsm1_index", i), zap.String("tsm1_file", f))
 	}
+
+	if len(s.rollups) > 0 {
+		s.engine.finishRollups(s.rollups, files, log)
+	}
 	log.Info("Finished compacting files",
 		zap.Int("tsm1_files_n", len(files)))
 	atomic.AddInt64(s.successStat, 1)
@@ -2124,6 +2455,7 @@ func (e *Engine) reloadCache() error {
 	e.Cache.SetMaxSize(0)
 
 	loader := NewCacheLoader(files)
+	loader.Keys = e.keys
 	loader.WithLogger(e.logger)
 	if err := loader.Load(e.Cache); err != nil {
 		return err
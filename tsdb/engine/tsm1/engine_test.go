@@ -23,7 +23,9 @@ import (
 	"github.com/influxdata/influxdb/logger"
 	"github.com/influxdata/influxdb/models"
 	"github.com/influxdata/influxdb/pkg/deep"
+	"github.com/influxdata/influxdb/pkg/limiter"
 	"github.com/influxdata/influxdb/query"
+	"github.com/influxdata/influxdb/toml"
 	"github.com/influxdata/influxdb/tsdb"
 	"github.com/influxdata/influxdb/tsdb/engine/tsm1"
 	"github.com/influxdata/influxdb/tsdb/index/inmem"
@@ -1665,6 +1667,69 @@ func TestEngine_LastModified(t *testing.T) {
 	}
 }
 
+func TestEngine_Scrub(t *testing.T) {
+	e := MustOpenEngine(inmem.IndexName)
+	defer e.Close()
+
+	// mock the planner so compactions don't run during the test
+	e.CompactionPlan = &mockPlanner{}
+
+	for _, p := range []string{
+		"cpu,host=A value=1.1 1000000000",
+		"cpu,host=B value=1.2 2000000000",
+	} {
+		if err := e.writePoints(MustParsePointString(p)); err != nil {
+			t.Fatalf("failed to write points: %s", err.Error())
+		} else if err := e.WriteSnapshot(); err != nil {
+			t.Fatalf("failed to snapshot: %s", err.Error())
+		}
+	}
+
+	report, err := e.Scrub(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+	} else if got, exp := report.FilesN, 2; got != exp {
+		t.Fatalf("files verified mismatch: got %v, exp %v", got, exp)
+	} else if got, exp := report.BlocksN, 2; got != exp {
+		t.Fatalf("blocks verified mismatch: got %v, exp %v", got, exp)
+	} else if len(report.Corrupt) != 0 {
+		t.Fatalf("unexpected corrupt files: %v", report.Corrupt)
+	}
+
+	// Corrupt the data of the first block of the first file.
+	path := e.FileStore.Files()[0].Path()
+	fd, err := os.OpenFile(path, os.O_RDWR, 0666)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := fd.WriteAt([]byte{0xff, 0xff}, 12); err != nil {
+		t.Fatal(err)
+	}
+	fd.Close()
+
+	report, err = e.Scrub(context.Background(), limiter.NewRate(1024*1024, 1024*1024))
+	if err != nil {
+		t.Fatal(err)
+	} else if got, exp := len(report.Corrupt), 1; got != exp {
+		t.Fatalf("corrupt files mismatch: got %v, exp %v", got, exp)
+	} else if got, exp := report.Corrupt[0].Path, path; got != exp {
+		t.Fatalf("corrupt file mismatch: got %v, exp %v", got, exp)
+	}
+
+	if got, exp := len(e.FileStore.Files()), 1; got != exp {
+		t.Fatalf("file count mismatch: got %v, exp %v", got, exp)
+	} else if _, err := os.Stat(path + "." + tsm1.QuarantineFileExtension); err != nil {
+		t.Fatalf("expected quarantined file: %v", err)
+	}
+
+	// The quarantined file must not be loaded when the engine is reopened.
+	if err := e.Reopen(); err != nil {
+		t.Fatal(err)
+	} else if got, exp := len(e.FileStore.Files()), 1; got != exp {
+		t.Fatalf("file count mismatch after reopen: got %v, exp %v", got, exp)
+	}
+}
+
 func TestEngine_SnapshotsDisabled(t *testing.T) {
 	sfile := MustOpenSeriesFile()
 	defer sfile.Close()
@@ -1703,6 +1768,180 @@ func TestEngine_SnapshotsDisabled(t *testing.T) {
 	}
 }
 
+// Ensure late writes are snapshotted into separate level 0 files.
+func TestEngine_WriteSnapshot_SegregateLateWrites(t *testing.T) {
+	e, err := NewEngine("inmem")
+	if err != nil {
+		t.Fatal(err)
+	}
+	e.SegregateLateWrites = true
+	e.CompactionPlan = &mockPlanner{}
+	if err := e.Open(); err != nil {
+		t.Fatal(err)
+	}
+	defer e.Close()
+
+	if err := e.WritePointsString(
+		`cpu,host=A value=1 10`,
+		`cpu,host=B value=1 10`,
+	); err != nil {
+		t.Fatal(err)
+	}
+	e.MustWriteSnapshot()
+
+	if err := e.WritePointsString(
+		`cpu,host=A value=2 5`,
+		`cpu,host=A value=3 20`,
+		`cpu,host=B value=4 10`,
+		`cpu,host=C value=5 1`,
+	); err != nil {
+		t.Fatal(err)
+	}
+	e.MustWriteSnapshot()
+
+	stats := e.FileStore.Stats()
+	if len(stats) != 3 {
+		t.Fatalf("unexpected files: %v", stats)
+	}
+
+	for _, tt := range []struct {
+		path   string
+		values map[string][]tsm1.Value
+	}{
+		{
+			path: "000000002-000000001.tsm",
+			values: map[string][]tsm1.Value{
+				"cpu,host=A#!~#value": {tsm1.NewValue(20, 3.0)},
+				"cpu,host=C#!~#value": {tsm1.NewValue(1, 5.0)},
+			},
+		},
+		{
+			path: "000000003-000000000.tsm",
+			values: map[string][]tsm1.Value{
+				"cpu,host=A#!~#value": {tsm1.NewValue(5, 2.0)},
+				"cpu,host=B#!~#value": {tsm1.NewValue(10, 4.0)},
+			},
+		},
+	} {
+		r := e.FileStore.TSMReader(filepath.Join(e.Path(), tt.path))
+		if r == nil {
+			t.Fatalf("missing file %s: %v", tt.path, stats)
+		} else if n := r.KeyCount(); n != len(tt.values) {
+			t.Fatalf("unexpected key count in %s: %d", tt.path, n)
+		}
+
+		for key, exp := range tt.values {
+			if values, err := r.ReadAll([]byte(key)); err != nil {
+				t.Fatal(err)
+			} else if !reflect.DeepEqual(values, exp) {
+				t.Fatalf("unexpected values for %s in %s: got %v, exp %v", key, tt.path, values, exp)
+			}
+		}
+	}
+
+	if n := e.Cache.Statistics(nil)[0].Values["lateValues"]; n != int64(2) {
+		t.Fatalf("unexpected late values: %v", n)
+	}
+}
+
+func TestEngine_Rollup(t *testing.T) {
+	for _, tt := range []struct {
+		name      string
+		snapshots int
+	}{
+		{name: "full compaction", snapshots: 2},
+		{name: "single generation", snapshots: 1},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			sfile := MustOpenSeriesFile()
+			defer sfile.Close()
+
+			dir, _ := ioutil.TempDir("", "tsm")
+			walPath := filepath.Join(dir, "wal")
+			os.MkdirAll(walPath, 0777)
+			defer os.RemoveAll(dir)
+
+			var w rollupWriter
+			db := path.Base(dir)
+			opt := tsdb.NewEngineOptions()
+			opt.InmemIndex = inmem.NewIndex(db, sfile.SeriesFile)
+			opt.CompactionLimiter = limiter.NewFixed(1)
+			opt.RetentionPolicy = "rp0"
+			opt.RollupWriter = &w
+			opt.Config.CompactFullWriteColdDuration = toml.Duration(time.Nanosecond)
+			opt.Config.Rollups = []tsdb.RollupConfig{{
+				Database:              db,
+				RetentionPolicy:       "rp0",
+				TargetRetentionPolicy: "rp1",
+				Interval:              toml.Duration(10 * time.Second),
+				Functions:             []string{"mean", "max", "count"},
+			}}
+			idx := tsdb.MustOpenIndex(1, db, filepath.Join(dir, "index"), tsdb.NewSeriesIDSet(), sfile.SeriesFile, opt)
+			defer idx.Close()
+
+			e := tsm1.NewEngine(1, idx, db, dir, walPath, sfile.SeriesFile, opt).(*tsm1.Engine)
+			e.SetEnabled(false)
+			if err := e.Open(); err != nil {
+				t.Fatalf("failed to open tsm1 engine: %s", err.Error())
+			}
+			defer e.Close()
+
+			points := MustParsePointsString(`
+cpu,host=A value=1 1000000000
+cpu,host=A value=2,status="ok" 2000000000
+cpu,host=B value=10i 3000000000
+cpu,host=A value=6 12000000000
+`)
+			for i := 0; i < tt.snapshots; i++ {
+				if err := e.WritePoints(points[i*len(points)/tt.snapshots : (i+1)*len(points)/tt.snapshots]); err != nil {
+					t.Fatalf("failed to write points: %s", err.Error())
+				} else if err := e.WriteSnapshot(); err != nil {
+					t.Fatalf("failed to snapshot: %s", err.Error())
+				}
+			}
+			e.SetEnabled(true)
+
+			// Wait for the rollups to be recorded.
+			for i := 0; i < 100; i++ {
+				if _, err := os.Stat(filepath.Join(dir, tsm1.RollupFileName)); err == nil {
+					break
+				}
+				time.Sleep(100 * time.Millisecond)
+			}
+
+			exp := []string{
+				"rp1 cpu,host=A count_status=1i,count_value=2i,max_value=2,mean_value=1.5 0",
+				"rp1 cpu,host=A count_value=1i,max_value=6,mean_value=6 10000000000",
+				"rp1 cpu,host=B count_value=1i,max_value=10i,mean_value=10 0",
+			}
+			if got := w.Points(); !reflect.DeepEqual(got, exp) {
+				t.Fatalf("unexpected rollup points:\n got=%v\n exp=%v", got, exp)
+			}
+		})
+	}
+}
+
+// rollupWriter records the points written by rollups.
+type rollupWriter struct {
+	mu     sync.Mutex
+	points []string
+}
+
+func (w *rollupWriter) WritePointsPrivileged(database, retentionPolicy string, consistencyLevel models.ConsistencyLevel, points []models.Point) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for _, p := range points {
+		w.points = append(w.points, retentionPolicy+" "+p.String())
+	}
+	return nil
+}
+
+func (w *rollupWriter) Points() []string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.points
+}
+
 // Ensure engine can create an ascending cursor for cache and tsm values.
 func TestEngine_CreateCursor_Ascending(t *testing.T) {
 	t.Parallel()
@@ -1888,6 +2127,66 @@ func TestEngine_DisableEnableCompactions_Concurrent(t *testing.T) {
 	}
 }
 
+func TestEngine_SetCompactionsPaused(t *testing.T) {
+	e := MustOpenEngine(inmem.IndexName)
+	defer e.Close()
+
+	// The planned files do not exist, so each compaction fails after starting.
+	e.CompactionPlan = &levelPlanner{groups: []tsm1.CompactionGroup{
+		{"000000001-000000001.tsm", "000000002-000000001.tsm"},
+		{"000000003-000000001.tsm", "000000004-000000001.tsm"},
+	}}
+	e.SetCompactionsEnabled(true)
+
+	// waitForPlanned waits until the second group is reported as planned.
+	waitForPlanned := func() {
+		for i := 0; i < 50; i++ {
+			for _, c := range e.Compactions() {
+				if !c.Active && c.Level == 1 && c.Strategy == "level" && len(c.Files) == 2 {
+					return
+				}
+			}
+			time.Sleep(100 * time.Millisecond)
+		}
+		t.Fatalf("planned compaction not reported: %+v", e.Compactions())
+	}
+	waitForPlanned()
+
+	e.SetCompactionsPaused(true)
+	if !e.CompactionsPaused() {
+		t.Fatal("expected compactions to be paused")
+	} else if infos := e.Compactions(); len(infos) != 0 {
+		t.Fatalf("unexpected compactions while paused: %+v", infos)
+	}
+
+	e.SetCompactionsPaused(false)
+	if e.CompactionsPaused() {
+		t.Fatal("expected compactions to be resumed")
+	}
+	waitForPlanned()
+}
+
+// Ensure cancelling compactions never restarts compactions which are disabled
+// while the cancel runs.
+func TestEngine_CancelCompactions_Disable(t *testing.T) {
+	e := MustOpenEngine(inmem.IndexName)
+	defer e.Close()
+
+	for i := 0; i < 10000; i++ {
+		e.SetCompactionsEnabled(true)
+
+		var wg sync.WaitGroup
+		wg.Add(2)
+		go func() { defer wg.Done(); e.SetCompactionsEnabled(false) }()
+		go func() { defer wg.Done(); e.CancelCompactions() }()
+		wg.Wait()
+
+		if _, err := e.Compactor.CompactFull(nil); err == nil || err.Error() != "compactions disabled" {
+			t.Fatalf("expected compactions to be disabled after %d attempts, got %v", i+1, err)
+		}
+	}
+}
+
 func BenchmarkEngine_WritePoints(b *testing.B) {
 	batchSizes := []int{10, 100, 1000, 5000, 10000}
 	for _, sz := range batchSizes {
@@ -2426,6 +2725,19 @@ func (m *mockPlanner) FullyCompacted() bool                            { return
 func (m *mockPlanner) ForceFull()                                      {}
 func (m *mockPlanner) SetFileStore(fs *tsm1.FileStore)                 {}
 
+// levelPlanner plans the same level 1 compactions each time it is called.
+type levelPlanner struct {
+	mockPlanner
+	groups []tsm1.CompactionGroup
+}
+
+func (p *levelPlanner) PlanLevel(level int) []tsm1.CompactionGroup {
+	if level != 1 {
+		return nil
+	}
+	return append([]tsm1.CompactionGroup(nil), p.groups...)
+}
+
 // ParseTags returns an instance of Tags for a comma-delimited list of key/values.
 func ParseTags(s string) query.Tags {
 	m := make(map[string]string)
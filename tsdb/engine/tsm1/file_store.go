@@ -3,7 +3,9 @@ package tsm1
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"math"
 	"os"
@@ -17,6 +19,7 @@ import (
 	"time"
 
 	"github.com/influxdata/influxdb/models"
+	"github.com/influxdata/influxdb/pkg/keyring"
 	"github.com/influxdata/influxdb/pkg/limiter"
 	"github.com/influxdata/influxdb/pkg/metrics"
 	"github.com/influxdata/influxdb/query"
@@ -26,6 +29,9 @@ import (
 const (
 	// The extension used to describe temporary snapshot files.
 	TmpTSMFileExtension = "tmp"
+
+	// The extension appended to TSM and tombstone files which failed verification.
+	QuarantineFileExtension = "quarantine"
 )
 
 // TSMFile represents an on-disk TSM file.
@@ -57,6 +63,10 @@ type TSMFile interface {
 	// key.
 	Contains(key []byte) bool
 
+	// MayContain returns false if the file definitely does not contain the
+	// given key.  It is cheaper than Contains.
+	MayContain(key []byte) bool
+
 	// OverlapsTimeRange returns true if the time range of the file intersect min and max.
 	OverlapsTimeRange(min, max int64) bool
 
@@ -138,8 +148,9 @@ type TSMFile interface {
 
 // Statistics gathered by the FileStore.
 const (
-	statFileStoreBytes = "diskBytes"
-	statFileStoreCount = "numFiles"
+	statFileStoreBytes     = "diskBytes"
+	statFileStoreColdBytes = "coldDiskBytes"
+	statFileStoreCount     = "numFiles"
 )
 
 var (
@@ -166,6 +177,17 @@ type FileStore struct {
 	currentGeneration int
 	dir               string
 
+	// coldDir holds the TSM files which have been moved off of dir.
+	coldDir string
+
+	// moveMu is held for reading while tombstones are written and for
+	// writing while files are moved to coldDir, so that no tombstone is
+	// written to a file after it has been copied.
+	moveMu sync.RWMutex
+
+	// keys provides the master keys of encrypted TSM files.
+	keys keyring.Provider
+
 	files []TSMFile
 
 	logger       *zap.Logger // Logger to be used for important messages
@@ -221,6 +243,18 @@ func NewFileStore(dir string) *FileStore {
 	return fs
 }
 
+// SetColdDir sets the directory which holds TSM files moved by MoveToCold.
+// It must be called before the FileStore is opened.
+func (f *FileStore) SetColdDir(dir string) {
+	f.coldDir = dir
+}
+
+// SetKeyProvider sets the provider of the master keys used to read encrypted
+// TSM files.  It must be called before the FileStore is opened.
+func (f *FileStore) SetKeyProvider(keys keyring.Provider) {
+	f.keys = keys
+}
+
 // enableTraceLogging must be called before the FileStore is opened.
 func (f *FileStore) enableTraceLogging(enabled bool) {
 	f.traceLogging = enabled
@@ -241,8 +275,9 @@ func (f *FileStore) WithLogger(log *zap.Logger) {
 
 // FileStoreStatistics keeps statistics about the file store.
 type FileStoreStatistics struct {
-	DiskBytes int64
-	FileCount int64
+	DiskBytes     int64
+	ColdDiskBytes int64
+	FileCount     int64
 }
 
 // Statistics returns statistics for periodic monitoring.
@@ -251,8 +286,9 @@ func (f *FileStore) Statistics(tags map[string]string) []models.Statistic {
 		Name: "tsm1_filestore",
 		Tags: tags,
 		Values: map[string]interface{}{
-			statFileStoreBytes: atomic.LoadInt64(&f.stats.DiskBytes),
-			statFileStoreCount: atomic.LoadInt64(&f.stats.FileCount),
+			statFileStoreBytes:     atomic.LoadInt64(&f.stats.DiskBytes),
+			statFileStoreColdBytes: atomic.LoadInt64(&f.stats.ColdDiskBytes),
+			statFileStoreCount:     atomic.LoadInt64(&f.stats.FileCount),
 		},
 	}}
 }
@@ -271,6 +307,34 @@ func (f *FileStore) Files() []TSMFile {
 	return f.files
 }
 
+// refFiles returns the TSM files of the store with a reference taken on each.
+// The references are taken while holding the lock, so that a compaction cannot
+// close a file before it is referenced.  Callers must Unref every file.
+func (f *FileStore) refFiles() []TSMFile {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	files := make([]TSMFile, len(f.files))
+	for i, fd := range f.files {
+		fd.Ref()
+		files[i] = fd
+	}
+	return files
+}
+
+// refFile returns the TSM file at path with a reference taken on it, or nil if
+// the file is no longer part of the store.  Callers must Unref the file.
+func (f *FileStore) refFile(path string) TSMFile {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	for _, fd := range f.files {
+		if fd.Path() == path {
+			fd.Ref()
+			return fd
+		}
+	}
+	return nil
+}
+
 // Free releases any resources held by the FileStore.  The resources will be re-acquired
 // if necessary if they are needed after freeing them.
 func (f *FileStore) Free() error {
@@ -355,6 +419,9 @@ func (f *FileStore) Delete(keys [][]byte) error {
 }
 
 func (f *FileStore) Apply(fn func(r TSMFile) error) error {
+	f.moveMu.RLock()
+	defer f.moveMu.RUnlock()
+
 	// Limit apply fn to number of cores
 	limiter := limiter.NewFixed(runtime.GOMAXPROCS(0))
 
@@ -391,6 +458,9 @@ func (f *FileStore) Apply(fn func(r TSMFile) error) error {
 // DeleteRange removes the values for keys between timestamps min and max.  This should only
 // be used with smaller batches of series keys.
 func (f *FileStore) DeleteRange(keys [][]byte, min, max int64) error {
+	f.moveMu.RLock()
+	defer f.moveMu.RUnlock()
+
 	var batches BatchDeleters
 	f.mu.RLock()
 	for _, f := range f.files {
@@ -457,6 +527,12 @@ func (f *FileStore) Open() error {
 		return err
 	}
 
+	if f.coldDir != "" {
+		if files, err = f.openColdDir(files); err != nil {
+			return err
+		}
+	}
+
 	// struct to hold the result of opening each reader in a goroutine
 	type res struct {
 		r   *TSMReader
@@ -482,7 +558,7 @@ func (f *FileStore) Open() error {
 
 		go func(idx int, file *os.File) {
 			start := time.Now()
-			df, err := NewTSMReader(file)
+			df, err := NewTSMReaderWithKeys(file, f.keys)
 			f.logger.Info("Opened file",
 				zap.String("path", file.Name()),
 				zap.Int("id", idx),
@@ -505,9 +581,13 @@ func (f *FileStore) Open() error {
 		}
 		f.files = append(f.files, res.r)
 		// Accumulate file store size stats
-		atomic.AddInt64(&f.stats.DiskBytes, int64(res.r.Size()))
+		size := int64(res.r.Size())
 		for _, ts := range res.r.TombstoneFiles() {
-			atomic.AddInt64(&f.stats.DiskBytes, int64(ts.Size))
+			size += int64(ts.Size)
+		}
+		atomic.AddInt64(&f.stats.DiskBytes, size)
+		if f.isCold(res.r.Path()) {
+			atomic.AddInt64(&f.stats.ColdDiskBytes, size)
 		}
 
 		// Re-initialize the lastModified time for the file store
@@ -543,6 +623,179 @@ func (f *FileStore) DiskSizeBytes() int64 {
 	return atomic.LoadInt64(&f.stats.DiskBytes)
 }
 
+// ColdDiskSizeBytes returns the size of the files in the cold directory.
+func (f *FileStore) ColdDiskSizeBytes() int64 {
+	return atomic.LoadInt64(&f.stats.ColdDiskBytes)
+}
+
+// isCold returns true if path is in the cold directory.
+func (f *FileStore) isCold(path string) bool {
+	return f.coldDir != "" && filepath.Dir(path) == filepath.Clean(f.coldDir)
+}
+
+// openColdDir removes any temporary files, tombstones and bloom filters left in
+// the cold directory by an interrupted move and returns hot with the cold TSM files appended. Hot
+// files which were copied to the cold directory, but not yet removed when the
+// move was interrupted, are removed.
+func (f *FileStore) openColdDir(hot []string) ([]string, error) {
+	tmpFiles, err := filepath.Glob(filepath.Join(f.coldDir, fmt.Sprintf("*.%s", TmpTSMFileExtension)))
+	if err != nil {
+		return nil, err
+	}
+	for _, fn := range tmpFiles {
+		if err := os.Remove(fn); err != nil {
+			return nil, err
+		}
+	}
+
+	cold, err := filepath.Glob(filepath.Join(f.coldDir, fmt.Sprintf("*.%s", TSMFileExtension)))
+	if err != nil {
+		return nil, err
+	}
+
+	moved := make(map[string]struct{}, len(cold))
+	for _, fn := range cold {
+		moved[filepath.Base(fn)] = struct{}{}
+	}
+
+	// Tombstones and bloom filters are copied before their TSM file, so they
+	// are left without one if a move was interrupted.
+	for _, ext := range []string{"tombstone", BloomFileExtension} {
+		leftovers, err := filepath.Glob(filepath.Join(f.coldDir, fmt.Sprintf("*.%s", ext)))
+		if err != nil {
+			return nil, err
+		}
+		for _, fn := range leftovers {
+			name := strings.TrimSuffix(filepath.Base(fn), "."+ext) + "." + TSMFileExtension
+			if _, ok := moved[name]; ok {
+				continue
+			}
+			if err := os.Remove(fn); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	files := make([]string, 0, len(hot)+len(cold))
+	for _, fn := range hot {
+		if _, ok := moved[filepath.Base(fn)]; !ok {
+			files = append(files, fn)
+			continue
+		}
+
+		f.logger.Info("Removing moved file", zap.String("path", fn))
+		tombstone := strings.TrimSuffix(fn, filepath.Ext(fn)) + ".tombstone"
+		for _, path := range []string{fn, tombstone, BloomFilterPath(fn)} {
+			if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+				return nil, err
+			}
+		}
+	}
+	return append(files, cold...), nil
+}
+
+// MoveToCold copies the TSM files at paths, and their tombstones, to the cold
+// directory and replaces the original files with the copies.  The caller must
+// ensure the files are not compacted while they are moved.  Deletes are
+// blocked until the files have been replaced, so that their tombstones are not
+// lost.
+func (f *FileStore) MoveToCold(paths []string) error {
+	if f.coldDir == "" {
+		return errors.New("cold directory not set")
+	}
+
+	f.moveMu.Lock()
+	defer f.moveMu.Unlock()
+
+	if err := os.MkdirAll(f.coldDir, 0777); err != nil {
+		return err
+	}
+
+	newFiles := make([]string, 0, len(paths))
+	for _, path := range paths {
+		r := f.TSMReader(path)
+		if r == nil {
+			return fmt.Errorf("unknown TSM file: %s", path)
+		}
+
+		// Tombstones are copied first since they are only read once the
+		// TSM file has been copied and renamed.
+		for _, ts := range r.TombstoneFiles() {
+			if err := copyFile(ts.Path, filepath.Join(f.coldDir, filepath.Base(ts.Path))); err != nil {
+				return err
+			}
+		}
+
+		// The bloom filter, if any, shares its path with the tmp file.
+		tmpPath := fmt.Sprintf("%s.%s", filepath.Join(f.coldDir, filepath.Base(path)), TmpTSMFileExtension)
+		if err := copyFile(BloomFilterPath(path), BloomFilterPath(tmpPath)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		if err := copyFile(path, tmpPath); err != nil {
+			return err
+		}
+		newFiles = append(newFiles, tmpPath)
+	}
+
+	if err := f.replace(paths, newFiles, nil); err != nil {
+		return err
+	}
+	return syncDir(f.coldDir)
+}
+
+// Quarantine removes the TSM file at path from the store and renames it, and
+// its tombstones, so the file is ignored when the store is next opened.  The
+// file is closed once any queries reading it complete.
+func (f *FileStore) Quarantine(path string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var file TSMFile
+	active := make([]TSMFile, 0, len(f.files))
+	for _, fd := range f.files {
+		if fd.Path() == path {
+			file = fd
+			continue
+		}
+		active = append(active, fd)
+	}
+	if file == nil {
+		return nil
+	}
+
+	size := int64(file.Size())
+	for _, ts := range file.TombstoneFiles() {
+		size += int64(ts.Size)
+		if err := os.Rename(ts.Path, fmt.Sprintf("%s.%s", ts.Path, QuarantineFileExtension)); err != nil {
+			return err
+		}
+	}
+	if err := file.Rename(fmt.Sprintf("%s.%s", path, QuarantineFileExtension)); err != nil {
+		return err
+	}
+	if err := os.Remove(BloomFilterPath(path)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	f.files = active
+	f.lastFileStats = nil
+	f.lastModified = time.Now().UTC()
+	atomic.StoreInt64(&f.stats.FileCount, int64(len(f.files)))
+
+	atomic.AddInt64(&f.stats.DiskBytes, -size)
+	if f.isCold(path) {
+		atomic.AddInt64(&f.stats.ColdDiskBytes, -size)
+	}
+
+	// Queries may still be reading the file, so it is closed once they finish.
+	go func() {
+		for file.Close() == ErrFileInUse {
+			time.Sleep(time.Second)
+		}
+	}()
+	return syncDir(filepath.Dir(path))
+}
+
 // Read returns the slice of values for the given key and the given timestamp,
 // if any file matches those constraints.
 func (f *FileStore) Read(key []byte, t int64) ([]Value, error) {
@@ -551,7 +804,7 @@ func (f *FileStore) Read(key []byte, t int64) ([]Value, error) {
 
 	for _, f := range f.files {
 		// Can this file possibly contain this key and timestamp?
-		if !f.Contains(key) {
+		if !f.MayContain(key) || !f.Contains(key) {
 			continue
 		}
 
@@ -568,6 +821,28 @@ func (f *FileStore) Read(key []byte, t int64) ([]Value, error) {
 	return nil, nil
 }
 
+// MaxTime returns the maximum time of the values stored for key in any file
+// containing values at or after min.  It returns false if there are none.
+func (f *FileStore) MaxTime(key []byte, min int64) (int64, bool) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	var max int64
+	var ok bool
+	var entries []IndexEntry
+	for _, f := range f.files {
+		if _, fmax := f.TimeRange(); fmax < min || (ok && fmax <= max) {
+			continue
+		}
+
+		entries = f.ReadEntries(key, &entries)
+		if n := len(entries); n > 0 && entries[n-1].MaxTime >= min && (!ok || entries[n-1].MaxTime > max) {
+			max, ok = entries[n-1].MaxTime, true
+		}
+	}
+	return max, ok
+}
+
 func (f *FileStore) Cost(key []byte, min, max int64) query.IteratorCost {
 	f.mu.RLock()
 	defer f.mu.RUnlock()
@@ -672,7 +947,7 @@ func (f *FileStore) replace(oldFiles, newFiles []string, updatedFn func(r []TSMF
 			}
 		}
 
-		tsm, err := NewTSMReader(fd)
+		tsm, err := NewTSMReaderWithKeys(fd, f.keys)
 		if err != nil {
 			return err
 		}
@@ -769,15 +1044,20 @@ func (f *FileStore) replace(oldFiles, newFiles []string, updatedFn func(r []TSMF
 	atomic.StoreInt64(&f.stats.FileCount, int64(len(f.files)))
 
 	// Recalculate the disk size stat
-	var totalSize int64
+	var totalSize, coldSize int64
 	for _, file := range f.files {
-		totalSize += int64(file.Size())
+		size := int64(file.Size())
 		for _, ts := range file.TombstoneFiles() {
-			totalSize += int64(ts.Size)
+			size += int64(ts.Size)
 		}
 
+		totalSize += size
+		if f.isCold(file.Path()) {
+			coldSize += size
+		}
 	}
 	atomic.StoreInt64(&f.stats.DiskBytes, totalSize)
+	atomic.StoreInt64(&f.stats.ColdDiskBytes, coldSize)
 
 	return nil
 }
@@ -876,6 +1156,8 @@ func (f *FileStore) locations(key []byte, t int64, ascending bool) []*location {
 			// then skip it.
 		} else if !ascending && minTime > t {
 			continue
+		} else if !fd.MayContain(key) {
+			continue
 		}
 		tombstones := fd.TombstoneRange(key)
 
@@ -948,14 +1230,21 @@ func (f *FileStore) CreateSnapshot() (string, error) {
 	}
 
 	for _, tsmf := range files {
+		// Files in the cold directory may be on another device, so they are
+		// copied rather than linked.
+		link := os.Link
+		if f.isCold(tsmf.Path()) {
+			link = copyFile
+		}
+
 		newpath := filepath.Join(tmpPath, filepath.Base(tsmf.Path()))
-		if err := os.Link(tsmf.Path(), newpath); err != nil {
+		if err := link(tsmf.Path(), newpath); err != nil {
 			return "", fmt.Errorf("error creating tsm hard link: %q", err)
 		}
 		// Check for tombstones and link those as well
 		for _, tf := range tsmf.TombstoneFiles() {
 			newpath := filepath.Join(tmpPath, filepath.Base(tf.Path))
-			if err := os.Link(tf.Path, newpath); err != nil {
+			if err := link(tf.Path, newpath); err != nil {
 				return "", fmt.Errorf("error creating tombstone hard link: %q", err)
 			}
 		}
@@ -964,6 +1253,38 @@ func (f *FileStore) CreateSnapshot() (string, error) {
 	return tmpPath, nil
 }
 
+// copyFile copies the file at src to dst and syncs it to disk.  The
+// modification time of src is preserved so that moving a file does not
+// appear as a write.
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	fi, err := in.Stat()
+	if err != nil {
+		return err
+	}
+
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_RDWR|os.O_TRUNC, 0666)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	} else if err := out.Sync(); err != nil {
+		out.Close()
+		return err
+	} else if err := out.Close(); err != nil {
+		return err
+	}
+	return os.Chtimes(dst, fi.ModTime(), fi.ModTime())
+}
+
 // ParseTSMFileName parses the generation and sequence from a TSM file name.
 func ParseTSMFileName(name string) (int, int, error) {
 	base := filepath.Base(name)
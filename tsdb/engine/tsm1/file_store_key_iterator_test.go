@@ -155,6 +155,7 @@ func (*mockTSMFile) Entries(key []byte) []IndexEntry
 func (*mockTSMFile) ReadEntries(key []byte, entries *[]IndexEntry) []IndexEntry { panic("implement me") }
 func (*mockTSMFile) ContainsValue(key []byte, t int64) bool                     { panic("implement me") }
 func (*mockTSMFile) Contains(key []byte) bool                                   { panic("implement me") }
+func (*mockTSMFile) MayContain(key []byte) bool                                 { panic("implement me") }
 func (*mockTSMFile) OverlapsTimeRange(min, max int64) bool                      { panic("implement me") }
 func (*mockTSMFile) OverlapsKeyRange(min, max []byte) bool                      { panic("implement me") }
 func (*mockTSMFile) TimeRange() (int64, int64)                                  { panic("implement me") }
@@ -2376,6 +2376,112 @@ func TestFileStore_Open(t *testing.T) {
 	}
 }
 
+func TestFileStore_MoveToCold(t *testing.T) {
+	dir, coldDir := MustTempDir(), MustTempDir()
+	defer os.RemoveAll(dir)
+	defer os.RemoveAll(coldDir)
+
+	data := []keyValues{
+		keyValues{"cpu", []tsm1.Value{tsm1.NewValue(0, 1.0)}},
+		keyValues{"mem", []tsm1.Value{tsm1.NewValue(0, 2.0)}},
+	}
+
+	files, err := newFileDir(dir, data...)
+	if err != nil {
+		fatal(t, "creating test files", err)
+	}
+
+	fs := tsm1.NewFileStore(dir)
+	fs.SetColdDir(coldDir)
+	if err := fs.Open(); err != nil {
+		fatal(t, "opening file store", err)
+	}
+	defer fs.Close()
+
+	size := fs.DiskSizeBytes()
+	if got := fs.ColdDiskSizeBytes(); got != 0 {
+		t.Fatalf("cold size mismatch: got %v, exp 0", got)
+	}
+
+	if err := fs.MoveToCold(files[:1]); err != nil {
+		fatal(t, "moving files", err)
+	}
+
+	if _, err := os.Stat(files[0]); !os.IsNotExist(err) {
+		t.Fatalf("expected hot file to be removed: %v", err)
+	}
+	fi, err := os.Stat(filepath.Join(coldDir, filepath.Base(files[0])))
+	if err != nil {
+		t.Fatalf("expected cold file to exist: %v", err)
+	}
+
+	if got := fs.DiskSizeBytes(); got != size {
+		t.Fatalf("size mismatch: got %v, exp %v", got, size)
+	} else if got, exp := fs.ColdDiskSizeBytes(), fi.Size(); got != exp {
+		t.Fatalf("cold size mismatch: got %v, exp %v", got, exp)
+	}
+
+	// Reopen the file store and ensure the cold file is still read.
+	fs.Close()
+	fs = tsm1.NewFileStore(dir)
+	fs.SetColdDir(coldDir)
+	if err := fs.Open(); err != nil {
+		fatal(t, "opening file store", err)
+	}
+	defer fs.Close()
+
+	if got, exp := fs.Count(), 2; got != exp {
+		t.Fatalf("file count mismatch: got %v, exp %v", got, exp)
+	}
+
+	values, err := fs.Read([]byte("cpu"), 0)
+	if err != nil {
+		t.Fatalf("unexpected error reading values: %v", err)
+	} else if exp := data[0].values; !reflect.DeepEqual(values, exp) {
+		t.Fatalf("read value mismatch: got %v, exp %v", values, exp)
+	}
+}
+
+// Ensure tombstones and bloom filters left in the cold directory by an
+// interrupted move are removed when the store is opened.
+func TestFileStore_Open_ColdLeftovers(t *testing.T) {
+	dir, coldDir := MustTempDir(), MustTempDir()
+	defer os.RemoveAll(dir)
+	defer os.RemoveAll(coldDir)
+
+	files, err := newFileDir(dir, keyValues{"cpu", []tsm1.Value{tsm1.NewValue(0, 1.0)}})
+	if err != nil {
+		fatal(t, "creating test files", err)
+	}
+
+	base := strings.TrimSuffix(filepath.Base(files[0]), "."+tsm1.TSMFileExtension)
+	leftovers := []string{
+		filepath.Join(coldDir, base+".tombstone"),
+		filepath.Join(coldDir, base+"."+tsm1.BloomFileExtension),
+	}
+	for _, path := range leftovers {
+		if err := ioutil.WriteFile(path, []byte("x"), 0666); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	fs := tsm1.NewFileStore(dir)
+	fs.SetColdDir(coldDir)
+	if err := fs.Open(); err != nil {
+		fatal(t, "opening file store", err)
+	}
+	defer fs.Close()
+
+	for _, path := range leftovers {
+		if _, err := os.Stat(path); !os.IsNotExist(err) {
+			t.Fatalf("expected %s to be removed: %v", path, err)
+		}
+	}
+	if got, exp := fs.Count(), 1; got != exp {
+		t.Fatalf("file count mismatch: got %v, exp %v", got, exp)
+	}
+}
+
 func TestFileStore_Remove(t *testing.T) {
 	dir := MustTempDir()
 	defer os.RemoveAll(dir)
@@ -19,8 +19,14 @@ import (
 )
 
 // Note: an uncompressed format is not yet implemented.
-// floatCompressedGorilla is a compressed format using the gorilla paper encoding
-const floatCompressedGorilla = 1
+const (
+	// floatCompressedGorilla is a compressed format using the gorilla paper encoding
+	floatCompressedGorilla = 1
+
+	// floatCompressedDeflate is a compressed format using DEFLATE on the
+	// byte-shuffled values.
+	floatCompressedDeflate = 2
+)
 
 // uvnan is the constant returned from math.NaN().
 const uvnan = 0x7FF8000000000001
@@ -38,6 +44,12 @@ type FloatEncoder struct {
 
 	first    bool
 	finished bool
+
+	// codec is the encoding of the values. Values encoded with DEFLATE are
+	// buffered in raw until Bytes is called.
+	codec    byte
+	raw      []uint64
+	shuffled []byte
 }
 
 // NewFloatEncoder returns a new FloatEncoder.
@@ -45,6 +57,7 @@ func NewFloatEncoder() *FloatEncoder {
 	s := FloatEncoder{
 		first:   true,
 		leading: ^uint64(0),
+		codec:   floatCompressedGorilla,
 	}
 
 	s.bw = bitstream.NewWriter(&s.buf)
@@ -66,15 +79,28 @@ func (s *FloatEncoder) Reset() {
 
 	s.finished = false
 	s.first = true
+	s.raw = s.raw[:0]
 }
 
 // Bytes returns a copy of the underlying byte buffer used in the encoder.
 func (s *FloatEncoder) Bytes() ([]byte, error) {
+	if s.codec == floatCompressedDeflate {
+		if s.err != nil {
+			return nil, s.err
+		}
+		s.shuffled = shuffleFloats(s.shuffled, s.raw)
+		return appendDeflate([]byte{floatCompressedDeflate << 4}, s.shuffled)
+	}
 	return s.buf.Bytes(), s.err
 }
 
 // Flush indicates there are no more values to encode.
 func (s *FloatEncoder) Flush() {
+	if s.codec == floatCompressedDeflate {
+		s.finished = true
+		return
+	}
+
 	if !s.finished {
 		// write an end-of-stream record
 		s.finished = true
@@ -90,6 +116,10 @@ func (s *FloatEncoder) Write(v float64) {
 		s.err = fmt.Errorf("unsupported value: NaN")
 		return
 	}
+	if s.codec == floatCompressedDeflate {
+		s.raw = append(s.raw, math.Float64bits(v))
+		return
+	}
 	if s.first {
 		// first point
 		s.val = v
@@ -151,23 +181,43 @@ type FloatDecoder struct {
 	first    bool
 	finished bool
 
+	// vals holds the values of a DEFLATE encoded block and i is the index of
+	// the current value.
+	deflate  bool
+	vals     []uint64
+	inflated []byte
+	i        int
+
 	err error
 }
 
 // SetBytes initializes the decoder with b. Must call before calling Next().
 func (it *FloatDecoder) SetBytes(b []byte) error {
 	var v uint64
+	it.vals = it.vals[:0]
 	if len(b) == 0 {
 		v = uvnan
 	} else {
 		// first byte is the compression type.
-		// we currently just have gorilla compression.
-		it.br.Reset(b[1:])
+		switch b[0] >> 4 {
+		case floatCompressedGorilla:
+			it.br.Reset(b[1:])
 
-		var err error
-		v, err = it.br.ReadBits(64)
-		if err != nil {
-			return err
+			var err error
+			v, err = it.br.ReadBits(64)
+			if err != nil {
+				return err
+			}
+		case floatCompressedDeflate:
+			var err error
+			if it.inflated, err = inflate(it.inflated, b[1:]); err != nil {
+				return fmt.Errorf("failed to decode float block: %v", err)
+			}
+			if it.vals, err = unshuffleFloats(it.vals, it.inflated); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("unknown float encoding: %v", b[0]>>4)
 		}
 	}
 
@@ -178,6 +228,8 @@ func (it *FloatDecoder) SetBytes(b []byte) error {
 	it.b = b
 	it.first = true
 	it.finished = false
+	it.deflate = len(b) > 0 && b[0]>>4 == floatCompressedDeflate
+	it.i = -1
 	it.err = nil
 
 	return nil
@@ -189,6 +241,16 @@ func (it *FloatDecoder) Next() bool {
 		return false
 	}
 
+	if it.deflate {
+		it.i++
+		if it.i >= len(it.vals) {
+			it.finished = true
+			return false
+		}
+		it.val = it.vals[it.i]
+		return true
+	}
+
 	if it.first {
 		it.first = false
 
@@ -2,8 +2,10 @@ package tsm1
 
 import (
 	"bytes"
+	"crypto/cipher"
 	"encoding/binary"
 	"fmt"
+	"hash/crc32"
 	"io"
 	"math"
 	"os"
@@ -12,7 +14,9 @@ import (
 	"sync"
 	"sync/atomic"
 
+	"github.com/influxdata/influxdb/pkg/bloom"
 	"github.com/influxdata/influxdb/pkg/bytesutil"
+	"github.com/influxdata/influxdb/pkg/keyring"
 )
 
 // ErrFileInUse is returned when attempting to remove or close a TSM file that is still being used.
@@ -38,6 +42,10 @@ type TSMReader struct {
 	// tombstoner ensures tombstoned keys are not available by the index.
 	tombstoner *Tombstoner
 
+	// bloom, if set, excludes keys which are not in the file without
+	// searching the index.
+	bloom *bloom.Filter
+
 	// size is the size of the file on disk.
 	size int64
 
@@ -225,6 +233,12 @@ type blockAccessor interface {
 
 // NewTSMReader returns a new TSMReader from the given file.
 func NewTSMReader(f *os.File) (*TSMReader, error) {
+	return NewTSMReaderWithKeys(f, nil)
+}
+
+// NewTSMReaderWithKeys returns a new TSMReader from the given file.  If the
+// file is encrypted, its data key is decrypted with a master key from keys.
+func NewTSMReaderWithKeys(f *os.File, keys keyring.Provider) (*TSMReader, error) {
 	t := &TSMReader{}
 
 	stat, err := f.Stat()
@@ -234,7 +248,8 @@ func NewTSMReader(f *os.File) (*TSMReader, error) {
 	t.size = stat.Size()
 	t.lastModified = stat.ModTime().UnixNano()
 	t.accessor = &mmapAccessor{
-		f: f,
+		f:    f,
+		keys: keys,
 	}
 
 	index, err := t.accessor.init()
@@ -249,6 +264,10 @@ func NewTSMReader(f *os.File) (*TSMReader, error) {
 		return nil, err
 	}
 
+	// The bloom filter is only an optimization, so the file is still
+	// readable if its filter is missing or invalid.
+	t.bloom, _ = readBloomFilter(t)
+
 	return t, nil
 }
 
@@ -461,6 +480,12 @@ func (t *TSMReader) remove() error {
 	if err := t.tombstoner.Delete(); err != nil {
 		return err
 	}
+
+	if path != "" {
+		if err := os.Remove(BloomFilterPath(path)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -469,6 +494,22 @@ func (t *TSMReader) Contains(key []byte) bool {
 	return t.index.Contains(key)
 }
 
+// MayContain returns false if the bloom filter of the file excludes key.  It
+// returns true if the key might be in the file, or if the file has no filter.
+func (t *TSMReader) MayContain(key []byte) bool {
+	if t.bloom == nil {
+		return true
+	}
+
+	// The filter temporarily modifies the key while hashing it, so it
+	// is copied to a pooled buffer in case it is shared or read-only.
+	buf := getBuf(len(key))
+	copy(*buf, key)
+	ok := t.bloom.Contains(*buf)
+	putBuf(buf)
+	return ok
+}
+
 // ContainsValue returns true if key and time might exists in this file.  This function could
 // return true even though the actual point does not exist.  For example, the key may
 // exist in this file, but not have a point exactly at time t.
@@ -1339,6 +1380,11 @@ type mmapAccessor struct {
 	f     *os.File
 	b     []byte
 	index *indirectIndex
+
+	// keys provides the master key of encrypted files.  aead is set to a
+	// cipher for the data key of the file if it is encrypted.
+	keys keyring.Provider
+	aead cipher.AEAD
 }
 
 func (m *mmapAccessor) init() (*indirectIndex, error) {
@@ -1374,8 +1420,28 @@ func (m *mmapAccessor) init() (*indirectIndex, error) {
 		return nil, fmt.Errorf("mmapAccessor: invalid indexStart")
 	}
 
+	// The index of encrypted files is decrypted into memory.
+	index := m.b[indexStart:indexOfsPos]
+	if m.b[4] == EncryptedVersion {
+		if len(m.b) < tsmEnvelopeOffset+envelopeSize {
+			return nil, fmt.Errorf("mmapAccessor: byte slice too small for envelope")
+		}
+
+		var env envelope
+		if err := env.UnmarshalBinary(m.b[tsmEnvelopeOffset:]); err != nil {
+			return nil, err
+		}
+		if m.aead, err = env.cipher(m.keys); err != nil {
+			return nil, err
+		}
+
+		if index, err = open(m.aead, nil, index, offsetData(int64(indexStart))); err != nil {
+			return nil, fmt.Errorf("mmapAccessor: decrypt index: %v", err)
+		}
+	}
+
 	m.index = NewIndirectIndex()
-	if err := m.index.UnmarshalBinary(m.b[indexStart:indexOfsPos]); err != nil {
+	if err := m.index.UnmarshalBinary(index); err != nil {
 		return nil, err
 	}
 
@@ -1473,8 +1539,11 @@ func (m *mmapAccessor) readBlock(entry *IndexEntry, values []Value) ([]Value, er
 		return nil, ErrTSMClosed
 	}
 	//TODO: Validate checksum
-	var err error
-	values, err = DecodeBlock(m.b[entry.Offset+4:entry.Offset+int64(entry.Size)], values)
+	b, err := m.blockData(entry)
+	if err != nil {
+		return nil, err
+	}
+	values, err = DecodeBlock(b, values)
 	if err != nil {
 		return nil, err
 	}
@@ -1491,7 +1560,13 @@ func (m *mmapAccessor) readFloatBlock(entry *IndexEntry, values *[]FloatValue) (
 		return nil, ErrTSMClosed
 	}
 
-	a, err := DecodeFloatBlock(m.b[entry.Offset+4:entry.Offset+int64(entry.Size)], values)
+	b, err := m.blockData(entry)
+	if err != nil {
+		m.mu.RUnlock()
+		return nil, err
+	}
+
+	a, err := DecodeFloatBlock(b, values)
 	m.mu.RUnlock()
 
 	if err != nil {
@@ -1510,7 +1585,13 @@ func (m *mmapAccessor) readIntegerBlock(entry *IndexEntry, values *[]IntegerValu
 		return nil, ErrTSMClosed
 	}
 
-	a, err := DecodeIntegerBlock(m.b[entry.Offset+4:entry.Offset+int64(entry.Size)], values)
+	b, err := m.blockData(entry)
+	if err != nil {
+		m.mu.RUnlock()
+		return nil, err
+	}
+
+	a, err := DecodeIntegerBlock(b, values)
 	m.mu.RUnlock()
 
 	if err != nil {
@@ -1529,7 +1610,13 @@ func (m *mmapAccessor) readUnsignedBlock(entry *IndexEntry, values *[]UnsignedVa
 		return nil, ErrTSMClosed
 	}
 
-	a, err := DecodeUnsignedBlock(m.b[entry.Offset+4:entry.Offset+int64(entry.Size)], values)
+	b, err := m.blockData(entry)
+	if err != nil {
+		m.mu.RUnlock()
+		return nil, err
+	}
+
+	a, err := DecodeUnsignedBlock(b, values)
 	m.mu.RUnlock()
 
 	if err != nil {
@@ -1548,7 +1635,13 @@ func (m *mmapAccessor) readStringBlock(entry *IndexEntry, values *[]StringValue)
 		return nil, ErrTSMClosed
 	}
 
-	a, err := DecodeStringBlock(m.b[entry.Offset+4:entry.Offset+int64(entry.Size)], values)
+	b, err := m.blockData(entry)
+	if err != nil {
+		m.mu.RUnlock()
+		return nil, err
+	}
+
+	a, err := DecodeStringBlock(b, values)
 	m.mu.RUnlock()
 
 	if err != nil {
@@ -1567,7 +1660,13 @@ func (m *mmapAccessor) readBooleanBlock(entry *IndexEntry, values *[]BooleanValu
 		return nil, ErrTSMClosed
 	}
 
-	a, err := DecodeBooleanBlock(m.b[entry.Offset+4:entry.Offset+int64(entry.Size)], values)
+	b, err := m.blockData(entry)
+	if err != nil {
+		m.mu.RUnlock()
+		return nil, err
+	}
+
+	a, err := DecodeBooleanBlock(b, values)
 	m.mu.RUnlock()
 
 	if err != nil {
@@ -1588,9 +1687,29 @@ func (m *mmapAccessor) readBytes(entry *IndexEntry, b []byte) (uint32, []byte, e
 
 	// return the bytes after the 4 byte checksum
 	crc, block := binary.BigEndian.Uint32(m.b[entry.Offset:entry.Offset+4]), m.b[entry.Offset+4:entry.Offset+int64(entry.Size)]
+	if m.aead == nil {
+		m.mu.RUnlock()
+		return crc, block, nil
+	}
+
+	// The checksum of encrypted blocks covers the sealed data, so return the
+	// checksum of the decrypted block instead.
+	block, err := open(m.aead, nil, block, offsetData(entry.Offset))
 	m.mu.RUnlock()
+	if err != nil {
+		return 0, nil, err
+	}
+	return crc32.ChecksumIEEE(block), block, nil
+}
 
-	return crc, block, nil
+// blockData returns the data of the block at entry, decrypting it if the file is
+// encrypted.  m.mu must be held.
+func (m *mmapAccessor) blockData(entry *IndexEntry) ([]byte, error) {
+	b := m.b[entry.Offset+4 : entry.Offset+int64(entry.Size)]
+	if m.aead == nil {
+		return b, nil
+	}
+	return open(m.aead, nil, b, offsetData(entry.Offset))
 }
 
 // readAll returns all values for a key in all blocks.
@@ -1608,7 +1727,6 @@ func (m *mmapAccessor) readAll(key []byte) ([]Value, error) {
 	defer m.mu.RUnlock()
 
 	var temp []Value
-	var err error
 	var values []Value
 	for _, block := range blocks {
 		var skip bool
@@ -1624,9 +1742,13 @@ func (m *mmapAccessor) readAll(key []byte) ([]Value, error) {
 			continue
 		}
 		//TODO: Validate checksum
+		b, err := m.blockData(&block)
+		if err != nil {
+			return nil, err
+		}
+
 		temp = temp[:0]
-		// The +4 is the 4 byte checksum length
-		temp, err = DecodeBlock(m.b[block.Offset+4:block.Offset+int64(block.Size)], temp)
+		temp, err = DecodeBlock(b, temp)
 		if err != nil {
 			return nil, err
 		}
@@ -0,0 +1,454 @@
+package tsm1
+
+// Rollups downsample the data of a shard into another retention policy.  The
+// values of each series are aggregated over fixed windows of time as the blocks
+// of a full compaction are read, so the data is not read through the query
+// engine.  Keys are read in sorted order, so every field of a series is read
+// before the next series and the aggregates of a series can be written as soon
+// as the next series is read.
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/influxdata/influxdb/logger"
+	"github.com/influxdata/influxdb/models"
+	"github.com/influxdata/influxdb/tsdb"
+	"go.uber.org/zap"
+)
+
+const (
+	// RollupFileName is the name of the file recording the TSM files which
+	// rollups were last computed from.
+	RollupFileName = "rollup"
+
+	// rollupBatchSize is the number of points written by rollups at once.
+	rollupBatchSize = 5000
+)
+
+// rollup computes the aggregates of a single rollup configuration.
+type rollup struct {
+	database        string
+	retentionPolicy string
+	interval        int64
+	funcs           []string
+	writer          tsdb.PointsWriter
+
+	// seriesKey is the series being aggregated and windows the aggregates of
+	// each of its fields, keyed by the start of each window.
+	seriesKey []byte
+	windows   map[int64]map[string]*rollupAggregate
+
+	points []models.Point
+	buf    []Value
+	err    error
+}
+
+func newRollup(c tsdb.RollupConfig, w tsdb.PointsWriter) *rollup {
+	return &rollup{
+		database:        c.Database,
+		retentionPolicy: c.TargetRetentionPolicy,
+		interval:        int64(time.Duration(c.Interval)),
+		funcs:           c.Functions,
+		writer:          w,
+		windows:         make(map[int64]map[string]*rollupAggregate),
+	}
+}
+
+// add aggregates the values of a block of key.
+func (r *rollup) add(key, block []byte) {
+	if r.err != nil {
+		return
+	}
+
+	seriesKey, field := SeriesAndFieldFromCompositeKey(key)
+	if !bytes.Equal(seriesKey, r.seriesKey) {
+		if r.err = r.flushSeries(); r.err != nil {
+			return
+		}
+		r.seriesKey = append(r.seriesKey[:0], seriesKey...)
+	}
+
+	if r.buf, r.err = DecodeBlock(block, r.buf); r.err != nil {
+		return
+	}
+
+	for _, v := range r.buf {
+		t := v.UnixNano()
+		window := t - t%r.interval
+		if t%r.interval < 0 {
+			window -= r.interval
+		}
+
+		fields := r.windows[window]
+		if fields == nil {
+			fields = make(map[string]*rollupAggregate)
+			r.windows[window] = fields
+		}
+		agg := fields[string(field)]
+		if agg == nil {
+			agg = &rollupAggregate{}
+			fields[string(field)] = agg
+		}
+		agg.add(v)
+	}
+}
+
+// flush writes the aggregates of the current series and any points not yet
+// written.  It returns the first error encountered by the rollup.
+func (r *rollup) flush() error {
+	if r.err != nil {
+		return r.err
+	}
+
+	if r.err = r.flushSeries(); r.err != nil {
+		return r.err
+	}
+	r.err = r.write()
+	return r.err
+}
+
+// flushSeries converts the aggregates of the current series to points.
+func (r *rollup) flushSeries() error {
+	if len(r.windows) == 0 {
+		return nil
+	}
+
+	name, tags := models.ParseKeyBytes(r.seriesKey)
+	windows := make([]int64, 0, len(r.windows))
+	for window := range r.windows {
+		windows = append(windows, window)
+	}
+	sort.Slice(windows, func(i, j int) bool { return windows[i] < windows[j] })
+
+	for _, window := range windows {
+		fields := make(models.Fields)
+		for field, agg := range r.windows[window] {
+			for _, fn := range r.funcs {
+				if v := agg.value(fn); v != nil {
+					fields[fn+"_"+field] = v
+				}
+			}
+		}
+		delete(r.windows, window)
+
+		if len(fields) == 0 {
+			continue
+		}
+		pt, err := models.NewPoint(string(name), tags, fields, time.Unix(0, window))
+		if err != nil {
+			return err
+		}
+		r.points = append(r.points, pt)
+	}
+
+	if len(r.points) >= rollupBatchSize {
+		return r.write()
+	}
+	return nil
+}
+
+// write writes the pending points to the target retention policy.
+func (r *rollup) write() error {
+	if len(r.points) == 0 {
+		return nil
+	}
+
+	err := r.writer.WritePointsPrivileged(r.database, r.retentionPolicy, models.ConsistencyLevelAny, r.points)
+	r.points = r.points[:0]
+
+	// Points outside of the target retention policy are dropped.
+	if _, ok := err.(tsdb.PartialWriteError); ok {
+		return nil
+	}
+	return err
+}
+
+// rollupAggregate holds the aggregates of the values of a field in a window.
+type rollupAggregate struct {
+	count int64
+	typ   byte
+
+	floatSum, floatMin, floatMax          float64
+	integerSum, integerMin, integerMax    int64
+	unsignedSum, unsignedMin, unsignedMax uint64
+}
+
+func (a *rollupAggregate) add(v Value) {
+	first := a.count == 0
+	a.count++
+
+	switch v := v.(type) {
+	case FloatValue:
+		a.typ = BlockFloat64
+		a.floatSum += v.value
+		if first || v.value < a.floatMin {
+			a.floatMin = v.value
+		}
+		if first || v.value > a.floatMax {
+			a.floatMax = v.value
+		}
+	case IntegerValue:
+		a.typ = BlockInteger
+		a.integerSum += v.value
+		if first || v.value < a.integerMin {
+			a.integerMin = v.value
+		}
+		if first || v.value > a.integerMax {
+			a.integerMax = v.value
+		}
+	case UnsignedValue:
+		a.typ = BlockUnsigned
+		a.unsignedSum += v.value
+		if first || v.value < a.unsignedMin {
+			a.unsignedMin = v.value
+		}
+		if first || v.value > a.unsignedMax {
+			a.unsignedMax = v.value
+		}
+	case BooleanValue:
+		a.typ = BlockBoolean
+	case StringValue:
+		a.typ = BlockString
+	}
+}
+
+// value returns the result of the rollup function fn, or nil if fn does not
+// apply to the type of the field.
+func (a *rollupAggregate) value(fn string) interface{} {
+	if fn == tsdb.RollupCount {
+		return a.count
+	}
+
+	switch a.typ {
+	case BlockFloat64:
+		switch fn {
+		case tsdb.RollupMin:
+			return a.floatMin
+		case tsdb.RollupMax:
+			return a.floatMax
+		case tsdb.RollupSum:
+			return a.floatSum
+		case tsdb.RollupMean:
+			return a.floatSum / float64(a.count)
+		}
+	case BlockInteger:
+		switch fn {
+		case tsdb.RollupMin:
+			return a.integerMin
+		case tsdb.RollupMax:
+			return a.integerMax
+		case tsdb.RollupSum:
+			return a.integerSum
+		case tsdb.RollupMean:
+			return float64(a.integerSum) / float64(a.count)
+		}
+	case BlockUnsigned:
+		switch fn {
+		case tsdb.RollupMin:
+			return a.unsignedMin
+		case tsdb.RollupMax:
+			return a.unsignedMax
+		case tsdb.RollupSum:
+			return a.unsignedSum
+		case tsdb.RollupMean:
+			return float64(a.unsignedSum) / float64(a.count)
+		}
+	}
+	return nil
+}
+
+// rollupKeyIterator computes rollups from the blocks read from a KeyIterator.
+type rollupKeyIterator struct {
+	KeyIterator
+	rollups []*rollup
+}
+
+func newRollupKeyIterator(iter KeyIterator, rollups []*rollup) KeyIterator {
+	return &rollupKeyIterator{KeyIterator: iter, rollups: rollups}
+}
+
+func (k *rollupKeyIterator) Read() ([]byte, int64, int64, []byte, error) {
+	key, minTime, maxTime, block, err := k.KeyIterator.Read()
+	if err == nil {
+		for _, r := range k.rollups {
+			r.add(key, block)
+		}
+	}
+	return key, minTime, maxTime, block, err
+}
+
+// newRollups returns the rollups of the engine's retention policy, or nil if
+// there are none.
+func (e *Engine) newRollups() []*rollup {
+	if e.rollupWriter == nil {
+		return nil
+	}
+
+	var rollups []*rollup
+	for _, c := range e.rollupConfigs {
+		rollups = append(rollups, newRollup(c, e.rollupWriter))
+	}
+	return rollups
+}
+
+// rollupPending returns true if the shard is cold and the rollups have not
+// been computed from its current TSM files.
+func (e *Engine) rollupPending() bool {
+	if len(e.rollupConfigs) == 0 || e.rollupWriter == nil {
+		return false
+	} else if time.Since(e.LastModified()) < e.rollupColdDuration {
+		return false
+	}
+
+	files := e.rollupFileNames()
+
+	e.rollupMu.Lock()
+	defer e.rollupMu.Unlock()
+	return len(files) > 0 && files != e.rolledUpFiles
+}
+
+// rollupFileNames returns the names of the current TSM files of the shard.
+// The names of files do not change when they are moved to the cold directory.
+func (e *Engine) rollupFileNames() string {
+	var names []string
+	for _, f := range e.FileStore.Files() {
+		names = append(names, filepath.Base(f.Path()))
+	}
+	sort.Strings(names)
+	return strings.Join(names, "\n")
+}
+
+// loadRolledUpFiles reads the names of the TSM files which rollups were last
+// computed from.
+func (e *Engine) loadRolledUpFiles() error {
+	buf, err := ioutil.ReadFile(filepath.Join(e.path, RollupFileName))
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	e.rollupMu.Lock()
+	e.rolledUpFiles = string(buf)
+	e.rollupMu.Unlock()
+	return nil
+}
+
+// markRolledUp records that rollups were computed from the TSM files given by names.
+func (e *Engine) markRolledUp(names string) error {
+	path := filepath.Join(e.path, RollupFileName)
+	tmpPath := path + "." + TmpTSMFileExtension
+	if err := ioutil.WriteFile(tmpPath, []byte(names), 0666); err != nil {
+		return err
+	} else if err := os.Rename(tmpPath, path); err != nil {
+		return err
+	}
+
+	e.rollupMu.Lock()
+	e.rolledUpFiles = names
+	e.rollupMu.Unlock()
+	return nil
+}
+
+// finishRollups writes the remaining points of rollups computed by the full
+// compaction which wrote files, and records that they were computed from files.
+func (e *Engine) finishRollups(rollups []*rollup, files []string, log *zap.Logger) {
+	for _, r := range rollups {
+		if err := r.flush(); err != nil {
+			log.Warn("Error computing rollups", zap.Error(err))
+			return
+		}
+	}
+
+	// The compacted files have been renamed to remove their temporary extension.
+	names := make([]string, 0, len(files))
+	for _, f := range files {
+		names = append(names, strings.TrimSuffix(filepath.Base(f), "."+TmpTSMFileExtension))
+	}
+	sort.Strings(names)
+
+	if err := e.markRolledUp(strings.Join(names, "\n")); err != nil {
+		log.Warn("Error recording rollups", zap.Error(err))
+	}
+}
+
+// coversAllFiles returns true if group holds every TSM file of the shard.
+func (e *Engine) coversAllFiles(group CompactionGroup) bool {
+	files := e.FileStore.Files()
+	if len(files) != len(group) {
+		return false
+	}
+
+	paths := make(map[string]struct{}, len(group))
+	for _, f := range group {
+		paths[f] = struct{}{}
+	}
+	for _, f := range files {
+		if _, ok := paths[f.Path()]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// rollupShard computes the rollups from all of the TSM files of the shard.  It
+// is used when the shard became cold without requiring a full compaction, such
+// as when it only has a single generation of files.  It must only be called
+// from the compaction goroutine while no compactions are running.
+func (e *Engine) rollupShard(interrupt chan struct{}) {
+	if time.Now().Before(e.rollupRetryTime) || !e.rollupPending() {
+		return
+	}
+
+	log, logEnd := logger.NewOperation(e.logger, "TSM rollup", "tsm1_rollup")
+	defer logEnd()
+
+	err := e.rollupFiles(interrupt)
+	if _, ok := err.(errCompactionAborted); ok {
+		log.Info("Aborted rollup")
+	} else if err != nil {
+		log.Warn("Error computing rollups", zap.Error(err))
+		e.rollupRetryTime = time.Now().Add(time.Minute)
+	}
+}
+
+// rollupFiles reads every TSM file of the shard and computes the rollups.
+func (e *Engine) rollupFiles(interrupt chan struct{}) error {
+	files := e.FileStore.refFiles()
+	readers := make([]*TSMReader, 0, len(files))
+	names := make([]string, 0, len(files))
+	for _, f := range files {
+		defer f.Unref()
+		readers = append(readers, f.(*TSMReader))
+		names = append(names, filepath.Base(f.Path()))
+	}
+	sort.Strings(names)
+
+	iter, err := newTSMKeyIterator(tsdb.DefaultMaxPointsPerBlock, false, BlockCodecs{}, interrupt, readers...)
+	if err != nil {
+		return err
+	}
+
+	rollups := e.newRollups()
+	iter = newRollupKeyIterator(iter, rollups)
+	for iter.Next() {
+		if _, _, _, _, err := iter.Read(); err != nil {
+			return err
+		}
+	}
+	if err := iter.Err(); err != nil {
+		return err
+	}
+
+	for _, r := range rollups {
+		if err := r.flush(); err != nil {
+			return err
+		}
+	}
+	return e.markRolledUp(strings.Join(names, "\n"))
+}
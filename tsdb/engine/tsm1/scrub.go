@@ -0,0 +1,105 @@
+package tsm1
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"hash/crc32"
+
+	"github.com/influxdata/influxdb/pkg/limiter"
+	"github.com/influxdata/influxdb/tsdb"
+	"go.uber.org/zap"
+)
+
+// scrubWaitSize is the largest number of bytes waited for at once on the
+// scrub rate limiter.
+const scrubWaitSize = 1024 * 1024 // 1MB
+
+// Scrub verifies the checksum of every block in each TSM file and that the
+// index of each file is consistent with its blocks.  Files which fail
+// verification are quarantined.  Reads are limited to the rate of lim, if set.
+func (e *Engine) Scrub(ctx context.Context, lim limiter.Rate) (tsdb.ScrubReport, error) {
+	var report tsdb.ScrubReport
+	var paths []string
+	for _, f := range e.FileStore.Files() {
+		paths = append(paths, f.Path())
+	}
+
+	// Files are referenced one at a time, skipping files replaced by
+	// compactions since the scrub started, so they can be removed while
+	// the scrub is running.
+	for _, path := range paths {
+		f := e.FileStore.refFile(path)
+		if f == nil {
+			continue
+		}
+		blockN, byteN, corruptErr, err := verifyTSMFile(ctx, f, lim)
+		f.Unref()
+
+		report.FilesN++
+		report.BlocksN += blockN
+		report.BytesN += byteN
+		if err != nil {
+			return report, err
+		} else if corruptErr == nil {
+			continue
+		}
+
+		if err := e.FileStore.Quarantine(path); err != nil {
+			e.logger.Warn("Error quarantining corrupt file", zap.String("path", path), zap.Error(err))
+		}
+		report.Corrupt = append(report.Corrupt, tsdb.CorruptFile{Path: path, Err: corruptErr})
+	}
+	return report, nil
+}
+
+// verifyTSMFile reads every block in f, returning the number of blocks and
+// bytes read.  corruptErr describes the first inconsistency found in the file.
+// err is only returned if verification could not complete.
+func verifyTSMFile(ctx context.Context, f TSMFile, lim limiter.Rate) (blockN int, byteN int64, corruptErr, err error) {
+	var prevKey []byte
+	itr := f.BlockIterator()
+	for itr.Next() {
+		if err := ctx.Err(); err != nil {
+			return blockN, byteN, nil, err
+		}
+
+		key, minTime, maxTime, typ, checksum, buf, err := itr.Read()
+		if err != nil {
+			return blockN, byteN, fmt.Errorf("block %d: %s", blockN, err), nil
+		}
+		blockN++
+		byteN += int64(len(buf))
+
+		// Wait in chunks no larger than the minimum burst of the limiter.
+		for n := len(buf); lim != nil && n > 0; n -= scrubWaitSize {
+			waitN := n
+			if waitN > scrubWaitSize {
+				waitN = scrubWaitSize
+			}
+			if err := lim.WaitN(ctx, waitN); err != nil {
+				return blockN, byteN, nil, err
+			}
+		}
+
+		if exp := crc32.ChecksumIEEE(buf); checksum != exp {
+			return blockN, byteN, fmt.Errorf("block %d of key %q: got checksum %d but expected %d", blockN-1, key, checksum, exp), nil
+		} else if bytes.Compare(key, prevKey) < 0 {
+			return blockN, byteN, fmt.Errorf("block %d: key %q is out of order", blockN-1, key), nil
+		} else if minTime > maxTime {
+			return blockN, byteN, fmt.Errorf("block %d of key %q: min time %d after max time %d", blockN-1, key, minTime, maxTime), nil
+		} else if len(buf) == 0 {
+			return blockN, byteN, fmt.Errorf("block %d of key %q: empty block", blockN-1, key), nil
+		} else if blockType, err := BlockType(buf); err != nil {
+			return blockN, byteN, fmt.Errorf("block %d of key %q: %s", blockN-1, key, err), nil
+		} else if blockType != typ {
+			return blockN, byteN, fmt.Errorf("block %d of key %q: block type %d does not match index type %d", blockN-1, key, blockType, typ), nil
+		}
+		prevKey = append(prevKey[:0], key...)
+	}
+
+	if err := itr.Err(); err != nil {
+		return blockN, byteN, err, nil
+	}
+	return blockN, byteN, nil, nil
+}
@@ -4,6 +4,10 @@ package tsm1
 // appended to byte slice prefixed with a variable byte length followed by the string
 // bytes.  The bytes are compressed using snappy compressor and a 1 byte header is used
 // to indicate the type of encoding.
+//
+// The bytes may instead be compressed using DEFLATE, or replaced by a dictionary of the
+// distinct strings followed by the dictionary index of each string, which is then
+// compressed using snappy.
 
 import (
 	"encoding/binary"
@@ -14,19 +18,31 @@ import (
 
 // Note: an uncompressed format is not yet implemented.
 
-// stringCompressedSnappy is a compressed encoding using Snappy compression
-const stringCompressedSnappy = 1
+const (
+	// stringCompressedSnappy is a compressed encoding using Snappy compression
+	stringCompressedSnappy = 1
+
+	// stringCompressedDeflate is a compressed encoding using DEFLATE compression
+	stringCompressedDeflate = 2
+
+	// stringCompressedDictionary is a dictionary encoding using Snappy compression
+	stringCompressedDictionary = 3
+)
 
 // StringEncoder encodes multiple strings into a byte slice.
 type StringEncoder struct {
 	// The encoded bytes
 	bytes []byte
+
+	// codec is the compression applied to the encoded bytes.
+	codec byte
 }
 
 // NewStringEncoder returns a new StringEncoder with an initial buffer ready to hold sz bytes.
 func NewStringEncoder(sz int) StringEncoder {
 	return StringEncoder{
 		bytes: make([]byte, 0, sz),
+		codec: stringCompressedSnappy,
 	}
 }
 
@@ -51,6 +67,18 @@ func (e *StringEncoder) Write(s string) {
 
 // Bytes returns a copy of the underlying buffer.
 func (e *StringEncoder) Bytes() ([]byte, error) {
+	switch e.codec {
+	case stringCompressedDeflate:
+		return appendDeflate([]byte{stringCompressedDeflate << 4}, e.bytes)
+	case stringCompressedDictionary:
+		if dict, ok, err := encodeStringDictionary(e.bytes); err != nil {
+			return nil, err
+		} else if ok {
+			data := snappy.Encode(nil, dict)
+			return append([]byte{stringCompressedDictionary << 4}, data...), nil
+		}
+	}
+
 	// Compress the currently appended bytes using snappy and prefix with
 	// a 1 byte header for future extension
 	data := snappy.Encode(nil, e.bytes)
@@ -68,12 +96,23 @@ type StringDecoder struct {
 // SetBytes initializes the decoder with bytes to read from.
 // This must be called before calling any other method.
 func (e *StringDecoder) SetBytes(b []byte) error {
-	// First byte stores the encoding type, only have snappy format
-	// currently so ignore for now.
+	// First byte stores the encoding type. Each encoding is decoded back to
+	// the length prefixed strings.
 	var data []byte
 	if len(b) > 0 {
 		var err error
-		data, err = snappy.Decode(nil, b[1:])
+		switch b[0] >> 4 {
+		case stringCompressedSnappy:
+			data, err = snappy.Decode(nil, b[1:])
+		case stringCompressedDeflate:
+			data, err = inflate(nil, b[1:])
+		case stringCompressedDictionary:
+			if data, err = snappy.Decode(nil, b[1:]); err == nil {
+				data, err = decodeStringDictionary(nil, data)
+			}
+		default:
+			err = fmt.Errorf("unknown string encoding: %v", b[0]>>4)
+		}
 		if err != nil {
 			return fmt.Errorf("failed to decode string block: %v", err.Error())
 		}
@@ -126,6 +126,73 @@ func Test_StringEncoder_Quick(t *testing.T) {
 	}, nil)
 }
 
+func Test_StringEncoder_Codecs_Quick(t *testing.T) {
+	for _, codec := range []byte{stringCompressedDeflate, stringCompressedDictionary} {
+		quick.Check(func(values []string) bool {
+			expected := values
+			if values == nil {
+				expected = []string{}
+			}
+
+			enc := NewStringEncoder(1024)
+			enc.codec = codec
+			for _, v := range values {
+				enc.Write(v)
+			}
+
+			buf, err := enc.Bytes()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got := make([]string, 0, len(values))
+			var dec StringDecoder
+			if err := dec.SetBytes(buf); err != nil {
+				t.Fatal(err)
+			}
+			for dec.Next() {
+				if err := dec.Error(); err != nil {
+					t.Fatal(err)
+				}
+				got = append(got, dec.Read())
+			}
+
+			if !reflect.DeepEqual(expected, got) {
+				t.Fatalf("mismatch (codec %d):\n\nexp=%#v\n\ngot=%#v\n\n", codec, expected, got)
+			}
+
+			return true
+		}, nil)
+	}
+}
+
+func Test_StringEncoder_Dictionary(t *testing.T) {
+	enc := NewStringEncoder(1024)
+	enc.codec = stringCompressedDictionary
+	for i := 0; i < 100; i++ {
+		enc.Write(fmt.Sprintf("host-%d", i%4))
+	}
+
+	b, err := enc.Bytes()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	} else if b[0]>>4 != stringCompressedDictionary {
+		t.Fatalf("unexpected encoding: got %v, exp %v", b[0]>>4, stringCompressedDictionary)
+	}
+
+	// Mostly distinct strings fall back to snappy.
+	enc.Reset()
+	for i := 0; i < 100; i++ {
+		enc.Write(fmt.Sprintf("host-%d", i))
+	}
+
+	if b, err = enc.Bytes(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	} else if b[0]>>4 != stringCompressedSnappy {
+		t.Fatalf("unexpected encoding: got %v, exp %v", b[0]>>4, stringCompressedSnappy)
+	}
+}
+
 func Test_StringDecoder_Empty(t *testing.T) {
 	var dec StringDecoder
 	if err := dec.SetBytes([]byte{}); err != nil {
@@ -175,3 +242,19 @@ func Test_StringDecoder_CorruptSetBytes(t *testing.T) {
 		}
 	}
 }
+
+func Test_StringDecoder_UnknownEncoding(t *testing.T) {
+	enc := NewStringEncoder(1024)
+	enc.Write("v1")
+	b, err := enc.Bytes()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// A block of snappy data with an unknown encoding must not be decoded as snappy.
+	b[0] = 15 << 4
+	var dec StringDecoder
+	if err := dec.SetBytes(b); err == nil {
+		t.Fatal("exp an err, got nil")
+	}
+}
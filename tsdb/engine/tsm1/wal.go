@@ -3,6 +3,7 @@ package tsm1
 import (
 	"bufio"
 	"bytes"
+	"crypto/cipher"
 	"encoding/binary"
 	"fmt"
 	"io"
@@ -19,6 +20,7 @@ import (
 
 	"github.com/golang/snappy"
 	"github.com/influxdata/influxdb/models"
+	"github.com/influxdata/influxdb/pkg/keyring"
 	"github.com/influxdata/influxdb/pkg/limiter"
 	"github.com/influxdata/influxdb/pkg/pool"
 	"go.uber.org/zap"
@@ -114,6 +116,10 @@ type WAL struct {
 	// statistics for the WAL
 	stats   *WALStatistics
 	limiter limiter.Fixed
+
+	// keys provides the master key for new segments.  If nil, new segments
+	// are not encrypted.
+	keys keyring.Provider
 }
 
 // NewWAL initializes a new WAL at the given directory.
@@ -179,6 +185,12 @@ func (l *WAL) Path() string {
 	return l.path
 }
 
+// SetKeyProvider sets the provider of the master key used to encrypt new
+// segments.  It must be called before the WAL is opened.
+func (l *WAL) SetKeyProvider(keys keyring.Provider) {
+	l.keys = keys
+}
+
 // Open opens and initializes the Log. Open can recover from previous unclosed shutdowns.
 func (l *WAL) Open() error {
 	l.mu.Lock()
@@ -217,13 +229,26 @@ func (l *WAL) Open() error {
 			if err != nil {
 				return err
 			}
-			if _, err := fd.Seek(0, io.SeekEnd); err != nil {
+
+			// The data key of an encrypted segment is not kept, so the next write
+			// starts a new segment if the last one is encrypted or should be.
+			_, encrypted, err := envelopeOffset(fd)
+			if err != nil {
+				fd.Close()
 				return err
 			}
-			l.currentSegmentWriter = NewWALSegmentWriter(fd)
 
-			// Reset the current segment size stat
-			atomic.StoreInt64(&l.stats.CurrentBytes, stat.Size())
+			if encrypted || l.keys != nil {
+				fd.Close()
+			} else {
+				if _, err := fd.Seek(0, io.SeekEnd); err != nil {
+					return err
+				}
+				l.currentSegmentWriter = NewWALSegmentWriter(fd)
+
+				// Reset the current segment size stat
+				atomic.StoreInt64(&l.stats.CurrentBytes, stat.Size())
+			}
 		}
 	}
 
@@ -572,7 +597,15 @@ func (l *WAL) newSegmentFile() error {
 	if err != nil {
 		return err
 	}
-	l.currentSegmentWriter = NewWALSegmentWriter(fd)
+
+	if l.keys != nil {
+		if l.currentSegmentWriter, err = NewWALSegmentWriterWithKeys(fd, l.keys); err != nil {
+			fd.Close()
+			return err
+		}
+	} else {
+		l.currentSegmentWriter = NewWALSegmentWriter(fd)
+	}
 
 	// Reset the current segment size stat
 	atomic.StoreInt64(&l.stats.CurrentBytes, 0)
@@ -1040,6 +1073,10 @@ type WALSegmentWriter struct {
 	bw   *bufio.Writer
 	w    io.WriteCloser
 	size int
+
+	// aead is set if the segment is encrypted.  buf holds sealed entries.
+	aead cipher.AEAD
+	buf  []byte
 }
 
 // NewWALSegmentWriter returns a new WALSegmentWriter writing to w.
@@ -1050,6 +1087,34 @@ func NewWALSegmentWriter(w io.WriteCloser) *WALSegmentWriter {
 	}
 }
 
+// NewWALSegmentWriterWithKeys returns a new WALSegmentWriter writing an encrypted
+// segment to w, which must be empty.  The segment is encrypted with a new data
+// key sealed by the active key of keys.
+func NewWALSegmentWriterWithKeys(w io.WriteCloser, keys keyring.Provider) (*WALSegmentWriter, error) {
+	env, aead, err := newEnvelope(keys)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := env.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	var hdr [walEnvelopeOffset]byte
+	binary.BigEndian.PutUint32(hdr[:], walMagicNumber)
+
+	sw := NewWALSegmentWriter(w)
+	sw.aead = aead
+	if _, err := sw.bw.Write(hdr[:]); err != nil {
+		return nil, err
+	} else if _, err := sw.bw.Write(b); err != nil {
+		return nil, err
+	}
+	sw.size = len(hdr) + len(b)
+	return sw, nil
+}
+
 func (w *WALSegmentWriter) path() string {
 	if f, ok := w.w.(*os.File); ok {
 		return f.Name()
@@ -1059,6 +1124,14 @@ func (w *WALSegmentWriter) path() string {
 
 // Write writes entryType and the buffer containing compressed entry data.
 func (w *WALSegmentWriter) Write(entryType WalEntryType, compressed []byte) error {
+	if w.aead != nil {
+		var err error
+		if w.buf, err = seal(w.aead, w.buf[:0], compressed, []byte{byte(entryType)}); err != nil {
+			return err
+		}
+		compressed = w.buf
+	}
+
 	var buf [5]byte
 	buf[0] = byte(entryType)
 	binary.BigEndian.PutUint32(buf[1:5], uint32(len(compressed)))
@@ -1107,13 +1180,25 @@ type WALSegmentReader struct {
 	entry WALEntry
 	n     int64
 	err   error
+
+	// keys provides the master key of encrypted segments.  aead is set once
+	// the envelope of an encrypted segment has been read.
+	keys keyring.Provider
+	aead cipher.AEAD
 }
 
 // NewWALSegmentReader returns a new WALSegmentReader reading from r.
 func NewWALSegmentReader(r io.ReadCloser) *WALSegmentReader {
+	return NewWALSegmentReaderWithKeys(r, nil)
+}
+
+// NewWALSegmentReaderWithKeys returns a new WALSegmentReader reading from r.  The
+// data keys of encrypted segments are decrypted with master keys from keys.
+func NewWALSegmentReaderWithKeys(r io.ReadCloser, keys keyring.Provider) *WALSegmentReader {
 	return &WALSegmentReader{
-		rc: r,
-		r:  bufio.NewReader(r),
+		rc:   r,
+		r:    bufio.NewReader(r),
+		keys: keys,
 	}
 }
 
@@ -1123,12 +1208,47 @@ func (r *WALSegmentReader) Reset(rc io.ReadCloser) {
 	r.entry = nil
 	r.n = 0
 	r.err = nil
+	r.aead = nil
+}
+
+// readEnvelope reads the envelope at the start of an encrypted segment.  Plain
+// segments are left unread.
+func (r *WALSegmentReader) readEnvelope() error {
+	if b, err := r.r.Peek(walEnvelopeOffset); err != nil || binary.BigEndian.Uint32(b) != walMagicNumber {
+		return nil
+	}
+
+	var hdr [walEnvelopeOffset + envelopeSize]byte
+	if _, err := io.ReadFull(r.r, hdr[:]); err != nil {
+		return err
+	}
+
+	var env envelope
+	if err := env.UnmarshalBinary(hdr[walEnvelopeOffset:]); err != nil {
+		return err
+	}
+
+	aead, err := env.cipher(r.keys)
+	if err != nil {
+		return err
+	}
+	r.aead = aead
+	r.n = int64(len(hdr))
+	return nil
 }
 
 // Next indicates if there is a value to read.
 func (r *WALSegmentReader) Next() bool {
 	var nReadOK int
 
+	// Encrypted segments start with the envelope holding their data key.
+	if r.n == 0 && r.aead == nil {
+		if err := r.readEnvelope(); err != nil {
+			r.err = err
+			return true
+		}
+	}
+
 	// read the type and the length of the entry
 	var lv [5]byte
 	n, err := io.ReadFull(r.r, lv[:])
@@ -1158,7 +1278,18 @@ func (r *WALSegmentReader) Next() bool {
 	}
 	nReadOK += n
 
-	decLen, err := snappy.DecodedLen(b[:length])
+	compressed := b[:length]
+	if r.aead != nil {
+		plain := *(getBuf(int(length)))
+		defer putBuf(&plain)
+
+		if compressed, err = open(r.aead, plain[:0], compressed, lv[:1]); err != nil {
+			r.err = err
+			return true
+		}
+	}
+
+	decLen, err := snappy.DecodedLen(compressed)
 	if err != nil {
 		r.err = err
 		return true
@@ -1166,7 +1297,7 @@ func (r *WALSegmentReader) Next() bool {
 	decBuf := *(getBuf(decLen))
 	defer putBuf(&decBuf)
 
-	data, err := snappy.Decode(decBuf, b[:length])
+	data, err := snappy.Decode(decBuf, compressed)
 	if err != nil {
 		r.err = err
 		return true
@@ -9,7 +9,7 @@ A TSM file is composed for four sections: header, blocks, index and the footer.
 └────────┴────────────────────────────────────┴─────────────┴──────────────┘
 
 Header is composed of a magic number to identify the file type and a version
-number.
+number.  Encrypted files are described in encryption.go.
 
 ┌───────────────────┐
 │      Header       │
@@ -65,6 +65,7 @@ The last section is the footer that stores the offset of the start of the index.
 import (
 	"bufio"
 	"bytes"
+	"crypto/cipher"
 	"encoding/binary"
 	"fmt"
 	"hash/crc32"
@@ -73,6 +74,8 @@ import (
 	"sort"
 	"strings"
 	"time"
+
+	"github.com/influxdata/influxdb/pkg/keyring"
 )
 
 const (
@@ -548,6 +551,11 @@ type tsmWriter struct {
 
 	// The bytes written count of when we last fsync'd
 	lastSync int64
+
+	// env and aead are set if the file is encrypted.  buf holds sealed data.
+	env  *envelope
+	aead cipher.AEAD
+	buf  []byte
 }
 
 // NewTSMWriter returns a new TSMWriter writing to w.
@@ -575,19 +583,59 @@ func NewTSMWriterWithDiskBuffer(w io.Writer) (TSMWriter, error) {
 	return &tsmWriter{wrapped: w, w: bufio.NewWriterSize(w, 1024*1024), index: index}, nil
 }
 
+// NewTSMWriterWithKeys returns a new TSMWriter writing an encrypted file to w.
+// The file is encrypted with a new data key sealed by the active key of keys.
+// Since the index is sealed as a whole, it is always buffered in memory.
+func NewTSMWriterWithKeys(w io.Writer, keys keyring.Provider) (TSMWriter, error) {
+	env, aead, err := newEnvelope(keys)
+	if err != nil {
+		return nil, err
+	}
+	return &tsmWriter{wrapped: w, w: bufio.NewWriterSize(w, 1024*1024), index: NewIndexWriter(), env: env, aead: aead}, nil
+}
+
 func (t *tsmWriter) writeHeader() error {
 	var buf [5]byte
 	binary.BigEndian.PutUint32(buf[0:4], MagicNumber)
 	buf[4] = Version
+	if t.env != nil {
+		buf[4] = EncryptedVersion
+	}
 
 	n, err := t.w.Write(buf[:])
 	if err != nil {
 		return err
 	}
 	t.n = int64(n)
+
+	if t.env == nil {
+		return nil
+	}
+
+	b, err := t.env.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	n, err = t.w.Write(b)
+	if err != nil {
+		return err
+	}
+	t.n += int64(n)
 	return nil
 }
 
+// seal returns b sealed with the data key of the file if it is encrypted. The
+// offset b is written at is authenticated so blocks cannot be moved.
+func (t *tsmWriter) seal(b []byte, offset int64) ([]byte, error) {
+	if t.aead == nil {
+		return b, nil
+	}
+
+	var err error
+	t.buf, err = seal(t.aead, t.buf[:0], b, offsetData(offset))
+	return t.buf, err
+}
+
 // Write writes a new block containing key and values.
 func (t *tsmWriter) Write(key []byte, values Values) error {
 	if len(key) > maxKeyLength {
@@ -616,6 +664,10 @@ func (t *tsmWriter) Write(key []byte, values Values) error {
 		return err
 	}
 
+	if block, err = t.seal(block, t.n); err != nil {
+		return err
+	}
+
 	var checksum [crc32.Size]byte
 	binary.BigEndian.PutUint32(checksum[:], crc32.ChecksumIEEE(block))
 
@@ -668,6 +720,10 @@ func (t *tsmWriter) WriteBlock(key []byte, minTime, maxTime int64, block []byte)
 		}
 	}
 
+	if block, err = t.seal(block, t.n); err != nil {
+		return err
+	}
+
 	var checksum [crc32.Size]byte
 	binary.BigEndian.PutUint32(checksum[:], crc32.ChecksumIEEE(block))
 
@@ -719,7 +775,18 @@ func (t *tsmWriter) WriteIndex() error {
 	}
 
 	// Write the index
-	if _, err := t.index.WriteTo(t.w); err != nil {
+	if t.aead != nil {
+		b, err := t.index.MarshalBinary()
+		if err != nil {
+			return err
+		}
+		if b, err = t.seal(b, indexPos); err != nil {
+			return err
+		}
+		if _, err := t.w.Write(b); err != nil {
+			return err
+		}
+	} else if _, err := t.index.WriteTo(t.w); err != nil {
 		return err
 	}
 
@@ -780,11 +847,14 @@ func (t *tsmWriter) Remove() error {
 }
 
 func (t *tsmWriter) Size() uint32 {
+	if t.aead != nil {
+		return uint32(t.n) + t.index.Size() + sealOverhead
+	}
 	return uint32(t.n) + t.index.Size()
 }
 
 // verifyVersion verifies that the reader's bytes are a TSM byte
-// stream of the correct version (1)
+// stream of the correct version (1), or an encrypted TSM byte stream (2).
 func verifyVersion(r io.ReadSeeker) error {
 	_, err := r.Seek(0, 0)
 	if err != nil {
@@ -802,7 +872,7 @@ func verifyVersion(r io.ReadSeeker) error {
 	if err != nil {
 		return fmt.Errorf("init: error reading version: %v", err)
 	}
-	if b[0] != Version {
+	if b[0] != Version && b[0] != EncryptedVersion {
 		return fmt.Errorf("init: file is version %b. expected %b", b[0], Version)
 	}
 
@@ -0,0 +1,155 @@
+package tsdb
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/influxdata/influxdb/logger"
+	"github.com/influxdata/influxdb/models"
+	"github.com/influxdata/influxdb/pkg/limiter"
+	"go.uber.org/zap"
+)
+
+// Statistics gathered by the scrubber.
+const (
+	statScrubRuns          = "scrubs"
+	statScrubErrors        = "scrubErr"
+	statScrubFiles         = "filesVerified"
+	statScrubBlocks        = "blocksVerified"
+	statScrubBytes         = "bytesVerified"
+	statScrubCorruptFiles  = "filesCorrupt"
+	statScrubLastDuration  = "lastScrubDuration"
+	statScrubLastCompleted = "lastScrubCompleted"
+)
+
+// scrubMinBurst is the minimum burst size of the scrub rate limiter.
+const scrubMinBurst = 1024 * 1024 // 1MB
+
+// ScrubReport describes the files verified by a scrub of a shard.
+type ScrubReport struct {
+	FilesN  int
+	BlocksN int
+	BytesN  int64
+
+	// Corrupt holds the files which failed verification. These files have
+	// been quarantined and are no longer read by the shard.
+	Corrupt []CorruptFile
+}
+
+// CorruptFile describes a file which failed verification.
+type CorruptFile struct {
+	Path string
+	Err  error
+}
+
+// ScrubStatistics keeps statistics about scrubs of the store's shards.
+type ScrubStatistics struct {
+	Runs          int64
+	Errors        int64
+	Files         int64
+	Blocks        int64
+	Bytes         int64
+	CorruptFiles  int64
+	LastDuration  int64
+	LastCompleted int64
+}
+
+// scrubShards periodically verifies the files of every shard until the store is closed.
+func (s *Store) scrubShards() {
+	defer s.wg.Done()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-s.closing:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	var lim limiter.Rate
+	if n := int(s.EngineOptions.Config.ScrubThroughput); n > 0 {
+		// Each block is read whole, so the burst must be large enough to hold
+		// the largest block.
+		burst := n
+		if burst < scrubMinBurst {
+			burst = scrubMinBurst
+		}
+		lim = limiter.NewRate(n, burst)
+	}
+
+	t := time.NewTicker(time.Duration(s.EngineOptions.Config.ScrubInterval))
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+			s.scrub(ctx, lim)
+		}
+	}
+}
+
+// scrub verifies the files of every shard, one shard at a time.
+func (s *Store) scrub(ctx context.Context, lim limiter.Rate) {
+	log, logEnd := logger.NewOperation(s.Logger, "Scrub shards", "tsdb_scrub")
+	defer logEnd()
+
+	s.mu.RLock()
+	shards := s.shardsSlice()
+	s.mu.RUnlock()
+
+	start := time.Now()
+	for _, sh := range shards {
+		report, err := sh.Scrub(ctx, lim)
+		if ctx.Err() != nil {
+			log.Info("Scrub interrupted")
+			return
+		} else if err == ErrEngineClosed || err == ErrShardDisabled {
+			// The shard is being deleted or has not finished opening.
+			continue
+		} else if err != nil {
+			atomic.AddInt64(&s.scrubStats.Errors, 1)
+			log.Warn("Error scrubbing shard", zap.Uint64("shard", sh.ID()), zap.Error(err))
+			continue
+		}
+
+		atomic.AddInt64(&s.scrubStats.Files, int64(report.FilesN))
+		atomic.AddInt64(&s.scrubStats.Blocks, int64(report.BlocksN))
+		atomic.AddInt64(&s.scrubStats.Bytes, report.BytesN)
+		atomic.AddInt64(&s.scrubStats.CorruptFiles, int64(len(report.Corrupt)))
+
+		for _, f := range report.Corrupt {
+			log.Error("Quarantined corrupt file",
+				zap.Uint64("shard", sh.ID()),
+				zap.String("db", sh.Database()),
+				zap.String("rp", sh.RetentionPolicy()),
+				zap.String("path", f.Path),
+				zap.Error(f.Err))
+		}
+	}
+
+	atomic.AddInt64(&s.scrubStats.Runs, 1)
+	atomic.StoreInt64(&s.scrubStats.LastDuration, int64(time.Since(start)))
+	atomic.StoreInt64(&s.scrubStats.LastCompleted, time.Now().UnixNano())
+}
+
+// scrubStatistics returns the statistics of the scrubber.
+func (s *Store) scrubStatistics(tags map[string]string) models.Statistic {
+	return models.Statistic{
+		Name: "scrub",
+		Tags: tags,
+		Values: map[string]interface{}{
+			statScrubRuns:          atomic.LoadInt64(&s.scrubStats.Runs),
+			statScrubErrors:        atomic.LoadInt64(&s.scrubStats.Errors),
+			statScrubFiles:         atomic.LoadInt64(&s.scrubStats.Files),
+			statScrubBlocks:        atomic.LoadInt64(&s.scrubStats.Blocks),
+			statScrubBytes:         atomic.LoadInt64(&s.scrubStats.Bytes),
+			statScrubCorruptFiles:  atomic.LoadInt64(&s.scrubStats.CorruptFiles),
+			statScrubLastDuration:  atomic.LoadInt64(&s.scrubStats.LastDuration),
+			statScrubLastCompleted: atomic.LoadInt64(&s.scrubStats.LastCompleted),
+		},
+	}
+}
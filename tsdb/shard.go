@@ -447,6 +447,47 @@ func (s *Shard) SetCompactionsEnabled(enabled bool) {
 	engine.SetCompactionsEnabled(enabled)
 }
 
+// SetCompactionsPaused pauses or resumes shard level and full compactions.
+// Running compactions are aborted when paused.  Unlike SetCompactionsEnabled,
+// a pause is not undone when the store re-enables compactions.
+func (s *Shard) SetCompactionsPaused(paused bool) error {
+	engine, err := s.engine()
+	if err != nil {
+		return err
+	}
+	engine.SetCompactionsPaused(paused)
+	return nil
+}
+
+// CompactionsPaused returns true if compactions of the shard are paused.
+func (s *Shard) CompactionsPaused() (bool, error) {
+	engine, err := s.engine()
+	if err != nil {
+		return false, err
+	}
+	return engine.CompactionsPaused(), nil
+}
+
+// CancelCompactions aborts the running level and full compactions of the
+// shard.  The files are compacted again when next planned.
+func (s *Shard) CancelCompactions() error {
+	engine, err := s.engine()
+	if err != nil {
+		return err
+	}
+	engine.CancelCompactions()
+	return nil
+}
+
+// Compactions returns the running and planned compactions of the shard.
+func (s *Shard) Compactions() ([]CompactionInfo, error) {
+	engine, err := s.engine()
+	if err != nil {
+		return nil, err
+	}
+	return engine.Compactions(), nil
+}
+
 // DiskSize returns the size on disk of this shard.
 func (s *Shard) DiskSize() (int64, error) {
 	s.mu.RLock()
@@ -461,6 +502,28 @@ func (s *Shard) DiskSize() (int64, error) {
 	return size, nil
 }
 
+// Scrub verifies the checksums and index of each of the shard's TSM files,
+// limiting the rate at which files are read to lim. Corrupt files are
+// quarantined and no longer read by the shard.
+func (s *Shard) Scrub(ctx context.Context, lim limiter.Rate) (ScrubReport, error) {
+	engine, err := s.engine()
+	if err != nil {
+		return ScrubReport{}, err
+	}
+	return engine.Scrub(ctx, lim)
+}
+
+// ColdDiskSize returns the size of the shard's files in the cold directory.
+// The size is included in the result of DiskSize.
+func (s *Shard) ColdDiskSize() (int64, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s._engine == nil {
+		return 0, ErrEngineClosed
+	}
+	return s._engine.ColdDiskSize(), nil
+}
+
 // FieldCreate holds information for a field to create on a measurement.
 type FieldCreate struct {
 	Measurement []byte
@@ -1353,6 +1416,26 @@ func (a Shards) IteratorCost(measurement string, opt query.IteratorOptions) (que
 	return costs, costerr
 }
 
+// IndexSet returns an IndexSet over the indexes of the shards. Shards which
+// are not open are skipped.
+func (a Shards) IndexSet() (IndexSet, error) {
+	is := IndexSet{Indexes: make([]Index, 0, len(a))}
+	for _, sh := range a {
+		if idx, err := sh.Index(); err == nil {
+			is.Indexes = append(is.Indexes, idx)
+		}
+		if is.SeriesFile == nil {
+			is.SeriesFile, _ = sh.seriesFile()
+		}
+	}
+
+	if is.SeriesFile == nil {
+		return IndexSet{}, errors.New("IndexSet: no series file")
+	}
+
+	return is.DedupeInmemIndexes(), nil
+}
+
 func (a Shards) CreateSeriesCursor(ctx context.Context, req SeriesCursorRequest, cond influxql.Expr) (_ SeriesCursor, err error) {
 	var (
 		idxs  []Index
@@ -209,6 +209,26 @@ func TestMaxSeriesLimit(t *testing.T) {
 	sh.Close()
 }
 
+// Ensure a shard configured with an unknown block codec fails to open.
+func TestShard_Open_UnknownBlockCodec(t *testing.T) {
+	tmpDir, _ := ioutil.TempDir("", "shard_test")
+	defer os.RemoveAll(tmpDir)
+
+	sfile := MustOpenSeriesFile()
+	defer sfile.Close()
+
+	opts := tsdb.NewEngineOptions()
+	opts.Config.WALDir = filepath.Join(tmpDir, "wal")
+	opts.Config.StringBlockCodec = "lz4"
+	opts.InmemIndex = inmem.NewIndex(path.Base(tmpDir), sfile.SeriesFile)
+
+	sh := tsdb.NewShard(1, path.Join(tmpDir, "shard"), path.Join(tmpDir, "wal"), sfile.SeriesFile, opts)
+	if err := sh.Open(); err == nil || !strings.Contains(err.Error(), "lz4") {
+		sh.Close()
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
 func TestShard_MaxTagValuesLimit(t *testing.T) {
 	tmpDir, _ := ioutil.TempDir("", "shard_test")
 	defer os.RemoveAll(tmpDir)
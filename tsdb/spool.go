@@ -0,0 +1,495 @@
+package tsdb
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/influxdata/influxdb"
+	"github.com/influxdata/influxdb/logger"
+	"github.com/influxdata/influxdb/models"
+	"go.uber.org/zap"
+)
+
+const (
+	// SpoolFileExtension is the extension of point spool segment files.
+	SpoolFileExtension = "spool"
+
+	// DefaultSpoolMaxSize is the default maximum size of a point spool.
+	DefaultSpoolMaxSize = 512 * 1024 * 1024
+
+	// SpoolRetryInterval is how often spooled batches are retried when no
+	// new batches are received.
+	SpoolRetryInterval = time.Second
+
+	// SpoolPolicyDropNewest rejects new batches when the spool is full.
+	SpoolPolicyDropNewest = "drop-newest"
+
+	// SpoolPolicyDropOldest discards the oldest batches to make room for new
+	// batches when the spool is full.
+	SpoolPolicyDropOldest = "drop-oldest"
+
+	// spoolSegmentSize is the size after which a new segment is started.
+	spoolSegmentSize = 4 * 1024 * 1024
+
+	// spoolRecordHeaderSize is the size of the length and checksum of a batch.
+	spoolRecordHeaderSize = 8
+)
+
+// ErrSpoolFull is returned when a batch cannot be added to a full spool.
+var ErrSpoolFull = errors.New("spool full")
+
+// Statistics gathered by the PointSpool.
+const (
+	statSpoolBatchesSpooled  = "batchesSpooled"
+	statSpoolPointsSpooled   = "pointsSpooled"
+	statSpoolBatchesReplayed = "batchesReplayed"
+	statSpoolPointsReplayed  = "pointsReplayed"
+	statSpoolBatchesDropped  = "batchesDropped"
+	statSpoolPointsDropped   = "pointsDropped"
+	statSpoolBatchesPending  = "batchesPending"
+	statSpoolDiskBytes       = "diskBytes"
+)
+
+// PointSpool is a durable queue of point batches.  Batches are appended to
+// segment files and fsynced, and are removed once they have been written.
+// Batches which were spooled but not written when the process stopped are
+// replayed when the spool is next opened, so a batch may be written more than
+// once.
+type PointSpool struct {
+	// replayMu serializes replays, so that batches are written in order.
+	replayMu sync.Mutex
+
+	mu       sync.Mutex
+	dir      string
+	maxSize  int64
+	policy   string
+	segments []*spoolSegment
+
+	// w is the segment new batches are appended to.  It is always the last
+	// segment, if set.
+	w *os.File
+
+	// r reads the first segment from offset.
+	r      *os.File
+	offset int64
+
+	stats PointSpoolStatistics
+}
+
+// spoolSegment is a file holding spooled batches.
+type spoolSegment struct {
+	id   int
+	path string
+	size int64
+
+	// The number of batches and points in the segment which have not been
+	// written.
+	batchN int
+	pointN int64
+}
+
+// PointSpoolStatistics are the statistics each spool tracks.
+type PointSpoolStatistics struct {
+	BatchesSpooled  int64
+	PointsSpooled   int64
+	BatchesReplayed int64
+	PointsReplayed  int64
+	BatchesDropped  int64
+	PointsDropped   int64
+	BatchesPending  int64
+	DiskBytes       int64
+}
+
+// NewPointSpool returns a spool storing batches in dir.  The spool holds at
+// most maxSize bytes of batches, after which batches are dropped according to
+// policy.
+func NewPointSpool(dir string, maxSize int64, policy string) *PointSpool {
+	return &PointSpool{
+		dir:     dir,
+		maxSize: maxSize,
+		policy:  policy,
+	}
+}
+
+// Open opens the spool, loading any batches spooled before it was last closed.
+func (s *PointSpool) Open() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch s.policy {
+	case SpoolPolicyDropNewest, SpoolPolicyDropOldest:
+	case "":
+		s.policy = SpoolPolicyDropNewest
+	default:
+		return fmt.Errorf("unknown spool policy: %q", s.policy)
+	}
+
+	if err := os.MkdirAll(s.dir, 0777); err != nil {
+		return err
+	}
+
+	fis, err := ioutil.ReadDir(s.dir)
+	if err != nil {
+		return err
+	}
+	for _, fi := range fis {
+		name := fi.Name()
+		if fi.IsDir() || filepath.Ext(name) != "."+SpoolFileExtension {
+			continue
+		}
+		id, err := strconv.Atoi(strings.TrimSuffix(name, filepath.Ext(name)))
+		if err != nil {
+			continue
+		}
+
+		seg := &spoolSegment{id: id, path: filepath.Join(s.dir, name)}
+		if err := seg.load(); err != nil {
+			return err
+		}
+		if seg.batchN == 0 {
+			if err := os.Remove(seg.path); err != nil {
+				return err
+			}
+			continue
+		}
+		s.segments = append(s.segments, seg)
+	}
+	sort.Slice(s.segments, func(i, j int) bool { return s.segments[i].id < s.segments[j].id })
+
+	s.updateStats()
+	return nil
+}
+
+// load counts the batches in the segment.  The segment is truncated after the
+// last complete batch, which removes a batch left partially written by a crash.
+func (seg *spoolSegment) load() error {
+	f, err := os.OpenFile(seg.path, os.O_RDWR, 0666)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	fi, err := f.Stat()
+	if err != nil {
+		return err
+	}
+
+	var offset int64
+	for {
+		points, n, err := readSpoolRecord(f, offset, fi.Size())
+		if err != nil {
+			break
+		}
+		offset += n
+		seg.batchN++
+		seg.pointN += int64(len(points))
+	}
+
+	if err := f.Truncate(offset); err != nil {
+		return err
+	}
+	seg.size = offset
+	return nil
+}
+
+// Close closes the spool.  Spooled batches remain on disk.
+func (s *PointSpool) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.w != nil {
+		s.w.Close()
+		s.w = nil
+	}
+	if s.r != nil {
+		s.r.Close()
+		s.r = nil
+	}
+	s.segments, s.offset = nil, 0
+	return nil
+}
+
+// Append adds a batch to the spool.  The batch is on disk when Append returns.
+func (s *PointSpool) Append(points []models.Point) error {
+	var buf []byte
+	for _, p := range points {
+		buf = p.AppendString(buf)
+		buf = append(buf, '\n')
+	}
+
+	b := make([]byte, spoolRecordHeaderSize, spoolRecordHeaderSize+len(buf))
+	binary.BigEndian.PutUint32(b[0:4], uint32(len(buf)))
+	binary.BigEndian.PutUint32(b[4:8], crc32.ChecksumIEEE(buf))
+	b = append(b, buf...)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.size()+int64(len(b)) > s.maxSize {
+		if s.policy == SpoolPolicyDropOldest {
+			for len(s.segments) > 0 && s.size()+int64(len(b)) > s.maxSize {
+				if err := s.dropSegment(); err != nil {
+					return err
+				}
+			}
+		}
+		if s.size()+int64(len(b)) > s.maxSize {
+			atomic.AddInt64(&s.stats.BatchesDropped, 1)
+			atomic.AddInt64(&s.stats.PointsDropped, int64(len(points)))
+			return ErrSpoolFull
+		}
+	}
+
+	if s.w == nil || s.segments[len(s.segments)-1].size >= spoolSegmentSize {
+		if err := s.createSegment(); err != nil {
+			return err
+		}
+	}
+
+	seg := s.segments[len(s.segments)-1]
+	if _, err := s.w.Write(b); err != nil {
+		return err
+	} else if err := s.w.Sync(); err != nil {
+		return err
+	}
+	seg.size += int64(len(b))
+	seg.batchN++
+	seg.pointN += int64(len(points))
+
+	atomic.AddInt64(&s.stats.BatchesSpooled, 1)
+	atomic.AddInt64(&s.stats.PointsSpooled, int64(len(points)))
+	s.updateStats()
+	return nil
+}
+
+// Replay passes each spooled batch, oldest first, to fn and removes it from
+// the spool.  If fn returns an error, the batch is kept and Replay returns the
+// error.  A batch which fails with a PartialWriteError is removed, as writing
+// it again would fail in the same way.  A batch rejected with a client error,
+// such as a field type conflict, is dropped and the error is returned.  The
+// spool is not locked while fn runs, so batches can be appended meanwhile.
+func (s *PointSpool) Replay(fn func(points []models.Point) error) error {
+	s.replayMu.Lock()
+	defer s.replayMu.Unlock()
+
+	for {
+		seg, offset, points, n, err := s.next()
+		if err != nil || seg == nil {
+			return err
+		}
+
+		err = fn(points)
+		_, partial := err.(PartialWriteError)
+		rejected := !partial && influxdb.IsClientError(err)
+		if err != nil && !partial && !rejected {
+			return err
+		}
+
+		s.mu.Lock()
+		// The segment may have been dropped to make room for new batches
+		// while the batch was written.
+		if len(s.segments) > 0 && s.segments[0] == seg && s.offset == offset {
+			s.offset += n
+			seg.batchN--
+			seg.pointN -= int64(len(points))
+			if rejected {
+				atomic.AddInt64(&s.stats.BatchesDropped, 1)
+				atomic.AddInt64(&s.stats.PointsDropped, int64(len(points)))
+			} else {
+				atomic.AddInt64(&s.stats.BatchesReplayed, 1)
+				atomic.AddInt64(&s.stats.PointsReplayed, int64(len(points)))
+			}
+			s.updateStats()
+		}
+		s.mu.Unlock()
+
+		if rejected {
+			return err
+		}
+	}
+}
+
+// WriteSpooled replays the spooled batches with write, which writes a batch to
+// database.  Batches which cannot be written remain in the spool and are
+// retried later, unless the database rejects them.  The error which stops the
+// replay is logged.  It returns the number of batches and points written and
+// the number of batches which failed.
+func (s *PointSpool) WriteSpooled(write func(points []models.Point) error, log *zap.Logger, database string) (batchN, pointN, failN int64) {
+	if err := s.Replay(func(points []models.Point) error {
+		if err := write(points); err != nil {
+			failN++
+			return err
+		}
+		batchN++
+		pointN += int64(len(points))
+		return nil
+	}); err != nil {
+		log.Info("Failed to write spooled point batch to database",
+			logger.Database(database), zap.Error(err))
+	}
+	return batchN, pointN, failN
+}
+
+// next returns the oldest spooled batch, along with its segment, offset and
+// size.  Fully written and corrupt segments are removed.  A nil segment is
+// returned if there are no batches.
+func (s *PointSpool) next() (*spoolSegment, int64, []models.Point, int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for len(s.segments) > 0 {
+		seg := s.segments[0]
+		if s.offset >= seg.size {
+			if seg.batchN > 0 || len(s.segments) > 1 || s.w == nil {
+				// The segment has been fully written.
+				if err := s.dropSegment(); err != nil {
+					return nil, 0, nil, 0, err
+				}
+				continue
+			}
+			return nil, 0, nil, 0, nil
+		}
+
+		if s.r == nil {
+			f, err := os.Open(seg.path)
+			if err != nil {
+				return nil, 0, nil, 0, err
+			}
+			s.r = f
+		}
+
+		points, n, err := readSpoolRecord(s.r, s.offset, seg.size)
+		if err != nil {
+			// A corrupt segment cannot be read any further.
+			if err := s.dropSegment(); err != nil {
+				return nil, 0, nil, 0, err
+			}
+			continue
+		}
+		return seg, s.offset, points, n, nil
+	}
+	return nil, 0, nil, 0, nil
+}
+
+// createSegment starts a new segment for appending batches.
+func (s *PointSpool) createSegment() error {
+	var id int
+	if len(s.segments) > 0 {
+		id = s.segments[len(s.segments)-1].id + 1
+	}
+
+	path := filepath.Join(s.dir, fmt.Sprintf("%08d.%s", id, SpoolFileExtension))
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0666)
+	if err != nil {
+		return err
+	}
+
+	if s.w != nil {
+		s.w.Close()
+	}
+	s.w = f
+	s.segments = append(s.segments, &spoolSegment{id: id, path: path})
+	return nil
+}
+
+// dropSegment removes the first segment.  Any batches in it which have not
+// been written are counted as dropped.
+func (s *PointSpool) dropSegment() error {
+	seg := s.segments[0]
+	if s.r != nil {
+		s.r.Close()
+		s.r = nil
+	}
+	if len(s.segments) == 1 && s.w != nil {
+		s.w.Close()
+		s.w = nil
+	}
+
+	if err := os.Remove(seg.path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	atomic.AddInt64(&s.stats.BatchesDropped, int64(seg.batchN))
+	atomic.AddInt64(&s.stats.PointsDropped, seg.pointN)
+
+	s.segments = s.segments[1:]
+	s.offset = 0
+	s.updateStats()
+	return nil
+}
+
+// size returns the number of bytes used by the spool.  s.mu must be held.
+func (s *PointSpool) size() int64 {
+	var n int64
+	for _, seg := range s.segments {
+		n += seg.size
+	}
+	return n
+}
+
+// updateStats updates the pending batch and disk statistics.  s.mu must be held.
+func (s *PointSpool) updateStats() {
+	var batchN int64
+	for _, seg := range s.segments {
+		batchN += int64(seg.batchN)
+	}
+	atomic.StoreInt64(&s.stats.BatchesPending, batchN)
+	atomic.StoreInt64(&s.stats.DiskBytes, s.size())
+}
+
+// Statistics returns statistics for periodic monitoring.
+func (s *PointSpool) Statistics(tags map[string]string) []models.Statistic {
+	return []models.Statistic{{
+		Name: "spool",
+		Tags: tags,
+		Values: map[string]interface{}{
+			statSpoolBatchesSpooled:  atomic.LoadInt64(&s.stats.BatchesSpooled),
+			statSpoolPointsSpooled:   atomic.LoadInt64(&s.stats.PointsSpooled),
+			statSpoolBatchesReplayed: atomic.LoadInt64(&s.stats.BatchesReplayed),
+			statSpoolPointsReplayed:  atomic.LoadInt64(&s.stats.PointsReplayed),
+			statSpoolBatchesDropped:  atomic.LoadInt64(&s.stats.BatchesDropped),
+			statSpoolPointsDropped:   atomic.LoadInt64(&s.stats.PointsDropped),
+			statSpoolBatchesPending:  atomic.LoadInt64(&s.stats.BatchesPending),
+			statSpoolDiskBytes:       atomic.LoadInt64(&s.stats.DiskBytes),
+		},
+	}}
+}
+
+// readSpoolRecord reads the batch at offset in f, a segment of the given size.
+// It returns the points and the size of the record.  A record extending past
+// the end of the segment has a corrupt length, which ends the segment.
+func readSpoolRecord(f io.ReaderAt, offset, size int64) ([]models.Point, int64, error) {
+	var hdr [spoolRecordHeaderSize]byte
+	if _, err := f.ReadAt(hdr[:], offset); err != nil {
+		return nil, 0, err
+	}
+
+	n := int64(binary.BigEndian.Uint32(hdr[0:4]))
+	if offset+spoolRecordHeaderSize+n > size {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+
+	buf := make([]byte, n)
+	if _, err := f.ReadAt(buf, offset+spoolRecordHeaderSize); err != nil {
+		return nil, 0, err
+	} else if crc32.ChecksumIEEE(buf) != binary.BigEndian.Uint32(hdr[4:8]) {
+		return nil, 0, errors.New("spool record checksum mismatch")
+	}
+
+	points, err := models.ParsePointsWithPrecision(buf, time.Now().UTC(), "n")
+	if err != nil {
+		return nil, 0, err
+	}
+	return points, int64(spoolRecordHeaderSize + len(buf)), nil
+}
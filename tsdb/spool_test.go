@@ -0,0 +1,303 @@
+package tsdb_test
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/influxdata/influxdb"
+	"github.com/influxdata/influxdb/models"
+	"github.com/influxdata/influxdb/tsdb"
+	"go.uber.org/zap"
+)
+
+// Ensure spooled batches are replayed in order and survive reopening the spool.
+func TestPointSpool_Replay(t *testing.T) {
+	dir, cleanup := MustTempDir()
+	defer cleanup()
+
+	s := tsdb.NewPointSpool(dir, 1<<20, tsdb.SpoolPolicyDropNewest)
+	if err := s.Open(); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 3; i++ {
+		if err := s.Append(mustParseSpoolPoints(i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// A failed write keeps the batch in the spool.
+	errWrite := errors.New("write failed")
+	var got []string
+	if err := s.Replay(func(points []models.Point) error {
+		if len(got) == 1 {
+			return errWrite
+		}
+		got = append(got, points[0].String())
+		return nil
+	}); err != errWrite {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// The remaining batches are replayed once the spool is reopened.
+	if err := s.Close(); err != nil {
+		t.Fatal(err)
+	}
+	s = tsdb.NewPointSpool(dir, 1<<20, tsdb.SpoolPolicyDropNewest)
+	if err := s.Open(); err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	if err := s.Replay(func(points []models.Point) error {
+		got = append(got, points[0].String())
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	// The first batch was replayed before the spool was closed, so it is
+	// replayed again.
+	if exp := []string{"cpu value=0 0", "cpu value=0 0", "cpu value=1 1", "cpu value=2 2"}; !reflect.DeepEqual(got, exp) {
+		t.Fatalf("unexpected batches: %v", got)
+	}
+	if stats := s.Statistics(nil); stats[0].Values["batchesPending"] != int64(0) {
+		t.Fatalf("unexpected statistics: %v", stats[0].Values)
+	}
+}
+
+// Ensure a full spool drops new batches or the oldest batches according to its
+// policy.
+func TestPointSpool_Full(t *testing.T) {
+	for _, policy := range []string{tsdb.SpoolPolicyDropNewest, tsdb.SpoolPolicyDropOldest} {
+		t.Run(policy, func(t *testing.T) {
+			dir, cleanup := MustTempDir()
+			defer cleanup()
+
+			// The spool only has room for one batch.
+			s := tsdb.NewPointSpool(dir, 30, policy)
+			if err := s.Open(); err != nil {
+				t.Fatal(err)
+			}
+			defer s.Close()
+
+			if err := s.Append(mustParseSpoolPoints(0)); err != nil {
+				t.Fatal(err)
+			}
+			err := s.Append(mustParseSpoolPoints(1))
+			if policy == tsdb.SpoolPolicyDropNewest && err != tsdb.ErrSpoolFull {
+				t.Fatalf("unexpected error: %v", err)
+			} else if policy == tsdb.SpoolPolicyDropOldest && err != nil {
+				t.Fatal(err)
+			}
+
+			var got []string
+			if err := s.Replay(func(points []models.Point) error {
+				got = append(got, points[0].String())
+				return nil
+			}); err != nil {
+				t.Fatal(err)
+			}
+
+			exp := []string{"cpu value=0 0"}
+			if policy == tsdb.SpoolPolicyDropOldest {
+				exp = []string{"cpu value=1 1"}
+			}
+			if !reflect.DeepEqual(got, exp) {
+				t.Fatalf("unexpected batches: %v", got)
+			} else if n := s.Statistics(nil)[0].Values["batchesDropped"]; n != int64(1) {
+				t.Fatalf("unexpected dropped batches: %v", n)
+			}
+		})
+	}
+}
+
+// Ensure a batch which fails with a partial write is not retried.
+func TestPointSpool_Replay_PartialWrite(t *testing.T) {
+	dir, cleanup := MustTempDir()
+	defer cleanup()
+
+	s := tsdb.NewPointSpool(dir, 1<<20, tsdb.SpoolPolicyDropNewest)
+	if err := s.Open(); err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	if err := s.Append(mustParseSpoolPoints(0)); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Replay(func(points []models.Point) error {
+		return tsdb.PartialWriteError{Reason: "field type conflict", Dropped: 1}
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Replay(func(points []models.Point) error {
+		t.Fatal("unexpected batch")
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Only the segment new batches are appended to remains once all batches
+	// are written.
+	if fis, err := ioutil.ReadDir(dir); err != nil {
+		t.Fatal(err)
+	} else if len(fis) > 1 {
+		t.Fatalf("unexpected spool files: %d", len(fis))
+	}
+}
+
+// Ensure a batch rejected by the database is dropped rather than retried.
+func TestPointSpool_Replay_Rejected(t *testing.T) {
+	dir, cleanup := MustTempDir()
+	defer cleanup()
+
+	s := tsdb.NewPointSpool(dir, 1<<20, tsdb.SpoolPolicyDropNewest)
+	if err := s.Open(); err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	for i := 0; i < 2; i++ {
+		if err := s.Append(mustParseSpoolPoints(i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := s.Replay(func(points []models.Point) error {
+		return influxdb.ErrFieldTypeConflict
+	}); err != influxdb.ErrFieldTypeConflict {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var got []string
+	if err := s.Replay(func(points []models.Point) error {
+		got = append(got, points[0].String())
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if exp := []string{"cpu value=1 1"}; !reflect.DeepEqual(got, exp) {
+		t.Fatalf("unexpected batches: %v", got)
+	} else if n := s.Statistics(nil)[0].Values["batchesDropped"]; n != int64(1) {
+		t.Fatalf("unexpected dropped batches: %v", n)
+	}
+}
+
+// Ensure spooled batches are written until a write fails, and counted.
+func TestPointSpool_WriteSpooled(t *testing.T) {
+	dir, cleanup := MustTempDir()
+	defer cleanup()
+
+	s := tsdb.NewPointSpool(dir, 1<<20, tsdb.SpoolPolicyDropNewest)
+	if err := s.Open(); err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	for i := 0; i < 3; i++ {
+		if err := s.Append(mustParseSpoolPoints(i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var writeN int
+	batchN, pointN, failN := s.WriteSpooled(func(points []models.Point) error {
+		if writeN++; writeN == 3 {
+			return errors.New("write failed")
+		}
+		return nil
+	}, zap.NewNop(), "db0")
+	if batchN != 2 || pointN != 2 || failN != 1 {
+		t.Fatalf("unexpected counts: batches %d, points %d, failed %d", batchN, pointN, failN)
+	} else if n := s.Statistics(nil)[0].Values["batchesPending"]; n != int64(1) {
+		t.Fatalf("unexpected pending batches: %v", n)
+	}
+}
+
+// Ensure batches can be appended while the spool is replayed.
+func TestPointSpool_Replay_Append(t *testing.T) {
+	dir, cleanup := MustTempDir()
+	defer cleanup()
+
+	s := tsdb.NewPointSpool(dir, 1<<20, tsdb.SpoolPolicyDropNewest)
+	if err := s.Open(); err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	if err := s.Append(mustParseSpoolPoints(0)); err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	if err := s.Replay(func(points []models.Point) error {
+		if len(got) == 0 {
+			if err := s.Append(mustParseSpoolPoints(1)); err != nil {
+				return err
+			}
+		}
+		got = append(got, points[0].String())
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if exp := []string{"cpu value=0 0", "cpu value=1 1"}; !reflect.DeepEqual(got, exp) {
+		t.Fatalf("unexpected batches: %v", got)
+	}
+}
+
+// Ensure a record with a corrupt length ends its segment.
+func TestPointSpool_Open_CorruptLength(t *testing.T) {
+	dir, cleanup := MustTempDir()
+	defer cleanup()
+
+	s := tsdb.NewPointSpool(dir, 1<<20, tsdb.SpoolPolicyDropNewest)
+	if err := s.Open(); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Append(mustParseSpoolPoints(0)); err != nil {
+		t.Fatal(err)
+	} else if err := s.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Append the header of a record claiming to be far larger than the segment.
+	path := filepath.Join(dir, "00000000."+tsdb.SpoolFileExtension)
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0666)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.Write([]byte{0xff, 0xff, 0xff, 0xf0, 0, 0, 0, 0}); err != nil {
+		t.Fatal(err)
+	} else if err := f.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	s = tsdb.NewPointSpool(dir, 1<<20, tsdb.SpoolPolicyDropNewest)
+	if err := s.Open(); err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	var got []string
+	if err := s.Replay(func(points []models.Point) error {
+		got = append(got, points[0].String())
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if exp := []string{"cpu value=0 0"}; !reflect.DeepEqual(got, exp) {
+		t.Fatalf("unexpected batches: %v", got)
+	}
+}
+
+func mustParseSpoolPoints(i int) []models.Point {
+	points, err := models.ParsePointsString(fmt.Sprintf("cpu value=%d %d", i, i))
+	if err != nil {
+		panic(err)
+	}
+	return points
+}
@@ -20,6 +20,7 @@ import (
 
 	"github.com/influxdata/influxdb/models"
 	"github.com/influxdata/influxdb/pkg/estimator"
+	"github.com/influxdata/influxdb/pkg/keyring"
 	"github.com/influxdata/influxdb/pkg/limiter"
 	"github.com/influxdata/influxdb/query"
 	"github.com/influxdata/influxql"
@@ -61,6 +62,8 @@ type Store struct {
 
 	EngineOptions EngineOptions
 
+	scrubStats *ScrubStatistics
+
 	baseLogger *zap.Logger
 	Logger     *zap.Logger
 
@@ -80,6 +83,7 @@ func NewStore(path string) *Store {
 		indexes:             make(map[string]interface{}),
 		pendingShardDeletes: make(map[uint64]struct{}),
 		EngineOptions:       NewEngineOptions(),
+		scrubStats:          &ScrubStatistics{},
 		Logger:              logger,
 		baseLogger:          logger,
 	}
@@ -130,6 +134,10 @@ func (s *Store) Statistics(tags map[string]string) []models.Statistic {
 	for _, shard := range shards {
 		statistics = append(statistics, shard.Statistics(tags)...)
 	}
+
+	if s.EngineOptions.Config.ScrubInterval > 0 {
+		statistics = append(statistics, s.scrubStatistics(tags))
+	}
 	return statistics
 }
 
@@ -157,6 +165,17 @@ func (s *Store) Open() error {
 		return err
 	}
 
+	// Load the keyring used to encrypt shard files, unless a key provider has
+	// already been set.
+	if path := s.EngineOptions.Config.EncryptionKeyring; path != "" && s.EngineOptions.KeyProvider == nil {
+		keys, err := keyring.Open(path)
+		if err != nil {
+			return err
+		}
+		s.EngineOptions.KeyProvider = keys
+		s.Logger.Info("Encrypting shard files", zap.String("keyring", path))
+	}
+
 	if err := s.loadShards(); err != nil {
 		return err
 	}
@@ -165,6 +184,11 @@ func (s *Store) Open() error {
 	s.wg.Add(1)
 	go s.monitorShards()
 
+	if s.EngineOptions.Config.ScrubInterval > 0 {
+		s.wg.Add(1)
+		go s.scrubShards()
+	}
+
 	return nil
 }
 
@@ -280,6 +304,8 @@ func (s *Store) loadShards() error {
 					// Copy options and assign shared index.
 					opt := s.EngineOptions
 					opt.InmemIndex = idx
+					opt.ColdPath = s.coldPath(db, rp, sh)
+					opt.RetentionPolicy = rp
 
 					// Provide an implementation of the ShardIDSets
 					opt.SeriesIDSets = shardSet{store: s, db: db}
@@ -511,6 +537,8 @@ func (s *Store) CreateShard(database, retentionPolicy string, shardID uint64, en
 	opt := s.EngineOptions
 	opt.InmemIndex = idx
 	opt.SeriesIDSets = shardSet{store: s, db: database}
+	opt.ColdPath = s.coldPath(database, retentionPolicy, strconv.FormatUint(shardID, 10))
+	opt.RetentionPolicy = retentionPolicy
 
 	path := filepath.Join(s.path, database, retentionPolicy, strconv.FormatUint(shardID, 10))
 	shard := NewShard(shardID, path, walPath, sfile, opt)
@@ -633,9 +661,24 @@ func (s *Store) DeleteShard(shardID uint64) error {
 		return err
 	}
 
+	if sh.options.ColdPath != "" {
+		if err := os.RemoveAll(sh.options.ColdPath); err != nil {
+			return err
+		}
+	}
+
 	return os.RemoveAll(sh.walPath)
 }
 
+// coldPath returns the path under the cold directory for the given path
+// elements. Returns an empty string if no cold directory is configured.
+func (s *Store) coldPath(elem ...string) string {
+	if s.EngineOptions.Config.ColdDir == "" {
+		return ""
+	}
+	return filepath.Join(append([]string{s.EngineOptions.Config.ColdDir}, elem...)...)
+}
+
 // DeleteDatabase will close all shards associated with a database and remove the directory and files from disk.
 func (s *Store) DeleteDatabase(name string) error {
 	s.mu.RLock()
@@ -686,6 +729,11 @@ func (s *Store) DeleteDatabase(name string) error {
 	if err := os.RemoveAll(filepath.Join(s.EngineOptions.Config.WALDir, name)); err != nil {
 		return err
 	}
+	if path := s.coldPath(name); path != "" {
+		if err := os.RemoveAll(path); err != nil {
+			return err
+		}
+	}
 
 	for _, sh := range shards {
 		delete(s.shards, sh.id)
@@ -745,6 +793,13 @@ func (s *Store) DeleteRetentionPolicy(database, name string) error {
 		return err
 	}
 
+	// Remove the retention policy folder from the cold directory.
+	if path := s.coldPath(database, name); path != "" {
+		if err := os.RemoveAll(path); err != nil {
+			return err
+		}
+	}
+
 	s.mu.Lock()
 	for _, sh := range shards {
 		delete(s.shards, sh.id)